@@ -19,13 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ArticleService_Save_FullMethodName             = "/article.v1.ArticleService/Save"
-	ArticleService_Publish_FullMethodName          = "/article.v1.ArticleService/Publish"
-	ArticleService_Withdraw_FullMethodName         = "/article.v1.ArticleService/Withdraw"
-	ArticleService_List_FullMethodName             = "/article.v1.ArticleService/List"
-	ArticleService_GetById_FullMethodName          = "/article.v1.ArticleService/GetById"
-	ArticleService_GetPublishedById_FullMethodName = "/article.v1.ArticleService/GetPublishedById"
-	ArticleService_ListPub_FullMethodName          = "/article.v1.ArticleService/ListPub"
+	ArticleService_Save_FullMethodName              = "/article.v1.ArticleService/Save"
+	ArticleService_Publish_FullMethodName           = "/article.v1.ArticleService/Publish"
+	ArticleService_Withdraw_FullMethodName          = "/article.v1.ArticleService/Withdraw"
+	ArticleService_List_FullMethodName              = "/article.v1.ArticleService/List"
+	ArticleService_GetById_FullMethodName           = "/article.v1.ArticleService/GetById"
+	ArticleService_GetPublishedById_FullMethodName  = "/article.v1.ArticleService/GetPublishedById"
+	ArticleService_ListPub_FullMethodName           = "/article.v1.ArticleService/ListPub"
+	ArticleService_GetPublishedByIds_FullMethodName = "/article.v1.ArticleService/GetPublishedByIds"
+	ArticleService_ListRevisions_FullMethodName     = "/article.v1.ArticleService/ListRevisions"
+	ArticleService_GetRevision_FullMethodName       = "/article.v1.ArticleService/GetRevision"
+	ArticleService_DiffRevisions_FullMethodName     = "/article.v1.ArticleService/DiffRevisions"
+	ArticleService_RestoreRevision_FullMethodName   = "/article.v1.ArticleService/RestoreRevision"
+	ArticleService_SchedulePublish_FullMethodName   = "/article.v1.ArticleService/SchedulePublish"
+	ArticleService_CancelSchedule_FullMethodName    = "/article.v1.ArticleService/CancelSchedule"
 )
 
 // ArticleServiceClient is the client API for ArticleService service.
@@ -39,6 +46,18 @@ type ArticleServiceClient interface {
 	GetById(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetByIdResponse, error)
 	GetPublishedById(ctx context.Context, in *GetPublishedByIdRequest, opts ...grpc.CallOption) (*GetPublishedByIdResponse, error)
 	ListPub(ctx context.Context, in *ListPubRequest, opts ...grpc.CallOption) (*ListPubResponse, error)
+	// GetPublishedByIds 批量查询线上库的文章，不会产生阅读事件，给热榜之类的内部服务用。
+	// 不存在的文章不会出现在结果里面
+	GetPublishedByIds(ctx context.Context, in *GetPublishedByIdsRequest, opts ...grpc.CallOption) (*GetPublishedByIdsResponse, error)
+	// 历史版本相关，每一次 Save/Publish 都会产生一个不可变的版本
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error)
+	DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error)
+	// RestoreRevision 将某个历史版本恢复为当前草稿
+	RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error)
+	// SchedulePublish 定时发表，到时间之后由定时任务发表
+	SchedulePublish(ctx context.Context, in *SchedulePublishRequest, opts ...grpc.CallOption) (*SchedulePublishResponse, error)
+	CancelSchedule(ctx context.Context, in *CancelScheduleRequest, opts ...grpc.CallOption) (*CancelScheduleResponse, error)
 }
 
 type articleServiceClient struct {
@@ -112,6 +131,69 @@ func (c *articleServiceClient) ListPub(ctx context.Context, in *ListPubRequest,
 	return out, nil
 }
 
+func (c *articleServiceClient) GetPublishedByIds(ctx context.Context, in *GetPublishedByIdsRequest, opts ...grpc.CallOption) (*GetPublishedByIdsResponse, error) {
+	out := new(GetPublishedByIdsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetPublishedByIds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error) {
+	out := new(GetRevisionResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error) {
+	out := new(DiffRevisionsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_DiffRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error) {
+	out := new(RestoreRevisionResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RestoreRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) SchedulePublish(ctx context.Context, in *SchedulePublishRequest, opts ...grpc.CallOption) (*SchedulePublishResponse, error) {
+	out := new(SchedulePublishResponse)
+	err := c.cc.Invoke(ctx, ArticleService_SchedulePublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) CancelSchedule(ctx context.Context, in *CancelScheduleRequest, opts ...grpc.CallOption) (*CancelScheduleResponse, error) {
+	out := new(CancelScheduleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_CancelSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArticleServiceServer is the server API for ArticleService service.
 // All implementations must embed UnimplementedArticleServiceServer
 // for forward compatibility
@@ -123,6 +205,18 @@ type ArticleServiceServer interface {
 	GetById(context.Context, *GetByIdRequest) (*GetByIdResponse, error)
 	GetPublishedById(context.Context, *GetPublishedByIdRequest) (*GetPublishedByIdResponse, error)
 	ListPub(context.Context, *ListPubRequest) (*ListPubResponse, error)
+	// GetPublishedByIds 批量查询线上库的文章，不会产生阅读事件，给热榜之类的内部服务用。
+	// 不存在的文章不会出现在结果里面
+	GetPublishedByIds(context.Context, *GetPublishedByIdsRequest) (*GetPublishedByIdsResponse, error)
+	// 历史版本相关，每一次 Save/Publish 都会产生一个不可变的版本
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionResponse, error)
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error)
+	// RestoreRevision 将某个历史版本恢复为当前草稿
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionResponse, error)
+	// SchedulePublish 定时发表，到时间之后由定时任务发表
+	SchedulePublish(context.Context, *SchedulePublishRequest) (*SchedulePublishResponse, error)
+	CancelSchedule(context.Context, *CancelScheduleRequest) (*CancelScheduleResponse, error)
 	mustEmbedUnimplementedArticleServiceServer()
 }
 
@@ -151,6 +245,27 @@ func (UnimplementedArticleServiceServer) GetPublishedById(context.Context, *GetP
 func (UnimplementedArticleServiceServer) ListPub(context.Context, *ListPubRequest) (*ListPubResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPub not implemented")
 }
+func (UnimplementedArticleServiceServer) GetPublishedByIds(context.Context, *GetPublishedByIdsRequest) (*GetPublishedByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublishedByIds not implemented")
+}
+func (UnimplementedArticleServiceServer) ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedArticleServiceServer) GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedArticleServiceServer) DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedArticleServiceServer) RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
+func (UnimplementedArticleServiceServer) SchedulePublish(context.Context, *SchedulePublishRequest) (*SchedulePublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePublish not implemented")
+}
+func (UnimplementedArticleServiceServer) CancelSchedule(context.Context, *CancelScheduleRequest) (*CancelScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSchedule not implemented")
+}
 func (UnimplementedArticleServiceServer) mustEmbedUnimplementedArticleServiceServer() {}
 
 // UnsafeArticleServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -290,6 +405,132 @@ func _ArticleService_ListPub_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleService_GetPublishedByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublishedByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetPublishedByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetPublishedByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetPublishedByIds(ctx, req.(*GetPublishedByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetRevision(ctx, req.(*GetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_DiffRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).DiffRevisions(ctx, req.(*DiffRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RestoreRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_SchedulePublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).SchedulePublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_SchedulePublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).SchedulePublish(ctx, req.(*SchedulePublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_CancelSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).CancelSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_CancelSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).CancelSchedule(ctx, req.(*CancelScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ArticleService_ServiceDesc is the grpc.ServiceDesc for ArticleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -325,6 +566,34 @@ var ArticleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPub",
 			Handler:    _ArticleService_ListPub_Handler,
 		},
+		{
+			MethodName: "GetPublishedByIds",
+			Handler:    _ArticleService_GetPublishedByIds_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _ArticleService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _ArticleService_GetRevision_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _ArticleService_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _ArticleService_RestoreRevision_Handler,
+		},
+		{
+			MethodName: "SchedulePublish",
+			Handler:    _ArticleService_SchedulePublish_Handler,
+		},
+		{
+			MethodName: "CancelSchedule",
+			Handler:    _ArticleService_CancelSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "article/v1/article.proto",
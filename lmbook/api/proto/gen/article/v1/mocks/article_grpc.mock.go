@@ -40,6 +40,46 @@ func (m *MockArticleServiceClient) EXPECT() *MockArticleServiceClientMockRecorde
 	return m.recorder
 }
 
+// CancelSchedule mocks base method.
+func (m *MockArticleServiceClient) CancelSchedule(ctx context.Context, in *articlev1.CancelScheduleRequest, opts ...grpc.CallOption) (*articlev1.CancelScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelSchedule", varargs...)
+	ret0, _ := ret[0].(*articlev1.CancelScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSchedule indicates an expected call of CancelSchedule.
+func (mr *MockArticleServiceClientMockRecorder) CancelSchedule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSchedule", reflect.TypeOf((*MockArticleServiceClient)(nil).CancelSchedule), varargs...)
+}
+
+// DiffRevisions mocks base method.
+func (m *MockArticleServiceClient) DiffRevisions(ctx context.Context, in *articlev1.DiffRevisionsRequest, opts ...grpc.CallOption) (*articlev1.DiffRevisionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiffRevisions", varargs...)
+	ret0, _ := ret[0].(*articlev1.DiffRevisionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffRevisions indicates an expected call of DiffRevisions.
+func (mr *MockArticleServiceClientMockRecorder) DiffRevisions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffRevisions", reflect.TypeOf((*MockArticleServiceClient)(nil).DiffRevisions), varargs...)
+}
+
 // GetById mocks base method.
 func (m *MockArticleServiceClient) GetById(ctx context.Context, in *articlev1.GetByIdRequest, opts ...grpc.CallOption) (*articlev1.GetByIdResponse, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +120,46 @@ func (mr *MockArticleServiceClientMockRecorder) GetPublishedById(ctx, in any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedById", reflect.TypeOf((*MockArticleServiceClient)(nil).GetPublishedById), varargs...)
 }
 
+// GetPublishedByIds mocks base method.
+func (m *MockArticleServiceClient) GetPublishedByIds(ctx context.Context, in *articlev1.GetPublishedByIdsRequest, opts ...grpc.CallOption) (*articlev1.GetPublishedByIdsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPublishedByIds", varargs...)
+	ret0, _ := ret[0].(*articlev1.GetPublishedByIdsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublishedByIds indicates an expected call of GetPublishedByIds.
+func (mr *MockArticleServiceClientMockRecorder) GetPublishedByIds(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedByIds", reflect.TypeOf((*MockArticleServiceClient)(nil).GetPublishedByIds), varargs...)
+}
+
+// GetRevision mocks base method.
+func (m *MockArticleServiceClient) GetRevision(ctx context.Context, in *articlev1.GetRevisionRequest, opts ...grpc.CallOption) (*articlev1.GetRevisionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRevision", varargs...)
+	ret0, _ := ret[0].(*articlev1.GetRevisionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockArticleServiceClientMockRecorder) GetRevision(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockArticleServiceClient)(nil).GetRevision), varargs...)
+}
+
 // List mocks base method.
 func (m *MockArticleServiceClient) List(ctx context.Context, in *articlev1.ListRequest, opts ...grpc.CallOption) (*articlev1.ListResponse, error) {
 	m.ctrl.T.Helper()
@@ -120,6 +200,26 @@ func (mr *MockArticleServiceClientMockRecorder) ListPub(ctx, in any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPub", reflect.TypeOf((*MockArticleServiceClient)(nil).ListPub), varargs...)
 }
 
+// ListRevisions mocks base method.
+func (m *MockArticleServiceClient) ListRevisions(ctx context.Context, in *articlev1.ListRevisionsRequest, opts ...grpc.CallOption) (*articlev1.ListRevisionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRevisions", varargs...)
+	ret0, _ := ret[0].(*articlev1.ListRevisionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockArticleServiceClientMockRecorder) ListRevisions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockArticleServiceClient)(nil).ListRevisions), varargs...)
+}
+
 // Publish mocks base method.
 func (m *MockArticleServiceClient) Publish(ctx context.Context, in *articlev1.PublishRequest, opts ...grpc.CallOption) (*articlev1.PublishResponse, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +240,26 @@ func (mr *MockArticleServiceClientMockRecorder) Publish(ctx, in any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockArticleServiceClient)(nil).Publish), varargs...)
 }
 
+// RestoreRevision mocks base method.
+func (m *MockArticleServiceClient) RestoreRevision(ctx context.Context, in *articlev1.RestoreRevisionRequest, opts ...grpc.CallOption) (*articlev1.RestoreRevisionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreRevision", varargs...)
+	ret0, _ := ret[0].(*articlev1.RestoreRevisionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockArticleServiceClientMockRecorder) RestoreRevision(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockArticleServiceClient)(nil).RestoreRevision), varargs...)
+}
+
 // Save mocks base method.
 func (m *MockArticleServiceClient) Save(ctx context.Context, in *articlev1.SaveRequest, opts ...grpc.CallOption) (*articlev1.SaveResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +280,26 @@ func (mr *MockArticleServiceClientMockRecorder) Save(ctx, in any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockArticleServiceClient)(nil).Save), varargs...)
 }
 
+// SchedulePublish mocks base method.
+func (m *MockArticleServiceClient) SchedulePublish(ctx context.Context, in *articlev1.SchedulePublishRequest, opts ...grpc.CallOption) (*articlev1.SchedulePublishResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SchedulePublish", varargs...)
+	ret0, _ := ret[0].(*articlev1.SchedulePublishResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePublish indicates an expected call of SchedulePublish.
+func (mr *MockArticleServiceClientMockRecorder) SchedulePublish(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePublish", reflect.TypeOf((*MockArticleServiceClient)(nil).SchedulePublish), varargs...)
+}
+
 // Withdraw mocks base method.
 func (m *MockArticleServiceClient) Withdraw(ctx context.Context, in *articlev1.WithdrawRequest, opts ...grpc.CallOption) (*articlev1.WithdrawResponse, error) {
 	m.ctrl.T.Helper()
@@ -203,6 +343,36 @@ func (m *MockArticleServiceServer) EXPECT() *MockArticleServiceServerMockRecorde
 	return m.recorder
 }
 
+// CancelSchedule mocks base method.
+func (m *MockArticleServiceServer) CancelSchedule(arg0 context.Context, arg1 *articlev1.CancelScheduleRequest) (*articlev1.CancelScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*articlev1.CancelScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSchedule indicates an expected call of CancelSchedule.
+func (mr *MockArticleServiceServerMockRecorder) CancelSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSchedule", reflect.TypeOf((*MockArticleServiceServer)(nil).CancelSchedule), arg0, arg1)
+}
+
+// DiffRevisions mocks base method.
+func (m *MockArticleServiceServer) DiffRevisions(arg0 context.Context, arg1 *articlev1.DiffRevisionsRequest) (*articlev1.DiffRevisionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffRevisions", arg0, arg1)
+	ret0, _ := ret[0].(*articlev1.DiffRevisionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffRevisions indicates an expected call of DiffRevisions.
+func (mr *MockArticleServiceServerMockRecorder) DiffRevisions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffRevisions", reflect.TypeOf((*MockArticleServiceServer)(nil).DiffRevisions), arg0, arg1)
+}
+
 // GetById mocks base method.
 func (m *MockArticleServiceServer) GetById(arg0 context.Context, arg1 *articlev1.GetByIdRequest) (*articlev1.GetByIdResponse, error) {
 	m.ctrl.T.Helper()
@@ -233,6 +403,36 @@ func (mr *MockArticleServiceServerMockRecorder) GetPublishedById(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedById", reflect.TypeOf((*MockArticleServiceServer)(nil).GetPublishedById), arg0, arg1)
 }
 
+// GetPublishedByIds mocks base method.
+func (m *MockArticleServiceServer) GetPublishedByIds(arg0 context.Context, arg1 *articlev1.GetPublishedByIdsRequest) (*articlev1.GetPublishedByIdsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishedByIds", arg0, arg1)
+	ret0, _ := ret[0].(*articlev1.GetPublishedByIdsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublishedByIds indicates an expected call of GetPublishedByIds.
+func (mr *MockArticleServiceServerMockRecorder) GetPublishedByIds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedByIds", reflect.TypeOf((*MockArticleServiceServer)(nil).GetPublishedByIds), arg0, arg1)
+}
+
+// GetRevision mocks base method.
+func (m *MockArticleServiceServer) GetRevision(arg0 context.Context, arg1 *articlev1.GetRevisionRequest) (*articlev1.GetRevisionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", arg0, arg1)
+	ret0, _ := ret[0].(*articlev1.GetRevisionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockArticleServiceServerMockRecorder) GetRevision(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockArticleServiceServer)(nil).GetRevision), arg0, arg1)
+}
+
 // List mocks base method.
 func (m *MockArticleServiceServer) List(arg0 context.Context, arg1 *articlev1.ListRequest) (*articlev1.ListResponse, error) {
 	m.ctrl.T.Helper()
@@ -263,6 +463,21 @@ func (mr *MockArticleServiceServerMockRecorder) ListPub(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPub", reflect.TypeOf((*MockArticleServiceServer)(nil).ListPub), arg0, arg1)
 }
 
+// ListRevisions mocks base method.
+func (m *MockArticleServiceServer) ListRevisions(arg0 context.Context, arg1 *articlev1.ListRevisionsRequest) (*articlev1.ListRevisionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", arg0, arg1)
+	ret0, _ := ret[0].(*articlev1.ListRevisionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockArticleServiceServerMockRecorder) ListRevisions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockArticleServiceServer)(nil).ListRevisions), arg0, arg1)
+}
+
 // Publish mocks base method.
 func (m *MockArticleServiceServer) Publish(arg0 context.Context, arg1 *articlev1.PublishRequest) (*articlev1.PublishResponse, error) {
 	m.ctrl.T.Helper()
@@ -278,6 +493,21 @@ func (mr *MockArticleServiceServerMockRecorder) Publish(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockArticleServiceServer)(nil).Publish), arg0, arg1)
 }
 
+// RestoreRevision mocks base method.
+func (m *MockArticleServiceServer) RestoreRevision(arg0 context.Context, arg1 *articlev1.RestoreRevisionRequest) (*articlev1.RestoreRevisionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRevision", arg0, arg1)
+	ret0, _ := ret[0].(*articlev1.RestoreRevisionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockArticleServiceServerMockRecorder) RestoreRevision(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockArticleServiceServer)(nil).RestoreRevision), arg0, arg1)
+}
+
 // Save mocks base method.
 func (m *MockArticleServiceServer) Save(arg0 context.Context, arg1 *articlev1.SaveRequest) (*articlev1.SaveResponse, error) {
 	m.ctrl.T.Helper()
@@ -293,6 +523,21 @@ func (mr *MockArticleServiceServerMockRecorder) Save(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockArticleServiceServer)(nil).Save), arg0, arg1)
 }
 
+// SchedulePublish mocks base method.
+func (m *MockArticleServiceServer) SchedulePublish(arg0 context.Context, arg1 *articlev1.SchedulePublishRequest) (*articlev1.SchedulePublishResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePublish", arg0, arg1)
+	ret0, _ := ret[0].(*articlev1.SchedulePublishResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePublish indicates an expected call of SchedulePublish.
+func (mr *MockArticleServiceServerMockRecorder) SchedulePublish(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePublish", reflect.TypeOf((*MockArticleServiceServer)(nil).SchedulePublish), arg0, arg1)
+}
+
 // Withdraw mocks base method.
 func (m *MockArticleServiceServer) Withdraw(arg0 context.Context, arg1 *articlev1.WithdrawRequest) (*articlev1.WithdrawResponse, error) {
 	m.ctrl.T.Helper()
@@ -19,10 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CommentService_GetCommentList_FullMethodName = "/comment.v1.CommentService/GetCommentList"
-	CommentService_DeleteComment_FullMethodName  = "/comment.v1.CommentService/DeleteComment"
-	CommentService_CreateComment_FullMethodName  = "/comment.v1.CommentService/CreateComment"
-	CommentService_GetMoreReplies_FullMethodName = "/comment.v1.CommentService/GetMoreReplies"
+	CommentService_GetCommentList_FullMethodName     = "/comment.v1.CommentService/GetCommentList"
+	CommentService_DeleteComment_FullMethodName      = "/comment.v1.CommentService/DeleteComment"
+	CommentService_RestoreComment_FullMethodName     = "/comment.v1.CommentService/RestoreComment"
+	CommentService_CreateComment_FullMethodName      = "/comment.v1.CommentService/CreateComment"
+	CommentService_GetMoreReplies_FullMethodName     = "/comment.v1.CommentService/GetMoreReplies"
+	CommentService_GetCommentTree_FullMethodName     = "/comment.v1.CommentService/GetCommentTree"
+	CommentService_LikeComment_FullMethodName        = "/comment.v1.CommentService/LikeComment"
+	CommentService_CancelLikeComment_FullMethodName  = "/comment.v1.CommentService/CancelLikeComment"
+	CommentService_PinComment_FullMethodName         = "/comment.v1.CommentService/PinComment"
+	CommentService_UnpinComment_FullMethodName       = "/comment.v1.CommentService/UnpinComment"
+	CommentService_GetPendingComments_FullMethodName = "/comment.v1.CommentService/GetPendingComments"
+	CommentService_ReviewComment_FullMethodName      = "/comment.v1.CommentService/ReviewComment"
 )
 
 // CommentServiceClient is the client API for CommentService service.
@@ -31,11 +39,25 @@ const (
 type CommentServiceClient interface {
 	// GetCommentList Comment的id为0 获取一级评论
 	GetCommentList(ctx context.Context, in *CommentListRequest, opts ...grpc.CallOption) (*CommentListResponse, error)
-	// DeleteComment 删除评论，删除本评论和其子评论
+	// DeleteComment 软删除评论，内容替换成占位，回复仍然可见
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	// RestoreComment 恢复删除的评论，给管理员用的
+	RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*RestoreCommentResponse, error)
 	// CreateComment 创建评论
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
 	GetMoreReplies(ctx context.Context, in *GetMoreRepliesRequest, opts ...grpc.CallOption) (*GetMoreRepliesResponse, error)
+	// GetCommentTree 一次返回一页一级评论，每条都带上回复数和最早的几条回复
+	GetCommentTree(ctx context.Context, in *GetCommentTreeRequest, opts ...grpc.CallOption) (*GetCommentTreeResponse, error)
+	// LikeComment 点赞评论，计数复用互动服务 biz = comment 的点赞
+	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error)
+	CancelLikeComment(ctx context.Context, in *CancelLikeCommentRequest, opts ...grpc.CallOption) (*CancelLikeCommentResponse, error)
+	// PinComment 置顶评论，只有资源的作者可以置顶，一个资源只能置顶一条一级评论
+	PinComment(ctx context.Context, in *PinCommentRequest, opts ...grpc.CallOption) (*PinCommentResponse, error)
+	UnpinComment(ctx context.Context, in *UnpinCommentRequest, opts ...grpc.CallOption) (*UnpinCommentResponse, error)
+	// GetPendingComments 待审核的评论，按照 ID 升序，先发表的先审核
+	GetPendingComments(ctx context.Context, in *GetPendingCommentsRequest, opts ...grpc.CallOption) (*GetPendingCommentsResponse, error)
+	// ReviewComment 审核评论，只能把待审核的评论改成通过或者拒绝
+	ReviewComment(ctx context.Context, in *ReviewCommentRequest, opts ...grpc.CallOption) (*ReviewCommentResponse, error)
 }
 
 type commentServiceClient struct {
@@ -64,6 +86,15 @@ func (c *commentServiceClient) DeleteComment(ctx context.Context, in *DeleteComm
 	return out, nil
 }
 
+func (c *commentServiceClient) RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*RestoreCommentResponse, error) {
+	out := new(RestoreCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_RestoreComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commentServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
 	out := new(CreateCommentResponse)
 	err := c.cc.Invoke(ctx, CommentService_CreateComment_FullMethodName, in, out, opts...)
@@ -82,17 +113,94 @@ func (c *commentServiceClient) GetMoreReplies(ctx context.Context, in *GetMoreRe
 	return out, nil
 }
 
+func (c *commentServiceClient) GetCommentTree(ctx context.Context, in *GetCommentTreeRequest, opts ...grpc.CallOption) (*GetCommentTreeResponse, error) {
+	out := new(GetCommentTreeResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetCommentTree_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error) {
+	out := new(LikeCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_LikeComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) CancelLikeComment(ctx context.Context, in *CancelLikeCommentRequest, opts ...grpc.CallOption) (*CancelLikeCommentResponse, error) {
+	out := new(CancelLikeCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_CancelLikeComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) PinComment(ctx context.Context, in *PinCommentRequest, opts ...grpc.CallOption) (*PinCommentResponse, error) {
+	out := new(PinCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_PinComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) UnpinComment(ctx context.Context, in *UnpinCommentRequest, opts ...grpc.CallOption) (*UnpinCommentResponse, error) {
+	out := new(UnpinCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_UnpinComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetPendingComments(ctx context.Context, in *GetPendingCommentsRequest, opts ...grpc.CallOption) (*GetPendingCommentsResponse, error) {
+	out := new(GetPendingCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetPendingComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ReviewComment(ctx context.Context, in *ReviewCommentRequest, opts ...grpc.CallOption) (*ReviewCommentResponse, error) {
+	out := new(ReviewCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_ReviewComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentServiceServer is the server API for CommentService service.
 // All implementations must embed UnimplementedCommentServiceServer
 // for forward compatibility
 type CommentServiceServer interface {
 	// GetCommentList Comment的id为0 获取一级评论
 	GetCommentList(context.Context, *CommentListRequest) (*CommentListResponse, error)
-	// DeleteComment 删除评论，删除本评论和其子评论
+	// DeleteComment 软删除评论，内容替换成占位，回复仍然可见
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	// RestoreComment 恢复删除的评论，给管理员用的
+	RestoreComment(context.Context, *RestoreCommentRequest) (*RestoreCommentResponse, error)
 	// CreateComment 创建评论
 	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
 	GetMoreReplies(context.Context, *GetMoreRepliesRequest) (*GetMoreRepliesResponse, error)
+	// GetCommentTree 一次返回一页一级评论，每条都带上回复数和最早的几条回复
+	GetCommentTree(context.Context, *GetCommentTreeRequest) (*GetCommentTreeResponse, error)
+	// LikeComment 点赞评论，计数复用互动服务 biz = comment 的点赞
+	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error)
+	CancelLikeComment(context.Context, *CancelLikeCommentRequest) (*CancelLikeCommentResponse, error)
+	// PinComment 置顶评论，只有资源的作者可以置顶，一个资源只能置顶一条一级评论
+	PinComment(context.Context, *PinCommentRequest) (*PinCommentResponse, error)
+	UnpinComment(context.Context, *UnpinCommentRequest) (*UnpinCommentResponse, error)
+	// GetPendingComments 待审核的评论，按照 ID 升序，先发表的先审核
+	GetPendingComments(context.Context, *GetPendingCommentsRequest) (*GetPendingCommentsResponse, error)
+	// ReviewComment 审核评论，只能把待审核的评论改成通过或者拒绝
+	ReviewComment(context.Context, *ReviewCommentRequest) (*ReviewCommentResponse, error)
 	mustEmbedUnimplementedCommentServiceServer()
 }
 
@@ -106,12 +214,36 @@ func (UnimplementedCommentServiceServer) GetCommentList(context.Context, *Commen
 func (UnimplementedCommentServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
+func (UnimplementedCommentServiceServer) RestoreComment(context.Context, *RestoreCommentRequest) (*RestoreCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreComment not implemented")
+}
 func (UnimplementedCommentServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
 }
 func (UnimplementedCommentServiceServer) GetMoreReplies(context.Context, *GetMoreRepliesRequest) (*GetMoreRepliesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMoreReplies not implemented")
 }
+func (UnimplementedCommentServiceServer) GetCommentTree(context.Context, *GetCommentTreeRequest) (*GetCommentTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentTree not implemented")
+}
+func (UnimplementedCommentServiceServer) LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+}
+func (UnimplementedCommentServiceServer) CancelLikeComment(context.Context, *CancelLikeCommentRequest) (*CancelLikeCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLikeComment not implemented")
+}
+func (UnimplementedCommentServiceServer) PinComment(context.Context, *PinCommentRequest) (*PinCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinComment not implemented")
+}
+func (UnimplementedCommentServiceServer) UnpinComment(context.Context, *UnpinCommentRequest) (*UnpinCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinComment not implemented")
+}
+func (UnimplementedCommentServiceServer) GetPendingComments(context.Context, *GetPendingCommentsRequest) (*GetPendingCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingComments not implemented")
+}
+func (UnimplementedCommentServiceServer) ReviewComment(context.Context, *ReviewCommentRequest) (*ReviewCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewComment not implemented")
+}
 func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
 
 // UnsafeCommentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -161,6 +293,24 @@ func _CommentService_DeleteComment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_RestoreComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).RestoreComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_RestoreComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).RestoreComment(ctx, req.(*RestoreCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommentService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCommentRequest)
 	if err := dec(in); err != nil {
@@ -197,6 +347,132 @@ func _CommentService_GetMoreReplies_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_GetCommentTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetCommentTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetCommentTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetCommentTree(ctx, req.(*GetCommentTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).LikeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_LikeComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).LikeComment(ctx, req.(*LikeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_CancelLikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelLikeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).CancelLikeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_CancelLikeComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).CancelLikeComment(ctx, req.(*CancelLikeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_PinComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).PinComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_PinComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).PinComment(ctx, req.(*PinCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_UnpinComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).UnpinComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_UnpinComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).UnpinComment(ctx, req.(*UnpinCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetPendingComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetPendingComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetPendingComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetPendingComments(ctx, req.(*GetPendingCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_ReviewComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ReviewComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ReviewComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ReviewComment(ctx, req.(*ReviewCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -212,6 +488,10 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteComment",
 			Handler:    _CommentService_DeleteComment_Handler,
 		},
+		{
+			MethodName: "RestoreComment",
+			Handler:    _CommentService_RestoreComment_Handler,
+		},
 		{
 			MethodName: "CreateComment",
 			Handler:    _CommentService_CreateComment_Handler,
@@ -220,6 +500,34 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMoreReplies",
 			Handler:    _CommentService_GetMoreReplies_Handler,
 		},
+		{
+			MethodName: "GetCommentTree",
+			Handler:    _CommentService_GetCommentTree_Handler,
+		},
+		{
+			MethodName: "LikeComment",
+			Handler:    _CommentService_LikeComment_Handler,
+		},
+		{
+			MethodName: "CancelLikeComment",
+			Handler:    _CommentService_CancelLikeComment_Handler,
+		},
+		{
+			MethodName: "PinComment",
+			Handler:    _CommentService_PinComment_Handler,
+		},
+		{
+			MethodName: "UnpinComment",
+			Handler:    _CommentService_UnpinComment_Handler,
+		},
+		{
+			MethodName: "GetPendingComments",
+			Handler:    _CommentService_GetPendingComments_Handler,
+		},
+		{
+			MethodName: "ReviewComment",
+			Handler:    _CommentService_ReviewComment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comment/v1/comment.proto",
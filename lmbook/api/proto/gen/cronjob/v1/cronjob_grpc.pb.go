@@ -22,6 +22,8 @@ const (
 	CronJobService_Preempt_FullMethodName       = "/cronjob.v1.CronJobService/Preempt"
 	CronJobService_ResetNextTime_FullMethodName = "/cronjob.v1.CronJobService/ResetNextTime"
 	CronJobService_AddJob_FullMethodName        = "/cronjob.v1.CronJobService/AddJob"
+	CronJobService_Release_FullMethodName       = "/cronjob.v1.CronJobService/Release"
+	CronJobService_Refresh_FullMethodName       = "/cronjob.v1.CronJobService/Refresh"
 )
 
 // CronJobServiceClient is the client API for CronJobService service.
@@ -31,6 +33,11 @@ type CronJobServiceClient interface {
 	Preempt(ctx context.Context, in *PreemptRequest, opts ...grpc.CallOption) (*PreemptResponse, error)
 	ResetNextTime(ctx context.Context, in *ResetNextTimeRequest, opts ...grpc.CallOption) (*ResetNextTimeResponse, error)
 	AddJob(ctx context.Context, in *AddJobRequest, opts ...grpc.CallOption) (*AddJobResponse, error)
+	// Release 任务执行完毕之后释放，其它实例才能再次抢占
+	Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*ReleaseResponse, error)
+	// Refresh 执行任务期间定期续约，超过一段时间没有续约，其它实例就可以重新抢占。
+	// 任务已经被释放了的话返回 FailedPrecondition
+	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error)
 }
 
 type cronJobServiceClient struct {
@@ -68,6 +75,24 @@ func (c *cronJobServiceClient) AddJob(ctx context.Context, in *AddJobRequest, op
 	return out, nil
 }
 
+func (c *cronJobServiceClient) Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*ReleaseResponse, error) {
+	out := new(ReleaseResponse)
+	err := c.cc.Invoke(ctx, CronJobService_Release_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronJobServiceClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error) {
+	out := new(RefreshResponse)
+	err := c.cc.Invoke(ctx, CronJobService_Refresh_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CronJobServiceServer is the server API for CronJobService service.
 // All implementations must embed UnimplementedCronJobServiceServer
 // for forward compatibility
@@ -75,6 +100,11 @@ type CronJobServiceServer interface {
 	Preempt(context.Context, *PreemptRequest) (*PreemptResponse, error)
 	ResetNextTime(context.Context, *ResetNextTimeRequest) (*ResetNextTimeResponse, error)
 	AddJob(context.Context, *AddJobRequest) (*AddJobResponse, error)
+	// Release 任务执行完毕之后释放，其它实例才能再次抢占
+	Release(context.Context, *ReleaseRequest) (*ReleaseResponse, error)
+	// Refresh 执行任务期间定期续约，超过一段时间没有续约，其它实例就可以重新抢占。
+	// 任务已经被释放了的话返回 FailedPrecondition
+	Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error)
 	mustEmbedUnimplementedCronJobServiceServer()
 }
 
@@ -91,6 +121,12 @@ func (UnimplementedCronJobServiceServer) ResetNextTime(context.Context, *ResetNe
 func (UnimplementedCronJobServiceServer) AddJob(context.Context, *AddJobRequest) (*AddJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddJob not implemented")
 }
+func (UnimplementedCronJobServiceServer) Release(context.Context, *ReleaseRequest) (*ReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
+func (UnimplementedCronJobServiceServer) Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
 func (UnimplementedCronJobServiceServer) mustEmbedUnimplementedCronJobServiceServer() {}
 
 // UnsafeCronJobServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +194,42 @@ func _CronJobService_AddJob_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CronJobService_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronJobServiceServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CronJobService_Release_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronJobServiceServer).Release(ctx, req.(*ReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CronJobService_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronJobServiceServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CronJobService_Refresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronJobServiceServer).Refresh(ctx, req.(*RefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CronJobService_ServiceDesc is the grpc.ServiceDesc for CronJobService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +249,14 @@ var CronJobService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddJob",
 			Handler:    _CronJobService_AddJob_Handler,
 		},
+		{
+			MethodName: "Release",
+			Handler:    _CronJobService_Release_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _CronJobService_Refresh_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cronjob/v1/cronjob.proto",
@@ -0,0 +1,286 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lmbook/api/proto/gen/cronjob/v1/cronjob_grpc.pb.go
+//
+// Generated by this command:
+//
+//	mockgen -source=lmbook/api/proto/gen/cronjob/v1/cronjob_grpc.pb.go -package=cronjobmocks -destination=lmbook/api/proto/gen/cronjob/v1/mocks/cronjob_grpc.mock.go
+//
+// Package cronjobmocks is a generated GoMock package.
+package cronjobmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	cronjobv1 "basic-go/lmbook/api/proto/gen/cronjob/v1"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockCronJobServiceClient is a mock of CronJobServiceClient interface.
+type MockCronJobServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCronJobServiceClientMockRecorder
+}
+
+// MockCronJobServiceClientMockRecorder is the mock recorder for MockCronJobServiceClient.
+type MockCronJobServiceClientMockRecorder struct {
+	mock *MockCronJobServiceClient
+}
+
+// NewMockCronJobServiceClient creates a new mock instance.
+func NewMockCronJobServiceClient(ctrl *gomock.Controller) *MockCronJobServiceClient {
+	mock := &MockCronJobServiceClient{ctrl: ctrl}
+	mock.recorder = &MockCronJobServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCronJobServiceClient) EXPECT() *MockCronJobServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AddJob mocks base method.
+func (m *MockCronJobServiceClient) AddJob(ctx context.Context, in *cronjobv1.AddJobRequest, opts ...grpc.CallOption) (*cronjobv1.AddJobResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddJob", varargs...)
+	ret0, _ := ret[0].(*cronjobv1.AddJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddJob indicates an expected call of AddJob.
+func (mr *MockCronJobServiceClientMockRecorder) AddJob(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddJob", reflect.TypeOf((*MockCronJobServiceClient)(nil).AddJob), varargs...)
+}
+
+// Preempt mocks base method.
+func (m *MockCronJobServiceClient) Preempt(ctx context.Context, in *cronjobv1.PreemptRequest, opts ...grpc.CallOption) (*cronjobv1.PreemptResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Preempt", varargs...)
+	ret0, _ := ret[0].(*cronjobv1.PreemptResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preempt indicates an expected call of Preempt.
+func (mr *MockCronJobServiceClientMockRecorder) Preempt(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preempt", reflect.TypeOf((*MockCronJobServiceClient)(nil).Preempt), varargs...)
+}
+
+// Refresh mocks base method.
+func (m *MockCronJobServiceClient) Refresh(ctx context.Context, in *cronjobv1.RefreshRequest, opts ...grpc.CallOption) (*cronjobv1.RefreshResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Refresh", varargs...)
+	ret0, _ := ret[0].(*cronjobv1.RefreshResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockCronJobServiceClientMockRecorder) Refresh(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockCronJobServiceClient)(nil).Refresh), varargs...)
+}
+
+// Release mocks base method.
+func (m *MockCronJobServiceClient) Release(ctx context.Context, in *cronjobv1.ReleaseRequest, opts ...grpc.CallOption) (*cronjobv1.ReleaseResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Release", varargs...)
+	ret0, _ := ret[0].(*cronjobv1.ReleaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockCronJobServiceClientMockRecorder) Release(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockCronJobServiceClient)(nil).Release), varargs...)
+}
+
+// ResetNextTime mocks base method.
+func (m *MockCronJobServiceClient) ResetNextTime(ctx context.Context, in *cronjobv1.ResetNextTimeRequest, opts ...grpc.CallOption) (*cronjobv1.ResetNextTimeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetNextTime", varargs...)
+	ret0, _ := ret[0].(*cronjobv1.ResetNextTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetNextTime indicates an expected call of ResetNextTime.
+func (mr *MockCronJobServiceClientMockRecorder) ResetNextTime(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetNextTime", reflect.TypeOf((*MockCronJobServiceClient)(nil).ResetNextTime), varargs...)
+}
+
+// MockCronJobServiceServer is a mock of CronJobServiceServer interface.
+type MockCronJobServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockCronJobServiceServerMockRecorder
+}
+
+// MockCronJobServiceServerMockRecorder is the mock recorder for MockCronJobServiceServer.
+type MockCronJobServiceServerMockRecorder struct {
+	mock *MockCronJobServiceServer
+}
+
+// NewMockCronJobServiceServer creates a new mock instance.
+func NewMockCronJobServiceServer(ctrl *gomock.Controller) *MockCronJobServiceServer {
+	mock := &MockCronJobServiceServer{ctrl: ctrl}
+	mock.recorder = &MockCronJobServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCronJobServiceServer) EXPECT() *MockCronJobServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddJob mocks base method.
+func (m *MockCronJobServiceServer) AddJob(arg0 context.Context, arg1 *cronjobv1.AddJobRequest) (*cronjobv1.AddJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddJob", arg0, arg1)
+	ret0, _ := ret[0].(*cronjobv1.AddJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddJob indicates an expected call of AddJob.
+func (mr *MockCronJobServiceServerMockRecorder) AddJob(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddJob", reflect.TypeOf((*MockCronJobServiceServer)(nil).AddJob), arg0, arg1)
+}
+
+// Preempt mocks base method.
+func (m *MockCronJobServiceServer) Preempt(arg0 context.Context, arg1 *cronjobv1.PreemptRequest) (*cronjobv1.PreemptResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preempt", arg0, arg1)
+	ret0, _ := ret[0].(*cronjobv1.PreemptResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preempt indicates an expected call of Preempt.
+func (mr *MockCronJobServiceServerMockRecorder) Preempt(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preempt", reflect.TypeOf((*MockCronJobServiceServer)(nil).Preempt), arg0, arg1)
+}
+
+// Refresh mocks base method.
+func (m *MockCronJobServiceServer) Refresh(arg0 context.Context, arg1 *cronjobv1.RefreshRequest) (*cronjobv1.RefreshResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", arg0, arg1)
+	ret0, _ := ret[0].(*cronjobv1.RefreshResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockCronJobServiceServerMockRecorder) Refresh(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockCronJobServiceServer)(nil).Refresh), arg0, arg1)
+}
+
+// Release mocks base method.
+func (m *MockCronJobServiceServer) Release(arg0 context.Context, arg1 *cronjobv1.ReleaseRequest) (*cronjobv1.ReleaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", arg0, arg1)
+	ret0, _ := ret[0].(*cronjobv1.ReleaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockCronJobServiceServerMockRecorder) Release(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockCronJobServiceServer)(nil).Release), arg0, arg1)
+}
+
+// ResetNextTime mocks base method.
+func (m *MockCronJobServiceServer) ResetNextTime(arg0 context.Context, arg1 *cronjobv1.ResetNextTimeRequest) (*cronjobv1.ResetNextTimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetNextTime", arg0, arg1)
+	ret0, _ := ret[0].(*cronjobv1.ResetNextTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetNextTime indicates an expected call of ResetNextTime.
+func (mr *MockCronJobServiceServerMockRecorder) ResetNextTime(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetNextTime", reflect.TypeOf((*MockCronJobServiceServer)(nil).ResetNextTime), arg0, arg1)
+}
+
+// mustEmbedUnimplementedCronJobServiceServer mocks base method.
+func (m *MockCronJobServiceServer) mustEmbedUnimplementedCronJobServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedCronJobServiceServer")
+}
+
+// mustEmbedUnimplementedCronJobServiceServer indicates an expected call of mustEmbedUnimplementedCronJobServiceServer.
+func (mr *MockCronJobServiceServerMockRecorder) mustEmbedUnimplementedCronJobServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedCronJobServiceServer", reflect.TypeOf((*MockCronJobServiceServer)(nil).mustEmbedUnimplementedCronJobServiceServer))
+}
+
+// MockUnsafeCronJobServiceServer is a mock of UnsafeCronJobServiceServer interface.
+type MockUnsafeCronJobServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeCronJobServiceServerMockRecorder
+}
+
+// MockUnsafeCronJobServiceServerMockRecorder is the mock recorder for MockUnsafeCronJobServiceServer.
+type MockUnsafeCronJobServiceServerMockRecorder struct {
+	mock *MockUnsafeCronJobServiceServer
+}
+
+// NewMockUnsafeCronJobServiceServer creates a new mock instance.
+func NewMockUnsafeCronJobServiceServer(ctrl *gomock.Controller) *MockUnsafeCronJobServiceServer {
+	mock := &MockUnsafeCronJobServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeCronJobServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeCronJobServiceServer) EXPECT() *MockUnsafeCronJobServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedCronJobServiceServer mocks base method.
+func (m *MockUnsafeCronJobServiceServer) mustEmbedUnimplementedCronJobServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedCronJobServiceServer")
+}
+
+// mustEmbedUnimplementedCronJobServiceServer indicates an expected call of mustEmbedUnimplementedCronJobServiceServer.
+func (mr *MockUnsafeCronJobServiceServerMockRecorder) mustEmbedUnimplementedCronJobServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedCronJobServiceServer", reflect.TypeOf((*MockUnsafeCronJobServiceServer)(nil).mustEmbedUnimplementedCronJobServiceServer))
+}
@@ -19,12 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	FollowService_Follow_FullMethodName          = "/follow.v1.FollowService/Follow"
-	FollowService_CancelFollow_FullMethodName    = "/follow.v1.FollowService/CancelFollow"
-	FollowService_GetFollowee_FullMethodName     = "/follow.v1.FollowService/GetFollowee"
-	FollowService_FollowInfo_FullMethodName      = "/follow.v1.FollowService/FollowInfo"
-	FollowService_GetFollower_FullMethodName     = "/follow.v1.FollowService/GetFollower"
-	FollowService_GetFollowStatic_FullMethodName = "/follow.v1.FollowService/GetFollowStatic"
+	FollowService_Follow_FullMethodName                   = "/follow.v1.FollowService/Follow"
+	FollowService_CancelFollow_FullMethodName             = "/follow.v1.FollowService/CancelFollow"
+	FollowService_UpdateFollowRelation_FullMethodName     = "/follow.v1.FollowService/UpdateFollowRelation"
+	FollowService_CreateFollowGroup_FullMethodName        = "/follow.v1.FollowService/CreateFollowGroup"
+	FollowService_RenameFollowGroup_FullMethodName        = "/follow.v1.FollowService/RenameFollowGroup"
+	FollowService_DeleteFollowGroup_FullMethodName        = "/follow.v1.FollowService/DeleteFollowGroup"
+	FollowService_GetFollowGroups_FullMethodName          = "/follow.v1.FollowService/GetFollowGroups"
+	FollowService_GetFolloweeByGroup_FullMethodName       = "/follow.v1.FollowService/GetFolloweeByGroup"
+	FollowService_Block_FullMethodName                    = "/follow.v1.FollowService/Block"
+	FollowService_CancelBlock_FullMethodName              = "/follow.v1.FollowService/CancelBlock"
+	FollowService_Mute_FullMethodName                     = "/follow.v1.FollowService/Mute"
+	FollowService_CancelMute_FullMethodName               = "/follow.v1.FollowService/CancelMute"
+	FollowService_IsBlocked_FullMethodName                = "/follow.v1.FollowService/IsBlocked"
+	FollowService_GetMuteList_FullMethodName              = "/follow.v1.FollowService/GetMuteList"
+	FollowService_FilterMuted_FullMethodName              = "/follow.v1.FollowService/FilterMuted"
+	FollowService_GetMutualFollow_FullMethodName          = "/follow.v1.FollowService/GetMutualFollow"
+	FollowService_GetCommonFollowee_FullMethodName        = "/follow.v1.FollowService/GetCommonFollowee"
+	FollowService_GetFollowRecommendations_FullMethodName = "/follow.v1.FollowService/GetFollowRecommendations"
+	FollowService_GetFollowee_FullMethodName              = "/follow.v1.FollowService/GetFollowee"
+	FollowService_FollowInfo_FullMethodName               = "/follow.v1.FollowService/FollowInfo"
+	FollowService_GetFollower_FullMethodName              = "/follow.v1.FollowService/GetFollower"
+	FollowService_GetFollowStatic_FullMethodName          = "/follow.v1.FollowService/GetFollowStatic"
 )
 
 // FollowServiceClient is the client API for FollowService service.
@@ -34,6 +50,34 @@ type FollowServiceClient interface {
 	// 增删
 	Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
 	CancelFollow(ctx context.Context, in *CancelFollowRequest, opts ...grpc.CallOption) (*CancelFollowResponse, error)
+	// 改，修改分组、备注、特别关注
+	UpdateFollowRelation(ctx context.Context, in *UpdateFollowRelationRequest, opts ...grpc.CallOption) (*UpdateFollowRelationResponse, error)
+	// 关注分组
+	CreateFollowGroup(ctx context.Context, in *CreateFollowGroupRequest, opts ...grpc.CallOption) (*CreateFollowGroupResponse, error)
+	RenameFollowGroup(ctx context.Context, in *RenameFollowGroupRequest, opts ...grpc.CallOption) (*RenameFollowGroupResponse, error)
+	// 删除分组，分组里面的人还是关注着的，只是变成没有分组
+	DeleteFollowGroup(ctx context.Context, in *DeleteFollowGroupRequest, opts ...grpc.CallOption) (*DeleteFollowGroupResponse, error)
+	GetFollowGroups(ctx context.Context, in *GetFollowGroupsRequest, opts ...grpc.CallOption) (*GetFollowGroupsResponse, error)
+	// 按照分组获得关注列表，也可以只看特别关注
+	GetFolloweeByGroup(ctx context.Context, in *GetFolloweeByGroupRequest, opts ...grpc.CallOption) (*GetFolloweeByGroupResponse, error)
+	// 拉黑，拉黑之后双方的关注关系都会被取消，对方不能关注、评论、打赏自己
+	Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	CancelBlock(ctx context.Context, in *CancelBlockRequest, opts ...grpc.CallOption) (*CancelBlockResponse, error)
+	// 屏蔽，对方的动态不会出现在自己的 feed 里面
+	Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error)
+	CancelMute(ctx context.Context, in *CancelMuteRequest, opts ...grpc.CallOption) (*CancelMuteResponse, error)
+	// uid 是否拉黑了 target
+	IsBlocked(ctx context.Context, in *IsBlockedRequest, opts ...grpc.CallOption) (*IsBlockedResponse, error)
+	// 获得 uid 屏蔽的人，拉黑的人也算
+	GetMuteList(ctx context.Context, in *GetMuteListRequest, opts ...grpc.CallOption) (*GetMuteListResponse, error)
+	// 在 uids 里面找出屏蔽或者拉黑了 target 的人，feed 扩散的时候用
+	FilterMuted(ctx context.Context, in *FilterMutedRequest, opts ...grpc.CallOption) (*FilterMutedResponse, error)
+	// 批量判断 uid 和 targets 里面的人是不是互相关注
+	GetMutualFollow(ctx context.Context, in *GetMutualFollowRequest, opts ...grpc.CallOption) (*GetMutualFollowResponse, error)
+	// uid 和 target 共同关注的人
+	GetCommonFollowee(ctx context.Context, in *GetCommonFolloweeRequest, opts ...grpc.CallOption) (*GetCommonFolloweeResponse, error)
+	// 推荐关注：我关注的人关注了谁，按照重合的次数排序
+	GetFollowRecommendations(ctx context.Context, in *GetFollowRecommendationsRequest, opts ...grpc.CallOption) (*GetFollowRecommendationsResponse, error)
 	// 获得某个人的关注列表
 	GetFollowee(ctx context.Context, in *GetFolloweeRequest, opts ...grpc.CallOption) (*GetFolloweeResponse, error)
 	// 获得某个人关注另外一个人的详细信息
@@ -70,6 +114,150 @@ func (c *followServiceClient) CancelFollow(ctx context.Context, in *CancelFollow
 	return out, nil
 }
 
+func (c *followServiceClient) UpdateFollowRelation(ctx context.Context, in *UpdateFollowRelationRequest, opts ...grpc.CallOption) (*UpdateFollowRelationResponse, error) {
+	out := new(UpdateFollowRelationResponse)
+	err := c.cc.Invoke(ctx, FollowService_UpdateFollowRelation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) CreateFollowGroup(ctx context.Context, in *CreateFollowGroupRequest, opts ...grpc.CallOption) (*CreateFollowGroupResponse, error) {
+	out := new(CreateFollowGroupResponse)
+	err := c.cc.Invoke(ctx, FollowService_CreateFollowGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) RenameFollowGroup(ctx context.Context, in *RenameFollowGroupRequest, opts ...grpc.CallOption) (*RenameFollowGroupResponse, error) {
+	out := new(RenameFollowGroupResponse)
+	err := c.cc.Invoke(ctx, FollowService_RenameFollowGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) DeleteFollowGroup(ctx context.Context, in *DeleteFollowGroupRequest, opts ...grpc.CallOption) (*DeleteFollowGroupResponse, error) {
+	out := new(DeleteFollowGroupResponse)
+	err := c.cc.Invoke(ctx, FollowService_DeleteFollowGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) GetFollowGroups(ctx context.Context, in *GetFollowGroupsRequest, opts ...grpc.CallOption) (*GetFollowGroupsResponse, error) {
+	out := new(GetFollowGroupsResponse)
+	err := c.cc.Invoke(ctx, FollowService_GetFollowGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) GetFolloweeByGroup(ctx context.Context, in *GetFolloweeByGroupRequest, opts ...grpc.CallOption) (*GetFolloweeByGroupResponse, error) {
+	out := new(GetFolloweeByGroupResponse)
+	err := c.cc.Invoke(ctx, FollowService_GetFolloweeByGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, FollowService_Block_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) CancelBlock(ctx context.Context, in *CancelBlockRequest, opts ...grpc.CallOption) (*CancelBlockResponse, error) {
+	out := new(CancelBlockResponse)
+	err := c.cc.Invoke(ctx, FollowService_CancelBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error) {
+	out := new(MuteResponse)
+	err := c.cc.Invoke(ctx, FollowService_Mute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) CancelMute(ctx context.Context, in *CancelMuteRequest, opts ...grpc.CallOption) (*CancelMuteResponse, error) {
+	out := new(CancelMuteResponse)
+	err := c.cc.Invoke(ctx, FollowService_CancelMute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) IsBlocked(ctx context.Context, in *IsBlockedRequest, opts ...grpc.CallOption) (*IsBlockedResponse, error) {
+	out := new(IsBlockedResponse)
+	err := c.cc.Invoke(ctx, FollowService_IsBlocked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) GetMuteList(ctx context.Context, in *GetMuteListRequest, opts ...grpc.CallOption) (*GetMuteListResponse, error) {
+	out := new(GetMuteListResponse)
+	err := c.cc.Invoke(ctx, FollowService_GetMuteList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) FilterMuted(ctx context.Context, in *FilterMutedRequest, opts ...grpc.CallOption) (*FilterMutedResponse, error) {
+	out := new(FilterMutedResponse)
+	err := c.cc.Invoke(ctx, FollowService_FilterMuted_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) GetMutualFollow(ctx context.Context, in *GetMutualFollowRequest, opts ...grpc.CallOption) (*GetMutualFollowResponse, error) {
+	out := new(GetMutualFollowResponse)
+	err := c.cc.Invoke(ctx, FollowService_GetMutualFollow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) GetCommonFollowee(ctx context.Context, in *GetCommonFolloweeRequest, opts ...grpc.CallOption) (*GetCommonFolloweeResponse, error) {
+	out := new(GetCommonFolloweeResponse)
+	err := c.cc.Invoke(ctx, FollowService_GetCommonFollowee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followServiceClient) GetFollowRecommendations(ctx context.Context, in *GetFollowRecommendationsRequest, opts ...grpc.CallOption) (*GetFollowRecommendationsResponse, error) {
+	out := new(GetFollowRecommendationsResponse)
+	err := c.cc.Invoke(ctx, FollowService_GetFollowRecommendations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *followServiceClient) GetFollowee(ctx context.Context, in *GetFolloweeRequest, opts ...grpc.CallOption) (*GetFolloweeResponse, error) {
 	out := new(GetFolloweeResponse)
 	err := c.cc.Invoke(ctx, FollowService_GetFollowee_FullMethodName, in, out, opts...)
@@ -113,6 +301,34 @@ type FollowServiceServer interface {
 	// 增删
 	Follow(context.Context, *FollowRequest) (*FollowResponse, error)
 	CancelFollow(context.Context, *CancelFollowRequest) (*CancelFollowResponse, error)
+	// 改，修改分组、备注、特别关注
+	UpdateFollowRelation(context.Context, *UpdateFollowRelationRequest) (*UpdateFollowRelationResponse, error)
+	// 关注分组
+	CreateFollowGroup(context.Context, *CreateFollowGroupRequest) (*CreateFollowGroupResponse, error)
+	RenameFollowGroup(context.Context, *RenameFollowGroupRequest) (*RenameFollowGroupResponse, error)
+	// 删除分组，分组里面的人还是关注着的，只是变成没有分组
+	DeleteFollowGroup(context.Context, *DeleteFollowGroupRequest) (*DeleteFollowGroupResponse, error)
+	GetFollowGroups(context.Context, *GetFollowGroupsRequest) (*GetFollowGroupsResponse, error)
+	// 按照分组获得关注列表，也可以只看特别关注
+	GetFolloweeByGroup(context.Context, *GetFolloweeByGroupRequest) (*GetFolloweeByGroupResponse, error)
+	// 拉黑，拉黑之后双方的关注关系都会被取消，对方不能关注、评论、打赏自己
+	Block(context.Context, *BlockRequest) (*BlockResponse, error)
+	CancelBlock(context.Context, *CancelBlockRequest) (*CancelBlockResponse, error)
+	// 屏蔽，对方的动态不会出现在自己的 feed 里面
+	Mute(context.Context, *MuteRequest) (*MuteResponse, error)
+	CancelMute(context.Context, *CancelMuteRequest) (*CancelMuteResponse, error)
+	// uid 是否拉黑了 target
+	IsBlocked(context.Context, *IsBlockedRequest) (*IsBlockedResponse, error)
+	// 获得 uid 屏蔽的人，拉黑的人也算
+	GetMuteList(context.Context, *GetMuteListRequest) (*GetMuteListResponse, error)
+	// 在 uids 里面找出屏蔽或者拉黑了 target 的人，feed 扩散的时候用
+	FilterMuted(context.Context, *FilterMutedRequest) (*FilterMutedResponse, error)
+	// 批量判断 uid 和 targets 里面的人是不是互相关注
+	GetMutualFollow(context.Context, *GetMutualFollowRequest) (*GetMutualFollowResponse, error)
+	// uid 和 target 共同关注的人
+	GetCommonFollowee(context.Context, *GetCommonFolloweeRequest) (*GetCommonFolloweeResponse, error)
+	// 推荐关注：我关注的人关注了谁，按照重合的次数排序
+	GetFollowRecommendations(context.Context, *GetFollowRecommendationsRequest) (*GetFollowRecommendationsResponse, error)
 	// 获得某个人的关注列表
 	GetFollowee(context.Context, *GetFolloweeRequest) (*GetFolloweeResponse, error)
 	// 获得某个人关注另外一个人的详细信息
@@ -134,6 +350,54 @@ func (UnimplementedFollowServiceServer) Follow(context.Context, *FollowRequest)
 func (UnimplementedFollowServiceServer) CancelFollow(context.Context, *CancelFollowRequest) (*CancelFollowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelFollow not implemented")
 }
+func (UnimplementedFollowServiceServer) UpdateFollowRelation(context.Context, *UpdateFollowRelationRequest) (*UpdateFollowRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFollowRelation not implemented")
+}
+func (UnimplementedFollowServiceServer) CreateFollowGroup(context.Context, *CreateFollowGroupRequest) (*CreateFollowGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFollowGroup not implemented")
+}
+func (UnimplementedFollowServiceServer) RenameFollowGroup(context.Context, *RenameFollowGroupRequest) (*RenameFollowGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFollowGroup not implemented")
+}
+func (UnimplementedFollowServiceServer) DeleteFollowGroup(context.Context, *DeleteFollowGroupRequest) (*DeleteFollowGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFollowGroup not implemented")
+}
+func (UnimplementedFollowServiceServer) GetFollowGroups(context.Context, *GetFollowGroupsRequest) (*GetFollowGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowGroups not implemented")
+}
+func (UnimplementedFollowServiceServer) GetFolloweeByGroup(context.Context, *GetFolloweeByGroupRequest) (*GetFolloweeByGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFolloweeByGroup not implemented")
+}
+func (UnimplementedFollowServiceServer) Block(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedFollowServiceServer) CancelBlock(context.Context, *CancelBlockRequest) (*CancelBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBlock not implemented")
+}
+func (UnimplementedFollowServiceServer) Mute(context.Context, *MuteRequest) (*MuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mute not implemented")
+}
+func (UnimplementedFollowServiceServer) CancelMute(context.Context, *CancelMuteRequest) (*CancelMuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMute not implemented")
+}
+func (UnimplementedFollowServiceServer) IsBlocked(context.Context, *IsBlockedRequest) (*IsBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsBlocked not implemented")
+}
+func (UnimplementedFollowServiceServer) GetMuteList(context.Context, *GetMuteListRequest) (*GetMuteListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMuteList not implemented")
+}
+func (UnimplementedFollowServiceServer) FilterMuted(context.Context, *FilterMutedRequest) (*FilterMutedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterMuted not implemented")
+}
+func (UnimplementedFollowServiceServer) GetMutualFollow(context.Context, *GetMutualFollowRequest) (*GetMutualFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMutualFollow not implemented")
+}
+func (UnimplementedFollowServiceServer) GetCommonFollowee(context.Context, *GetCommonFolloweeRequest) (*GetCommonFolloweeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommonFollowee not implemented")
+}
+func (UnimplementedFollowServiceServer) GetFollowRecommendations(context.Context, *GetFollowRecommendationsRequest) (*GetFollowRecommendationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowRecommendations not implemented")
+}
 func (UnimplementedFollowServiceServer) GetFollowee(context.Context, *GetFolloweeRequest) (*GetFolloweeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFollowee not implemented")
 }
@@ -195,6 +459,294 @@ func _FollowService_CancelFollow_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FollowService_UpdateFollowRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFollowRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).UpdateFollowRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_UpdateFollowRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).UpdateFollowRelation(ctx, req.(*UpdateFollowRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_CreateFollowGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFollowGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).CreateFollowGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_CreateFollowGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).CreateFollowGroup(ctx, req.(*CreateFollowGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_RenameFollowGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFollowGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).RenameFollowGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_RenameFollowGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).RenameFollowGroup(ctx, req.(*RenameFollowGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_DeleteFollowGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFollowGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).DeleteFollowGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_DeleteFollowGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).DeleteFollowGroup(ctx, req.(*DeleteFollowGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_GetFollowGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).GetFollowGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_GetFollowGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).GetFollowGroups(ctx, req.(*GetFollowGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_GetFolloweeByGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFolloweeByGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).GetFolloweeByGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_GetFolloweeByGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).GetFolloweeByGroup(ctx, req.(*GetFolloweeByGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_Block_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).Block(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_CancelBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).CancelBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_CancelBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).CancelBlock(ctx, req.(*CancelBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_Mute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).Mute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_Mute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).Mute(ctx, req.(*MuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_CancelMute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).CancelMute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_CancelMute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).CancelMute(ctx, req.(*CancelMuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_IsBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).IsBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_IsBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).IsBlocked(ctx, req.(*IsBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_GetMuteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMuteListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).GetMuteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_GetMuteList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).GetMuteList(ctx, req.(*GetMuteListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_FilterMuted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterMutedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).FilterMuted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_FilterMuted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).FilterMuted(ctx, req.(*FilterMutedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_GetMutualFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMutualFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).GetMutualFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_GetMutualFollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).GetMutualFollow(ctx, req.(*GetMutualFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_GetCommonFollowee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommonFolloweeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).GetCommonFollowee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_GetCommonFollowee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).GetCommonFollowee(ctx, req.(*GetCommonFolloweeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowService_GetFollowRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServiceServer).GetFollowRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowService_GetFollowRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServiceServer).GetFollowRecommendations(ctx, req.(*GetFollowRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FollowService_GetFollowee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFolloweeRequest)
 	if err := dec(in); err != nil {
@@ -282,6 +834,70 @@ var FollowService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelFollow",
 			Handler:    _FollowService_CancelFollow_Handler,
 		},
+		{
+			MethodName: "UpdateFollowRelation",
+			Handler:    _FollowService_UpdateFollowRelation_Handler,
+		},
+		{
+			MethodName: "CreateFollowGroup",
+			Handler:    _FollowService_CreateFollowGroup_Handler,
+		},
+		{
+			MethodName: "RenameFollowGroup",
+			Handler:    _FollowService_RenameFollowGroup_Handler,
+		},
+		{
+			MethodName: "DeleteFollowGroup",
+			Handler:    _FollowService_DeleteFollowGroup_Handler,
+		},
+		{
+			MethodName: "GetFollowGroups",
+			Handler:    _FollowService_GetFollowGroups_Handler,
+		},
+		{
+			MethodName: "GetFolloweeByGroup",
+			Handler:    _FollowService_GetFolloweeByGroup_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _FollowService_Block_Handler,
+		},
+		{
+			MethodName: "CancelBlock",
+			Handler:    _FollowService_CancelBlock_Handler,
+		},
+		{
+			MethodName: "Mute",
+			Handler:    _FollowService_Mute_Handler,
+		},
+		{
+			MethodName: "CancelMute",
+			Handler:    _FollowService_CancelMute_Handler,
+		},
+		{
+			MethodName: "IsBlocked",
+			Handler:    _FollowService_IsBlocked_Handler,
+		},
+		{
+			MethodName: "GetMuteList",
+			Handler:    _FollowService_GetMuteList_Handler,
+		},
+		{
+			MethodName: "FilterMuted",
+			Handler:    _FollowService_FilterMuted_Handler,
+		},
+		{
+			MethodName: "GetMutualFollow",
+			Handler:    _FollowService_GetMutualFollow_Handler,
+		},
+		{
+			MethodName: "GetCommonFollowee",
+			Handler:    _FollowService_GetCommonFollowee_Handler,
+		},
+		{
+			MethodName: "GetFollowRecommendations",
+			Handler:    _FollowService_GetFollowRecommendations_Handler,
+		},
 		{
 			MethodName: "GetFollowee",
 			Handler:    _FollowService_GetFollowee_Handler,
@@ -40,6 +40,46 @@ func (m *MockFollowServiceClient) EXPECT() *MockFollowServiceClientMockRecorder
 	return m.recorder
 }
 
+// Block mocks base method.
+func (m *MockFollowServiceClient) Block(ctx context.Context, in *followv1.BlockRequest, opts ...grpc.CallOption) (*followv1.BlockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Block", varargs...)
+	ret0, _ := ret[0].(*followv1.BlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockFollowServiceClientMockRecorder) Block(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockFollowServiceClient)(nil).Block), varargs...)
+}
+
+// CancelBlock mocks base method.
+func (m *MockFollowServiceClient) CancelBlock(ctx context.Context, in *followv1.CancelBlockRequest, opts ...grpc.CallOption) (*followv1.CancelBlockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelBlock", varargs...)
+	ret0, _ := ret[0].(*followv1.CancelBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelBlock indicates an expected call of CancelBlock.
+func (mr *MockFollowServiceClientMockRecorder) CancelBlock(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBlock", reflect.TypeOf((*MockFollowServiceClient)(nil).CancelBlock), varargs...)
+}
+
 // CancelFollow mocks base method.
 func (m *MockFollowServiceClient) CancelFollow(ctx context.Context, in *followv1.CancelFollowRequest, opts ...grpc.CallOption) (*followv1.CancelFollowResponse, error) {
 	m.ctrl.T.Helper()
@@ -60,6 +100,86 @@ func (mr *MockFollowServiceClientMockRecorder) CancelFollow(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelFollow", reflect.TypeOf((*MockFollowServiceClient)(nil).CancelFollow), varargs...)
 }
 
+// CancelMute mocks base method.
+func (m *MockFollowServiceClient) CancelMute(ctx context.Context, in *followv1.CancelMuteRequest, opts ...grpc.CallOption) (*followv1.CancelMuteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelMute", varargs...)
+	ret0, _ := ret[0].(*followv1.CancelMuteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelMute indicates an expected call of CancelMute.
+func (mr *MockFollowServiceClientMockRecorder) CancelMute(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMute", reflect.TypeOf((*MockFollowServiceClient)(nil).CancelMute), varargs...)
+}
+
+// CreateFollowGroup mocks base method.
+func (m *MockFollowServiceClient) CreateFollowGroup(ctx context.Context, in *followv1.CreateFollowGroupRequest, opts ...grpc.CallOption) (*followv1.CreateFollowGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateFollowGroup", varargs...)
+	ret0, _ := ret[0].(*followv1.CreateFollowGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFollowGroup indicates an expected call of CreateFollowGroup.
+func (mr *MockFollowServiceClientMockRecorder) CreateFollowGroup(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFollowGroup", reflect.TypeOf((*MockFollowServiceClient)(nil).CreateFollowGroup), varargs...)
+}
+
+// DeleteFollowGroup mocks base method.
+func (m *MockFollowServiceClient) DeleteFollowGroup(ctx context.Context, in *followv1.DeleteFollowGroupRequest, opts ...grpc.CallOption) (*followv1.DeleteFollowGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteFollowGroup", varargs...)
+	ret0, _ := ret[0].(*followv1.DeleteFollowGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFollowGroup indicates an expected call of DeleteFollowGroup.
+func (mr *MockFollowServiceClientMockRecorder) DeleteFollowGroup(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFollowGroup", reflect.TypeOf((*MockFollowServiceClient)(nil).DeleteFollowGroup), varargs...)
+}
+
+// FilterMuted mocks base method.
+func (m *MockFollowServiceClient) FilterMuted(ctx context.Context, in *followv1.FilterMutedRequest, opts ...grpc.CallOption) (*followv1.FilterMutedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FilterMuted", varargs...)
+	ret0, _ := ret[0].(*followv1.FilterMutedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterMuted indicates an expected call of FilterMuted.
+func (mr *MockFollowServiceClientMockRecorder) FilterMuted(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterMuted", reflect.TypeOf((*MockFollowServiceClient)(nil).FilterMuted), varargs...)
+}
+
 // Follow mocks base method.
 func (m *MockFollowServiceClient) Follow(ctx context.Context, in *followv1.FollowRequest, opts ...grpc.CallOption) (*followv1.FollowResponse, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +220,66 @@ func (mr *MockFollowServiceClientMockRecorder) FollowInfo(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowInfo", reflect.TypeOf((*MockFollowServiceClient)(nil).FollowInfo), varargs...)
 }
 
+// GetCommonFollowee mocks base method.
+func (m *MockFollowServiceClient) GetCommonFollowee(ctx context.Context, in *followv1.GetCommonFolloweeRequest, opts ...grpc.CallOption) (*followv1.GetCommonFolloweeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCommonFollowee", varargs...)
+	ret0, _ := ret[0].(*followv1.GetCommonFolloweeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommonFollowee indicates an expected call of GetCommonFollowee.
+func (mr *MockFollowServiceClientMockRecorder) GetCommonFollowee(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommonFollowee", reflect.TypeOf((*MockFollowServiceClient)(nil).GetCommonFollowee), varargs...)
+}
+
+// GetFollowGroups mocks base method.
+func (m *MockFollowServiceClient) GetFollowGroups(ctx context.Context, in *followv1.GetFollowGroupsRequest, opts ...grpc.CallOption) (*followv1.GetFollowGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFollowGroups", varargs...)
+	ret0, _ := ret[0].(*followv1.GetFollowGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowGroups indicates an expected call of GetFollowGroups.
+func (mr *MockFollowServiceClientMockRecorder) GetFollowGroups(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowGroups", reflect.TypeOf((*MockFollowServiceClient)(nil).GetFollowGroups), varargs...)
+}
+
+// GetFollowRecommendations mocks base method.
+func (m *MockFollowServiceClient) GetFollowRecommendations(ctx context.Context, in *followv1.GetFollowRecommendationsRequest, opts ...grpc.CallOption) (*followv1.GetFollowRecommendationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFollowRecommendations", varargs...)
+	ret0, _ := ret[0].(*followv1.GetFollowRecommendationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowRecommendations indicates an expected call of GetFollowRecommendations.
+func (mr *MockFollowServiceClientMockRecorder) GetFollowRecommendations(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowRecommendations", reflect.TypeOf((*MockFollowServiceClient)(nil).GetFollowRecommendations), varargs...)
+}
+
 // GetFollowStatic mocks base method.
 func (m *MockFollowServiceClient) GetFollowStatic(ctx context.Context, in *followv1.GetFollowStaticRequest, opts ...grpc.CallOption) (*followv1.GetFollowStaticResponse, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +320,26 @@ func (mr *MockFollowServiceClientMockRecorder) GetFollowee(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowee", reflect.TypeOf((*MockFollowServiceClient)(nil).GetFollowee), varargs...)
 }
 
+// GetFolloweeByGroup mocks base method.
+func (m *MockFollowServiceClient) GetFolloweeByGroup(ctx context.Context, in *followv1.GetFolloweeByGroupRequest, opts ...grpc.CallOption) (*followv1.GetFolloweeByGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFolloweeByGroup", varargs...)
+	ret0, _ := ret[0].(*followv1.GetFolloweeByGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolloweeByGroup indicates an expected call of GetFolloweeByGroup.
+func (mr *MockFollowServiceClientMockRecorder) GetFolloweeByGroup(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolloweeByGroup", reflect.TypeOf((*MockFollowServiceClient)(nil).GetFolloweeByGroup), varargs...)
+}
+
 // GetFollower mocks base method.
 func (m *MockFollowServiceClient) GetFollower(ctx context.Context, in *followv1.GetFollowerRequest, opts ...grpc.CallOption) (*followv1.GetFollowerResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +360,126 @@ func (mr *MockFollowServiceClientMockRecorder) GetFollower(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollower", reflect.TypeOf((*MockFollowServiceClient)(nil).GetFollower), varargs...)
 }
 
+// GetMuteList mocks base method.
+func (m *MockFollowServiceClient) GetMuteList(ctx context.Context, in *followv1.GetMuteListRequest, opts ...grpc.CallOption) (*followv1.GetMuteListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMuteList", varargs...)
+	ret0, _ := ret[0].(*followv1.GetMuteListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMuteList indicates an expected call of GetMuteList.
+func (mr *MockFollowServiceClientMockRecorder) GetMuteList(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMuteList", reflect.TypeOf((*MockFollowServiceClient)(nil).GetMuteList), varargs...)
+}
+
+// GetMutualFollow mocks base method.
+func (m *MockFollowServiceClient) GetMutualFollow(ctx context.Context, in *followv1.GetMutualFollowRequest, opts ...grpc.CallOption) (*followv1.GetMutualFollowResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMutualFollow", varargs...)
+	ret0, _ := ret[0].(*followv1.GetMutualFollowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMutualFollow indicates an expected call of GetMutualFollow.
+func (mr *MockFollowServiceClientMockRecorder) GetMutualFollow(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutualFollow", reflect.TypeOf((*MockFollowServiceClient)(nil).GetMutualFollow), varargs...)
+}
+
+// IsBlocked mocks base method.
+func (m *MockFollowServiceClient) IsBlocked(ctx context.Context, in *followv1.IsBlockedRequest, opts ...grpc.CallOption) (*followv1.IsBlockedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsBlocked", varargs...)
+	ret0, _ := ret[0].(*followv1.IsBlockedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockFollowServiceClientMockRecorder) IsBlocked(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockFollowServiceClient)(nil).IsBlocked), varargs...)
+}
+
+// Mute mocks base method.
+func (m *MockFollowServiceClient) Mute(ctx context.Context, in *followv1.MuteRequest, opts ...grpc.CallOption) (*followv1.MuteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Mute", varargs...)
+	ret0, _ := ret[0].(*followv1.MuteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Mute indicates an expected call of Mute.
+func (mr *MockFollowServiceClientMockRecorder) Mute(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mute", reflect.TypeOf((*MockFollowServiceClient)(nil).Mute), varargs...)
+}
+
+// RenameFollowGroup mocks base method.
+func (m *MockFollowServiceClient) RenameFollowGroup(ctx context.Context, in *followv1.RenameFollowGroupRequest, opts ...grpc.CallOption) (*followv1.RenameFollowGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenameFollowGroup", varargs...)
+	ret0, _ := ret[0].(*followv1.RenameFollowGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameFollowGroup indicates an expected call of RenameFollowGroup.
+func (mr *MockFollowServiceClientMockRecorder) RenameFollowGroup(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameFollowGroup", reflect.TypeOf((*MockFollowServiceClient)(nil).RenameFollowGroup), varargs...)
+}
+
+// UpdateFollowRelation mocks base method.
+func (m *MockFollowServiceClient) UpdateFollowRelation(ctx context.Context, in *followv1.UpdateFollowRelationRequest, opts ...grpc.CallOption) (*followv1.UpdateFollowRelationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateFollowRelation", varargs...)
+	ret0, _ := ret[0].(*followv1.UpdateFollowRelationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFollowRelation indicates an expected call of UpdateFollowRelation.
+func (mr *MockFollowServiceClientMockRecorder) UpdateFollowRelation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFollowRelation", reflect.TypeOf((*MockFollowServiceClient)(nil).UpdateFollowRelation), varargs...)
+}
+
 // MockFollowServiceServer is a mock of FollowServiceServer interface.
 type MockFollowServiceServer struct {
 	ctrl     *gomock.Controller
@@ -183,6 +503,36 @@ func (m *MockFollowServiceServer) EXPECT() *MockFollowServiceServerMockRecorder
 	return m.recorder
 }
 
+// Block mocks base method.
+func (m *MockFollowServiceServer) Block(arg0 context.Context, arg1 *followv1.BlockRequest) (*followv1.BlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Block", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.BlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockFollowServiceServerMockRecorder) Block(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockFollowServiceServer)(nil).Block), arg0, arg1)
+}
+
+// CancelBlock mocks base method.
+func (m *MockFollowServiceServer) CancelBlock(arg0 context.Context, arg1 *followv1.CancelBlockRequest) (*followv1.CancelBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBlock", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.CancelBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelBlock indicates an expected call of CancelBlock.
+func (mr *MockFollowServiceServerMockRecorder) CancelBlock(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBlock", reflect.TypeOf((*MockFollowServiceServer)(nil).CancelBlock), arg0, arg1)
+}
+
 // CancelFollow mocks base method.
 func (m *MockFollowServiceServer) CancelFollow(arg0 context.Context, arg1 *followv1.CancelFollowRequest) (*followv1.CancelFollowResponse, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +548,66 @@ func (mr *MockFollowServiceServerMockRecorder) CancelFollow(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelFollow", reflect.TypeOf((*MockFollowServiceServer)(nil).CancelFollow), arg0, arg1)
 }
 
+// CancelMute mocks base method.
+func (m *MockFollowServiceServer) CancelMute(arg0 context.Context, arg1 *followv1.CancelMuteRequest) (*followv1.CancelMuteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelMute", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.CancelMuteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelMute indicates an expected call of CancelMute.
+func (mr *MockFollowServiceServerMockRecorder) CancelMute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMute", reflect.TypeOf((*MockFollowServiceServer)(nil).CancelMute), arg0, arg1)
+}
+
+// CreateFollowGroup mocks base method.
+func (m *MockFollowServiceServer) CreateFollowGroup(arg0 context.Context, arg1 *followv1.CreateFollowGroupRequest) (*followv1.CreateFollowGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFollowGroup", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.CreateFollowGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFollowGroup indicates an expected call of CreateFollowGroup.
+func (mr *MockFollowServiceServerMockRecorder) CreateFollowGroup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFollowGroup", reflect.TypeOf((*MockFollowServiceServer)(nil).CreateFollowGroup), arg0, arg1)
+}
+
+// DeleteFollowGroup mocks base method.
+func (m *MockFollowServiceServer) DeleteFollowGroup(arg0 context.Context, arg1 *followv1.DeleteFollowGroupRequest) (*followv1.DeleteFollowGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFollowGroup", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.DeleteFollowGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFollowGroup indicates an expected call of DeleteFollowGroup.
+func (mr *MockFollowServiceServerMockRecorder) DeleteFollowGroup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFollowGroup", reflect.TypeOf((*MockFollowServiceServer)(nil).DeleteFollowGroup), arg0, arg1)
+}
+
+// FilterMuted mocks base method.
+func (m *MockFollowServiceServer) FilterMuted(arg0 context.Context, arg1 *followv1.FilterMutedRequest) (*followv1.FilterMutedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterMuted", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.FilterMutedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterMuted indicates an expected call of FilterMuted.
+func (mr *MockFollowServiceServerMockRecorder) FilterMuted(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterMuted", reflect.TypeOf((*MockFollowServiceServer)(nil).FilterMuted), arg0, arg1)
+}
+
 // Follow mocks base method.
 func (m *MockFollowServiceServer) Follow(arg0 context.Context, arg1 *followv1.FollowRequest) (*followv1.FollowResponse, error) {
 	m.ctrl.T.Helper()
@@ -228,6 +638,51 @@ func (mr *MockFollowServiceServerMockRecorder) FollowInfo(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowInfo", reflect.TypeOf((*MockFollowServiceServer)(nil).FollowInfo), arg0, arg1)
 }
 
+// GetCommonFollowee mocks base method.
+func (m *MockFollowServiceServer) GetCommonFollowee(arg0 context.Context, arg1 *followv1.GetCommonFolloweeRequest) (*followv1.GetCommonFolloweeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommonFollowee", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.GetCommonFolloweeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommonFollowee indicates an expected call of GetCommonFollowee.
+func (mr *MockFollowServiceServerMockRecorder) GetCommonFollowee(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommonFollowee", reflect.TypeOf((*MockFollowServiceServer)(nil).GetCommonFollowee), arg0, arg1)
+}
+
+// GetFollowGroups mocks base method.
+func (m *MockFollowServiceServer) GetFollowGroups(arg0 context.Context, arg1 *followv1.GetFollowGroupsRequest) (*followv1.GetFollowGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowGroups", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.GetFollowGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowGroups indicates an expected call of GetFollowGroups.
+func (mr *MockFollowServiceServerMockRecorder) GetFollowGroups(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowGroups", reflect.TypeOf((*MockFollowServiceServer)(nil).GetFollowGroups), arg0, arg1)
+}
+
+// GetFollowRecommendations mocks base method.
+func (m *MockFollowServiceServer) GetFollowRecommendations(arg0 context.Context, arg1 *followv1.GetFollowRecommendationsRequest) (*followv1.GetFollowRecommendationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowRecommendations", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.GetFollowRecommendationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowRecommendations indicates an expected call of GetFollowRecommendations.
+func (mr *MockFollowServiceServerMockRecorder) GetFollowRecommendations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowRecommendations", reflect.TypeOf((*MockFollowServiceServer)(nil).GetFollowRecommendations), arg0, arg1)
+}
+
 // GetFollowStatic mocks base method.
 func (m *MockFollowServiceServer) GetFollowStatic(arg0 context.Context, arg1 *followv1.GetFollowStaticRequest) (*followv1.GetFollowStaticResponse, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +713,21 @@ func (mr *MockFollowServiceServerMockRecorder) GetFollowee(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowee", reflect.TypeOf((*MockFollowServiceServer)(nil).GetFollowee), arg0, arg1)
 }
 
+// GetFolloweeByGroup mocks base method.
+func (m *MockFollowServiceServer) GetFolloweeByGroup(arg0 context.Context, arg1 *followv1.GetFolloweeByGroupRequest) (*followv1.GetFolloweeByGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFolloweeByGroup", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.GetFolloweeByGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolloweeByGroup indicates an expected call of GetFolloweeByGroup.
+func (mr *MockFollowServiceServerMockRecorder) GetFolloweeByGroup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolloweeByGroup", reflect.TypeOf((*MockFollowServiceServer)(nil).GetFolloweeByGroup), arg0, arg1)
+}
+
 // GetFollower mocks base method.
 func (m *MockFollowServiceServer) GetFollower(arg0 context.Context, arg1 *followv1.GetFollowerRequest) (*followv1.GetFollowerResponse, error) {
 	m.ctrl.T.Helper()
@@ -273,6 +743,96 @@ func (mr *MockFollowServiceServerMockRecorder) GetFollower(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollower", reflect.TypeOf((*MockFollowServiceServer)(nil).GetFollower), arg0, arg1)
 }
 
+// GetMuteList mocks base method.
+func (m *MockFollowServiceServer) GetMuteList(arg0 context.Context, arg1 *followv1.GetMuteListRequest) (*followv1.GetMuteListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMuteList", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.GetMuteListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMuteList indicates an expected call of GetMuteList.
+func (mr *MockFollowServiceServerMockRecorder) GetMuteList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMuteList", reflect.TypeOf((*MockFollowServiceServer)(nil).GetMuteList), arg0, arg1)
+}
+
+// GetMutualFollow mocks base method.
+func (m *MockFollowServiceServer) GetMutualFollow(arg0 context.Context, arg1 *followv1.GetMutualFollowRequest) (*followv1.GetMutualFollowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMutualFollow", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.GetMutualFollowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMutualFollow indicates an expected call of GetMutualFollow.
+func (mr *MockFollowServiceServerMockRecorder) GetMutualFollow(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutualFollow", reflect.TypeOf((*MockFollowServiceServer)(nil).GetMutualFollow), arg0, arg1)
+}
+
+// IsBlocked mocks base method.
+func (m *MockFollowServiceServer) IsBlocked(arg0 context.Context, arg1 *followv1.IsBlockedRequest) (*followv1.IsBlockedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.IsBlockedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockFollowServiceServerMockRecorder) IsBlocked(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockFollowServiceServer)(nil).IsBlocked), arg0, arg1)
+}
+
+// Mute mocks base method.
+func (m *MockFollowServiceServer) Mute(arg0 context.Context, arg1 *followv1.MuteRequest) (*followv1.MuteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mute", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.MuteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Mute indicates an expected call of Mute.
+func (mr *MockFollowServiceServerMockRecorder) Mute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mute", reflect.TypeOf((*MockFollowServiceServer)(nil).Mute), arg0, arg1)
+}
+
+// RenameFollowGroup mocks base method.
+func (m *MockFollowServiceServer) RenameFollowGroup(arg0 context.Context, arg1 *followv1.RenameFollowGroupRequest) (*followv1.RenameFollowGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameFollowGroup", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.RenameFollowGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameFollowGroup indicates an expected call of RenameFollowGroup.
+func (mr *MockFollowServiceServerMockRecorder) RenameFollowGroup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameFollowGroup", reflect.TypeOf((*MockFollowServiceServer)(nil).RenameFollowGroup), arg0, arg1)
+}
+
+// UpdateFollowRelation mocks base method.
+func (m *MockFollowServiceServer) UpdateFollowRelation(arg0 context.Context, arg1 *followv1.UpdateFollowRelationRequest) (*followv1.UpdateFollowRelationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFollowRelation", arg0, arg1)
+	ret0, _ := ret[0].(*followv1.UpdateFollowRelationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFollowRelation indicates an expected call of UpdateFollowRelation.
+func (mr *MockFollowServiceServerMockRecorder) UpdateFollowRelation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFollowRelation", reflect.TypeOf((*MockFollowServiceServer)(nil).UpdateFollowRelation), arg0, arg1)
+}
+
 // mustEmbedUnimplementedFollowServiceServer mocks base method.
 func (m *MockFollowServiceServer) mustEmbedUnimplementedFollowServiceServer() {
 	m.ctrl.T.Helper()
@@ -19,14 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	InteractiveService_IncrReadCnt_FullMethodName    = "/intr.v1.InteractiveService/IncrReadCnt"
-	InteractiveService_Like_FullMethodName           = "/intr.v1.InteractiveService/Like"
-	InteractiveService_CancelLike_FullMethodName     = "/intr.v1.InteractiveService/CancelLike"
-	InteractiveService_Collect_FullMethodName        = "/intr.v1.InteractiveService/Collect"
-	InteractiveService_CancelCollect_FullMethodName  = "/intr.v1.InteractiveService/CancelCollect"
-	InteractiveService_GetCollections_FullMethodName = "/intr.v1.InteractiveService/GetCollections"
-	InteractiveService_Get_FullMethodName            = "/intr.v1.InteractiveService/Get"
-	InteractiveService_GetByIds_FullMethodName       = "/intr.v1.InteractiveService/GetByIds"
+	InteractiveService_IncrReadCnt_FullMethodName          = "/intr.v1.InteractiveService/IncrReadCnt"
+	InteractiveService_Like_FullMethodName                 = "/intr.v1.InteractiveService/Like"
+	InteractiveService_CancelLike_FullMethodName           = "/intr.v1.InteractiveService/CancelLike"
+	InteractiveService_React_FullMethodName                = "/intr.v1.InteractiveService/React"
+	InteractiveService_CancelReaction_FullMethodName       = "/intr.v1.InteractiveService/CancelReaction"
+	InteractiveService_Collect_FullMethodName              = "/intr.v1.InteractiveService/Collect"
+	InteractiveService_CancelCollect_FullMethodName        = "/intr.v1.InteractiveService/CancelCollect"
+	InteractiveService_GetCollections_FullMethodName       = "/intr.v1.InteractiveService/GetCollections"
+	InteractiveService_Get_FullMethodName                  = "/intr.v1.InteractiveService/Get"
+	InteractiveService_GetByIds_FullMethodName             = "/intr.v1.InteractiveService/GetByIds"
+	InteractiveService_CreateCollection_FullMethodName     = "/intr.v1.InteractiveService/CreateCollection"
+	InteractiveService_UpdateCollection_FullMethodName     = "/intr.v1.InteractiveService/UpdateCollection"
+	InteractiveService_DeleteCollection_FullMethodName     = "/intr.v1.InteractiveService/DeleteCollection"
+	InteractiveService_ListCollections_FullMethodName      = "/intr.v1.InteractiveService/ListCollections"
+	InteractiveService_GetCollectionItems_FullMethodName   = "/intr.v1.InteractiveService/GetCollectionItems"
+	InteractiveService_RemoveCollectionItem_FullMethodName = "/intr.v1.InteractiveService/RemoveCollectionItem"
+	InteractiveService_MoveCollectionItem_FullMethodName   = "/intr.v1.InteractiveService/MoveCollectionItem"
 )
 
 // InteractiveServiceClient is the client API for InteractiveService service.
@@ -37,6 +46,11 @@ type InteractiveServiceClient interface {
 	Like(ctx context.Context, in *LikeRequest, opts ...grpc.CallOption) (*LikeResponse, error)
 	// CancelLike 取消点赞
 	CancelLike(ctx context.Context, in *CancelLikeRequest, opts ...grpc.CallOption) (*CancelLikeResponse, error)
+	// React 表态，一个人对一个东西只能有一种表态，再次调用就是换一种。
+	// Like 等价于 React 点赞
+	React(ctx context.Context, in *ReactRequest, opts ...grpc.CallOption) (*ReactResponse, error)
+	// CancelReaction 取消表态，不管当前是哪一种
+	CancelReaction(ctx context.Context, in *CancelReactionRequest, opts ...grpc.CallOption) (*CancelReactionResponse, error)
 	// Collect 收藏
 	Collect(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (*CollectResponse, error)
 	// CancelCollect 取消收藏
@@ -45,6 +59,18 @@ type InteractiveServiceClient interface {
 	GetCollections(ctx context.Context, in *GetCollectionsRequest, opts ...grpc.CallOption) (*GetCollectionsResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	GetByIds(ctx context.Context, in *GetByIdsRequest, opts ...grpc.CallOption) (*GetByIdsResponse, error)
+	// 收藏夹相关，一个东西可以放进多个收藏夹，放进收藏夹用 Collect
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
+	// DeleteCollection 删除收藏夹，里面的东西依旧是收藏状态
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	// ListCollections 查看某个人的收藏夹，看别人的只能看到公开的
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	// GetCollectionItems 查看收藏夹里面的东西
+	GetCollectionItems(ctx context.Context, in *GetCollectionItemsRequest, opts ...grpc.CallOption) (*GetCollectionItemsResponse, error)
+	// RemoveCollectionItem 从收藏夹里面拿出来，依旧是收藏状态
+	RemoveCollectionItem(ctx context.Context, in *RemoveCollectionItemRequest, opts ...grpc.CallOption) (*RemoveCollectionItemResponse, error)
+	MoveCollectionItem(ctx context.Context, in *MoveCollectionItemRequest, opts ...grpc.CallOption) (*MoveCollectionItemResponse, error)
 }
 
 type interactiveServiceClient struct {
@@ -82,6 +108,24 @@ func (c *interactiveServiceClient) CancelLike(ctx context.Context, in *CancelLik
 	return out, nil
 }
 
+func (c *interactiveServiceClient) React(ctx context.Context, in *ReactRequest, opts ...grpc.CallOption) (*ReactResponse, error) {
+	out := new(ReactResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_React_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) CancelReaction(ctx context.Context, in *CancelReactionRequest, opts ...grpc.CallOption) (*CancelReactionResponse, error) {
+	out := new(CancelReactionResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_CancelReaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *interactiveServiceClient) Collect(ctx context.Context, in *CollectRequest, opts ...grpc.CallOption) (*CollectResponse, error) {
 	out := new(CollectResponse)
 	err := c.cc.Invoke(ctx, InteractiveService_Collect_FullMethodName, in, out, opts...)
@@ -127,6 +171,69 @@ func (c *interactiveServiceClient) GetByIds(ctx context.Context, in *GetByIdsReq
 	return out, nil
 }
 
+func (c *interactiveServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error) {
+	out := new(CreateCollectionResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_CreateCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error) {
+	out := new(UpdateCollectionResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_UpdateCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
+	out := new(DeleteCollectionResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_DeleteCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_ListCollections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) GetCollectionItems(ctx context.Context, in *GetCollectionItemsRequest, opts ...grpc.CallOption) (*GetCollectionItemsResponse, error) {
+	out := new(GetCollectionItemsResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_GetCollectionItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) RemoveCollectionItem(ctx context.Context, in *RemoveCollectionItemRequest, opts ...grpc.CallOption) (*RemoveCollectionItemResponse, error) {
+	out := new(RemoveCollectionItemResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_RemoveCollectionItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interactiveServiceClient) MoveCollectionItem(ctx context.Context, in *MoveCollectionItemRequest, opts ...grpc.CallOption) (*MoveCollectionItemResponse, error) {
+	out := new(MoveCollectionItemResponse)
+	err := c.cc.Invoke(ctx, InteractiveService_MoveCollectionItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InteractiveServiceServer is the server API for InteractiveService service.
 // All implementations must embed UnimplementedInteractiveServiceServer
 // for forward compatibility
@@ -135,6 +242,11 @@ type InteractiveServiceServer interface {
 	Like(context.Context, *LikeRequest) (*LikeResponse, error)
 	// CancelLike 取消点赞
 	CancelLike(context.Context, *CancelLikeRequest) (*CancelLikeResponse, error)
+	// React 表态，一个人对一个东西只能有一种表态，再次调用就是换一种。
+	// Like 等价于 React 点赞
+	React(context.Context, *ReactRequest) (*ReactResponse, error)
+	// CancelReaction 取消表态，不管当前是哪一种
+	CancelReaction(context.Context, *CancelReactionRequest) (*CancelReactionResponse, error)
 	// Collect 收藏
 	Collect(context.Context, *CollectRequest) (*CollectResponse, error)
 	// CancelCollect 取消收藏
@@ -143,6 +255,18 @@ type InteractiveServiceServer interface {
 	GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error)
 	Get(context.Context, *GetRequest) (*GetResponse, error)
 	GetByIds(context.Context, *GetByIdsRequest) (*GetByIdsResponse, error)
+	// 收藏夹相关，一个东西可以放进多个收藏夹，放进收藏夹用 Collect
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
+	// DeleteCollection 删除收藏夹，里面的东西依旧是收藏状态
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	// ListCollections 查看某个人的收藏夹，看别人的只能看到公开的
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	// GetCollectionItems 查看收藏夹里面的东西
+	GetCollectionItems(context.Context, *GetCollectionItemsRequest) (*GetCollectionItemsResponse, error)
+	// RemoveCollectionItem 从收藏夹里面拿出来，依旧是收藏状态
+	RemoveCollectionItem(context.Context, *RemoveCollectionItemRequest) (*RemoveCollectionItemResponse, error)
+	MoveCollectionItem(context.Context, *MoveCollectionItemRequest) (*MoveCollectionItemResponse, error)
 	mustEmbedUnimplementedInteractiveServiceServer()
 }
 
@@ -159,6 +283,12 @@ func (UnimplementedInteractiveServiceServer) Like(context.Context, *LikeRequest)
 func (UnimplementedInteractiveServiceServer) CancelLike(context.Context, *CancelLikeRequest) (*CancelLikeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelLike not implemented")
 }
+func (UnimplementedInteractiveServiceServer) React(context.Context, *ReactRequest) (*ReactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method React not implemented")
+}
+func (UnimplementedInteractiveServiceServer) CancelReaction(context.Context, *CancelReactionRequest) (*CancelReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReaction not implemented")
+}
 func (UnimplementedInteractiveServiceServer) Collect(context.Context, *CollectRequest) (*CollectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Collect not implemented")
 }
@@ -174,6 +304,27 @@ func (UnimplementedInteractiveServiceServer) Get(context.Context, *GetRequest) (
 func (UnimplementedInteractiveServiceServer) GetByIds(context.Context, *GetByIdsRequest) (*GetByIdsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByIds not implemented")
 }
+func (UnimplementedInteractiveServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedInteractiveServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedInteractiveServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedInteractiveServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedInteractiveServiceServer) GetCollectionItems(context.Context, *GetCollectionItemsRequest) (*GetCollectionItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionItems not implemented")
+}
+func (UnimplementedInteractiveServiceServer) RemoveCollectionItem(context.Context, *RemoveCollectionItemRequest) (*RemoveCollectionItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollectionItem not implemented")
+}
+func (UnimplementedInteractiveServiceServer) MoveCollectionItem(context.Context, *MoveCollectionItemRequest) (*MoveCollectionItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCollectionItem not implemented")
+}
 func (UnimplementedInteractiveServiceServer) mustEmbedUnimplementedInteractiveServiceServer() {}
 
 // UnsafeInteractiveServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -241,6 +392,42 @@ func _InteractiveService_CancelLike_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InteractiveService_React_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).React(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_React_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).React(ctx, req.(*ReactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_CancelReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).CancelReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_CancelReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).CancelReaction(ctx, req.(*CancelReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InteractiveService_Collect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CollectRequest)
 	if err := dec(in); err != nil {
@@ -331,6 +518,132 @@ func _InteractiveService_GetByIds_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InteractiveService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_GetCollectionItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).GetCollectionItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_GetCollectionItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).GetCollectionItems(ctx, req.(*GetCollectionItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_RemoveCollectionItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollectionItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).RemoveCollectionItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_RemoveCollectionItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).RemoveCollectionItem(ctx, req.(*RemoveCollectionItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InteractiveService_MoveCollectionItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCollectionItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InteractiveServiceServer).MoveCollectionItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InteractiveService_MoveCollectionItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InteractiveServiceServer).MoveCollectionItem(ctx, req.(*MoveCollectionItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InteractiveService_ServiceDesc is the grpc.ServiceDesc for InteractiveService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -350,6 +663,14 @@ var InteractiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelLike",
 			Handler:    _InteractiveService_CancelLike_Handler,
 		},
+		{
+			MethodName: "React",
+			Handler:    _InteractiveService_React_Handler,
+		},
+		{
+			MethodName: "CancelReaction",
+			Handler:    _InteractiveService_CancelReaction_Handler,
+		},
 		{
 			MethodName: "Collect",
 			Handler:    _InteractiveService_Collect_Handler,
@@ -370,6 +691,34 @@ var InteractiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByIds",
 			Handler:    _InteractiveService_GetByIds_Handler,
 		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _InteractiveService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _InteractiveService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _InteractiveService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _InteractiveService_ListCollections_Handler,
+		},
+		{
+			MethodName: "GetCollectionItems",
+			Handler:    _InteractiveService_GetCollectionItems_Handler,
+		},
+		{
+			MethodName: "RemoveCollectionItem",
+			Handler:    _InteractiveService_RemoveCollectionItem_Handler,
+		},
+		{
+			MethodName: "MoveCollectionItem",
+			Handler:    _InteractiveService_MoveCollectionItem_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "intr/v1/interactive.proto",
@@ -40,6 +40,26 @@ func (m *MockInteractiveServiceClient) EXPECT() *MockInteractiveServiceClientMoc
 	return m.recorder
 }
 
+// CancelCollect mocks base method.
+func (m *MockInteractiveServiceClient) CancelCollect(ctx context.Context, in *intrv1.CancelCollectRequest, opts ...grpc.CallOption) (*intrv1.CancelCollectResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelCollect", varargs...)
+	ret0, _ := ret[0].(*intrv1.CancelCollectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelCollect indicates an expected call of CancelCollect.
+func (mr *MockInteractiveServiceClientMockRecorder) CancelCollect(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelCollect", reflect.TypeOf((*MockInteractiveServiceClient)(nil).CancelCollect), varargs...)
+}
+
 // CancelLike mocks base method.
 func (m *MockInteractiveServiceClient) CancelLike(ctx context.Context, in *intrv1.CancelLikeRequest, opts ...grpc.CallOption) (*intrv1.CancelLikeResponse, error) {
 	m.ctrl.T.Helper()
@@ -60,6 +80,26 @@ func (mr *MockInteractiveServiceClientMockRecorder) CancelLike(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLike", reflect.TypeOf((*MockInteractiveServiceClient)(nil).CancelLike), varargs...)
 }
 
+// CancelReaction mocks base method.
+func (m *MockInteractiveServiceClient) CancelReaction(ctx context.Context, in *intrv1.CancelReactionRequest, opts ...grpc.CallOption) (*intrv1.CancelReactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelReaction", varargs...)
+	ret0, _ := ret[0].(*intrv1.CancelReactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReaction indicates an expected call of CancelReaction.
+func (mr *MockInteractiveServiceClientMockRecorder) CancelReaction(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReaction", reflect.TypeOf((*MockInteractiveServiceClient)(nil).CancelReaction), varargs...)
+}
+
 // Collect mocks base method.
 func (m *MockInteractiveServiceClient) Collect(ctx context.Context, in *intrv1.CollectRequest, opts ...grpc.CallOption) (*intrv1.CollectResponse, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +120,46 @@ func (mr *MockInteractiveServiceClientMockRecorder) Collect(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockInteractiveServiceClient)(nil).Collect), varargs...)
 }
 
+// CreateCollection mocks base method.
+func (m *MockInteractiveServiceClient) CreateCollection(ctx context.Context, in *intrv1.CreateCollectionRequest, opts ...grpc.CallOption) (*intrv1.CreateCollectionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCollection", varargs...)
+	ret0, _ := ret[0].(*intrv1.CreateCollectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCollection indicates an expected call of CreateCollection.
+func (mr *MockInteractiveServiceClientMockRecorder) CreateCollection(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCollection", reflect.TypeOf((*MockInteractiveServiceClient)(nil).CreateCollection), varargs...)
+}
+
+// DeleteCollection mocks base method.
+func (m *MockInteractiveServiceClient) DeleteCollection(ctx context.Context, in *intrv1.DeleteCollectionRequest, opts ...grpc.CallOption) (*intrv1.DeleteCollectionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCollection", varargs...)
+	ret0, _ := ret[0].(*intrv1.DeleteCollectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCollection indicates an expected call of DeleteCollection.
+func (mr *MockInteractiveServiceClientMockRecorder) DeleteCollection(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockInteractiveServiceClient)(nil).DeleteCollection), varargs...)
+}
+
 // Get mocks base method.
 func (m *MockInteractiveServiceClient) Get(ctx context.Context, in *intrv1.GetRequest, opts ...grpc.CallOption) (*intrv1.GetResponse, error) {
 	m.ctrl.T.Helper()
@@ -120,6 +200,46 @@ func (mr *MockInteractiveServiceClientMockRecorder) GetByIds(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockInteractiveServiceClient)(nil).GetByIds), varargs...)
 }
 
+// GetCollectionItems mocks base method.
+func (m *MockInteractiveServiceClient) GetCollectionItems(ctx context.Context, in *intrv1.GetCollectionItemsRequest, opts ...grpc.CallOption) (*intrv1.GetCollectionItemsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCollectionItems", varargs...)
+	ret0, _ := ret[0].(*intrv1.GetCollectionItemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionItems indicates an expected call of GetCollectionItems.
+func (mr *MockInteractiveServiceClientMockRecorder) GetCollectionItems(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionItems", reflect.TypeOf((*MockInteractiveServiceClient)(nil).GetCollectionItems), varargs...)
+}
+
+// GetCollections mocks base method.
+func (m *MockInteractiveServiceClient) GetCollections(ctx context.Context, in *intrv1.GetCollectionsRequest, opts ...grpc.CallOption) (*intrv1.GetCollectionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCollections", varargs...)
+	ret0, _ := ret[0].(*intrv1.GetCollectionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollections indicates an expected call of GetCollections.
+func (mr *MockInteractiveServiceClientMockRecorder) GetCollections(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollections", reflect.TypeOf((*MockInteractiveServiceClient)(nil).GetCollections), varargs...)
+}
+
 // IncrReadCnt mocks base method.
 func (m *MockInteractiveServiceClient) IncrReadCnt(ctx context.Context, in *intrv1.IncrReadCntRequest, opts ...grpc.CallOption) (*intrv1.IncrReadCntResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +280,106 @@ func (mr *MockInteractiveServiceClientMockRecorder) Like(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Like", reflect.TypeOf((*MockInteractiveServiceClient)(nil).Like), varargs...)
 }
 
+// ListCollections mocks base method.
+func (m *MockInteractiveServiceClient) ListCollections(ctx context.Context, in *intrv1.ListCollectionsRequest, opts ...grpc.CallOption) (*intrv1.ListCollectionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCollections", varargs...)
+	ret0, _ := ret[0].(*intrv1.ListCollectionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCollections indicates an expected call of ListCollections.
+func (mr *MockInteractiveServiceClientMockRecorder) ListCollections(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCollections", reflect.TypeOf((*MockInteractiveServiceClient)(nil).ListCollections), varargs...)
+}
+
+// MoveCollectionItem mocks base method.
+func (m *MockInteractiveServiceClient) MoveCollectionItem(ctx context.Context, in *intrv1.MoveCollectionItemRequest, opts ...grpc.CallOption) (*intrv1.MoveCollectionItemResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveCollectionItem", varargs...)
+	ret0, _ := ret[0].(*intrv1.MoveCollectionItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCollectionItem indicates an expected call of MoveCollectionItem.
+func (mr *MockInteractiveServiceClientMockRecorder) MoveCollectionItem(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCollectionItem", reflect.TypeOf((*MockInteractiveServiceClient)(nil).MoveCollectionItem), varargs...)
+}
+
+// React mocks base method.
+func (m *MockInteractiveServiceClient) React(ctx context.Context, in *intrv1.ReactRequest, opts ...grpc.CallOption) (*intrv1.ReactResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "React", varargs...)
+	ret0, _ := ret[0].(*intrv1.ReactResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// React indicates an expected call of React.
+func (mr *MockInteractiveServiceClientMockRecorder) React(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "React", reflect.TypeOf((*MockInteractiveServiceClient)(nil).React), varargs...)
+}
+
+// RemoveCollectionItem mocks base method.
+func (m *MockInteractiveServiceClient) RemoveCollectionItem(ctx context.Context, in *intrv1.RemoveCollectionItemRequest, opts ...grpc.CallOption) (*intrv1.RemoveCollectionItemResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveCollectionItem", varargs...)
+	ret0, _ := ret[0].(*intrv1.RemoveCollectionItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCollectionItem indicates an expected call of RemoveCollectionItem.
+func (mr *MockInteractiveServiceClientMockRecorder) RemoveCollectionItem(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollectionItem", reflect.TypeOf((*MockInteractiveServiceClient)(nil).RemoveCollectionItem), varargs...)
+}
+
+// UpdateCollection mocks base method.
+func (m *MockInteractiveServiceClient) UpdateCollection(ctx context.Context, in *intrv1.UpdateCollectionRequest, opts ...grpc.CallOption) (*intrv1.UpdateCollectionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCollection", varargs...)
+	ret0, _ := ret[0].(*intrv1.UpdateCollectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCollection indicates an expected call of UpdateCollection.
+func (mr *MockInteractiveServiceClientMockRecorder) UpdateCollection(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollection", reflect.TypeOf((*MockInteractiveServiceClient)(nil).UpdateCollection), varargs...)
+}
+
 // MockInteractiveServiceServer is a mock of InteractiveServiceServer interface.
 type MockInteractiveServiceServer struct {
 	ctrl     *gomock.Controller
@@ -183,6 +403,21 @@ func (m *MockInteractiveServiceServer) EXPECT() *MockInteractiveServiceServerMoc
 	return m.recorder
 }
 
+// CancelCollect mocks base method.
+func (m *MockInteractiveServiceServer) CancelCollect(arg0 context.Context, arg1 *intrv1.CancelCollectRequest) (*intrv1.CancelCollectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelCollect", arg0, arg1)
+	ret0, _ := ret[0].(*intrv1.CancelCollectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelCollect indicates an expected call of CancelCollect.
+func (mr *MockInteractiveServiceServerMockRecorder) CancelCollect(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelCollect", reflect.TypeOf((*MockInteractiveServiceServer)(nil).CancelCollect), arg0, arg1)
+}
+
 // CancelLike mocks base method.
 func (m *MockInteractiveServiceServer) CancelLike(arg0 context.Context, arg1 *intrv1.CancelLikeRequest) (*intrv1.CancelLikeResponse, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +433,21 @@ func (mr *MockInteractiveServiceServerMockRecorder) CancelLike(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLike", reflect.TypeOf((*MockInteractiveServiceServer)(nil).CancelLike), arg0, arg1)
 }
 
+// CancelReaction mocks base method.
+func (m *MockInteractiveServiceServer) CancelReaction(arg0 context.Context, arg1 *intrv1.CancelReactionRequest) (*intrv1.CancelReactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReaction", arg0, arg1)
+	ret0, _ := ret[0].(*intrv1.CancelReactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReaction indicates an expected call of CancelReaction.
+func (mr *MockInteractiveServiceServerMockRecorder) CancelReaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReaction", reflect.TypeOf((*MockInteractiveServiceServer)(nil).CancelReaction), arg0, arg1)
+}
+
 // Collect mocks base method.
 func (m *MockInteractiveServiceServer) Collect(arg0 context.Context, arg1 *intrv1.CollectRequest) (*intrv1.CollectResponse, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +463,36 @@ func (mr *MockInteractiveServiceServerMockRecorder) Collect(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockInteractiveServiceServer)(nil).Collect), arg0, arg1)
 }
 
+// CreateCollection mocks base method.
+func (m *MockInteractiveServiceServer) CreateCollection(arg0 context.Context, arg1 *intrv1.CreateCollectionRequest) (*intrv1.CreateCollectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCollection", arg0, arg1)
+	ret0, _ := ret[0].(*intrv1.CreateCollectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCollection indicates an expected call of CreateCollection.
+func (mr *MockInteractiveServiceServerMockRecorder) CreateCollection(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCollection", reflect.TypeOf((*MockInteractiveServiceServer)(nil).CreateCollection), arg0, arg1)
+}
+
+// DeleteCollection mocks base method.
+func (m *MockInteractiveServiceServer) DeleteCollection(arg0 context.Context, arg1 *intrv1.DeleteCollectionRequest) (*intrv1.DeleteCollectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCollection", arg0, arg1)
+	ret0, _ := ret[0].(*intrv1.DeleteCollectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCollection indicates an expected call of DeleteCollection.
+func (mr *MockInteractiveServiceServerMockRecorder) DeleteCollection(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockInteractiveServiceServer)(nil).DeleteCollection), arg0, arg1)
+}
+
 // Get mocks base method.
 func (m *MockInteractiveServiceServer) Get(arg0 context.Context, arg1 *intrv1.GetRequest) (*intrv1.GetResponse, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +523,36 @@ func (mr *MockInteractiveServiceServerMockRecorder) GetByIds(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockInteractiveServiceServer)(nil).GetByIds), arg0, arg1)
 }
 
+// GetCollectionItems mocks base method.
+func (m *MockInteractiveServiceServer) GetCollectionItems(arg0 context.Context, arg1 *intrv1.GetCollectionItemsRequest) (*intrv1.GetCollectionItemsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionItems", arg0, arg1)
+	ret0, _ := ret[0].(*intrv1.GetCollectionItemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionItems indicates an expected call of GetCollectionItems.
+func (mr *MockInteractiveServiceServerMockRecorder) GetCollectionItems(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionItems", reflect.TypeOf((*MockInteractiveServiceServer)(nil).GetCollectionItems), arg0, arg1)
+}
+
+// GetCollections mocks base method.
+func (m *MockInteractiveServiceServer) GetCollections(arg0 context.Context, arg1 *intrv1.GetCollectionsRequest) (*intrv1.GetCollectionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollections", arg0, arg1)
+	ret0, _ := ret[0].(*intrv1.GetCollectionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollections indicates an expected call of GetCollections.
+func (mr *MockInteractiveServiceServerMockRecorder) GetCollections(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollections", reflect.TypeOf((*MockInteractiveServiceServer)(nil).GetCollections), arg0, arg1)
+}
+
 // IncrReadCnt mocks base method.
 func (m *MockInteractiveServiceServer) IncrReadCnt(arg0 context.Context, arg1 *intrv1.IncrReadCntRequest) (*intrv1.IncrReadCntResponse, error) {
 	m.ctrl.T.Helper()
@@ -273,6 +583,81 @@ func (mr *MockInteractiveServiceServerMockRecorder) Like(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Like", reflect.TypeOf((*MockInteractiveServiceServer)(nil).Like), arg0, arg1)
 }
 
+// ListCollections mocks base method.
+func (m *MockInteractiveServiceServer) ListCollections(arg0 context.Context, arg1 *intrv1.ListCollectionsRequest) (*intrv1.ListCollectionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCollections", arg0, arg1)
+	ret0, _ := ret[0].(*intrv1.ListCollectionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCollections indicates an expected call of ListCollections.
+func (mr *MockInteractiveServiceServerMockRecorder) ListCollections(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCollections", reflect.TypeOf((*MockInteractiveServiceServer)(nil).ListCollections), arg0, arg1)
+}
+
+// MoveCollectionItem mocks base method.
+func (m *MockInteractiveServiceServer) MoveCollectionItem(arg0 context.Context, arg1 *intrv1.MoveCollectionItemRequest) (*intrv1.MoveCollectionItemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCollectionItem", arg0, arg1)
+	ret0, _ := ret[0].(*intrv1.MoveCollectionItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCollectionItem indicates an expected call of MoveCollectionItem.
+func (mr *MockInteractiveServiceServerMockRecorder) MoveCollectionItem(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCollectionItem", reflect.TypeOf((*MockInteractiveServiceServer)(nil).MoveCollectionItem), arg0, arg1)
+}
+
+// React mocks base method.
+func (m *MockInteractiveServiceServer) React(arg0 context.Context, arg1 *intrv1.ReactRequest) (*intrv1.ReactResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "React", arg0, arg1)
+	ret0, _ := ret[0].(*intrv1.ReactResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// React indicates an expected call of React.
+func (mr *MockInteractiveServiceServerMockRecorder) React(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "React", reflect.TypeOf((*MockInteractiveServiceServer)(nil).React), arg0, arg1)
+}
+
+// RemoveCollectionItem mocks base method.
+func (m *MockInteractiveServiceServer) RemoveCollectionItem(arg0 context.Context, arg1 *intrv1.RemoveCollectionItemRequest) (*intrv1.RemoveCollectionItemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollectionItem", arg0, arg1)
+	ret0, _ := ret[0].(*intrv1.RemoveCollectionItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCollectionItem indicates an expected call of RemoveCollectionItem.
+func (mr *MockInteractiveServiceServerMockRecorder) RemoveCollectionItem(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollectionItem", reflect.TypeOf((*MockInteractiveServiceServer)(nil).RemoveCollectionItem), arg0, arg1)
+}
+
+// UpdateCollection mocks base method.
+func (m *MockInteractiveServiceServer) UpdateCollection(arg0 context.Context, arg1 *intrv1.UpdateCollectionRequest) (*intrv1.UpdateCollectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollection", arg0, arg1)
+	ret0, _ := ret[0].(*intrv1.UpdateCollectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCollection indicates an expected call of UpdateCollection.
+func (mr *MockInteractiveServiceServerMockRecorder) UpdateCollection(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollection", reflect.TypeOf((*MockInteractiveServiceServer)(nil).UpdateCollection), arg0, arg1)
+}
+
 // mustEmbedUnimplementedInteractiveServiceServer mocks base method.
 func (m *MockInteractiveServiceServer) mustEmbedUnimplementedInteractiveServiceServer() {
 	m.ctrl.T.Helper()
@@ -0,0 +1,181 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lmbook/api/proto/gen/search/v1/search_grpc.pb.go
+//
+// Generated by this command:
+//
+//	mockgen -source=lmbook/api/proto/gen/search/v1/search_grpc.pb.go -package=searchmocks -destination=lmbook/api/proto/gen/search/v1/mocks/search_grpc.mock.go
+//
+// Package searchmocks is a generated GoMock package.
+package searchmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	searchv1 "basic-go/lmbook/api/proto/gen/search/v1"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockSearchServiceClient is a mock of SearchServiceClient interface.
+type MockSearchServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchServiceClientMockRecorder
+}
+
+// MockSearchServiceClientMockRecorder is the mock recorder for MockSearchServiceClient.
+type MockSearchServiceClientMockRecorder struct {
+	mock *MockSearchServiceClient
+}
+
+// NewMockSearchServiceClient creates a new mock instance.
+func NewMockSearchServiceClient(ctrl *gomock.Controller) *MockSearchServiceClient {
+	mock := &MockSearchServiceClient{ctrl: ctrl}
+	mock.recorder = &MockSearchServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchServiceClient) EXPECT() *MockSearchServiceClientMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockSearchServiceClient) Search(ctx context.Context, in *searchv1.SearchRequest, opts ...grpc.CallOption) (*searchv1.SearchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Search", varargs...)
+	ret0, _ := ret[0].(*searchv1.SearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchServiceClientMockRecorder) Search(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchServiceClient)(nil).Search), varargs...)
+}
+
+// Suggest mocks base method.
+func (m *MockSearchServiceClient) Suggest(ctx context.Context, in *searchv1.SuggestRequest, opts ...grpc.CallOption) (*searchv1.SuggestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Suggest", varargs...)
+	ret0, _ := ret[0].(*searchv1.SuggestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockSearchServiceClientMockRecorder) Suggest(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockSearchServiceClient)(nil).Suggest), varargs...)
+}
+
+// MockSearchServiceServer is a mock of SearchServiceServer interface.
+type MockSearchServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchServiceServerMockRecorder
+}
+
+// MockSearchServiceServerMockRecorder is the mock recorder for MockSearchServiceServer.
+type MockSearchServiceServerMockRecorder struct {
+	mock *MockSearchServiceServer
+}
+
+// NewMockSearchServiceServer creates a new mock instance.
+func NewMockSearchServiceServer(ctrl *gomock.Controller) *MockSearchServiceServer {
+	mock := &MockSearchServiceServer{ctrl: ctrl}
+	mock.recorder = &MockSearchServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchServiceServer) EXPECT() *MockSearchServiceServerMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockSearchServiceServer) Search(arg0 context.Context, arg1 *searchv1.SearchRequest) (*searchv1.SearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].(*searchv1.SearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchServiceServerMockRecorder) Search(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchServiceServer)(nil).Search), arg0, arg1)
+}
+
+// Suggest mocks base method.
+func (m *MockSearchServiceServer) Suggest(arg0 context.Context, arg1 *searchv1.SuggestRequest) (*searchv1.SuggestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", arg0, arg1)
+	ret0, _ := ret[0].(*searchv1.SuggestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockSearchServiceServerMockRecorder) Suggest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockSearchServiceServer)(nil).Suggest), arg0, arg1)
+}
+
+// mustEmbedUnimplementedSearchServiceServer mocks base method.
+func (m *MockSearchServiceServer) mustEmbedUnimplementedSearchServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedSearchServiceServer")
+}
+
+// mustEmbedUnimplementedSearchServiceServer indicates an expected call of mustEmbedUnimplementedSearchServiceServer.
+func (mr *MockSearchServiceServerMockRecorder) mustEmbedUnimplementedSearchServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedSearchServiceServer", reflect.TypeOf((*MockSearchServiceServer)(nil).mustEmbedUnimplementedSearchServiceServer))
+}
+
+// MockUnsafeSearchServiceServer is a mock of UnsafeSearchServiceServer interface.
+type MockUnsafeSearchServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeSearchServiceServerMockRecorder
+}
+
+// MockUnsafeSearchServiceServerMockRecorder is the mock recorder for MockUnsafeSearchServiceServer.
+type MockUnsafeSearchServiceServerMockRecorder struct {
+	mock *MockUnsafeSearchServiceServer
+}
+
+// NewMockUnsafeSearchServiceServer creates a new mock instance.
+func NewMockUnsafeSearchServiceServer(ctrl *gomock.Controller) *MockUnsafeSearchServiceServer {
+	mock := &MockUnsafeSearchServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeSearchServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeSearchServiceServer) EXPECT() *MockUnsafeSearchServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedSearchServiceServer mocks base method.
+func (m *MockUnsafeSearchServiceServer) mustEmbedUnimplementedSearchServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedSearchServiceServer")
+}
+
+// mustEmbedUnimplementedSearchServiceServer indicates an expected call of mustEmbedUnimplementedSearchServiceServer.
+func (mr *MockUnsafeSearchServiceServerMockRecorder) mustEmbedUnimplementedSearchServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedSearchServiceServer", reflect.TypeOf((*MockUnsafeSearchServiceServer)(nil).mustEmbedUnimplementedSearchServiceServer))
+}
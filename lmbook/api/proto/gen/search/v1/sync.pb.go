@@ -20,6 +20,124 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type InputPopularityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 目前只支持 article
+	Biz        string `protobuf:"bytes,1,opt,name=biz,proto3" json:"biz,omitempty"`
+	BizId      int64  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	ReadCnt    int64  `protobuf:"varint,3,opt,name=read_cnt,json=readCnt,proto3" json:"read_cnt,omitempty"`
+	LikeCnt    int64  `protobuf:"varint,4,opt,name=like_cnt,json=likeCnt,proto3" json:"like_cnt,omitempty"`
+	CollectCnt int64  `protobuf:"varint,5,opt,name=collect_cnt,json=collectCnt,proto3" json:"collect_cnt,omitempty"`
+}
+
+func (x *InputPopularityRequest) Reset() {
+	*x = InputPopularityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_v1_sync_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InputPopularityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputPopularityRequest) ProtoMessage() {}
+
+func (x *InputPopularityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_v1_sync_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputPopularityRequest.ProtoReflect.Descriptor instead.
+func (*InputPopularityRequest) Descriptor() ([]byte, []int) {
+	return file_search_v1_sync_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InputPopularityRequest) GetBiz() string {
+	if x != nil {
+		return x.Biz
+	}
+	return ""
+}
+
+func (x *InputPopularityRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *InputPopularityRequest) GetReadCnt() int64 {
+	if x != nil {
+		return x.ReadCnt
+	}
+	return 0
+}
+
+func (x *InputPopularityRequest) GetLikeCnt() int64 {
+	if x != nil {
+		return x.LikeCnt
+	}
+	return 0
+}
+
+func (x *InputPopularityRequest) GetCollectCnt() int64 {
+	if x != nil {
+		return x.CollectCnt
+	}
+	return 0
+}
+
+type InputPopularityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InputPopularityResponse) Reset() {
+	*x = InputPopularityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_v1_sync_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InputPopularityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputPopularityResponse) ProtoMessage() {}
+
+func (x *InputPopularityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_v1_sync_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputPopularityResponse.ProtoReflect.Descriptor instead.
+func (*InputPopularityResponse) Descriptor() ([]byte, []int) {
+	return file_search_v1_sync_proto_rawDescGZIP(), []int{1}
+}
+
 type InputAnyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -33,7 +151,7 @@ type InputAnyRequest struct {
 func (x *InputAnyRequest) Reset() {
 	*x = InputAnyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_v1_sync_proto_msgTypes[0]
+		mi := &file_search_v1_sync_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -46,7 +164,7 @@ func (x *InputAnyRequest) String() string {
 func (*InputAnyRequest) ProtoMessage() {}
 
 func (x *InputAnyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_search_v1_sync_proto_msgTypes[0]
+	mi := &file_search_v1_sync_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,7 +177,7 @@ func (x *InputAnyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputAnyRequest.ProtoReflect.Descriptor instead.
 func (*InputAnyRequest) Descriptor() ([]byte, []int) {
-	return file_search_v1_sync_proto_rawDescGZIP(), []int{0}
+	return file_search_v1_sync_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *InputAnyRequest) GetIndexName() string {
@@ -92,7 +210,7 @@ type InputAnyResponse struct {
 func (x *InputAnyResponse) Reset() {
 	*x = InputAnyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_v1_sync_proto_msgTypes[1]
+		mi := &file_search_v1_sync_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -105,7 +223,7 @@ func (x *InputAnyResponse) String() string {
 func (*InputAnyResponse) ProtoMessage() {}
 
 func (x *InputAnyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_search_v1_sync_proto_msgTypes[1]
+	mi := &file_search_v1_sync_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -118,7 +236,7 @@ func (x *InputAnyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputAnyResponse.ProtoReflect.Descriptor instead.
 func (*InputAnyResponse) Descriptor() ([]byte, []int) {
-	return file_search_v1_sync_proto_rawDescGZIP(), []int{1}
+	return file_search_v1_sync_proto_rawDescGZIP(), []int{3}
 }
 
 type InputUserRequest struct {
@@ -132,7 +250,7 @@ type InputUserRequest struct {
 func (x *InputUserRequest) Reset() {
 	*x = InputUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_v1_sync_proto_msgTypes[2]
+		mi := &file_search_v1_sync_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -145,7 +263,7 @@ func (x *InputUserRequest) String() string {
 func (*InputUserRequest) ProtoMessage() {}
 
 func (x *InputUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_search_v1_sync_proto_msgTypes[2]
+	mi := &file_search_v1_sync_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -158,7 +276,7 @@ func (x *InputUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputUserRequest.ProtoReflect.Descriptor instead.
 func (*InputUserRequest) Descriptor() ([]byte, []int) {
-	return file_search_v1_sync_proto_rawDescGZIP(), []int{2}
+	return file_search_v1_sync_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *InputUserRequest) GetUser() *User {
@@ -177,7 +295,7 @@ type InputUserResponse struct {
 func (x *InputUserResponse) Reset() {
 	*x = InputUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_v1_sync_proto_msgTypes[3]
+		mi := &file_search_v1_sync_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -190,7 +308,7 @@ func (x *InputUserResponse) String() string {
 func (*InputUserResponse) ProtoMessage() {}
 
 func (x *InputUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_search_v1_sync_proto_msgTypes[3]
+	mi := &file_search_v1_sync_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -203,7 +321,7 @@ func (x *InputUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputUserResponse.ProtoReflect.Descriptor instead.
 func (*InputUserResponse) Descriptor() ([]byte, []int) {
-	return file_search_v1_sync_proto_rawDescGZIP(), []int{3}
+	return file_search_v1_sync_proto_rawDescGZIP(), []int{5}
 }
 
 type InputArticleRequest struct {
@@ -217,7 +335,7 @@ type InputArticleRequest struct {
 func (x *InputArticleRequest) Reset() {
 	*x = InputArticleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_v1_sync_proto_msgTypes[4]
+		mi := &file_search_v1_sync_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -230,7 +348,7 @@ func (x *InputArticleRequest) String() string {
 func (*InputArticleRequest) ProtoMessage() {}
 
 func (x *InputArticleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_search_v1_sync_proto_msgTypes[4]
+	mi := &file_search_v1_sync_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -243,7 +361,7 @@ func (x *InputArticleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputArticleRequest.ProtoReflect.Descriptor instead.
 func (*InputArticleRequest) Descriptor() ([]byte, []int) {
-	return file_search_v1_sync_proto_rawDescGZIP(), []int{4}
+	return file_search_v1_sync_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *InputArticleRequest) GetArticle() *Article {
@@ -262,7 +380,7 @@ type InputArticleResponse struct {
 func (x *InputArticleResponse) Reset() {
 	*x = InputArticleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_v1_sync_proto_msgTypes[5]
+		mi := &file_search_v1_sync_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -275,7 +393,7 @@ func (x *InputArticleResponse) String() string {
 func (*InputArticleResponse) ProtoMessage() {}
 
 func (x *InputArticleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_search_v1_sync_proto_msgTypes[5]
+	mi := &file_search_v1_sync_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,7 +406,7 @@ func (x *InputArticleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputArticleResponse.ProtoReflect.Descriptor instead.
 func (*InputArticleResponse) Descriptor() ([]byte, []int) {
-	return file_search_v1_sync_proto_rawDescGZIP(), []int{5}
+	return file_search_v1_sync_proto_rawDescGZIP(), []int{7}
 }
 
 type Article struct {
@@ -301,12 +419,16 @@ type Article struct {
 	Status  int32    `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
 	Content string   `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
 	Tags    []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 更新时间，毫秒，按照时间排序的时候用
+	Utime int64 `protobuf:"varint,6,opt,name=utime,proto3" json:"utime,omitempty"`
+	// 作者，author: 过滤的时候用
+	AuthorId int64 `protobuf:"varint,7,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
 }
 
 func (x *Article) Reset() {
 	*x = Article{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_v1_sync_proto_msgTypes[6]
+		mi := &file_search_v1_sync_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -319,7 +441,7 @@ func (x *Article) String() string {
 func (*Article) ProtoMessage() {}
 
 func (x *Article) ProtoReflect() protoreflect.Message {
-	mi := &file_search_v1_sync_proto_msgTypes[6]
+	mi := &file_search_v1_sync_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,7 +454,7 @@ func (x *Article) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Article.ProtoReflect.Descriptor instead.
 func (*Article) Descriptor() ([]byte, []int) {
-	return file_search_v1_sync_proto_rawDescGZIP(), []int{6}
+	return file_search_v1_sync_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Article) GetId() int64 {
@@ -370,6 +492,20 @@ func (x *Article) GetTags() []string {
 	return nil
 }
 
+func (x *Article) GetUtime() int64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+func (x *Article) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
 type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -384,7 +520,7 @@ type User struct {
 func (x *User) Reset() {
 	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_v1_sync_proto_msgTypes[7]
+		mi := &file_search_v1_sync_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -397,7 +533,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_search_v1_sync_proto_msgTypes[7]
+	mi := &file_search_v1_sync_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,7 +546,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_search_v1_sync_proto_rawDescGZIP(), []int{7}
+	return file_search_v1_sync_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *User) GetId() int64 {
@@ -446,64 +582,84 @@ var File_search_v1_sync_proto protoreflect.FileDescriptor
 var file_search_v1_sync_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e, 0x63,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76,
-	0x31, 0x22, 0x5b, 0x0a, 0x0f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x6e, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6f, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x12,
-	0x0a, 0x10, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x37, 0x0a, 0x10, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x43, 0x0a, 0x13, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x07, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a,
-	0x07, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x22, 0x5e, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x32, 0xeb, 0x01, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72,
-	0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
-	0x08, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x6e, 0x79, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x6e, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0xa4, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x65, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x65, 0x65,
-	0x6b, 0x62, 0x61, 0x6e, 0x67, 0x2f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x2d, 0x67, 0x6f, 0x2f, 0x77,
-	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x31, 0x22, 0x98, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x62, 0x69, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x7a, 0x12, 0x15,
+	0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6e, 0x74, 0x22, 0x19, 0x0a, 0x17,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x0f, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x41, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6f, 0x63,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x12, 0x0a, 0x10, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x6e, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x10, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x22, 0x13, 0x0a, 0x11, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x13, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
+	0x07, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x63,
+	0x6c, 0x65, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x07, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x5e,
+	0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x32, 0xc5,
+	0x02, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46,
+	0x0a, 0x09, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x41, 0x6e, 0x79, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x41, 0x6e, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x21, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xa4, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x65, 0x65, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x67, 0x65, 0x65, 0x6b, 0x62, 0x61, 0x6e, 0x67, 0x2f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x2d,
+	0x67, 0x6f, 0x2f, 0x77, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x76,
+	0x31, 0x3b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x58, 0x58,
+	0xaa, 0x02, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x09, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -518,28 +674,32 @@ func file_search_v1_sync_proto_rawDescGZIP() []byte {
 	return file_search_v1_sync_proto_rawDescData
 }
 
-var file_search_v1_sync_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_search_v1_sync_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_search_v1_sync_proto_goTypes = []interface{}{
-	(*InputAnyRequest)(nil),      // 0: search.v1.InputAnyRequest
-	(*InputAnyResponse)(nil),     // 1: search.v1.InputAnyResponse
-	(*InputUserRequest)(nil),     // 2: search.v1.InputUserRequest
-	(*InputUserResponse)(nil),    // 3: search.v1.InputUserResponse
-	(*InputArticleRequest)(nil),  // 4: search.v1.InputArticleRequest
-	(*InputArticleResponse)(nil), // 5: search.v1.InputArticleResponse
-	(*Article)(nil),              // 6: search.v1.Article
-	(*User)(nil),                 // 7: search.v1.User
+	(*InputPopularityRequest)(nil),  // 0: search.v1.InputPopularityRequest
+	(*InputPopularityResponse)(nil), // 1: search.v1.InputPopularityResponse
+	(*InputAnyRequest)(nil),         // 2: search.v1.InputAnyRequest
+	(*InputAnyResponse)(nil),        // 3: search.v1.InputAnyResponse
+	(*InputUserRequest)(nil),        // 4: search.v1.InputUserRequest
+	(*InputUserResponse)(nil),       // 5: search.v1.InputUserResponse
+	(*InputArticleRequest)(nil),     // 6: search.v1.InputArticleRequest
+	(*InputArticleResponse)(nil),    // 7: search.v1.InputArticleResponse
+	(*Article)(nil),                 // 8: search.v1.Article
+	(*User)(nil),                    // 9: search.v1.User
 }
 var file_search_v1_sync_proto_depIdxs = []int32{
-	7, // 0: search.v1.InputUserRequest.user:type_name -> search.v1.User
-	6, // 1: search.v1.InputArticleRequest.article:type_name -> search.v1.Article
-	2, // 2: search.v1.SyncService.InputUser:input_type -> search.v1.InputUserRequest
-	4, // 3: search.v1.SyncService.InputArticle:input_type -> search.v1.InputArticleRequest
-	0, // 4: search.v1.SyncService.InputAny:input_type -> search.v1.InputAnyRequest
-	3, // 5: search.v1.SyncService.InputUser:output_type -> search.v1.InputUserResponse
-	5, // 6: search.v1.SyncService.InputArticle:output_type -> search.v1.InputArticleResponse
-	1, // 7: search.v1.SyncService.InputAny:output_type -> search.v1.InputAnyResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
+	9, // 0: search.v1.InputUserRequest.user:type_name -> search.v1.User
+	8, // 1: search.v1.InputArticleRequest.article:type_name -> search.v1.Article
+	4, // 2: search.v1.SyncService.InputUser:input_type -> search.v1.InputUserRequest
+	6, // 3: search.v1.SyncService.InputArticle:input_type -> search.v1.InputArticleRequest
+	2, // 4: search.v1.SyncService.InputAny:input_type -> search.v1.InputAnyRequest
+	0, // 5: search.v1.SyncService.InputPopularity:input_type -> search.v1.InputPopularityRequest
+	5, // 6: search.v1.SyncService.InputUser:output_type -> search.v1.InputUserResponse
+	7, // 7: search.v1.SyncService.InputArticle:output_type -> search.v1.InputArticleResponse
+	3, // 8: search.v1.SyncService.InputAny:output_type -> search.v1.InputAnyResponse
+	1, // 9: search.v1.SyncService.InputPopularity:output_type -> search.v1.InputPopularityResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
 	2, // [2:2] is the sub-list for extension extendee
 	0, // [0:2] is the sub-list for field type_name
@@ -552,7 +712,7 @@ func file_search_v1_sync_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_search_v1_sync_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InputAnyRequest); i {
+			switch v := v.(*InputPopularityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -564,7 +724,7 @@ func file_search_v1_sync_proto_init() {
 			}
 		}
 		file_search_v1_sync_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InputAnyResponse); i {
+			switch v := v.(*InputPopularityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -576,7 +736,7 @@ func file_search_v1_sync_proto_init() {
 			}
 		}
 		file_search_v1_sync_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InputUserRequest); i {
+			switch v := v.(*InputAnyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -588,7 +748,7 @@ func file_search_v1_sync_proto_init() {
 			}
 		}
 		file_search_v1_sync_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InputUserResponse); i {
+			switch v := v.(*InputAnyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -600,7 +760,7 @@ func file_search_v1_sync_proto_init() {
 			}
 		}
 		file_search_v1_sync_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InputArticleRequest); i {
+			switch v := v.(*InputUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -612,7 +772,7 @@ func file_search_v1_sync_proto_init() {
 			}
 		}
 		file_search_v1_sync_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InputArticleResponse); i {
+			switch v := v.(*InputUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -624,7 +784,7 @@ func file_search_v1_sync_proto_init() {
 			}
 		}
 		file_search_v1_sync_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Article); i {
+			switch v := v.(*InputArticleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -636,6 +796,30 @@ func file_search_v1_sync_proto_init() {
 			}
 		}
 		file_search_v1_sync_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InputArticleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_v1_sync_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Article); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_v1_sync_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*User); i {
 			case 0:
 				return &v.state
@@ -654,7 +838,7 @@ func file_search_v1_sync_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_search_v1_sync_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
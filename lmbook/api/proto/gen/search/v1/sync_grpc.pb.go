@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SyncService_InputUser_FullMethodName    = "/search.v1.SyncService/InputUser"
-	SyncService_InputArticle_FullMethodName = "/search.v1.SyncService/InputArticle"
-	SyncService_InputAny_FullMethodName     = "/search.v1.SyncService/InputAny"
+	SyncService_InputUser_FullMethodName       = "/search.v1.SyncService/InputUser"
+	SyncService_InputArticle_FullMethodName    = "/search.v1.SyncService/InputArticle"
+	SyncService_InputAny_FullMethodName        = "/search.v1.SyncService/InputAny"
+	SyncService_InputPopularity_FullMethodName = "/search.v1.SyncService/InputPopularity"
 )
 
 // SyncServiceClient is the client API for SyncService service.
@@ -31,6 +32,8 @@ type SyncServiceClient interface {
 	InputUser(ctx context.Context, in *InputUserRequest, opts ...grpc.CallOption) (*InputUserResponse, error)
 	InputArticle(ctx context.Context, in *InputArticleRequest, opts ...grpc.CallOption) (*InputArticleResponse, error)
 	InputAny(ctx context.Context, in *InputAnyRequest, opts ...grpc.CallOption) (*InputAnyResponse, error)
+	// 热度，来自阅读、点赞、收藏这些计数，用来排序和输入提示
+	InputPopularity(ctx context.Context, in *InputPopularityRequest, opts ...grpc.CallOption) (*InputPopularityResponse, error)
 }
 
 type syncServiceClient struct {
@@ -68,6 +71,15 @@ func (c *syncServiceClient) InputAny(ctx context.Context, in *InputAnyRequest, o
 	return out, nil
 }
 
+func (c *syncServiceClient) InputPopularity(ctx context.Context, in *InputPopularityRequest, opts ...grpc.CallOption) (*InputPopularityResponse, error) {
+	out := new(InputPopularityResponse)
+	err := c.cc.Invoke(ctx, SyncService_InputPopularity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SyncServiceServer is the server API for SyncService service.
 // All implementations must embed UnimplementedSyncServiceServer
 // for forward compatibility
@@ -75,6 +87,8 @@ type SyncServiceServer interface {
 	InputUser(context.Context, *InputUserRequest) (*InputUserResponse, error)
 	InputArticle(context.Context, *InputArticleRequest) (*InputArticleResponse, error)
 	InputAny(context.Context, *InputAnyRequest) (*InputAnyResponse, error)
+	// 热度，来自阅读、点赞、收藏这些计数，用来排序和输入提示
+	InputPopularity(context.Context, *InputPopularityRequest) (*InputPopularityResponse, error)
 	mustEmbedUnimplementedSyncServiceServer()
 }
 
@@ -91,6 +105,9 @@ func (UnimplementedSyncServiceServer) InputArticle(context.Context, *InputArticl
 func (UnimplementedSyncServiceServer) InputAny(context.Context, *InputAnyRequest) (*InputAnyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InputAny not implemented")
 }
+func (UnimplementedSyncServiceServer) InputPopularity(context.Context, *InputPopularityRequest) (*InputPopularityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InputPopularity not implemented")
+}
 func (UnimplementedSyncServiceServer) mustEmbedUnimplementedSyncServiceServer() {}
 
 // UnsafeSyncServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +175,24 @@ func _SyncService_InputAny_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SyncService_InputPopularity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InputPopularityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).InputPopularity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyncService_InputPopularity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).InputPopularity(ctx, req.(*InputPopularityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SyncService_ServiceDesc is the grpc.ServiceDesc for SyncService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +212,10 @@ var SyncService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InputAny",
 			Handler:    _SyncService_InputAny_Handler,
 		},
+		{
+			MethodName: "InputPopularity",
+			Handler:    _SyncService_InputPopularity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "search/v1/sync.proto",
@@ -0,0 +1,286 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lmbook/api/proto/gen/tag/v1/tag_grpc.pb.go
+//
+// Generated by this command:
+//
+//	mockgen -source=lmbook/api/proto/gen/tag/v1/tag_grpc.pb.go -package=tagmocks -destination=lmbook/api/proto/gen/tag/v1/mocks/tag_grpc.mock.go
+//
+// Package tagmocks is a generated GoMock package.
+package tagmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	tagv1 "basic-go/lmbook/api/proto/gen/tag/v1"
+	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockTagServiceClient is a mock of TagServiceClient interface.
+type MockTagServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagServiceClientMockRecorder
+}
+
+// MockTagServiceClientMockRecorder is the mock recorder for MockTagServiceClient.
+type MockTagServiceClientMockRecorder struct {
+	mock *MockTagServiceClient
+}
+
+// NewMockTagServiceClient creates a new mock instance.
+func NewMockTagServiceClient(ctrl *gomock.Controller) *MockTagServiceClient {
+	mock := &MockTagServiceClient{ctrl: ctrl}
+	mock.recorder = &MockTagServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagServiceClient) EXPECT() *MockTagServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AttachTags mocks base method.
+func (m *MockTagServiceClient) AttachTags(ctx context.Context, in *tagv1.AttachTagsRequest, opts ...grpc.CallOption) (*tagv1.AttachTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AttachTags", varargs...)
+	ret0, _ := ret[0].(*tagv1.AttachTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachTags indicates an expected call of AttachTags.
+func (mr *MockTagServiceClientMockRecorder) AttachTags(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachTags", reflect.TypeOf((*MockTagServiceClient)(nil).AttachTags), varargs...)
+}
+
+// BatchGetBizTags mocks base method.
+func (m *MockTagServiceClient) BatchGetBizTags(ctx context.Context, in *tagv1.BatchGetBizTagsRequest, opts ...grpc.CallOption) (*tagv1.BatchGetBizTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchGetBizTags", varargs...)
+	ret0, _ := ret[0].(*tagv1.BatchGetBizTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetBizTags indicates an expected call of BatchGetBizTags.
+func (mr *MockTagServiceClientMockRecorder) BatchGetBizTags(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetBizTags", reflect.TypeOf((*MockTagServiceClient)(nil).BatchGetBizTags), varargs...)
+}
+
+// CreateTag mocks base method.
+func (m *MockTagServiceClient) CreateTag(ctx context.Context, in *tagv1.CreateTagRequest, opts ...grpc.CallOption) (*tagv1.CreateTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTag", varargs...)
+	ret0, _ := ret[0].(*tagv1.CreateTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockTagServiceClientMockRecorder) CreateTag(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockTagServiceClient)(nil).CreateTag), varargs...)
+}
+
+// GetBizTags mocks base method.
+func (m *MockTagServiceClient) GetBizTags(ctx context.Context, in *tagv1.GetBizTagsRequest, opts ...grpc.CallOption) (*tagv1.GetBizTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBizTags", varargs...)
+	ret0, _ := ret[0].(*tagv1.GetBizTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBizTags indicates an expected call of GetBizTags.
+func (mr *MockTagServiceClientMockRecorder) GetBizTags(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBizTags", reflect.TypeOf((*MockTagServiceClient)(nil).GetBizTags), varargs...)
+}
+
+// GetTags mocks base method.
+func (m *MockTagServiceClient) GetTags(ctx context.Context, in *tagv1.GetTagsRequest, opts ...grpc.CallOption) (*tagv1.GetTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTags", varargs...)
+	ret0, _ := ret[0].(*tagv1.GetTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockTagServiceClientMockRecorder) GetTags(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockTagServiceClient)(nil).GetTags), varargs...)
+}
+
+// MockTagServiceServer is a mock of TagServiceServer interface.
+type MockTagServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagServiceServerMockRecorder
+}
+
+// MockTagServiceServerMockRecorder is the mock recorder for MockTagServiceServer.
+type MockTagServiceServerMockRecorder struct {
+	mock *MockTagServiceServer
+}
+
+// NewMockTagServiceServer creates a new mock instance.
+func NewMockTagServiceServer(ctrl *gomock.Controller) *MockTagServiceServer {
+	mock := &MockTagServiceServer{ctrl: ctrl}
+	mock.recorder = &MockTagServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagServiceServer) EXPECT() *MockTagServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AttachTags mocks base method.
+func (m *MockTagServiceServer) AttachTags(arg0 context.Context, arg1 *tagv1.AttachTagsRequest) (*tagv1.AttachTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachTags", arg0, arg1)
+	ret0, _ := ret[0].(*tagv1.AttachTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachTags indicates an expected call of AttachTags.
+func (mr *MockTagServiceServerMockRecorder) AttachTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachTags", reflect.TypeOf((*MockTagServiceServer)(nil).AttachTags), arg0, arg1)
+}
+
+// BatchGetBizTags mocks base method.
+func (m *MockTagServiceServer) BatchGetBizTags(arg0 context.Context, arg1 *tagv1.BatchGetBizTagsRequest) (*tagv1.BatchGetBizTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetBizTags", arg0, arg1)
+	ret0, _ := ret[0].(*tagv1.BatchGetBizTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetBizTags indicates an expected call of BatchGetBizTags.
+func (mr *MockTagServiceServerMockRecorder) BatchGetBizTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetBizTags", reflect.TypeOf((*MockTagServiceServer)(nil).BatchGetBizTags), arg0, arg1)
+}
+
+// CreateTag mocks base method.
+func (m *MockTagServiceServer) CreateTag(arg0 context.Context, arg1 *tagv1.CreateTagRequest) (*tagv1.CreateTagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", arg0, arg1)
+	ret0, _ := ret[0].(*tagv1.CreateTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockTagServiceServerMockRecorder) CreateTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockTagServiceServer)(nil).CreateTag), arg0, arg1)
+}
+
+// GetBizTags mocks base method.
+func (m *MockTagServiceServer) GetBizTags(arg0 context.Context, arg1 *tagv1.GetBizTagsRequest) (*tagv1.GetBizTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBizTags", arg0, arg1)
+	ret0, _ := ret[0].(*tagv1.GetBizTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBizTags indicates an expected call of GetBizTags.
+func (mr *MockTagServiceServerMockRecorder) GetBizTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBizTags", reflect.TypeOf((*MockTagServiceServer)(nil).GetBizTags), arg0, arg1)
+}
+
+// GetTags mocks base method.
+func (m *MockTagServiceServer) GetTags(arg0 context.Context, arg1 *tagv1.GetTagsRequest) (*tagv1.GetTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", arg0, arg1)
+	ret0, _ := ret[0].(*tagv1.GetTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockTagServiceServerMockRecorder) GetTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockTagServiceServer)(nil).GetTags), arg0, arg1)
+}
+
+// mustEmbedUnimplementedTagServiceServer mocks base method.
+func (m *MockTagServiceServer) mustEmbedUnimplementedTagServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedTagServiceServer")
+}
+
+// mustEmbedUnimplementedTagServiceServer indicates an expected call of mustEmbedUnimplementedTagServiceServer.
+func (mr *MockTagServiceServerMockRecorder) mustEmbedUnimplementedTagServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedTagServiceServer", reflect.TypeOf((*MockTagServiceServer)(nil).mustEmbedUnimplementedTagServiceServer))
+}
+
+// MockUnsafeTagServiceServer is a mock of UnsafeTagServiceServer interface.
+type MockUnsafeTagServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeTagServiceServerMockRecorder
+}
+
+// MockUnsafeTagServiceServerMockRecorder is the mock recorder for MockUnsafeTagServiceServer.
+type MockUnsafeTagServiceServerMockRecorder struct {
+	mock *MockUnsafeTagServiceServer
+}
+
+// NewMockUnsafeTagServiceServer creates a new mock instance.
+func NewMockUnsafeTagServiceServer(ctrl *gomock.Controller) *MockUnsafeTagServiceServer {
+	mock := &MockUnsafeTagServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeTagServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeTagServiceServer) EXPECT() *MockUnsafeTagServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedTagServiceServer mocks base method.
+func (m *MockUnsafeTagServiceServer) mustEmbedUnimplementedTagServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedTagServiceServer")
+}
+
+// mustEmbedUnimplementedTagServiceServer indicates an expected call of mustEmbedUnimplementedTagServiceServer.
+func (mr *MockUnsafeTagServiceServerMockRecorder) mustEmbedUnimplementedTagServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedTagServiceServer", reflect.TypeOf((*MockUnsafeTagServiceServer)(nil).mustEmbedUnimplementedTagServiceServer))
+}
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
-// - protoc             (unknown)
+// - protoc             v3.21.12
 // source: tag/v1/tag.proto
 
 package tagv1
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	TagService_CreateTag_FullMethodName  = "/tag.v1.TagService/CreateTag"
-	TagService_AttachTags_FullMethodName = "/tag.v1.TagService/AttachTags"
-	TagService_GetTags_FullMethodName    = "/tag.v1.TagService/GetTags"
-	TagService_GetBizTags_FullMethodName = "/tag.v1.TagService/GetBizTags"
+	TagService_CreateTag_FullMethodName       = "/tag.v1.TagService/CreateTag"
+	TagService_AttachTags_FullMethodName      = "/tag.v1.TagService/AttachTags"
+	TagService_GetTags_FullMethodName         = "/tag.v1.TagService/GetTags"
+	TagService_GetBizTags_FullMethodName      = "/tag.v1.TagService/GetBizTags"
+	TagService_BatchGetBizTags_FullMethodName = "/tag.v1.TagService/BatchGetBizTags"
 )
 
 // TagServiceClient is the client API for TagService service.
@@ -36,6 +37,8 @@ type TagServiceClient interface {
 	// 我们可以预期，一个用户的标签不会有很多，所以没特别大的必要做成分页
 	GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*GetTagsResponse, error)
 	GetBizTags(ctx context.Context, in *GetBizTagsRequest, opts ...grpc.CallOption) (*GetBizTagsResponse, error)
+	// 批量查询多个资源上的标签，比如热榜一次算一批文章
+	BatchGetBizTags(ctx context.Context, in *BatchGetBizTagsRequest, opts ...grpc.CallOption) (*BatchGetBizTagsResponse, error)
 }
 
 type tagServiceClient struct {
@@ -82,6 +85,15 @@ func (c *tagServiceClient) GetBizTags(ctx context.Context, in *GetBizTagsRequest
 	return out, nil
 }
 
+func (c *tagServiceClient) BatchGetBizTags(ctx context.Context, in *BatchGetBizTagsRequest, opts ...grpc.CallOption) (*BatchGetBizTagsResponse, error) {
+	out := new(BatchGetBizTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_BatchGetBizTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TagServiceServer is the server API for TagService service.
 // All implementations must embed UnimplementedTagServiceServer
 // for forward compatibility
@@ -93,6 +105,8 @@ type TagServiceServer interface {
 	// 我们可以预期，一个用户的标签不会有很多，所以没特别大的必要做成分页
 	GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error)
 	GetBizTags(context.Context, *GetBizTagsRequest) (*GetBizTagsResponse, error)
+	// 批量查询多个资源上的标签，比如热榜一次算一批文章
+	BatchGetBizTags(context.Context, *BatchGetBizTagsRequest) (*BatchGetBizTagsResponse, error)
 	mustEmbedUnimplementedTagServiceServer()
 }
 
@@ -112,6 +126,9 @@ func (UnimplementedTagServiceServer) GetTags(context.Context, *GetTagsRequest) (
 func (UnimplementedTagServiceServer) GetBizTags(context.Context, *GetBizTagsRequest) (*GetBizTagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBizTags not implemented")
 }
+func (UnimplementedTagServiceServer) BatchGetBizTags(context.Context, *BatchGetBizTagsRequest) (*BatchGetBizTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetBizTags not implemented")
+}
 func (UnimplementedTagServiceServer) mustEmbedUnimplementedTagServiceServer() {}
 
 // UnsafeTagServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -197,6 +214,24 @@ func _TagService_GetBizTags_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TagService_BatchGetBizTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetBizTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).BatchGetBizTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_BatchGetBizTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).BatchGetBizTags(ctx, req.(*BatchGetBizTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -220,6 +255,10 @@ var TagService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBizTags",
 			Handler:    _TagService_GetBizTags_Handler,
 		},
+		{
+			MethodName: "BatchGetBizTags",
+			Handler:    _TagService_BatchGetBizTags_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tag/v1/tag.proto",
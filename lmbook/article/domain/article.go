@@ -11,6 +11,8 @@ type Article struct {
 	Author Author
 	Ctime  time.Time
 	Utime  time.Time
+	// PublishTime 定时发表的时间，只有 ArticleStatusScheduled 状态下才有意义
+	PublishTime time.Time
 }
 
 // Abstract 取部分作为摘要
@@ -42,6 +44,8 @@ const (
 	ArticleStatusPublished
 	// ArticleStatusPrivate 仅自己可见
 	ArticleStatusPrivate
+	// ArticleStatusScheduled 等待定时发表
+	ArticleStatusScheduled
 )
 
 // Author 在帖子这个领域内，
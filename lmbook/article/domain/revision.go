@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// ArticleRevision 文章的一个历史版本
+// 每一次 Save/Publish 都会生成一个，生成之后就不会再修改
+type ArticleRevision struct {
+	Id        int64
+	ArticleId int64
+	// Version 同一篇文章内从 1 开始递增
+	Version int64
+	Title   string
+	Content string
+	Status  ArticleStatus
+	Author  Author
+	Ctime   time.Time
+}
+
+type DiffOp uint8
+
+const (
+	// DiffOpEqual 两个版本都有的行
+	DiffOpEqual DiffOp = iota
+	// DiffOpInsert 新版本新增的行
+	DiffOpInsert
+	// DiffOpDelete 新版本删掉的行
+	DiffOpDelete
+)
+
+type DiffLine struct {
+	Op      DiffOp
+	Content string
+}
+
+// RevisionDiff 两个版本之间按行比较的结果
+type RevisionDiff struct {
+	Title   []DiffLine
+	Content []DiffLine
+}
@@ -5,15 +5,35 @@ import (
 	"github.com/IBM/sarama"
 )
 
-const topicReadEvent = "article_read_event"
+const (
+	topicReadEvent = "article_read_event"
+	// topicSyncArticle 搜索那边消费这个 topic，把文章同步到索引里面
+	topicSyncArticle = "sync_article_event"
+)
 
 type ReadEvent struct {
 	Aid int64
 	Uid int64
 }
 
+// SyncArticleEvent 字段和搜索那边的 ArticleEvent 一一对应
+type SyncArticleEvent struct {
+	Id      int64  `json:"id"`
+	Title   string `json:"title"`
+	Status  int32  `json:"status"`
+	Content string `json:"content"`
+	// AuthorId 按照作者搜索的时候用。
+	// 加这个字段之前同步过去的文章没有作者，要用文章服务做数据源重建一次索引才能按作者搜到，
+	// 重放 topic 的话老消息里面还是没有作者
+	AuthorId int64 `json:"author_id"`
+	// Utime 毫秒
+	Utime int64 `json:"utime"`
+}
+
 type Producer interface {
 	ProduceReadEvent(evt ReadEvent) error
+	// ProduceSyncArticleEvent 文章发表、撤回之后同步给搜索
+	ProduceSyncArticleEvent(evt SyncArticleEvent) error
 }
 
 type SaramaSyncProducer struct {
@@ -38,3 +58,16 @@ func (s *SaramaSyncProducer) ProduceReadEvent(evt ReadEvent) error {
 		})
 	return err
 }
+
+func (s *SaramaSyncProducer) ProduceSyncArticleEvent(evt SyncArticleEvent) error {
+	val, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	_, _, err = s.producer.
+		SendMessage(&sarama.ProducerMessage{
+			Topic: topicSyncArticle,
+			Value: sarama.ByteEncoder(val),
+		})
+	return err
+}
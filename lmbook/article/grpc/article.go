@@ -4,8 +4,14 @@ import (
 	articlev1 "basic-go/lmbook/api/proto/gen/article/v1"
 	"basic-go/lmbook/article/domain"
 	"basic-go/lmbook/article/service"
+	"basic-go/lmbook/pkg/cursorx"
 	"context"
+	"errors"
+
+	"github.com/ecodeclub/ekit/slice"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -19,3 +25,202 @@ func NewArticleServiceServer(svc service.ArticleService) *ArticleServiceServer {
 		service: svc,
 	}
 }
+
+func (a *ArticleServiceServer) Register(server grpc.ServiceRegistrar) {
+	articlev1.RegisterArticleServiceServer(server, a)
+}
+
+// toStatusErr 命中敏感词要让调用方知道，转成 InvalidArgument
+func toStatusErr(err error) error {
+	if errors.Is(err, service.ErrSensitiveContent) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func (a *ArticleServiceServer) Save(ctx context.Context, request *articlev1.SaveRequest) (*articlev1.SaveResponse, error) {
+	id, err := a.service.Save(ctx, toDomain(request.GetArticle()))
+	return &articlev1.SaveResponse{Id: id}, err
+}
+
+func (a *ArticleServiceServer) Publish(ctx context.Context, request *articlev1.PublishRequest) (*articlev1.PublishResponse, error) {
+	id, err := a.service.Publish(ctx, toDomain(request.GetArticle()))
+	return &articlev1.PublishResponse{Id: id}, toStatusErr(err)
+}
+
+func (a *ArticleServiceServer) Withdraw(ctx context.Context, request *articlev1.WithdrawRequest) (*articlev1.WithdrawResponse, error) {
+	err := a.service.Withdraw(ctx, request.GetUid(), request.GetId())
+	return &articlev1.WithdrawResponse{}, err
+}
+
+func (a *ArticleServiceServer) List(ctx context.Context, request *articlev1.ListRequest) (*articlev1.ListResponse, error) {
+	cur, err := cursorx.Decode(request.GetCursor())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limit := int(request.GetLimit())
+	arts, err := a.service.List(ctx, request.GetAuthor(), cur, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.ListResponse{
+		Articles: slice.Map(arts, func(idx int, src domain.Article) *articlev1.Article {
+			return toDTO(src)
+		}),
+		NextCursor: cursorx.Next(arts, limit, func(art domain.Article) cursorx.Cursor {
+			return cursorx.Cursor{Time: art.Utime.UnixMilli(), Id: art.Id}
+		}),
+	}, nil
+}
+
+func (a *ArticleServiceServer) GetById(ctx context.Context, request *articlev1.GetByIdRequest) (*articlev1.GetByIdResponse, error) {
+	art, err := a.service.GetById(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.GetByIdResponse{Article: toDTO(art)}, nil
+}
+
+func (a *ArticleServiceServer) GetPublishedById(ctx context.Context, request *articlev1.GetPublishedByIdRequest) (*articlev1.GetPublishedByIdResponse, error) {
+	art, err := a.service.GetPublishedById(ctx, request.GetId(), request.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.GetPublishedByIdResponse{Article: toDTO(art)}, nil
+}
+
+func (a *ArticleServiceServer) ListPub(ctx context.Context, request *articlev1.ListPubRequest) (*articlev1.ListPubResponse, error) {
+	arts, err := a.service.ListPub(ctx, request.GetStartTime().AsTime(),
+		int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.ListPubResponse{
+		Articles: slice.Map(arts, func(idx int, src domain.Article) *articlev1.Article {
+			return toDTO(src)
+		}),
+	}, nil
+}
+
+func (a *ArticleServiceServer) GetPublishedByIds(ctx context.Context, request *articlev1.GetPublishedByIdsRequest) (*articlev1.GetPublishedByIdsResponse, error) {
+	arts, err := a.service.GetPublishedByIds(ctx, request.GetIds())
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.GetPublishedByIdsResponse{
+		Articles: slice.Map(arts, func(idx int, src domain.Article) *articlev1.Article {
+			return toDTO(src)
+		}),
+	}, nil
+}
+
+func (a *ArticleServiceServer) ListRevisions(ctx context.Context, request *articlev1.ListRevisionsRequest) (*articlev1.ListRevisionsResponse, error) {
+	revs, err := a.service.ListRevisions(ctx, request.GetUid(), request.GetArticleId(),
+		int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.ListRevisionsResponse{
+		Revisions: slice.Map(revs, func(idx int, src domain.ArticleRevision) *articlev1.Revision {
+			return toRevisionDTO(src)
+		}),
+	}, nil
+}
+
+func (a *ArticleServiceServer) GetRevision(ctx context.Context, request *articlev1.GetRevisionRequest) (*articlev1.GetRevisionResponse, error) {
+	rev, err := a.service.GetRevision(ctx, request.GetUid(), request.GetArticleId(), request.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.GetRevisionResponse{Revision: toRevisionDTO(rev)}, nil
+}
+
+func (a *ArticleServiceServer) DiffRevisions(ctx context.Context, request *articlev1.DiffRevisionsRequest) (*articlev1.DiffRevisionsResponse, error) {
+	diff, err := a.service.DiffRevisions(ctx, request.GetUid(), request.GetArticleId(),
+		request.GetFromVersion(), request.GetToVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &articlev1.DiffRevisionsResponse{
+		Title:   toDiffLineDTOs(diff.Title),
+		Content: toDiffLineDTOs(diff.Content),
+	}, nil
+}
+
+func (a *ArticleServiceServer) RestoreRevision(ctx context.Context, request *articlev1.RestoreRevisionRequest) (*articlev1.RestoreRevisionResponse, error) {
+	id, err := a.service.RestoreRevision(ctx, request.GetUid(), request.GetArticleId(), request.GetVersion())
+	return &articlev1.RestoreRevisionResponse{Id: id}, err
+}
+
+func (a *ArticleServiceServer) SchedulePublish(ctx context.Context, request *articlev1.SchedulePublishRequest) (*articlev1.SchedulePublishResponse, error) {
+	id, err := a.service.SchedulePublish(ctx, toDomain(request.GetArticle()),
+		request.GetPublishTime().AsTime())
+	return &articlev1.SchedulePublishResponse{Id: id}, toStatusErr(err)
+}
+
+func (a *ArticleServiceServer) CancelSchedule(ctx context.Context, request *articlev1.CancelScheduleRequest) (*articlev1.CancelScheduleResponse, error) {
+	err := a.service.CancelSchedule(ctx, request.GetUid(), request.GetId())
+	return &articlev1.CancelScheduleResponse{}, err
+}
+
+func toDomain(art *articlev1.Article) domain.Article {
+	if art == nil {
+		return domain.Article{}
+	}
+	return domain.Article{
+		Id:      art.GetId(),
+		Title:   art.GetTitle(),
+		Status:  domain.ArticleStatus(art.GetStatus()),
+		Content: art.GetContent(),
+		Author: domain.Author{
+			Id:   art.GetAuthor().GetId(),
+			Name: art.GetAuthor().GetName(),
+		},
+	}
+}
+
+func toDTO(art domain.Article) *articlev1.Article {
+	var publishTime *timestamppb.Timestamp
+	if !art.PublishTime.IsZero() {
+		publishTime = timestamppb.New(art.PublishTime)
+	}
+	return &articlev1.Article{
+		Id:      art.Id,
+		Title:   art.Title,
+		Status:  int32(art.Status),
+		Content: art.Content,
+		Author: &articlev1.Author{
+			Id:   art.Author.Id,
+			Name: art.Author.Name,
+		},
+		Ctime:       timestamppb.New(art.Ctime),
+		Utime:       timestamppb.New(art.Utime),
+		Abstract:    art.Abstract(),
+		PublishTime: publishTime,
+	}
+}
+
+func toRevisionDTO(rev domain.ArticleRevision) *articlev1.Revision {
+	return &articlev1.Revision{
+		Id:        rev.Id,
+		ArticleId: rev.ArticleId,
+		Version:   rev.Version,
+		Title:     rev.Title,
+		Content:   rev.Content,
+		Status:    int32(rev.Status),
+		Author: &articlev1.Author{
+			Id:   rev.Author.Id,
+			Name: rev.Author.Name,
+		},
+		Ctime: timestamppb.New(rev.Ctime),
+	}
+}
+
+func toDiffLineDTOs(lines []domain.DiffLine) []*articlev1.DiffLine {
+	return slice.Map(lines, func(idx int, src domain.DiffLine) *articlev1.DiffLine {
+		return &articlev1.DiffLine{
+			Op:      int32(src.Op),
+			Content: src.Content,
+		}
+	})
+}
@@ -0,0 +1,24 @@
+package ioc
+
+import (
+	cronjobv1 "basic-go/lmbook/api/proto/gen/cronjob/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func InitCronJobRpcClient() cronjobv1.CronJobServiceClient {
+	type config struct {
+		Addr string `yaml:"addr"`
+	}
+	var cfg config
+	err := viper.UnmarshalKey("grpc.client.cronjob", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	conn, err := grpc.Dial(cfg.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+	return cronjobv1.NewCronJobServiceClient(conn)
+}
@@ -0,0 +1,42 @@
+package ioc
+
+import (
+	cronjobv1 "basic-go/lmbook/api/proto/gen/cronjob/v1"
+	"basic-go/lmbook/article/job"
+	"basic-go/lmbook/pkg/cronjobx"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func InitScheduler(client cronjobv1.CronJobServiceClient,
+	publishJob *job.ScheduledPublishJob,
+	l logger.LoggerV1) *job.Scheduler {
+	type Config struct {
+		// 秒级的 cron 表达式
+		Expression string `yaml:"expression"`
+	}
+	cfg := Config{
+		Expression: "0 * * * * ?",
+	}
+	err := viper.UnmarshalKey("job.scheduledPublish", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+	// 任务名字是唯一的，所以重复注册会失败，这里忽略就可以
+	_, err = client.AddJob(ctx, &cronjobv1.AddJobRequest{
+		Job: &cronjobv1.CronJob{
+			Name:       publishJob.Name(),
+			Executor:   "local",
+			Expression: cfg.Expression,
+		},
+	})
+	if err != nil {
+		l.Warn("注册定时发表任务失败，可能是已经注册过了", logger.Error(err))
+	}
+	return job.NewScheduler(client, cronjobx.NewCronJobBuilder(l), l, publishJob)
+}
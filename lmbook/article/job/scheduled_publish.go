@@ -0,0 +1,52 @@
+package job
+
+import (
+	"basic-go/lmbook/article/service"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"time"
+)
+
+// ScheduledPublishJob 发表到期的定时文章。
+// 多实例部署的时候由 cronjob 服务的抢占机制保证同一时刻只有一个实例在运行，
+// 单篇文章的重复发表则由 DAO 里面的 CAS 兜底
+type ScheduledPublishJob struct {
+	svc       service.ArticleService
+	l         logger.LoggerV1
+	batchSize int
+	// maxBatch 一次运行最多处理多少批，防止一直失败的文章导致死循环
+	maxBatch int
+	timeout  time.Duration
+}
+
+func NewScheduledPublishJob(svc service.ArticleService, l logger.LoggerV1) *ScheduledPublishJob {
+	return &ScheduledPublishJob{
+		svc:       svc,
+		l:         l,
+		batchSize: 100,
+		maxBatch:  10,
+		timeout:   time.Second * 10,
+	}
+}
+
+func (s *ScheduledPublishJob) Name() string {
+	return "article_scheduled_publish"
+}
+
+func (s *ScheduledPublishJob) Run() error {
+	now := time.Now()
+	for i := 0; i < s.maxBatch; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		cnt, err := s.svc.PublishDue(ctx, now, s.batchSize)
+		cancel()
+		if err != nil {
+			return err
+		}
+		if cnt < s.batchSize {
+			return nil
+		}
+	}
+	s.l.Warn("定时发表的文章太多，本次没有处理完",
+		logger.Int64("batches", int64(s.maxBatch)))
+	return nil
+}
@@ -0,0 +1,128 @@
+package job
+
+import (
+	cronjobv1 "basic-go/lmbook/api/proto/gen/cronjob/v1"
+	"basic-go/lmbook/pkg/cronjobx"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Scheduler 通过 cronjob 服务抢占任务，抢到了就在本地执行。
+// 只会抢占注册到本地的任务
+type Scheduler struct {
+	client cronjobv1.CronJobServiceClient
+	l      logger.LoggerV1
+	jobs   map[string]cron.Job
+	names  []string
+	// interval 没有抢到任务的时候，隔多久再试
+	interval time.Duration
+	// refreshInterval 执行任务期间隔多久续约一次，要比 cronjob 服务那边的续约超时时间短得多
+	refreshInterval time.Duration
+	timeout         time.Duration
+}
+
+func NewScheduler(client cronjobv1.CronJobServiceClient,
+	builder *cronjobx.CronJobBuilder,
+	l logger.LoggerV1,
+	jobs ...cronjobx.Job) *Scheduler {
+	s := &Scheduler{
+		client:          client,
+		l:               l,
+		jobs:            make(map[string]cron.Job, len(jobs)),
+		interval:        time.Second * 10,
+		refreshInterval: time.Second * 10,
+		timeout:         time.Second * 3,
+	}
+	for _, j := range jobs {
+		// 借助 builder 接入日志、监控和 tracing
+		s.jobs[j.Name()] = builder.Build(j)
+		s.names = append(s.names, j.Name())
+	}
+	return s
+}
+
+// Start 会阻塞直到 ctx 被取消
+func (s *Scheduler) Start(ctx context.Context) {
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+		pctx, cancel := context.WithTimeout(ctx, s.timeout)
+		resp, err := s.client.Preempt(pctx, &cronjobv1.PreemptRequest{Names: s.names})
+		cancel()
+		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				s.l.Error("抢占任务失败", logger.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(s.interval):
+			}
+			continue
+		}
+		s.run(resp.GetCronjob())
+	}
+}
+
+func (s *Scheduler) run(j *cronjobv1.CronJob) {
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		defer cancel()
+		_, err := s.client.Release(ctx, &cronjobv1.ReleaseRequest{Id: j.GetId()})
+		if err != nil {
+			s.l.Error("释放任务失败", logger.Error(err),
+				logger.Int64("jid", j.GetId()))
+		}
+	}()
+	job, ok := s.jobs[j.GetName()]
+	if !ok {
+		// 理论上不会出现，因为我们只抢占本地注册了的任务
+		s.l.Error("未知的任务", logger.String("name", j.GetName()))
+		return
+	}
+	// 续约要在释放之前停下来
+	rctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	go s.refresh(rctx, j.GetId())
+	job.Run()
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	_, err := s.client.ResetNextTime(ctx, &cronjobv1.ResetNextTimeRequest{Job: j})
+	if err != nil {
+		s.l.Error("设置任务下一次执行时间失败", logger.Error(err),
+			logger.Int64("jid", j.GetId()))
+	}
+}
+
+// refresh 执行任务期间定期续约，直到 ctx 被取消。
+// 本实例崩溃之后不再续约，cronjob 服务那边超时之后别的实例就能抢占了
+func (s *Scheduler) refresh(ctx context.Context, id int64) {
+	ticker := time.NewTicker(s.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rctx, cancel := context.WithTimeout(ctx, s.timeout)
+			_, err := s.client.Refresh(rctx, &cronjobv1.RefreshRequest{Id: id})
+			cancel()
+			if status.Code(err) == codes.FailedPrecondition {
+				// 任务已经被释放了，比如说续约超时被别人抢走了，续约也没有意义了
+				s.l.Warn("任务已经被释放，停止续约",
+					logger.Int64("jid", id))
+				return
+			}
+			if err != nil && ctx.Err() == nil {
+				s.l.Error("续约失败", logger.Error(err),
+					logger.Int64("jid", id))
+			}
+		}
+	}
+}
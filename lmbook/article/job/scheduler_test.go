@@ -0,0 +1,69 @@
+package job
+
+import (
+	cronjobv1 "basic-go/lmbook/api/proto/gen/cronjob/v1"
+	cronjobmocks "basic-go/lmbook/api/proto/gen/cronjob/v1/mocks"
+	"basic-go/lmbook/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestScheduler_run(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) cronjobv1.CronJobServiceClient
+	}{
+		{
+			name: "执行期间一直续约，执行完释放",
+			mock: func(ctrl *gomock.Controller) cronjobv1.CronJobServiceClient {
+				client := cronjobmocks.NewMockCronJobServiceClient(ctrl)
+				// 任务执行 350 毫秒，100 毫秒续约一次
+				refresh := client.EXPECT().Refresh(gomock.Any(), &cronjobv1.RefreshRequest{Id: 1}).
+					MinTimes(2).MaxTimes(3).Return(&cronjobv1.RefreshResponse{}, nil)
+				client.EXPECT().ResetNextTime(gomock.Any(), gomock.Any()).
+					Return(&cronjobv1.ResetNextTimeResponse{}, nil)
+				client.EXPECT().Release(gomock.Any(), &cronjobv1.ReleaseRequest{Id: 1}).
+					After(refresh).Return(&cronjobv1.ReleaseResponse{}, nil)
+				return client
+			},
+		},
+		{
+			name: "任务已经被释放了，停止续约",
+			mock: func(ctrl *gomock.Controller) cronjobv1.CronJobServiceClient {
+				client := cronjobmocks.NewMockCronJobServiceClient(ctrl)
+				client.EXPECT().Refresh(gomock.Any(), &cronjobv1.RefreshRequest{Id: 1}).
+					Return(nil, status.Error(codes.FailedPrecondition, "任务已经被释放了"))
+				client.EXPECT().ResetNextTime(gomock.Any(), gomock.Any()).
+					Return(&cronjobv1.ResetNextTimeResponse{}, nil)
+				client.EXPECT().Release(gomock.Any(), &cronjobv1.ReleaseRequest{Id: 1}).
+					Return(&cronjobv1.ReleaseResponse{}, nil)
+				return client
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			s := &Scheduler{
+				client: tc.mock(ctrl),
+				l:      logger.NewNoOpLogger(),
+				jobs: map[string]cron.Job{
+					"test_job": cron.FuncJob(func() {
+						time.Sleep(time.Millisecond * 350)
+					}),
+				},
+				refreshInterval: time.Millisecond * 100,
+				timeout:         time.Second,
+			}
+			s.run(&cronjobv1.CronJob{Id: 1, Name: "test_job"})
+			// 借助 mock 确定释放之后真的退出了续约循环
+			time.Sleep(time.Millisecond * 250)
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package main
 
 import (
+	"basic-go/lmbook/article/job"
+	"basic-go/lmbook/pkg/grpcx"
+	"context"
+
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
@@ -8,7 +12,11 @@ import (
 func main() {
 	initViperV2Watch()
 	app := Init()
-	err := app.GRPCServer.Serve()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// 定时发表之类的任务
+	go app.scheduler.Start(ctx)
+	err := app.server.Serve()
 	if err != nil {
 		panic(err)
 	}
@@ -26,3 +34,8 @@ func initViperV2Watch() {
 		panic(err)
 	}
 }
+
+type App struct {
+	server    *grpcx.Server
+	scheduler *job.Scheduler
+}
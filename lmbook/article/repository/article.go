@@ -4,6 +4,7 @@ import (
 	"basic-go/lmbook/article/domain"
 	"basic-go/lmbook/article/repository/cache"
 	"basic-go/lmbook/article/repository/dao"
+	"basic-go/lmbook/pkg/cursorx"
 	"basic-go/lmbook/pkg/logger"
 	"context"
 	"time"
@@ -12,12 +13,16 @@ import (
 	"gorm.io/gorm"
 )
 
-//go:generate mockgen -source=./type.go -package=repomocks -destination=mocks/article.mock.go ArticleRepository
+// ErrNotScheduled 文章已经不处于定时发表状态
+var ErrNotScheduled = dao.ErrNotScheduled
+
+//go:generate mockgen -source=./article.go -package=repomocks -destination=mocks/article.mock.go ArticleRepository
 type ArticleRepository interface {
 	Create(ctx context.Context, art domain.Article) (int64, error)
 	Update(ctx context.Context, art domain.Article) error
+	// List 创作者的文章列表，按照更新时间倒序，cur 为零值代表第一页
 	List(ctx context.Context, author int64,
-		offset int, limit int) ([]domain.Article, error)
+		cur cursorx.Cursor, limit int) ([]domain.Article, error)
 
 	// Sync 本身要求先保存到制作库，再同步到线上库
 	Sync(ctx context.Context, art domain.Article) (int64, error)
@@ -26,7 +31,15 @@ type ArticleRepository interface {
 	GetById(ctx context.Context, id int64) (domain.Article, error)
 
 	GetPublishedById(ctx context.Context, id int64) (domain.Article, error)
+	// GetPublishedByIds 直接查线上库，不存在的文章不会出现在结果里面
+	GetPublishedByIds(ctx context.Context, ids []int64) ([]domain.Article, error)
 	ListPub(ctx context.Context, utime time.Time, offset int, limit int) ([]domain.Article, error)
+
+	// ListScheduled 到了发表时间的定时发表文章
+	ListScheduled(ctx context.Context, t time.Time, limit int) ([]domain.Article, error)
+	// SyncScheduled 和 Sync 类似，但是只有文章依旧处于定时发表状态才会同步
+	SyncScheduled(ctx context.Context, art domain.Article) error
+	CancelSchedule(ctx context.Context, uid, id int64) error
 }
 
 type CachedArticleRepository struct {
@@ -58,6 +71,19 @@ func (repo *CachedArticleRepository) ListPub(ctx context.Context, utime time.Tim
 	}), nil
 }
 
+func (repo *CachedArticleRepository) GetPublishedByIds(ctx context.Context, ids []int64) ([]domain.Article, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	val, err := repo.dao.GetPubByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.PublishedArticle, domain.Article](val, func(idx int, src dao.PublishedArticle) domain.Article {
+		return repo.ToDomain(dao.Article(src))
+	}), nil
+}
+
 func NewArticleRepository(dao dao.ArticleDAO,
 	c cache.ArticleCache,
 	l logger.LoggerV1) ArticleRepository {
@@ -114,11 +140,11 @@ func (repo *CachedArticleRepository) GetById(ctx context.Context, id int64) (dom
 }
 
 func (repo *CachedArticleRepository) List(ctx context.Context, author int64,
-	offset int, limit int) ([]domain.Article, error) {
+	cur cursorx.Cursor, limit int) ([]domain.Article, error) {
 	// 只有第一页才走缓存，并且假定一页只有 100 条
 	// 也就是说，如果前端允许创作者调整页的大小
 	// 那么只有 100 这个页大小这个默认情况下，会走索引
-	if offset == 0 && limit == 100 {
+	if cur.IsZero() && limit == 100 {
 		data, err := repo.cache.GetFirstPage(ctx, author)
 		if err == nil {
 			go func() {
@@ -133,7 +159,7 @@ func (repo *CachedArticleRepository) List(ctx context.Context, author int64,
 		}
 	}
 	// 慢路径
-	arts, err := repo.dao.GetByAuthor(ctx, author, offset, limit)
+	arts, err := repo.dao.GetByAuthor(ctx, author, cur, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -193,6 +219,55 @@ func (repo *CachedArticleRepository) Sync(ctx context.Context,
 	return id, nil
 }
 
+func (repo *CachedArticleRepository) ListScheduled(ctx context.Context,
+	t time.Time, limit int) ([]domain.Article, error) {
+	arts, err := repo.dao.ListScheduled(ctx, t, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.Article, domain.Article](arts,
+		func(idx int, src dao.Article) domain.Article {
+			return repo.ToDomain(src)
+		}), nil
+}
+
+func (repo *CachedArticleRepository) SyncScheduled(ctx context.Context,
+	art domain.Article) error {
+	err := repo.dao.SyncScheduled(ctx, repo.toEntity(art))
+	if err != nil {
+		return err
+	}
+	// 和 Sync 一样处理缓存
+	go func() {
+		author := art.Author.Id
+		err = repo.cache.DelFirstPage(ctx, author)
+		if err != nil {
+			repo.l.Error("删除第一页缓存失败",
+				logger.Int64("author", author), logger.Error(err))
+		}
+		err = repo.cache.SetPub(ctx, art)
+		if err != nil {
+			repo.l.Error("提前设置缓存失败",
+				logger.Int64("author", author), logger.Error(err))
+		}
+	}()
+	return nil
+}
+
+func (repo *CachedArticleRepository) CancelSchedule(ctx context.Context,
+	uid, id int64) error {
+	err := repo.dao.CancelSchedule(ctx, uid, id)
+	if err != nil {
+		return err
+	}
+	err = repo.cache.DelFirstPage(ctx, uid)
+	if err != nil {
+		repo.l.Error("删除缓存失败",
+			logger.Int64("author", uid), logger.Error(err))
+	}
+	return nil
+}
+
 func (repo *CachedArticleRepository) SyncV2(ctx context.Context,
 	art domain.Article) (int64, error) {
 	tx := repo.db.WithContext(ctx).Begin()
@@ -294,6 +369,9 @@ func (repo *CachedArticleRepository) ToDomain(art dao.Article) domain.Article {
 		Author: domain.Author{
 			Id: art.AuthorId,
 		},
+		PublishTime: repo.fromPublishTime(art.PublishTime),
+		Ctime:       time.UnixMilli(art.Ctime),
+		Utime:       time.UnixMilli(art.Utime),
 	}
 }
 
@@ -306,6 +384,22 @@ func (repo *CachedArticleRepository) toEntity(art domain.Article) dao.Article {
 		// 这一步，就是将领域状态转化为存储状态。
 		// 这里我们就是直接转换，
 		// 有些情况下，这里可能是借助一个 map 来转
-		Status: uint8(art.Status),
+		Status:      uint8(art.Status),
+		PublishTime: repo.toPublishTime(art),
+	}
+}
+
+// toPublishTime 零值代表没有定时，落库为 0
+func (repo *CachedArticleRepository) toPublishTime(art domain.Article) int64 {
+	if art.PublishTime.IsZero() {
+		return 0
+	}
+	return art.PublishTime.UnixMilli()
+}
+
+func (repo *CachedArticleRepository) fromPublishTime(t int64) time.Time {
+	if t == 0 {
+		return time.Time{}
 	}
+	return time.UnixMilli(t)
 }
@@ -11,8 +11,16 @@ type Article struct {
 	Status   uint8 `bson:"status,omitempty"`
 	Ctime    int64 `bson:"ctime,omitempty"`
 	Utime    int64 `bson:"utime,omitempty" gorm:"index"`
+	// PublishTime 定时发表的时间，毫秒数
+	PublishTime int64 `bson:"publish_time,omitempty" gorm:"index"`
 }
 
+// 和 domain.ArticleStatus 保持一致，DAO 里面只用到了这两个
+const (
+	articleStatusUnpublished uint8 = 1
+	articleStatusScheduled   uint8 = 4
+)
+
 // PublishedArticle 衍生类型，偷个懒
 type PublishedArticle Article
 
@@ -25,3 +33,15 @@ type PublishedArticleV1 struct {
 	Ctime    int64  `bson:"ctime,omitempty"`
 	Utime    int64  `bson:"utime,omitempty"`
 }
+
+// ArticleRevision 文章的历史版本
+type ArticleRevision struct {
+	Id        int64  `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64  `gorm:"uniqueIndex:article_version"`
+	Version   int64  `gorm:"uniqueIndex:article_version"`
+	Title     string `gorm:"type=varchar(4096)"`
+	Content   string `gorm:"type=BLOB"`
+	AuthorId  int64
+	Status    uint8
+	Ctime     int64
+}
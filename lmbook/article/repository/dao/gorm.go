@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"basic-go/lmbook/pkg/cursorx"
 	"context"
 	"errors"
 	"time"
@@ -21,13 +22,11 @@ func (dao *GORMArticleDAO) ListPubByUtime(ctx context.Context, utime time.Time,
 	return res, err
 }
 
-func (dao *GORMArticleDAO) GetByAuthor(ctx context.Context, author int64, offset, limit int) ([]Article, error) {
+func (dao *GORMArticleDAO) GetByAuthor(ctx context.Context, author int64, cur cursorx.Cursor, limit int) ([]Article, error) {
 	var arts []Article
 	err := dao.db.WithContext(ctx).Model(&Article{}).
 		Where("author_id = ?", author).
-		Offset(offset).
-		Limit(limit).
-		Order("utime DESC").
+		Scopes(cursorx.Scope(cur, "utime", "id", limit)).
 		Find(&arts).Error
 	return arts, err
 }
@@ -40,6 +39,14 @@ func (dao *GORMArticleDAO) GetPubById(ctx context.Context, id int64) (PublishedA
 	return pub, err
 }
 
+func (dao *GORMArticleDAO) GetPubByIds(ctx context.Context, ids []int64) ([]PublishedArticle, error) {
+	var res []PublishedArticle
+	err := dao.db.WithContext(ctx).
+		Where("id IN ?", ids).
+		Find(&res).Error
+	return res, err
+}
+
 func (dao *GORMArticleDAO) GetById(ctx context.Context, id int64) (Article, error) {
 	var art Article
 	err := dao.db.WithContext(ctx).Model(&Article{}).
@@ -170,6 +177,8 @@ func (dao *GORMArticleDAO) UpdateById(ctx context.Context,
 			"content": art.Content,
 			"status":  art.Status,
 			"utime":   now,
+			// 保存或者发表都会覆盖掉之前的定时设置
+			"publish_time": art.PublishTime,
 		})
 	err := res.Error
 	if err != nil {
@@ -180,3 +189,62 @@ func (dao *GORMArticleDAO) UpdateById(ctx context.Context,
 	}
 	return nil
 }
+
+func (dao *GORMArticleDAO) ListScheduled(ctx context.Context, t time.Time, limit int) ([]Article, error) {
+	var res []Article
+	err := dao.db.WithContext(ctx).
+		Where("status = ? AND publish_time <= ?", articleStatusScheduled, t.UnixMilli()).
+		Order("publish_time ASC").
+		Limit(limit).Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMArticleDAO) SyncScheduled(ctx context.Context, art Article) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UnixMilli()
+		// 利用 status 做 CAS，保证同一篇文章只会被发表一次
+		res := tx.Model(&Article{}).
+			Where("id = ? AND author_id = ? AND status = ?",
+				art.Id, art.AuthorId, articleStatusScheduled).
+			Updates(map[string]any{
+				"status": art.Status,
+				"utime":  now,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected != 1 {
+			return ErrNotScheduled
+		}
+		publishArt := PublishedArticle(art)
+		publishArt.Utime = now
+		publishArt.Ctime = now
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"title":        art.Title,
+				"content":      art.Content,
+				"status":       art.Status,
+				"publish_time": art.PublishTime,
+				"utime":        now,
+			}),
+		}).Create(&publishArt).Error
+	})
+}
+
+func (dao *GORMArticleDAO) CancelSchedule(ctx context.Context, author, id int64) error {
+	res := dao.db.WithContext(ctx).Model(&Article{}).
+		Where("id = ? AND author_id = ? AND status = ?", id, author, articleStatusScheduled).
+		Updates(map[string]any{
+			"status":       articleStatusUnpublished,
+			"publish_time": 0,
+			"utime":        time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected != 1 {
+		return ErrNotScheduled
+	}
+	return nil
+}
@@ -7,5 +7,6 @@ func InitTables(db *gorm.DB) error {
 		&Article{},
 		&PublishedArticle{},
 		&PublishedArticleV1{},
+		&ArticleRevision{},
 	)
 }
@@ -9,6 +9,7 @@
 package artdaomocks
 
 import (
+	cursorx "basic-go/lmbook/pkg/cursorx"
 	context "context"
 	reflect "reflect"
 	time "time"
@@ -41,18 +42,18 @@ func (m *MockArticleDAO) EXPECT() *MockArticleDAOMockRecorder {
 }
 
 // GetByAuthor mocks base method.
-func (m *MockArticleDAO) GetByAuthor(ctx context.Context, author int64, offset, limit int) ([]dao.Article, error) {
+func (m *MockArticleDAO) GetByAuthor(ctx context.Context, author int64, cur cursorx.Cursor, limit int) ([]dao.Article, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByAuthor", ctx, author, offset, limit)
+	ret := m.ctrl.Call(m, "GetByAuthor", ctx, author, cur, limit)
 	ret0, _ := ret[0].([]dao.Article)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByAuthor indicates an expected call of GetByAuthor.
-func (mr *MockArticleDAOMockRecorder) GetByAuthor(ctx, author, offset, limit any) *gomock.Call {
+func (mr *MockArticleDAOMockRecorder) GetByAuthor(ctx, author, cur, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthor", reflect.TypeOf((*MockArticleDAO)(nil).GetByAuthor), ctx, author, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthor", reflect.TypeOf((*MockArticleDAO)(nil).GetByAuthor), ctx, author, cur, limit)
 }
 
 // GetById mocks base method.
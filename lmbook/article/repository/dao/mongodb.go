@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"basic-go/lmbook/pkg/cursorx"
 	"context"
 	"errors"
 	"time"
@@ -22,12 +23,104 @@ func (m *MongoDBDAO) ListPubByUtime(ctx context.Context, utime time.Time, offset
 	panic("implement me")
 }
 
+func (m *MongoDBDAO) ListScheduled(ctx context.Context, t time.Time, limit int) ([]Article, error) {
+	filter := bson.D{bson.E{Key: "status", Value: articleStatusScheduled},
+		bson.E{Key: "publish_time", Value: bson.D{bson.E{Key: "$lte", Value: t.UnixMilli()}}}}
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "publish_time", Value: 1}}).
+		SetLimit(int64(limit))
+	cursor, err := m.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []Article
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+// SyncScheduled MongoDB 这里没有用事务，
+// 先用 status 做 CAS 抢到这篇文章，再写线上库。
+// 写线上库失败了就把 status 改回定时发表，这样下一轮任务还会重试
+func (m *MongoDBDAO) SyncScheduled(ctx context.Context, art Article) error {
+	now := time.Now().UnixMilli()
+	filter := bson.D{bson.E{Key: "id", Value: art.Id},
+		bson.E{Key: "author_id", Value: art.AuthorId},
+		bson.E{Key: "status", Value: articleStatusScheduled}}
+	sets := bson.D{bson.E{Key: "$set",
+		Value: bson.D{bson.E{Key: "status", Value: art.Status},
+			bson.E{Key: "utime", Value: now},
+		}}}
+	res, err := m.col.UpdateOne(ctx, filter, sets)
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount != 1 {
+		return ErrNotScheduled
+	}
+	art.Utime = now
+	_, err = m.liveCol.UpdateOne(ctx,
+		bson.D{bson.E{Key: "id", Value: art.Id}},
+		bson.D{bson.E{Key: "$set", Value: art},
+			bson.E{Key: "$setOnInsert",
+				Value: bson.D{bson.E{Key: "ctime", Value: now}}}},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		return errors.Join(err, m.rollbackScheduled(art, now))
+	}
+	return nil
+}
+
+// rollbackScheduled 把 status 改回定时发表。
+// 带上 CAS 写进去的 status 和 utime，中间作者又修改了文章的话就不回滚了。
+// 不用调用方的 ctx，因为写线上库失败很可能就是 ctx 超时了
+func (m *MongoDBDAO) rollbackScheduled(art Article, utime int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	filter := bson.D{bson.E{Key: "id", Value: art.Id},
+		bson.E{Key: "status", Value: art.Status},
+		bson.E{Key: "utime", Value: utime}}
+	sets := bson.D{bson.E{Key: "$set",
+		Value: bson.D{bson.E{Key: "status", Value: articleStatusScheduled}}}}
+	_, err := m.col.UpdateOne(ctx, filter, sets)
+	return err
+}
+
+func (m *MongoDBDAO) CancelSchedule(ctx context.Context, author, id int64) error {
+	filter := bson.D{bson.E{Key: "id", Value: id},
+		bson.E{Key: "author_id", Value: author},
+		bson.E{Key: "status", Value: articleStatusScheduled}}
+	sets := bson.D{bson.E{Key: "$set",
+		Value: bson.D{bson.E{Key: "status", Value: articleStatusUnpublished},
+			bson.E{Key: "publish_time", Value: 0},
+			bson.E{Key: "utime", Value: time.Now().UnixMilli()},
+		}}}
+	res, err := m.col.UpdateOne(ctx, filter, sets)
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount != 1 {
+		return ErrNotScheduled
+	}
+	return nil
+}
+
 func (m *MongoDBDAO) GetPubById(ctx context.Context, id int64) (PublishedArticle, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (m *MongoDBDAO) GetByAuthor(ctx context.Context, author int64, offset, limit int) ([]Article, error) {
+func (m *MongoDBDAO) GetPubByIds(ctx context.Context, ids []int64) ([]PublishedArticle, error) {
+	filter := bson.D{bson.E{Key: "id", Value: bson.D{bson.E{Key: "$in", Value: ids}}}}
+	cursor, err := m.liveCol.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var res []PublishedArticle
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m *MongoDBDAO) GetByAuthor(ctx context.Context, author int64, cur cursorx.Cursor, limit int) ([]Article, error) {
 	//TODO implement me
 	panic("implement me")
 }
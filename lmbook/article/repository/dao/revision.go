@@ -0,0 +1,86 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+// ArticleRevisionDAO 历史版本只允许插入和查询，不提供修改和删除
+type ArticleRevisionDAO interface {
+	Insert(ctx context.Context, rev ArticleRevision) (ArticleRevision, error)
+	ListByArticle(ctx context.Context, author, artId int64, offset, limit int) ([]ArticleRevision, error)
+	GetByVersion(ctx context.Context, author, artId, version int64) (ArticleRevision, error)
+}
+
+type GORMArticleRevisionDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMArticleRevisionDAO(db *gorm.DB) ArticleRevisionDAO {
+	return &GORMArticleRevisionDAO{
+		db: db,
+	}
+}
+
+// maxInsertRetry 并发保存同一篇文章的时候，版本号冲突重试的次数
+const maxInsertRetry = 3
+
+// Insert 版本号在事务内取当前最大版本号加一，
+// (article_id, version) 上面有唯一索引，并发保存撞上同一个版本号的时候重新取一次
+func (dao *GORMArticleRevisionDAO) Insert(ctx context.Context,
+	rev ArticleRevision) (ArticleRevision, error) {
+	var err error
+	for i := 0; i < maxInsertRetry; i++ {
+		rev.Id = 0
+		err = dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var maxVersion int64
+			err := tx.Model(&ArticleRevision{}).
+				Select("COALESCE(MAX(version), 0)").
+				Where("article_id = ?", rev.ArticleId).
+				Scan(&maxVersion).Error
+			if err != nil {
+				return err
+			}
+			rev.Version = maxVersion + 1
+			rev.Ctime = time.Now().UnixMilli()
+			return tx.Create(&rev).Error
+		})
+		if !isDuplicate(err) {
+			return rev, err
+		}
+	}
+	return rev, err
+}
+
+func isDuplicate(err error) bool {
+	var me *mysql.MySQLError
+	if errors.As(err, &me) {
+		const uniqueIndexErrNo uint16 = 1062
+		return me.Number == uniqueIndexErrNo
+	}
+	return false
+}
+
+func (dao *GORMArticleRevisionDAO) ListByArticle(ctx context.Context,
+	author, artId int64, offset, limit int) ([]ArticleRevision, error) {
+	var res []ArticleRevision
+	err := dao.db.WithContext(ctx).
+		Where("article_id = ? AND author_id = ?", artId, author).
+		Order("version DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMArticleRevisionDAO) GetByVersion(ctx context.Context,
+	author, artId, version int64) (ArticleRevision, error) {
+	var res ArticleRevision
+	err := dao.db.WithContext(ctx).
+		Where("article_id = ? AND version = ? AND author_id = ?", artId, version, author).
+		First(&res).Error
+	return res, err
+}
@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"basic-go/lmbook/pkg/cursorx"
 	"context"
 	"errors"
 	"time"
@@ -8,14 +9,27 @@ import (
 
 var ErrPossibleIncorrectAuthor = errors.New("用户在尝试操作非本人数据")
 
+// ErrNotScheduled 文章不是待定时发表的状态，可能已经发表或者被取消了
+var ErrNotScheduled = errors.New("文章不在定时发表状态")
+
 //go:generate mockgen -source=./types.go -package=artdaomocks -destination=mocks/article.mock.go ArticleDAO
 type ArticleDAO interface {
 	Insert(ctx context.Context, art Article) (int64, error)
 	UpdateById(ctx context.Context, art Article) error
-	GetByAuthor(ctx context.Context, author int64, offset, limit int) ([]Article, error)
+	// GetByAuthor 按照 (utime, id) 倒序翻页，cur 为零值代表第一页
+	GetByAuthor(ctx context.Context, author int64, cur cursorx.Cursor, limit int) ([]Article, error)
 	GetById(ctx context.Context, id int64) (Article, error)
 	GetPubById(ctx context.Context, id int64) (PublishedArticle, error)
+	// GetPubByIds 不存在的 id 直接忽略
+	GetPubByIds(ctx context.Context, ids []int64) ([]PublishedArticle, error)
 	Sync(ctx context.Context, art Article) (int64, error)
 	SyncStatus(ctx context.Context, author, id int64, status uint8) error
 	ListPubByUtime(ctx context.Context, utime time.Time, offset int, limit int) ([]PublishedArticle, error)
+
+	// ListScheduled 找到发表时间不晚于 t 的定时发表文章
+	ListScheduled(ctx context.Context, t time.Time, limit int) ([]Article, error)
+	// SyncScheduled 将定时发表的文章同步到线上库，
+	// 只有文章还处于定时发表状态才会成功，否则返回 ErrNotScheduled
+	SyncScheduled(ctx context.Context, art Article) error
+	CancelSchedule(ctx context.Context, author, id int64) error
 }
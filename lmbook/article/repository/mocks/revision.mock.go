@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./revision.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./revision.go -package=repomocks -destination=mocks/revision.mock.go ArticleRevisionRepository
+//
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "basic-go/lmbook/article/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleRevisionRepository is a mock of ArticleRevisionRepository interface.
+type MockArticleRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleRevisionRepositoryMockRecorder
+}
+
+// MockArticleRevisionRepositoryMockRecorder is the mock recorder for MockArticleRevisionRepository.
+type MockArticleRevisionRepositoryMockRecorder struct {
+	mock *MockArticleRevisionRepository
+}
+
+// NewMockArticleRevisionRepository creates a new mock instance.
+func NewMockArticleRevisionRepository(ctrl *gomock.Controller) *MockArticleRevisionRepository {
+	mock := &MockArticleRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockArticleRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleRevisionRepository) EXPECT() *MockArticleRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockArticleRevisionRepository) Create(ctx context.Context, art domain.Article) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, art)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockArticleRevisionRepositoryMockRecorder) Create(ctx, art any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockArticleRevisionRepository)(nil).Create), ctx, art)
+}
+
+// GetByVersion mocks base method.
+func (m *MockArticleRevisionRepository) GetByVersion(ctx context.Context, author, artId, version int64) (domain.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByVersion", ctx, author, artId, version)
+	ret0, _ := ret[0].(domain.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByVersion indicates an expected call of GetByVersion.
+func (mr *MockArticleRevisionRepositoryMockRecorder) GetByVersion(ctx, author, artId, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByVersion", reflect.TypeOf((*MockArticleRevisionRepository)(nil).GetByVersion), ctx, author, artId, version)
+}
+
+// List mocks base method.
+func (m *MockArticleRevisionRepository) List(ctx context.Context, author, artId int64, offset, limit int) ([]domain.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, author, artId, offset, limit)
+	ret0, _ := ret[0].([]domain.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockArticleRevisionRepositoryMockRecorder) List(ctx, author, artId, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockArticleRevisionRepository)(nil).List), ctx, author, artId, offset, limit)
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"basic-go/lmbook/article/domain"
+	"basic-go/lmbook/article/repository/dao"
+	"context"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// ArticleRevisionRepository 文章历史版本
+//
+//go:generate mockgen -source=./revision.go -package=repomocks -destination=mocks/revision.mock.go ArticleRevisionRepository
+type ArticleRevisionRepository interface {
+	// Create 为 art 的当前内容生成一个新版本，返回新的版本号
+	Create(ctx context.Context, art domain.Article) (int64, error)
+	List(ctx context.Context, author, artId int64, offset, limit int) ([]domain.ArticleRevision, error)
+	GetByVersion(ctx context.Context, author, artId, version int64) (domain.ArticleRevision, error)
+}
+
+type DAOArticleRevisionRepository struct {
+	dao dao.ArticleRevisionDAO
+}
+
+func NewArticleRevisionRepository(dao dao.ArticleRevisionDAO) ArticleRevisionRepository {
+	return &DAOArticleRevisionRepository{
+		dao: dao,
+	}
+}
+
+func (repo *DAOArticleRevisionRepository) Create(ctx context.Context,
+	art domain.Article) (int64, error) {
+	rev, err := repo.dao.Insert(ctx, dao.ArticleRevision{
+		ArticleId: art.Id,
+		Title:     art.Title,
+		Content:   art.Content,
+		AuthorId:  art.Author.Id,
+		Status:    art.Status.ToUint8(),
+	})
+	return rev.Version, err
+}
+
+func (repo *DAOArticleRevisionRepository) List(ctx context.Context,
+	author, artId int64, offset, limit int) ([]domain.ArticleRevision, error) {
+	revs, err := repo.dao.ListByArticle(ctx, author, artId, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.ArticleRevision, domain.ArticleRevision](revs,
+		func(idx int, src dao.ArticleRevision) domain.ArticleRevision {
+			return repo.toDomain(src)
+		}), nil
+}
+
+func (repo *DAOArticleRevisionRepository) GetByVersion(ctx context.Context,
+	author, artId, version int64) (domain.ArticleRevision, error) {
+	rev, err := repo.dao.GetByVersion(ctx, author, artId, version)
+	if err != nil {
+		return domain.ArticleRevision{}, err
+	}
+	return repo.toDomain(rev), nil
+}
+
+func (repo *DAOArticleRevisionRepository) toDomain(rev dao.ArticleRevision) domain.ArticleRevision {
+	return domain.ArticleRevision{
+		Id:        rev.Id,
+		ArticleId: rev.ArticleId,
+		Version:   rev.Version,
+		Title:     rev.Title,
+		Content:   rev.Content,
+		Status:    domain.ArticleStatus(rev.Status),
+		Author: domain.Author{
+			Id: rev.AuthorId,
+		},
+		Ctime: time.UnixMilli(rev.Ctime),
+	}
+}
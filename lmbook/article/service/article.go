@@ -5,21 +5,33 @@ import (
 	"basic-go/lmbook/article/domain"
 	"basic-go/lmbook/article/events"
 	"basic-go/lmbook/article/repository"
+	"basic-go/lmbook/pkg/cursorx"
 	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/pkg/sensitive"
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"golang.org/x/sync/errgroup"
 )
 
+var (
+	ErrInvalidPublishTime = errors.New("定时发表的时间必须晚于当前时间")
+	// ErrSensitiveContent 标题或者内容命中了敏感词，不允许发表
+	ErrSensitiveContent = errors.New("文章包含敏感词")
+)
+
 //go:generate mockgen -source=./type.go -package=svcmocks -destination=mocks/article.mock.go ArticleService
 type ArticleService interface {
 	Save(ctx context.Context, art domain.Article) (int64, error)
 	Publish(ctx context.Context, art domain.Article) (int64, error)
 	Withdraw(ctx context.Context, uid, id int64) error
 	PublishV1(ctx context.Context, art domain.Article) (int64, error)
+	// List 创作者的文章列表，按照更新时间倒序，cur 为零值代表第一页
 	List(ctx context.Context, author int64,
-		offset, limit int) ([]domain.Article, error)
+		cur cursorx.Cursor, limit int) ([]domain.Article, error)
 	GetById(ctx context.Context, id int64) (domain.Article, error)
 
 	// 剩下的这个是给读者用的服务，暂时放到这里
@@ -30,6 +42,23 @@ type ArticleService interface {
 	GetPublishedById(ctx context.Context, id, uid int64) (domain.Article, error)
 	// ListPub 根据更新时间来分页，更新时间必须小于 startTime
 	ListPub(ctx context.Context, startTime time.Time, offset, limit int) ([]domain.Article, error)
+	// GetPublishedByIds 批量查找已经发表的，和 GetPublishedById 不同，不会产生阅读事件
+	GetPublishedByIds(ctx context.Context, ids []int64) ([]domain.Article, error)
+
+	// ListRevisions 历史版本，按照版本号倒序
+	ListRevisions(ctx context.Context, uid, artId int64, offset, limit int) ([]domain.ArticleRevision, error)
+	GetRevision(ctx context.Context, uid, artId, version int64) (domain.ArticleRevision, error)
+	// DiffRevisions 按行比较 from 和 to 两个版本
+	DiffRevisions(ctx context.Context, uid, artId, from, to int64) (domain.RevisionDiff, error)
+	// RestoreRevision 用历史版本覆盖当前草稿，恢复本身也会产生一个新版本
+	RestoreRevision(ctx context.Context, uid, artId, version int64) (int64, error)
+
+	// SchedulePublish 保存文章，并且在 publishTime 的时候自动发表
+	SchedulePublish(ctx context.Context, art domain.Article, publishTime time.Time) (int64, error)
+	// CancelSchedule 取消定时发表，文章回到未发表状态
+	CancelSchedule(ctx context.Context, uid, id int64) error
+	// PublishDue 发表所有到期的定时文章，返回这一批找到的文章数量
+	PublishDue(ctx context.Context, now time.Time, limit int) (int, error)
 }
 
 type articleService struct {
@@ -41,7 +70,9 @@ type articleService struct {
 	// 1 和 2 是互斥的，不会同时存在
 	userRepo repository.AuthorRepository
 	repo     repository.ArticleRepository
+	revRepo  repository.ArticleRevisionRepository
 	logger   logger.LoggerV1
+	filter   sensitive.Filter
 
 	syncClient searchv1.SyncServiceClient
 
@@ -55,25 +86,33 @@ func (svc *articleService) ListPub(ctx context.Context,
 	return svc.repo.ListPub(ctx, startTime, offset, limit)
 }
 
+func (svc *articleService) GetPublishedByIds(ctx context.Context, ids []int64) ([]domain.Article, error) {
+	return svc.repo.GetPublishedByIds(ctx, ids)
+}
+
 func (svc *articleService) GetById(ctx context.Context, id int64) (domain.Article, error) {
 	return svc.repo.GetById(ctx, id)
 }
 
 func (svc *articleService) List(ctx context.Context, author int64,
-	offset, limit int) ([]domain.Article, error) {
-	return svc.repo.List(ctx, author, offset, limit)
+	cur cursorx.Cursor, limit int) ([]domain.Article, error) {
+	return svc.repo.List(ctx, author, cur, limit)
 }
 
 func NewArticleService(repo repository.ArticleRepository,
+	revRepo repository.ArticleRevisionRepository,
 	authorRepo repository.AuthorRepository,
 	l logger.LoggerV1,
 	producer events.Producer,
+	filter sensitive.Filter,
 ) ArticleService {
 	return &articleService{
 		repo:     repo,
+		revRepo:  revRepo,
 		logger:   l,
 		userRepo: authorRepo,
 		producer: producer,
+		filter:   filter,
 	}
 }
 
@@ -128,7 +167,21 @@ func (svc *articleService) GetPublishedById(ctx context.Context, id, uid int64)
 }
 
 func (svc *articleService) Withdraw(ctx context.Context, uid, id int64) error {
-	return svc.repo.SyncStatus(ctx, uid, id, domain.ArticleStatusPrivate)
+	err := svc.repo.SyncStatus(ctx, uid, id, domain.ArticleStatusPrivate)
+	if err != nil {
+		return err
+	}
+	// 把内容一起带过去，之前没有同步过的文章也能建出完整的文档
+	art, err := svc.repo.GetById(ctx, id)
+	if err != nil {
+		svc.logger.Error("查询撤回的文章失败，没有同步给搜索",
+			logger.Int64("aid", id),
+			logger.Error(err))
+		return nil
+	}
+	art.Status = domain.ArticleStatusPrivate
+	svc.syncSearch(art)
+	return nil
 }
 
 func (svc *articleService) Save(ctx context.Context,
@@ -137,15 +190,191 @@ func (svc *articleService) Save(ctx context.Context,
 	art.Status = domain.ArticleStatusUnpublished
 	if art.Id > 0 {
 		err := svc.repo.Update(ctx, art)
-		return art.Id, err
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		id, err := svc.create(ctx, art)
+		if err != nil {
+			return 0, err
+		}
+		art.Id = id
+	}
+	err := svc.createRevision(ctx, art)
+	if err != nil {
+		return 0, err
 	}
-	return svc.create(ctx, art)
+	return art.Id, nil
 }
 
 func (svc *articleService) Publish(ctx context.Context,
 	art domain.Article) (int64, error) {
+	if err := svc.checkSensitive(art); err != nil {
+		return 0, err
+	}
 	art.Status = domain.ArticleStatusPublished
-	return svc.repo.Sync(ctx, art)
+	id, err := svc.repo.Sync(ctx, art)
+	if err != nil {
+		return 0, err
+	}
+	art.Id = id
+	err = svc.createRevision(ctx, art)
+	// 文章已经发表出去了，生成版本失败也要同步给搜索
+	svc.syncSearch(art)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (svc *articleService) SchedulePublish(ctx context.Context,
+	art domain.Article, publishTime time.Time) (int64, error) {
+	if !publishTime.After(time.Now()) {
+		return 0, ErrInvalidPublishTime
+	}
+	// 到期之后是自动发表的，所以要提前检查
+	if err := svc.checkSensitive(art); err != nil {
+		return 0, err
+	}
+	art.Status = domain.ArticleStatusScheduled
+	art.PublishTime = publishTime
+	if art.Id > 0 {
+		err := svc.repo.Update(ctx, art)
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		id, err := svc.create(ctx, art)
+		if err != nil {
+			return 0, err
+		}
+		art.Id = id
+	}
+	err := svc.createRevision(ctx, art)
+	if err != nil {
+		return 0, err
+	}
+	return art.Id, nil
+}
+
+// checkSensitive 标题和内容都不能有敏感词，错误信息里面会带上命中的词
+func (svc *articleService) checkSensitive(art domain.Article) error {
+	words := svc.filter.Find(art.Title + "\n" + art.Content)
+	if len(words) > 0 {
+		return fmt.Errorf("%w: %s", ErrSensitiveContent, strings.Join(words, ","))
+	}
+	return nil
+}
+
+func (svc *articleService) CancelSchedule(ctx context.Context, uid, id int64) error {
+	return svc.repo.CancelSchedule(ctx, uid, id)
+}
+
+func (svc *articleService) PublishDue(ctx context.Context,
+	now time.Time, limit int) (int, error) {
+	arts, err := svc.repo.ListScheduled(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+	for _, art := range arts {
+		art.Status = domain.ArticleStatusPublished
+		err = svc.repo.SyncScheduled(ctx, art)
+		if err == repository.ErrNotScheduled {
+			// 在我们查询之后，作者取消了定时或者手动发表了
+			continue
+		}
+		if err != nil {
+			// 不中断，其它文章还是要按时发表，没发出去的下一次还能找到
+			svc.logger.Error("定时发表文章失败",
+				logger.Int64("aid", art.Id),
+				logger.Error(err))
+			continue
+		}
+		// 和手动发表一样，生成一个版本。文章已经发表了，没有调用方可以重试，只能记录日志
+		err = svc.createRevision(ctx, art)
+		if err != nil {
+			svc.logger.Error("生成文章历史版本失败",
+				logger.Int64("aid", art.Id),
+				logger.Int64("uid", art.Author.Id),
+				logger.Error(err))
+		}
+		svc.syncSearch(art)
+	}
+	return len(arts), nil
+}
+
+// createRevision 每一次保存、发表都生成一个版本。
+// 生成失败的时候返回错误，让作者知道这一次的内容没有留下版本，重新保存一次就可以了
+func (svc *articleService) createRevision(ctx context.Context, art domain.Article) error {
+	_, err := svc.revRepo.Create(ctx, art)
+	return err
+}
+
+// syncSearch 发给搜索，文章已经保存成功了，所以失败只记录日志，
+// 下一次发表或者重建索引的时候会补上
+func (svc *articleService) syncSearch(art domain.Article) {
+	err := svc.producer.ProduceSyncArticleEvent(events.SyncArticleEvent{
+		Id:       art.Id,
+		Title:    art.Title,
+		Status:   int32(art.Status.ToUint8()),
+		Content:  art.Content,
+		AuthorId: art.Author.Id,
+		Utime:    time.Now().UnixMilli(),
+	})
+	if err != nil {
+		svc.logger.Error("发送文章同步消息失败",
+			logger.Int64("aid", art.Id),
+			logger.Error(err))
+	}
+}
+
+func (svc *articleService) ListRevisions(ctx context.Context,
+	uid, artId int64, offset, limit int) ([]domain.ArticleRevision, error) {
+	return svc.revRepo.List(ctx, uid, artId, offset, limit)
+}
+
+func (svc *articleService) GetRevision(ctx context.Context,
+	uid, artId, version int64) (domain.ArticleRevision, error) {
+	return svc.revRepo.GetByVersion(ctx, uid, artId, version)
+}
+
+func (svc *articleService) DiffRevisions(ctx context.Context,
+	uid, artId, from, to int64) (domain.RevisionDiff, error) {
+	var eg errgroup.Group
+	var fromRev, toRev domain.ArticleRevision
+	eg.Go(func() error {
+		var err error
+		fromRev, err = svc.revRepo.GetByVersion(ctx, uid, artId, from)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		toRev, err = svc.revRepo.GetByVersion(ctx, uid, artId, to)
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		return domain.RevisionDiff{}, err
+	}
+	return domain.RevisionDiff{
+		Title:   diffLines(fromRev.Title, toRev.Title),
+		Content: diffLines(fromRev.Content, toRev.Content),
+	}, nil
+}
+
+func (svc *articleService) RestoreRevision(ctx context.Context,
+	uid, artId, version int64) (int64, error) {
+	rev, err := svc.revRepo.GetByVersion(ctx, uid, artId, version)
+	if err != nil {
+		return 0, err
+	}
+	return svc.Save(ctx, domain.Article{
+		Id:      artId,
+		Title:   rev.Title,
+		Content: rev.Content,
+		Author: domain.Author{
+			Id: uid,
+		},
+	})
 }
 
 // PublishV1 基于使用两种 repository 的写法
@@ -2,12 +2,17 @@ package service
 
 import (
 	"basic-go/lmbook/article/domain"
+	"basic-go/lmbook/article/events"
+	evtmocks "basic-go/lmbook/article/events/mocks"
 	"basic-go/lmbook/article/repository"
 	repomocks "basic-go/lmbook/article/repository/mocks"
 	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/pkg/sensitive"
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -188,3 +193,261 @@ func TestArticleService_PublishV1(t *testing.T) {
 		})
 	}
 }
+
+func TestArticleService_PublishDue(t *testing.T) {
+	now := time.UnixMilli(1000)
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.ArticleRepository,
+			repository.ArticleRevisionRepository, events.Producer)
+		wantCnt int
+		wantErr error
+	}{
+		{
+			name: "发表成功，生成版本",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository,
+				repository.ArticleRevisionRepository, events.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				revRepo := repomocks.NewMockArticleRevisionRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				repo.EXPECT().ListScheduled(gomock.Any(), now, 10).
+					Return([]domain.Article{{Id: 1, Status: domain.ArticleStatusScheduled}}, nil)
+				art := domain.Article{Id: 1, Status: domain.ArticleStatusPublished}
+				repo.EXPECT().SyncScheduled(gomock.Any(), art).Return(nil)
+				revRepo.EXPECT().Create(gomock.Any(), art).Return(int64(2), nil)
+				producer.EXPECT().ProduceSyncArticleEvent(syncEventMatcher{Id: 1, Status: 2}).Return(nil)
+				return repo, revRepo, producer
+			},
+			wantCnt: 1,
+		},
+		{
+			name: "已经取消了定时或者失败的跳过，不影响别的文章",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository,
+				repository.ArticleRevisionRepository, events.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				revRepo := repomocks.NewMockArticleRevisionRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				repo.EXPECT().ListScheduled(gomock.Any(), now, 10).
+					Return([]domain.Article{{Id: 1}, {Id: 2}, {Id: 3}}, nil)
+				repo.EXPECT().SyncScheduled(gomock.Any(),
+					domain.Article{Id: 1, Status: domain.ArticleStatusPublished}).
+					Return(repository.ErrNotScheduled)
+				repo.EXPECT().SyncScheduled(gomock.Any(),
+					domain.Article{Id: 2, Status: domain.ArticleStatusPublished}).
+					Return(errors.New("mock db error"))
+				art := domain.Article{Id: 3, Status: domain.ArticleStatusPublished}
+				repo.EXPECT().SyncScheduled(gomock.Any(), art).Return(nil)
+				revRepo.EXPECT().Create(gomock.Any(), art).Return(int64(1), nil)
+				// 同步给搜索失败也不影响
+				producer.EXPECT().ProduceSyncArticleEvent(syncEventMatcher{Id: 3, Status: 2}).
+					Return(errors.New("mock kafka error"))
+				return repo, revRepo, producer
+			},
+			wantCnt: 3,
+		},
+		{
+			name: "查询失败",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository,
+				repository.ArticleRevisionRepository, events.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				revRepo := repomocks.NewMockArticleRevisionRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				repo.EXPECT().ListScheduled(gomock.Any(), now, 10).
+					Return(nil, errors.New("mock db error"))
+				return repo, revRepo, producer
+			},
+			wantErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, revRepo, producer := tc.mock(ctrl)
+			svc := NewArticleService(repo, revRepo, nil,
+				logger.NewNoOpLogger(), producer, nil)
+			cnt, err := svc.PublishDue(context.Background(), now, 10)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantCnt, cnt)
+		})
+	}
+}
+
+func TestArticleService_Revision(t *testing.T) {
+	publishTime := time.Now().Add(time.Hour)
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.ArticleRepository,
+			repository.ArticleRevisionRepository, events.Producer)
+		save func(svc ArticleService) (int64, error)
+
+		wantId  int64
+		wantErr error
+	}{
+		{
+			name: "保存成功，生成版本",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository,
+				repository.ArticleRevisionRepository, events.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				revRepo := repomocks.NewMockArticleRevisionRepository(ctrl)
+				art := domain.Article{Title: "我的标题", Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusUnpublished}
+				repo.EXPECT().Create(gomock.Any(), art).Return(int64(1), nil)
+				art.Id = 1
+				revRepo.EXPECT().Create(gomock.Any(), art).Return(int64(1), nil)
+				return repo, revRepo, nil
+			},
+			save: func(svc ArticleService) (int64, error) {
+				return svc.Save(context.Background(),
+					domain.Article{Title: "我的标题", Author: domain.Author{Id: 123}})
+			},
+			wantId: 1,
+		},
+		{
+			name: "保存之后生成版本失败",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository,
+				repository.ArticleRevisionRepository, events.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				revRepo := repomocks.NewMockArticleRevisionRepository(ctrl)
+				art := domain.Article{Id: 1, Title: "我的标题", Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusUnpublished}
+				repo.EXPECT().Update(gomock.Any(), art).Return(nil)
+				revRepo.EXPECT().Create(gomock.Any(), art).
+					Return(int64(0), errors.New("mock db error"))
+				return repo, revRepo, nil
+			},
+			save: func(svc ArticleService) (int64, error) {
+				return svc.Save(context.Background(),
+					domain.Article{Id: 1, Title: "我的标题", Author: domain.Author{Id: 123}})
+			},
+			wantErr: errors.New("mock db error"),
+		},
+		{
+			name: "发表之后生成版本失败，依旧同步给搜索",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository,
+				repository.ArticleRevisionRepository, events.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				revRepo := repomocks.NewMockArticleRevisionRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				art := domain.Article{Id: 1, Title: "我的标题", Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusPublished}
+				repo.EXPECT().Sync(gomock.Any(), art).Return(int64(1), nil)
+				revRepo.EXPECT().Create(gomock.Any(), art).
+					Return(int64(0), errors.New("mock db error"))
+				producer.EXPECT().ProduceSyncArticleEvent(syncEventMatcher{
+					Id: 1, Title: "我的标题", Status: 2, AuthorId: 123,
+				}).Return(nil)
+				return repo, revRepo, producer
+			},
+			save: func(svc ArticleService) (int64, error) {
+				return svc.Publish(context.Background(),
+					domain.Article{Id: 1, Title: "我的标题", Author: domain.Author{Id: 123}})
+			},
+			wantErr: errors.New("mock db error"),
+		},
+		{
+			name: "定时发表之后生成版本失败",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository,
+				repository.ArticleRevisionRepository, events.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				revRepo := repomocks.NewMockArticleRevisionRepository(ctrl)
+				art := domain.Article{Id: 1, Title: "我的标题", Author: domain.Author{Id: 123},
+					Status: domain.ArticleStatusScheduled, PublishTime: publishTime}
+				repo.EXPECT().Update(gomock.Any(), art).Return(nil)
+				revRepo.EXPECT().Create(gomock.Any(), art).
+					Return(int64(0), errors.New("mock db error"))
+				return repo, revRepo, nil
+			},
+			save: func(svc ArticleService) (int64, error) {
+				return svc.SchedulePublish(context.Background(),
+					domain.Article{Id: 1, Title: "我的标题", Author: domain.Author{Id: 123}},
+					publishTime)
+			},
+			wantErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, revRepo, producer := tc.mock(ctrl)
+			svc := NewArticleService(repo, revRepo, nil,
+				logger.NewNoOpLogger(), producer, sensitive.NewACFilter(nil))
+			id, err := tc.save(svc)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantId, id)
+		})
+	}
+}
+
+func TestArticleService_Withdraw(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.ArticleRepository, events.Producer)
+
+		wantErr error
+	}{
+		{
+			name: "撤回之后同步给搜索",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository, events.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				repo.EXPECT().SyncStatus(gomock.Any(), int64(123), int64(1), domain.ArticleStatusPrivate).
+					Return(nil)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:      1,
+					Title:   "我的标题",
+					Content: "我的内容",
+					Status:  domain.ArticleStatusPublished,
+					Author:  domain.Author{Id: 123},
+				}, nil)
+				producer.EXPECT().ProduceSyncArticleEvent(syncEventMatcher{
+					Id:       1,
+					Title:    "我的标题",
+					Content:  "我的内容",
+					Status:   int32(domain.ArticleStatusPrivate),
+					AuthorId: 123,
+				}).Return(nil)
+				return repo, producer
+			},
+		},
+		{
+			name: "撤回失败",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository, events.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				repo.EXPECT().SyncStatus(gomock.Any(), int64(123), int64(1), domain.ArticleStatusPrivate).
+					Return(errors.New("mock db error"))
+				return repo, producer
+			},
+			wantErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, producer := tc.mock(ctrl)
+			svc := NewArticleService(repo, nil, nil,
+				logger.NewNoOpLogger(), producer, nil)
+			err := svc.Withdraw(context.Background(), 123, 1)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+// syncEventMatcher 除了 Utime 都要一样，Utime 是发送的时候的时间
+type syncEventMatcher events.SyncArticleEvent
+
+func (m syncEventMatcher) Matches(x any) bool {
+	evt, ok := x.(events.SyncArticleEvent)
+	if !ok || evt.Utime <= 0 {
+		return false
+	}
+	evt.Utime = 0
+	return evt == events.SyncArticleEvent(m)
+}
+
+func (m syncEventMatcher) String() string {
+	return fmt.Sprintf("%+v", events.SyncArticleEvent(m))
+}
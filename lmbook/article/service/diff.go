@@ -0,0 +1,97 @@
+package service
+
+import (
+	"basic-go/lmbook/article/domain"
+	"strings"
+)
+
+// diffLines 按行比较 a 和 b，基于最长公共子序列。
+// 先去掉公共的前缀和后缀，正常编辑只改动一小部分，
+// 这样真正需要算 LCS 的部分很小
+func diffLines(a, b string) []domain.DiffLine {
+	al, bl := splitLines(a), splitLines(b)
+	prefix := 0
+	for prefix < len(al) && prefix < len(bl) && al[prefix] == bl[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(al)-prefix && suffix < len(bl)-prefix &&
+		al[len(al)-1-suffix] == bl[len(bl)-1-suffix] {
+		suffix++
+	}
+
+	res := make([]domain.DiffLine, 0, len(al)+len(bl))
+	for _, l := range al[:prefix] {
+		res = append(res, domain.DiffLine{Op: domain.DiffOpEqual, Content: l})
+	}
+	res = append(res, lcsDiff(al[prefix:len(al)-suffix], bl[prefix:len(bl)-suffix])...)
+	for _, l := range al[len(al)-suffix:] {
+		res = append(res, domain.DiffLine{Op: domain.DiffOpEqual, Content: l})
+	}
+	return res
+}
+
+// maxLCSCells LCS 的表最多这么多个格子，大概 16MB。
+// 两个版本改动的部分都很大的时候，不再逐行对齐，整段当成删除再插入
+const maxLCSCells = 1 << 22
+
+func lcsDiff(a, b []string) []domain.DiffLine {
+	if (len(a)+1)*(len(b)+1) > maxLCSCells {
+		return replaceAll(a, b)
+	}
+	// dp[i][j] 是 a[i:] 和 b[j:] 的最长公共子序列长度
+	dp := make([][]int32, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else {
+				dp[i][j] = max(dp[i+1][j], dp[i][j+1])
+			}
+		}
+	}
+	res := make([]domain.DiffLine, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			res = append(res, domain.DiffLine{Op: domain.DiffOpEqual, Content: a[i]})
+			i++
+			j++
+		case dp[i+1][j] >= dp[i][j+1]:
+			res = append(res, domain.DiffLine{Op: domain.DiffOpDelete, Content: a[i]})
+			i++
+		default:
+			res = append(res, domain.DiffLine{Op: domain.DiffOpInsert, Content: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		res = append(res, domain.DiffLine{Op: domain.DiffOpDelete, Content: a[i]})
+	}
+	for ; j < len(b); j++ {
+		res = append(res, domain.DiffLine{Op: domain.DiffOpInsert, Content: b[j]})
+	}
+	return res
+}
+
+func replaceAll(a, b []string) []domain.DiffLine {
+	res := make([]domain.DiffLine, 0, len(a)+len(b))
+	for _, l := range a {
+		res = append(res, domain.DiffLine{Op: domain.DiffOpDelete, Content: l})
+	}
+	for _, l := range b {
+		res = append(res, domain.DiffLine{Op: domain.DiffOpInsert, Content: l})
+	}
+	return res
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
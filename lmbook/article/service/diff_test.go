@@ -0,0 +1,94 @@
+package service
+
+import (
+	"basic-go/lmbook/article/domain"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLines(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    string
+		b    string
+		want []domain.DiffLine
+	}{
+		{
+			name: "完全一样",
+			a:    "第一行\n第二行",
+			b:    "第一行\n第二行",
+			want: []domain.DiffLine{
+				{Op: domain.DiffOpEqual, Content: "第一行"},
+				{Op: domain.DiffOpEqual, Content: "第二行"},
+			},
+		},
+		{
+			name: "从空内容新增",
+			a:    "",
+			b:    "第一行",
+			want: []domain.DiffLine{
+				{Op: domain.DiffOpInsert, Content: "第一行"},
+			},
+		},
+		{
+			name: "删光",
+			a:    "第一行",
+			b:    "",
+			want: []domain.DiffLine{
+				{Op: domain.DiffOpDelete, Content: "第一行"},
+			},
+		},
+		{
+			name: "中间修改一行",
+			a:    "a\nb\nc",
+			b:    "a\nx\nc",
+			want: []domain.DiffLine{
+				{Op: domain.DiffOpEqual, Content: "a"},
+				{Op: domain.DiffOpDelete, Content: "b"},
+				{Op: domain.DiffOpInsert, Content: "x"},
+				{Op: domain.DiffOpEqual, Content: "c"},
+			},
+		},
+		{
+			name: "插入和删除混合",
+			a:    "a\nb\nc\nd",
+			b:    "b\nc\ne\nd",
+			want: []domain.DiffLine{
+				{Op: domain.DiffOpDelete, Content: "a"},
+				{Op: domain.DiffOpEqual, Content: "b"},
+				{Op: domain.DiffOpEqual, Content: "c"},
+				{Op: domain.DiffOpInsert, Content: "e"},
+				{Op: domain.DiffOpEqual, Content: "d"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, diffLines(tc.a, tc.b))
+		})
+	}
+}
+
+func TestDiffLines_TooLarge(t *testing.T) {
+	// 超过 LCS 的上限，中间改动的部分整段删除再插入，前后公共的部分照样对齐
+	n := 3000
+	var a, b strings.Builder
+	a.WriteString("head")
+	b.WriteString("head")
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&a, "\na%d", i)
+		fmt.Fprintf(&b, "\nb%d", i)
+	}
+	a.WriteString("\ntail")
+	b.WriteString("\ntail")
+	res := diffLines(a.String(), b.String())
+	require.Len(t, res, 2*n+2)
+	assert.Equal(t, domain.DiffLine{Op: domain.DiffOpEqual, Content: "head"}, res[0])
+	assert.Equal(t, domain.DiffLine{Op: domain.DiffOpDelete, Content: "a0"}, res[1])
+	assert.Equal(t, domain.DiffLine{Op: domain.DiffOpInsert, Content: "b0"}, res[n+1])
+	assert.Equal(t, domain.DiffLine{Op: domain.DiffOpEqual, Content: "tail"}, res[2*n+1])
+}
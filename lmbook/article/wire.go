@@ -6,11 +6,12 @@ import (
 	"basic-go/lmbook/article/events"
 	"basic-go/lmbook/article/grpc"
 	"basic-go/lmbook/article/ioc"
+	"basic-go/lmbook/article/job"
 	"basic-go/lmbook/article/repository"
 	"basic-go/lmbook/article/repository/cache"
 	"basic-go/lmbook/article/repository/dao"
 	"basic-go/lmbook/article/service"
-	"basic-go/lmbook/pkg/wego"
+	"basic-go/lmbook/pkg/sensitive"
 
 	"github.com/google/wire"
 )
@@ -19,23 +20,29 @@ var thirdProvider = wire.NewSet(
 	ioc.InitRedis,
 	ioc.InitLogger,
 	ioc.InitUserRpcClient,
+	ioc.InitCronJobRpcClient,
 	ioc.InitProducer,
 	ioc.InitEtcdClient,
 	ioc.InitDB,
+	sensitive.InitFilter,
 )
 
-func Init() *wego.App {
+func Init() *App {
 	wire.Build(
 		thirdProvider,
 		events.NewSaramaSyncProducer,
 		cache.NewRedisArticleCache,
 		dao.NewGORMArticleDAO,
+		dao.NewGORMArticleRevisionDAO,
 		repository.NewArticleRepository,
+		repository.NewArticleRevisionRepository,
 		repository.NewGrpcAuthorRepository,
 		service.NewArticleService,
 		grpc.NewArticleServiceServer,
 		ioc.InitGRPCxServer,
-		wire.Struct(new(wego.App), "GRPCServer"),
+		job.NewScheduledPublishJob,
+		ioc.InitScheduler,
+		wire.Struct(new(App), "*"),
 	)
-	return new(wego.App)
+	return new(App)
 }
@@ -10,37 +10,45 @@ import (
 	"basic-go/lmbook/article/events"
 	"basic-go/lmbook/article/grpc"
 	"basic-go/lmbook/article/ioc"
+	"basic-go/lmbook/article/job"
 	"basic-go/lmbook/article/repository"
 	"basic-go/lmbook/article/repository/cache"
 	"basic-go/lmbook/article/repository/dao"
 	"basic-go/lmbook/article/service"
-	"basic-go/lmbook/pkg/wego"
+	"basic-go/lmbook/pkg/sensitive"
 	"github.com/google/wire"
 )
 
 // Injectors from wire.go:
 
-func Init() *wego.App {
+func Init() *App {
 	loggerV1 := ioc.InitLogger()
 	db := ioc.InitDB(loggerV1)
 	articleDAO := dao.NewGORMArticleDAO(db)
 	cmdable := ioc.InitRedis()
 	articleCache := cache.NewRedisArticleCache(cmdable)
 	articleRepository := repository.NewArticleRepository(articleDAO, articleCache, loggerV1)
+	articleRevisionDAO := dao.NewGORMArticleRevisionDAO(db)
+	articleRevisionRepository := repository.NewArticleRevisionRepository(articleRevisionDAO)
 	userServiceClient := ioc.InitUserRpcClient()
 	authorRepository := repository.NewGrpcAuthorRepository(articleDAO, userServiceClient)
 	syncProducer := ioc.InitProducer()
 	producer := events.NewSaramaSyncProducer(syncProducer)
-	articleService := service.NewArticleService(articleRepository, authorRepository, loggerV1, producer)
+	filter := sensitive.InitFilter(loggerV1)
+	articleService := service.NewArticleService(articleRepository, articleRevisionRepository, authorRepository, loggerV1, producer, filter)
 	articleServiceServer := grpc.NewArticleServiceServer(articleService)
 	client := ioc.InitEtcdClient()
 	server := ioc.InitGRPCxServer(articleServiceServer, client, loggerV1)
-	app := &wego.App{
-		GRPCServer: server,
+	cronJobServiceClient := ioc.InitCronJobRpcClient()
+	scheduledPublishJob := job.NewScheduledPublishJob(articleService, loggerV1)
+	scheduler := ioc.InitScheduler(cronJobServiceClient, scheduledPublishJob, loggerV1)
+	app := &App{
+		server:    server,
+		scheduler: scheduler,
 	}
 	return app
 }
 
 // wire.go:
 
-var thirdProvider = wire.NewSet(ioc.InitRedis, ioc.InitLogger, ioc.InitUserRpcClient, ioc.InitProducer, ioc.InitEtcdClient, ioc.InitDB)
+var thirdProvider = wire.NewSet(ioc.InitRedis, ioc.InitLogger, ioc.InitUserRpcClient, ioc.InitCronJobRpcClient, ioc.InitProducer, ioc.InitEtcdClient, ioc.InitDB, sensitive.InitFilter)
@@ -0,0 +1,56 @@
+package ioc
+
+import (
+	searchv1 "basic-go/lmbook/api/proto/gen/search/v1"
+	"basic-go/lmbook/pkg/ratelimit"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/naming/resolver"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func InitSearchClient(ecli *clientv3.Client) searchv1.SearchServiceClient {
+	type Config struct {
+		Target string `json:"target"`
+		Secure bool   `json:"secure"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("grpc.client.search", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	rs, err := resolver.NewBuilder(ecli)
+	if err != nil {
+		panic(err)
+	}
+	opts := []grpc.DialOption{grpc.WithResolvers(rs)}
+	if !cfg.Secure {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	cc, err := grpc.Dial(cfg.Target, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return searchv1.NewSearchServiceClient(cc)
+}
+
+// InitSuggestLimiter 输入提示按照用户限流，默认每个用户一秒钟最多 10 次
+func InitSuggestLimiter(cmd redis.Cmdable) ratelimit.Limiter {
+	type Config struct {
+		Interval time.Duration `yaml:"interval"`
+		Rate     int           `yaml:"rate"`
+	}
+	cfg := Config{
+		Interval: time.Second,
+		Rate:     10,
+	}
+	err := viper.UnmarshalKey("search.suggest.limit", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return ratelimit.NewRedisSlidingWindowLimiter(cmd, cfg.Interval, cfg.Rate)
+}
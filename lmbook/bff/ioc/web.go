@@ -21,7 +21,8 @@ func InitGinServer(
 	user *web.UserHandler,
 	article *web.ArticleHandler,
 	reward *web.RewardHandler,
-	collection *web.CollectionHandler) *ginx.Server {
+	collection *web.CollectionHandler,
+	search *web.SearchHandler) *ginx.Server {
 	engine := gin.Default()
 	engine.Use(
 		corsHdl(),
@@ -31,6 +32,7 @@ func InitGinServer(
 	article.RegisterRoutes(engine)
 	reward.RegisterRoutes(engine)
 	collection.RegisterRoutes(engine)
+	search.RegisterRoutes(engine)
 	addr := viper.GetString("http.addr")
 	ginx.InitCounter(prometheus.CounterOpts{
 		Namespace: "daming_geektime",
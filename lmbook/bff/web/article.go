@@ -11,6 +11,8 @@ import (
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/gin-gonic/gin"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
 	"strconv"
 	"time"
@@ -56,6 +58,8 @@ func (a *ArticleHandler) RegisterRoutes(s *gin.Engine) {
 	//pub.GET("/pub", a.PubList)
 	pub.GET("/:id", ginx.WrapClaims(a.PubDetail))
 	pub.POST("/like", ginx.WrapClaimsAndReq[LikeReq](a.Like))
+	// 表态，点赞也可以走这里
+	pub.POST("/react", ginx.WrapClaimsAndReq[ReactReq](a.React))
 	pub.POST("/collect", ginx.WrapClaimsAndReq[CollectReq](a.Collect))
 	// 打赏
 	pub.POST("/reward", ginx.WrapClaimsAndReq[RewardReq](a.Reward))
@@ -93,8 +97,9 @@ func (a *ArticleHandler) Withdraw(ctx *gin.Context) {
 }
 
 type ListReq struct {
-	Offset int32 `json:"offset"`
-	Limit  int32 `json:"limit"`
+	// Cursor 上一页返回的 nextCursor，第一页不用传
+	Cursor string `json:"cursor"`
+	Limit  int32  `json:"limit"`
 }
 
 func (a *ArticleHandler) List(ctx *gin.Context, req ListReq, usr jwt.UserClaims) (ginx.Result, error) {
@@ -113,7 +118,13 @@ func (a *ArticleHandler) List(ctx *gin.Context, req ListReq, usr jwt.UserClaims)
 	}
 
 	arts, err := a.svc.List(ctx, &articlev1.ListRequest{Author: usr.Id,
-		Offset: req.Offset, Limit: req.Limit})
+		Cursor: req.Cursor, Limit: req.Limit})
+	if status.Code(err) == codes.InvalidArgument {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "请求有误",
+		}, nil
+	}
 	if err != nil {
 		a.l.Error("获得用户会话信息失败")
 		return ginx.Result{
@@ -122,21 +133,24 @@ func (a *ArticleHandler) List(ctx *gin.Context, req ListReq, usr jwt.UserClaims)
 		}, nil
 	}
 	return ginx.Result{
-		Data: slice.Map[*articlev1.Article, ArticleVo](arts.Articles,
-			func(idx int, src *articlev1.Article) ArticleVo {
-				return ArticleVo{
-					Id:       src.Id,
-					Title:    src.Title,
-					Abstract: src.Abstract,
-					Status:   src.Status,
-					// 这个列表请求，不需要返回内容
-					//Content: src.Content,
-					// 这个是创作者看自己的文章列表，也不需要这个字段
-					//Author: src.Author
-					Ctime: src.Ctime.AsTime().Format(time.DateTime),
-					Utime: src.Utime.AsTime().Format(time.DateTime),
-				}
-			}),
+		Data: map[string]any{
+			"articles": slice.Map[*articlev1.Article, ArticleVo](arts.Articles,
+				func(idx int, src *articlev1.Article) ArticleVo {
+					return ArticleVo{
+						Id:       src.Id,
+						Title:    src.Title,
+						Abstract: src.Abstract,
+						Status:   src.Status,
+						// 这个列表请求，不需要返回内容
+						//Content: src.Content,
+						// 这个是创作者看自己的文章列表，也不需要这个字段
+						//Author: src.Author
+						Ctime: src.Ctime.AsTime().Format(time.DateTime),
+						Utime: src.Utime.AsTime().Format(time.DateTime),
+					}
+				}),
+			"nextCursor": arts.GetNextCursor(),
+		},
 	}, nil
 }
 
@@ -223,6 +237,14 @@ func (a *ArticleHandler) Publish(ctx *gin.Context) {
 			},
 		},
 	})
+	if status.Code(err) == codes.InvalidArgument {
+		// 命中了敏感词
+		ctx.JSON(http.StatusOK, Result{
+			Code: 4,
+			Msg:  status.Convert(err).Message(),
+		})
+		return
+	}
 	if err != nil {
 		ctx.JSON(http.StatusOK, Result{
 			Code: 5,
@@ -323,14 +345,16 @@ func (a *ArticleHandler) PubDetail(ctx *gin.Context, uc ginx.UserClaims) (Result
 			Status:  art.Status,
 			Content: art.Content,
 			// 要把作者信息带出去
-			Author:     art.Author.Name,
-			Ctime:      art.Ctime.AsTime().Format(time.DateTime),
-			Utime:      art.Utime.AsTime().Format(time.DateTime),
-			ReadCnt:    intr.ReadCnt,
-			CollectCnt: intr.CollectCnt,
-			LikeCnt:    intr.LikeCnt,
-			Liked:      intr.Liked,
-			Collected:  intr.Collected,
+			Author:       art.Author.Name,
+			Ctime:        art.Ctime.AsTime().Format(time.DateTime),
+			Utime:        art.Utime.AsTime().Format(time.DateTime),
+			ReadCnt:      intr.ReadCnt,
+			CollectCnt:   intr.CollectCnt,
+			LikeCnt:      intr.LikeCnt,
+			Liked:        intr.Liked,
+			Collected:    intr.Collected,
+			ReactionCnts: intr.ReactionCnts,
+			Reaction:     int32(intr.Reaction),
 		},
 	}, nil
 }
@@ -356,6 +380,34 @@ func (a *ArticleHandler) Like(ctx *gin.Context, req LikeReq, uc jwt.UserClaims)
 	return Result{Msg: "OK"}, nil
 }
 
+// React Reaction 为 0 代表取消表态
+func (a *ArticleHandler) React(ctx *gin.Context, req ReactReq, uc jwt.UserClaims) (ginx.Result, error) {
+	var err error
+	if req.Reaction == 0 {
+		_, err = a.intrSvc.CancelReaction(ctx, &intrv1.CancelReactionRequest{
+			Biz: a.biz, BizId: req.Id, Uid: uc.Id,
+		})
+	} else {
+		_, err = a.intrSvc.React(ctx, &intrv1.ReactRequest{
+			Biz: a.biz, BizId: req.Id, Uid: uc.Id,
+			Reaction: intrv1.ReactionType(req.Reaction),
+		})
+	}
+	if status.Code(err) == codes.InvalidArgument {
+		return Result{
+			Code: 4,
+			Msg:  "不支持的表态",
+		}, nil
+	}
+	if err != nil {
+		return Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	return Result{Msg: "OK"}, nil
+}
+
 func (a *ArticleHandler) Reward(
 	ctx *gin.Context,
 	req RewardReq,
@@ -400,6 +452,12 @@ func (a *ArticleHandler) Collect(
 		Biz: a.biz, BizId: req.Id, Uid: uc.Id,
 		Cid: req.Cid,
 	})
+	if status.Code(err) == codes.NotFound {
+		return Result{
+			Code: 4,
+			Msg:  "收藏夹不存在",
+		}, nil
+	}
 	if err != nil {
 		return Result{
 			Code: 5,
@@ -14,6 +14,12 @@ type LikeReq struct {
 	Like bool  `json:"like"`
 }
 
+// ReactReq Reaction 是表态的类型，1 是点赞，0 代表取消表态
+type ReactReq struct {
+	Id       int64 `json:"id"`
+	Reaction int32 `json:"reaction"`
+}
+
 type CollectReq struct {
 	Id  int64 `json:"id"`
 	Cid int64 `json:"cid"`
@@ -36,9 +42,14 @@ type ArticleVo struct {
 	CollectCnt int64 `json:"collectCnt"`
 	ReadCnt    int64 `json:"readCnt"`
 
+	// 每一种表态的数量，key 是表态的类型
+	ReactionCnts map[int32]int64 `json:"reactionCnts"`
+
 	// 个人是否点赞的信息
 	Liked     bool `json:"liked"`
 	Collected bool `json:"collected"`
+	// 个人的表态，0 代表没有表态
+	Reaction int32 `json:"reaction"`
 }
 
 type ArticleReq struct {
@@ -36,6 +36,14 @@ func (i *InteractiveClient) Like(ctx context.Context, in *intrv1.LikeRequest, op
 	return i.selectClient().Like(ctx, in)
 }
 
+func (i *InteractiveClient) React(ctx context.Context, in *intrv1.ReactRequest, opts ...grpc.CallOption) (*intrv1.ReactResponse, error) {
+	return i.selectClient().React(ctx, in)
+}
+
+func (i *InteractiveClient) CancelReaction(ctx context.Context, in *intrv1.CancelReactionRequest, opts ...grpc.CallOption) (*intrv1.CancelReactionResponse, error) {
+	return i.selectClient().CancelReaction(ctx, in)
+}
+
 func (i *InteractiveClient) CancelLike(ctx context.Context, in *intrv1.CancelLikeRequest, opts ...grpc.CallOption) (*intrv1.CancelLikeResponse, error) {
 	return i.selectClient().CancelLike(ctx, in)
 }
@@ -60,6 +68,34 @@ func (i *InteractiveClient) GetCollections(ctx context.Context, in *intrv1.GetCo
 	return i.selectClient().GetCollections(ctx, in)
 }
 
+func (i *InteractiveClient) CreateCollection(ctx context.Context, in *intrv1.CreateCollectionRequest, opts ...grpc.CallOption) (*intrv1.CreateCollectionResponse, error) {
+	return i.selectClient().CreateCollection(ctx, in)
+}
+
+func (i *InteractiveClient) UpdateCollection(ctx context.Context, in *intrv1.UpdateCollectionRequest, opts ...grpc.CallOption) (*intrv1.UpdateCollectionResponse, error) {
+	return i.selectClient().UpdateCollection(ctx, in)
+}
+
+func (i *InteractiveClient) DeleteCollection(ctx context.Context, in *intrv1.DeleteCollectionRequest, opts ...grpc.CallOption) (*intrv1.DeleteCollectionResponse, error) {
+	return i.selectClient().DeleteCollection(ctx, in)
+}
+
+func (i *InteractiveClient) ListCollections(ctx context.Context, in *intrv1.ListCollectionsRequest, opts ...grpc.CallOption) (*intrv1.ListCollectionsResponse, error) {
+	return i.selectClient().ListCollections(ctx, in)
+}
+
+func (i *InteractiveClient) GetCollectionItems(ctx context.Context, in *intrv1.GetCollectionItemsRequest, opts ...grpc.CallOption) (*intrv1.GetCollectionItemsResponse, error) {
+	return i.selectClient().GetCollectionItems(ctx, in)
+}
+
+func (i *InteractiveClient) RemoveCollectionItem(ctx context.Context, in *intrv1.RemoveCollectionItemRequest, opts ...grpc.CallOption) (*intrv1.RemoveCollectionItemResponse, error) {
+	return i.selectClient().RemoveCollectionItem(ctx, in)
+}
+
+func (i *InteractiveClient) MoveCollectionItem(ctx context.Context, in *intrv1.MoveCollectionItemRequest, opts ...grpc.CallOption) (*intrv1.MoveCollectionItemResponse, error) {
+	return i.selectClient().MoveCollectionItem(ctx, in)
+}
+
 func (i *InteractiveClient) selectClient() intrv1.InteractiveServiceClient {
 	num := rand.Int31n(100)
 	if num < i.threshold.Load() {
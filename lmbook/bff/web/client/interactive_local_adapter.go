@@ -4,8 +4,13 @@ import (
 	intrv1 "basic-go/lmbook/api/proto/gen/intr/v1"
 	"basic-go/lmbook/interactive/domain"
 	"basic-go/lmbook/interactive/service"
+	"basic-go/lmbook/pkg/cursorx"
 	"context"
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type InteractiveLocalAdapter struct {
@@ -31,9 +36,19 @@ func (i *InteractiveLocalAdapter) CancelLike(ctx context.Context, in *intrv1.Can
 	return &intrv1.CancelLikeResponse{}, err
 }
 
+func (i *InteractiveLocalAdapter) React(ctx context.Context, in *intrv1.ReactRequest, opts ...grpc.CallOption) (*intrv1.ReactResponse, error) {
+	err := i.svc.React(ctx, in.GetBiz(), in.GetBizId(), in.GetUid(), domain.ReactionType(in.GetReaction()))
+	return &intrv1.ReactResponse{}, collectionStatusErr(err)
+}
+
+func (i *InteractiveLocalAdapter) CancelReaction(ctx context.Context, in *intrv1.CancelReactionRequest, opts ...grpc.CallOption) (*intrv1.CancelReactionResponse, error) {
+	err := i.svc.CancelReaction(ctx, in.GetBiz(), in.GetBizId(), in.GetUid())
+	return &intrv1.CancelReactionResponse{}, err
+}
+
 func (i *InteractiveLocalAdapter) Collect(ctx context.Context, in *intrv1.CollectRequest, opts ...grpc.CallOption) (*intrv1.CollectResponse, error) {
 	err := i.svc.Collect(ctx, in.GetBiz(), in.GetBizId(), in.GetCid(), in.GetUid())
-	return &intrv1.CollectResponse{}, err
+	return &intrv1.CollectResponse{}, collectionStatusErr(err)
 }
 
 func (i *InteractiveLocalAdapter) Get(ctx context.Context, in *intrv1.GetRequest, opts ...grpc.CallOption) (*intrv1.GetResponse, error) {
@@ -50,7 +65,7 @@ func (i *InteractiveLocalAdapter) GetByIds(ctx context.Context, in *intrv1.GetBy
 	if len(in.Ids) == 0 {
 		return &intrv1.GetByIdsResponse{}, nil
 	}
-	data, err := i.svc.GetByIds(ctx, in.GetBiz(), in.GetIds())
+	data, err := i.svc.GetByIds(ctx, in.GetBiz(), in.GetIds(), in.GetUid())
 	if err != nil {
 		return nil, err
 	}
@@ -70,25 +85,34 @@ func (i *InteractiveLocalAdapter) CancelCollect(ctx context.Context, in *intrv1.
 }
 
 func (i *InteractiveLocalAdapter) GetCollections(ctx context.Context, in *intrv1.GetCollectionsRequest, opts ...grpc.CallOption) (*intrv1.GetCollectionsResponse, error) {
-	bizIds, err := i.svc.GetCollections(ctx, in.GetUid(), in.GetBiz(),
-		int(in.GetOffset()), int(in.GetLimit()))
+	cur, err := cursorx.Decode(in.GetCursor())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limit := int(in.GetLimit())
+	collections, err := i.svc.GetCollections(ctx, in.GetUid(), in.GetBiz(), cur, limit)
 	if err != nil {
 		return nil, err
 	}
-	items := make([]*intrv1.CollectionItem, len(bizIds))
-	for idx, bizId := range bizIds {
+	items := make([]*intrv1.CollectionItem, len(collections))
+	for idx, c := range collections {
 		items[idx] = &intrv1.CollectionItem{
-			BizId: bizId,
+			BizId: c.BizId,
+			Ctime: c.Ctime.UnixMilli(),
 		}
 	}
 	return &intrv1.GetCollectionsResponse{
 		Items: items,
-		Total: int64(len(bizIds)),
+		Total: int64(len(collections)),
+		NextCursor: cursorx.Next(collections, limit,
+			func(c domain.CollectionItem) cursorx.Cursor {
+				return cursorx.Cursor{Time: c.Ctime.UnixMilli(), Id: c.Id}
+			}),
 	}, nil
 }
 
 func (i *InteractiveLocalAdapter) toDTO(intr domain.Interactive) *intrv1.Interactive {
-	return &intrv1.Interactive{
+	res := &intrv1.Interactive{
 		Biz:        intr.Biz,
 		BizId:      intr.BizId,
 		ReadCnt:    intr.ReadCnt,
@@ -96,5 +120,122 @@ func (i *InteractiveLocalAdapter) toDTO(intr domain.Interactive) *intrv1.Interac
 		CollectCnt: intr.CollectCnt,
 		Liked:      intr.Liked,
 		Collected:  intr.Collected,
+		Reaction:   intrv1.ReactionType(intr.Reaction),
+	}
+	if len(intr.ReactionCnts) > 0 {
+		res.ReactionCnts = make(map[int32]int64, len(intr.ReactionCnts))
+		for k, v := range intr.ReactionCnts {
+			res.ReactionCnts[int32(k)] = v
+		}
+	}
+	return res
+}
+
+func (i *InteractiveLocalAdapter) CreateCollection(ctx context.Context, in *intrv1.CreateCollectionRequest, opts ...grpc.CallOption) (*intrv1.CreateCollectionResponse, error) {
+	id, err := i.svc.CreateCollection(ctx, domain.Collection{
+		Uid:    in.GetUid(),
+		Name:   in.GetName(),
+		Public: in.GetPublic(),
+	})
+	if err != nil {
+		return nil, collectionStatusErr(err)
+	}
+	return &intrv1.CreateCollectionResponse{Id: id}, nil
+}
+
+func (i *InteractiveLocalAdapter) UpdateCollection(ctx context.Context, in *intrv1.UpdateCollectionRequest, opts ...grpc.CallOption) (*intrv1.UpdateCollectionResponse, error) {
+	err := i.svc.UpdateCollection(ctx, domain.Collection{
+		Id:     in.GetId(),
+		Uid:    in.GetUid(),
+		Name:   in.GetName(),
+		Public: in.GetPublic(),
+	})
+	if err != nil {
+		return nil, collectionStatusErr(err)
+	}
+	return &intrv1.UpdateCollectionResponse{}, nil
+}
+
+func (i *InteractiveLocalAdapter) DeleteCollection(ctx context.Context, in *intrv1.DeleteCollectionRequest, opts ...grpc.CallOption) (*intrv1.DeleteCollectionResponse, error) {
+	err := i.svc.DeleteCollection(ctx, in.GetUid(), in.GetId())
+	if err != nil {
+		return nil, collectionStatusErr(err)
+	}
+	return &intrv1.DeleteCollectionResponse{}, nil
+}
+
+func (i *InteractiveLocalAdapter) ListCollections(ctx context.Context, in *intrv1.ListCollectionsRequest, opts ...grpc.CallOption) (*intrv1.ListCollectionsResponse, error) {
+	cols, err := i.svc.ListCollections(ctx, in.GetUid(), in.GetViewer())
+	if err != nil {
+		return nil, err
+	}
+	return &intrv1.ListCollectionsResponse{
+		Collections: slice.Map(cols, func(idx int, src domain.Collection) *intrv1.Collection {
+			return i.collectionToDTO(src)
+		}),
+	}, nil
+}
+
+func (i *InteractiveLocalAdapter) GetCollectionItems(ctx context.Context, in *intrv1.GetCollectionItemsRequest, opts ...grpc.CallOption) (*intrv1.GetCollectionItemsResponse, error) {
+	cur, err := cursorx.Decode(in.GetCursor())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limit := int(in.GetLimit())
+	items, err := i.svc.GetCollectionItems(ctx, in.GetCid(), in.GetViewer(), cur, limit)
+	if err != nil {
+		return nil, collectionStatusErr(err)
+	}
+	return &intrv1.GetCollectionItemsResponse{
+		Items: slice.Map(items, func(idx int, src domain.CollectionItem) *intrv1.CollectionItem {
+			return &intrv1.CollectionItem{
+				Biz:   src.Biz,
+				BizId: src.BizId,
+				Ctime: src.Ctime.UnixMilli(),
+			}
+		}),
+		NextCursor: cursorx.Next(items, limit, func(c domain.CollectionItem) cursorx.Cursor {
+			return cursorx.Cursor{Time: c.Ctime.UnixMilli(), Id: c.Id}
+		}),
+	}, nil
+}
+
+func (i *InteractiveLocalAdapter) RemoveCollectionItem(ctx context.Context, in *intrv1.RemoveCollectionItemRequest, opts ...grpc.CallOption) (*intrv1.RemoveCollectionItemResponse, error) {
+	err := i.svc.RemoveCollectionItem(ctx, in.GetUid(), in.GetCid(),
+		in.GetBiz(), in.GetBizId())
+	return &intrv1.RemoveCollectionItemResponse{}, err
+}
+
+func (i *InteractiveLocalAdapter) MoveCollectionItem(ctx context.Context, in *intrv1.MoveCollectionItemRequest, opts ...grpc.CallOption) (*intrv1.MoveCollectionItemResponse, error) {
+	err := i.svc.MoveCollectionItem(ctx, in.GetUid(), in.GetBiz(), in.GetBizId(),
+		in.GetFromCid(), in.GetToCid())
+	if err != nil {
+		return nil, collectionStatusErr(err)
+	}
+	return &intrv1.MoveCollectionItemResponse{}, nil
+}
+
+func (i *InteractiveLocalAdapter) collectionToDTO(c domain.Collection) *intrv1.Collection {
+	return &intrv1.Collection{
+		Id:      c.Id,
+		Uid:     c.Uid,
+		Name:    c.Name,
+		Public:  c.Public,
+		ItemCnt: c.ItemCnt,
+		Ctime:   c.Ctime.UnixMilli(),
+		Utime:   c.Utime.UnixMilli(),
+	}
+}
+
+// collectionStatusErr 和 gRPC 服务端保持一致，把业务错误转成错误码
+func collectionStatusErr(err error) error {
+	switch {
+	case errors.Is(err, service.ErrCollectionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidCollectionName),
+		errors.Is(err, service.ErrInvalidReaction):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
 	}
 }
@@ -4,7 +4,11 @@ import (
 	intrv1 "basic-go/lmbook/api/proto/gen/intr/v1"
 	"basic-go/lmbook/bff/web/jwt"
 	"basic-go/lmbook/pkg/ginx"
+	"github.com/ecodeclub/ekit/slice"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
 )
 
 var _ handler = (*CollectionHandler)(nil)
@@ -25,17 +29,64 @@ func (h *CollectionHandler) RegisterRoutes(s *gin.Engine) {
 	g := s.Group("/collections")
 	g.POST("/list", ginx.WrapClaimsAndReq(h.List))
 	g.POST("/cancel", ginx.WrapClaimsAndReq(h.Cancel))
+	// 收藏夹
+	fg := g.Group("/folders")
+	fg.POST("/create", ginx.WrapClaimsAndReq(h.CreateFolder))
+	fg.POST("/edit", ginx.WrapClaimsAndReq(h.EditFolder))
+	fg.POST("/delete", ginx.WrapClaimsAndReq(h.DeleteFolder))
+	fg.POST("/list", ginx.WrapClaimsAndReq(h.ListFolders))
+	fg.POST("/items", ginx.WrapClaimsAndReq(h.FolderItems))
+	fg.POST("/remove", ginx.WrapClaimsAndReq(h.RemoveFromFolder))
+	fg.POST("/move", ginx.WrapClaimsAndReq(h.MoveToFolder))
 }
 
 type CollectionListReq struct {
-	Offset int `json:"offset"`
-	Limit  int `json:"limit"`
+	// Cursor 上一页返回的 nextCursor，第一页不用传
+	Cursor string `json:"cursor"`
+	Limit  int    `json:"limit"`
 }
 
 type CollectionCancelReq struct {
 	BizId int64 `json:"bizId"`
 }
 
+type FolderReq struct {
+	Id     int64  `json:"id"`
+	Name   string `json:"name"`
+	Public bool   `json:"public"`
+}
+
+type FolderListReq struct {
+	// Uid 查看谁的收藏夹，不传就是自己的
+	Uid int64 `json:"uid"`
+}
+
+type FolderItemsReq struct {
+	Cid    int64  `json:"cid"`
+	Cursor string `json:"cursor"`
+	Limit  int    `json:"limit"`
+}
+
+type FolderItemReq struct {
+	Cid   int64 `json:"cid"`
+	BizId int64 `json:"bizId"`
+}
+
+type FolderMoveReq struct {
+	BizId int64 `json:"bizId"`
+	From  int64 `json:"from"`
+	To    int64 `json:"to"`
+}
+
+type FolderVo struct {
+	Id      int64  `json:"id"`
+	Uid     int64  `json:"uid"`
+	Name    string `json:"name"`
+	Public  bool   `json:"public"`
+	ItemCnt int64  `json:"itemCnt"`
+	Ctime   string `json:"ctime"`
+}
+
 // List 获取用户收藏列表
 func (h *CollectionHandler) List(ctx *gin.Context,
 	req CollectionListReq, uc jwt.UserClaims) (ginx.Result, error) {
@@ -46,17 +97,21 @@ func (h *CollectionHandler) List(ctx *gin.Context,
 	resp, err := h.intrSvc.GetCollections(ctx, &intrv1.GetCollectionsRequest{
 		Uid:    uc.Id,
 		Biz:    h.biz,
-		Offset: int32(req.Offset),
+		Cursor: req.Cursor,
 		Limit:  int32(req.Limit),
 	})
+	if status.Code(err) == codes.InvalidArgument {
+		return ginx.Result{Code: 4, Msg: "游标不正确"}, nil
+	}
 	if err != nil {
 		return ginx.Result{Code: 5, Msg: "系统错误"}, err
 	}
 
 	return ginx.Result{
 		Data: map[string]any{
-			"items": resp.Items,
-			"total": resp.Total,
+			"items":      resp.Items,
+			"total":      resp.Total,
+			"nextCursor": resp.NextCursor,
 		},
 	}, nil
 }
@@ -74,3 +129,138 @@ func (h *CollectionHandler) Cancel(ctx *gin.Context,
 	}
 	return ginx.Result{Msg: "OK"}, nil
 }
+
+// CreateFolder 创建收藏夹
+func (h *CollectionHandler) CreateFolder(ctx *gin.Context,
+	req FolderReq, uc jwt.UserClaims) (ginx.Result, error) {
+	resp, err := h.intrSvc.CreateCollection(ctx, &intrv1.CreateCollectionRequest{
+		Uid:    uc.Id,
+		Name:   req.Name,
+		Public: req.Public,
+	})
+	if err != nil {
+		return h.folderErrResult(err)
+	}
+	return ginx.Result{Data: resp.Id}, nil
+}
+
+// EditFolder 修改收藏夹名字，或者是否公开
+func (h *CollectionHandler) EditFolder(ctx *gin.Context,
+	req FolderReq, uc jwt.UserClaims) (ginx.Result, error) {
+	_, err := h.intrSvc.UpdateCollection(ctx, &intrv1.UpdateCollectionRequest{
+		Uid:    uc.Id,
+		Id:     req.Id,
+		Name:   req.Name,
+		Public: req.Public,
+	})
+	if err != nil {
+		return h.folderErrResult(err)
+	}
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+// DeleteFolder 删除收藏夹，里面的东西依旧是收藏状态
+func (h *CollectionHandler) DeleteFolder(ctx *gin.Context,
+	req FolderReq, uc jwt.UserClaims) (ginx.Result, error) {
+	_, err := h.intrSvc.DeleteCollection(ctx, &intrv1.DeleteCollectionRequest{
+		Uid: uc.Id,
+		Id:  req.Id,
+	})
+	if err != nil {
+		return h.folderErrResult(err)
+	}
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+// ListFolders 查看收藏夹列表，看别人的只能看到公开的
+func (h *CollectionHandler) ListFolders(ctx *gin.Context,
+	req FolderListReq, uc jwt.UserClaims) (ginx.Result, error) {
+	uid := req.Uid
+	if uid == 0 {
+		uid = uc.Id
+	}
+	resp, err := h.intrSvc.ListCollections(ctx, &intrv1.ListCollectionsRequest{
+		Uid:    uid,
+		Viewer: uc.Id,
+	})
+	if err != nil {
+		return ginx.Result{Code: 5, Msg: "系统错误"}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(resp.Collections, func(idx int, src *intrv1.Collection) FolderVo {
+			return FolderVo{
+				Id:      src.Id,
+				Uid:     src.Uid,
+				Name:    src.Name,
+				Public:  src.Public,
+				ItemCnt: src.ItemCnt,
+				Ctime:   time.UnixMilli(src.Ctime).Format(time.DateTime),
+			}
+		}),
+	}, nil
+}
+
+// FolderItems 查看收藏夹里面的东西
+func (h *CollectionHandler) FolderItems(ctx *gin.Context,
+	req FolderItemsReq, uc jwt.UserClaims) (ginx.Result, error) {
+	if req.Limit <= 0 || req.Limit > 50 {
+		req.Limit = 20
+	}
+	resp, err := h.intrSvc.GetCollectionItems(ctx, &intrv1.GetCollectionItemsRequest{
+		Cid:    req.Cid,
+		Viewer: uc.Id,
+		Cursor: req.Cursor,
+		Limit:  int32(req.Limit),
+	})
+	if err != nil {
+		return h.folderErrResult(err)
+	}
+	return ginx.Result{
+		Data: map[string]any{
+			"items":      resp.Items,
+			"nextCursor": resp.NextCursor,
+		},
+	}, nil
+}
+
+// RemoveFromFolder 从收藏夹里面拿出来，但是不取消收藏
+func (h *CollectionHandler) RemoveFromFolder(ctx *gin.Context,
+	req FolderItemReq, uc jwt.UserClaims) (ginx.Result, error) {
+	_, err := h.intrSvc.RemoveCollectionItem(ctx, &intrv1.RemoveCollectionItemRequest{
+		Uid:   uc.Id,
+		Cid:   req.Cid,
+		Biz:   h.biz,
+		BizId: req.BizId,
+	})
+	if err != nil {
+		return h.folderErrResult(err)
+	}
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+// MoveToFolder 移动到另外一个收藏夹
+func (h *CollectionHandler) MoveToFolder(ctx *gin.Context,
+	req FolderMoveReq, uc jwt.UserClaims) (ginx.Result, error) {
+	_, err := h.intrSvc.MoveCollectionItem(ctx, &intrv1.MoveCollectionItemRequest{
+		Uid:     uc.Id,
+		Biz:     h.biz,
+		BizId:   req.BizId,
+		FromCid: req.From,
+		ToCid:   req.To,
+	})
+	if err != nil {
+		return h.folderErrResult(err)
+	}
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+func (h *CollectionHandler) folderErrResult(err error) (ginx.Result, error) {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return ginx.Result{Code: 4, Msg: "收藏夹不存在"}, nil
+	case codes.InvalidArgument:
+		return ginx.Result{Code: 4, Msg: status.Convert(err).Message()}, nil
+	default:
+		return ginx.Result{Code: 5, Msg: "系统错误"}, err
+	}
+}
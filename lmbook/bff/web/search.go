@@ -0,0 +1,150 @@
+package web
+
+import (
+	searchv1 "basic-go/lmbook/api/proto/gen/search/v1"
+	"basic-go/lmbook/bff/web/jwt"
+	"basic-go/lmbook/pkg/ginx"
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/pkg/ratelimit"
+	"fmt"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var _ handler = (*SearchHandler)(nil)
+
+type SearchHandler struct {
+	svc searchv1.SearchServiceClient
+	// 输入提示是每敲一个字就调用一次的，要按照用户限流
+	suggestLimiter ratelimit.Limiter
+	l              logger.LoggerV1
+}
+
+func NewSearchHandler(svc searchv1.SearchServiceClient,
+	suggestLimiter ratelimit.Limiter,
+	l logger.LoggerV1) *SearchHandler {
+	return &SearchHandler{
+		svc:            svc,
+		suggestLimiter: suggestLimiter,
+		l:              l,
+	}
+}
+
+func (h *SearchHandler) RegisterRoutes(s *gin.Engine) {
+	g := s.Group("/search")
+	g.POST("", ginx.WrapClaimsAndReq(h.Search))
+	g.POST("/suggest", ginx.WrapClaimsAndReq(h.Suggest))
+}
+
+type SearchReq struct {
+	Expression string `json:"expression"`
+	Offset     int    `json:"offset"`
+	Limit      int    `json:"limit"`
+	// relevance、recency、hotness，不传就是按照相关度
+	Sort string `json:"sort"`
+	// 上一页返回的 nextCursor，用户和文章分开翻页
+	UserCursor    string `json:"userCursor"`
+	ArticleCursor string `json:"articleCursor"`
+}
+
+type SuggestReq struct {
+	Prefix string `json:"prefix"`
+	Limit  int    `json:"limit"`
+}
+
+type SearchUserVo struct {
+	Id       int64  `json:"id"`
+	Nickname string `json:"nickname"`
+}
+
+type SearchArticleVo struct {
+	Id    int64  `json:"id"`
+	Title string `json:"title"`
+	// 命中的片段，关键字用 <em></em> 包起来了
+	TitleHighlight   []string `json:"titleHighlight"`
+	ContentHighlight []string `json:"contentHighlight"`
+	Utime            string   `json:"utime"`
+}
+
+var searchSorts = map[string]searchv1.SortBy{
+	"relevance": searchv1.SortBy_SORT_BY_RELEVANCE,
+	"recency":   searchv1.SortBy_SORT_BY_RECENCY,
+	"hotness":   searchv1.SortBy_SORT_BY_HOTNESS,
+}
+
+func (h *SearchHandler) Search(ctx *gin.Context,
+	req SearchReq, uc jwt.UserClaims) (ginx.Result, error) {
+	resp, err := h.svc.Search(ctx, &searchv1.SearchRequest{
+		Expression:    req.Expression,
+		Uid:           uc.Id,
+		Offset:        int32(req.Offset),
+		Limit:         int32(req.Limit),
+		Sort:          searchSorts[req.Sort],
+		UserCursor:    req.UserCursor,
+		ArticleCursor: req.ArticleCursor,
+	})
+	if status.Code(err) == codes.InvalidArgument {
+		return ginx.Result{Code: 4, Msg: status.Convert(err).Message()}, nil
+	}
+	if err != nil {
+		return ginx.Result{Code: 5, Msg: "系统错误"}, err
+	}
+	// 用户的邮箱、手机号是不能给前端的
+	users := slice.Map(resp.User.GetUsers(), func(idx int, src *searchv1.User) SearchUserVo {
+		return SearchUserVo{Id: src.Id, Nickname: src.Nickname}
+	})
+	highlights := resp.Article.GetHighlights()
+	arts := slice.Map(resp.Article.GetArticles(), func(idx int, src *searchv1.Article) SearchArticleVo {
+		vo := SearchArticleVo{
+			Id:    src.Id,
+			Title: src.Title,
+			Utime: time.UnixMilli(src.Utime).Format(time.DateTime),
+		}
+		if idx < len(highlights) {
+			vo.TitleHighlight = highlights[idx].Title
+			vo.ContentHighlight = highlights[idx].Content
+		}
+		return vo
+	})
+	return ginx.Result{
+		Data: map[string]any{
+			"users":             users,
+			"userTotal":         resp.User.GetTotal(),
+			"userNextCursor":    resp.User.GetNextCursor(),
+			"articles":          arts,
+			"articleTotal":      resp.Article.GetTotal(),
+			"articleNextCursor": resp.Article.GetNextCursor(),
+		},
+	}, nil
+}
+
+func (h *SearchHandler) Suggest(ctx *gin.Context,
+	req SuggestReq, uc jwt.UserClaims) (ginx.Result, error) {
+	limited, err := h.suggestLimiter.Limit(ctx, fmt.Sprintf("search:suggest:%d", uc.Id))
+	if err != nil {
+		// 限流器出问题了也不影响输入提示，顶多就是多打一点流量到搜索服务
+		h.l.Error("输入提示判断是否限流失败",
+			logger.Int64("uid", uc.Id), logger.Error(err))
+	}
+	if limited {
+		return ginx.Result{Code: 4, Msg: "请求太频繁"}, nil
+	}
+	resp, err := h.svc.Suggest(ctx, &searchv1.SuggestRequest{
+		Prefix: req.Prefix,
+		Uid:    uc.Id,
+		Limit:  int32(req.Limit),
+	})
+	if err != nil {
+		return ginx.Result{Code: 5, Msg: "系统错误"}, err
+	}
+	return ginx.Result{
+		Data: map[string]any{
+			"articles": resp.Articles,
+			"users":    resp.Users,
+			"tags":     resp.Tags,
+		},
+	}, nil
+}
@@ -0,0 +1,147 @@
+package web
+
+import (
+	searchv1 "basic-go/lmbook/api/proto/gen/search/v1"
+	searchmocks "basic-go/lmbook/api/proto/gen/search/v1/mocks"
+	"basic-go/lmbook/bff/web/jwt"
+	"basic-go/lmbook/pkg/ginx"
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/pkg/ratelimit"
+	limitmocks "basic-go/lmbook/pkg/ratelimit/mocks"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMain(m *testing.M) {
+	// ginx.WrapClaimsAndReq 里面会用到计数器，不初始化会 panic
+	ginx.InitCounter(prometheus.CounterOpts{
+		Namespace: "test",
+		Subsystem: "lmbook_bff",
+		Name:      "http",
+	})
+	os.Exit(m.Run())
+}
+
+func TestSearchHandler_Suggest(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (searchv1.SearchServiceClient, ratelimit.Limiter)
+		reqBody string
+
+		wantRes ginx.Result
+	}{
+		{
+			name: "成功",
+			mock: func(ctrl *gomock.Controller) (searchv1.SearchServiceClient, ratelimit.Limiter) {
+				svc := searchmocks.NewMockSearchServiceClient(ctrl)
+				limiter := limitmocks.NewMockLimiter(ctrl)
+				limiter.EXPECT().Limit(gomock.Any(), "search:suggest:789").Return(false, nil)
+				svc.EXPECT().Suggest(gomock.Any(), &searchv1.SuggestRequest{
+					Prefix: "Go",
+					Uid:    789,
+					Limit:  5,
+				}).Return(&searchv1.SuggestResponse{
+					Tags: []string{"Go"},
+				}, nil)
+				return svc, limiter
+			},
+			reqBody: `{"prefix":"Go","limit":5}`,
+			wantRes: ginx.Result{
+				Data: map[string]any{
+					"articles": nil,
+					"users":    nil,
+					"tags":     []any{"Go"},
+				},
+			},
+		},
+		{
+			name: "被限流",
+			mock: func(ctrl *gomock.Controller) (searchv1.SearchServiceClient, ratelimit.Limiter) {
+				svc := searchmocks.NewMockSearchServiceClient(ctrl)
+				limiter := limitmocks.NewMockLimiter(ctrl)
+				limiter.EXPECT().Limit(gomock.Any(), "search:suggest:789").Return(true, nil)
+				return svc, limiter
+			},
+			reqBody: `{"prefix":"Go"}`,
+			wantRes: ginx.Result{
+				Code: 4,
+				Msg:  "请求太频繁",
+			},
+		},
+		{
+			name: "限流器出错，放行",
+			mock: func(ctrl *gomock.Controller) (searchv1.SearchServiceClient, ratelimit.Limiter) {
+				svc := searchmocks.NewMockSearchServiceClient(ctrl)
+				limiter := limitmocks.NewMockLimiter(ctrl)
+				limiter.EXPECT().Limit(gomock.Any(), "search:suggest:789").
+					Return(false, errors.New("mock 错误"))
+				svc.EXPECT().Suggest(gomock.Any(), gomock.Any()).
+					Return(&searchv1.SuggestResponse{}, nil)
+				return svc, limiter
+			},
+			reqBody: `{"prefix":"Go"}`,
+			wantRes: ginx.Result{
+				Data: map[string]any{
+					"articles": nil,
+					"users":    nil,
+					"tags":     nil,
+				},
+			},
+		},
+		{
+			name: "搜索服务出错",
+			mock: func(ctrl *gomock.Controller) (searchv1.SearchServiceClient, ratelimit.Limiter) {
+				svc := searchmocks.NewMockSearchServiceClient(ctrl)
+				limiter := limitmocks.NewMockLimiter(ctrl)
+				limiter.EXPECT().Limit(gomock.Any(), "search:suggest:789").Return(false, nil)
+				svc.EXPECT().Suggest(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock 错误"))
+				return svc, limiter
+			},
+			reqBody: `{"prefix":"Go"}`,
+			wantRes: ginx.Result{
+				Code: 5,
+				Msg:  "系统错误",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc, limiter := tc.mock(ctrl)
+			hdl := NewSearchHandler(svc, limiter, logger.NewNoOpLogger())
+
+			server := gin.Default()
+			server.Use(func(ctx *gin.Context) {
+				ctx.Set("user", jwt.UserClaims{
+					Id: 789,
+				})
+			})
+			hdl.RegisterRoutes(server)
+			req, err := http.NewRequest(http.MethodPost,
+				"/search/suggest",
+				bytes.NewReader([]byte(tc.reqBody)))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, req)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var res ginx.Result
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
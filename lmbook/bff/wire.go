@@ -19,6 +19,7 @@ func InitApp() *wego.App {
 		web.NewArticleHandler,
 		web.NewUserHandler,
 		web.NewRewardHandler,
+		web.NewSearchHandler,
 		jwt.NewRedisHandler,
 
 		ioc.InitUserClient,
@@ -26,6 +27,8 @@ func InitApp() *wego.App {
 		ioc.InitRewardClient,
 		ioc.InitCodeClient,
 		ioc.InitArticleClient,
+		ioc.InitSearchClient,
+		ioc.InitSuggestLimiter,
 		ioc.InitGinServer,
 		wire.Struct(new(wego.App), "WebServer"),
 	)
@@ -29,7 +29,10 @@ func InitApp() *wego.App {
 	articleHandler := web.NewArticleHandler(articleServiceClient, interactiveServiceClient, rewardServiceClient, loggerV1)
 	rewardHandler := web.NewRewardHandler(rewardServiceClient, articleServiceClient)
 	collectionHandler := web.NewCollectionHandler(interactiveServiceClient)
-	server := ioc.InitGinServer(loggerV1, handler, userHandler, articleHandler, rewardHandler, collectionHandler)
+	searchServiceClient := ioc.InitSearchClient(client)
+	limiter := ioc.InitSuggestLimiter(cmdable)
+	searchHandler := web.NewSearchHandler(searchServiceClient, limiter, loggerV1)
+	server := ioc.InitGinServer(loggerV1, handler, userHandler, articleHandler, rewardHandler, collectionHandler, searchHandler)
 	app := &wego.App{
 		WebServer: server,
 	}
@@ -17,10 +17,56 @@ type Comment struct {
 	//父评论
 	ParentComment *Comment   `json:"parentComment"`
 	Children      []*Comment `json:"children"`
-	CTime         time.Time  `json:"ctime"`
-	UTime         time.Time  `json:"utime"`
+	// LikeCnt 点赞数，来自互动服务 biz = comment 的计数
+	LikeCnt int64 `json:"likeCnt"`
+	// ReplyCnt 所有层级的回复总数，只有一级评论才有
+	ReplyCnt int64 `json:"replyCnt"`
+	// Liked 当前查看的用户是否点赞过
+	Liked bool `json:"liked"`
+	// Pinned 是否被资源的作者置顶
+	Pinned bool `json:"pinned"`
+	// Deleted 已经被删除，只剩下占位，内容是 DeletedContent
+	// 保留下来是为了不让下面的回复失去上下文
+	Deleted bool          `json:"deleted"`
+	Status  CommentStatus `json:"status"`
+	// SensitiveWords 发表的时候命中的敏感词
+	SensitiveWords []string  `json:"sensitiveWords"`
+	CTime          time.Time `json:"ctime"`
+	UTime          time.Time `json:"utime"`
 }
 
+// DeletedContent 删除之后展示的内容
+const DeletedContent = "该评论已删除"
+
+type CommentStatus uint8
+
+const (
+	CommentStatusUnknown CommentStatus = iota
+	// CommentStatusPending 命中了敏感词，等待人工审核
+	CommentStatusPending
+	CommentStatusApproved
+	CommentStatusRejected
+)
+
+func (s CommentStatus) ToUint8() uint8 {
+	return uint8(s)
+}
+
+// Visible 除了作者自己，其他人是否看得到
+func (s CommentStatus) Visible() bool {
+	return s == CommentStatusApproved
+}
+
+// CommentSort 评论列表的排序方式
+type CommentSort uint8
+
+const (
+	// CommentSortLatest 最新的在前面
+	CommentSortLatest CommentSort = iota
+	// CommentSortHot 综合点赞数、回复数和发表时间
+	CommentSortHot
+)
+
 type User struct {
 	ID   int64  `json:"id"`
 	Name string `json:"name"`
@@ -3,9 +3,13 @@ package grpc
 import (
 	commentv1 "basic-go/lmbook/api/proto/gen/comment/v1"
 	"basic-go/lmbook/comment/domain"
+	"basic-go/lmbook/comment/repository"
 	"basic-go/lmbook/comment/service"
 	"context"
+	"errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"math"
 )
@@ -27,7 +31,7 @@ func NewGrpcServer(svc service.CommentService) *CommentServiceServer {
 }
 
 func (c *CommentServiceServer) GetMoreReplies(ctx context.Context, req *commentv1.GetMoreRepliesRequest) (*commentv1.GetMoreRepliesResponse, error) {
-	cs, err := c.svc.GetMoreReplies(ctx, req.Rid, req.MinId, req.Limit)
+	cs, err := c.svc.GetMoreReplies(ctx, req.Rid, req.Uid, req.MaxId, req.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -37,21 +41,58 @@ func (c *CommentServiceServer) GetMoreReplies(ctx context.Context, req *commentv
 }
 
 func (c *CommentServiceServer) GetCommentList(ctx context.Context, request *commentv1.CommentListRequest) (*commentv1.CommentListResponse, error) {
-	minID := request.MinId
+	var (
+		domainComments []domain.Comment
+		err            error
+	)
+	switch request.GetSort() {
+	case commentv1.CommentSort_CommentSortHot:
+		domainComments, err = c.svc.
+			GetHotCommentList(ctx,
+				request.GetBiz(),
+				request.GetBizid(),
+				request.GetUid(),
+				request.GetOffset(),
+				request.GetLimit())
+	default:
+		minID := request.MinId
+		// 第一次查询
+		if minID <= 0 {
+			minID = math.MaxInt64
+		}
+		domainComments, err = c.svc.
+			GetCommentList(ctx,
+				request.GetBiz(),
+				request.GetBizid(),
+				request.GetUid(),
+				minID,
+				request.GetLimit())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &commentv1.CommentListResponse{
+		Comments: c.toDTO(domainComments),
+	}, nil
+}
+
+func (c *CommentServiceServer) GetCommentTree(ctx context.Context, request *commentv1.GetCommentTreeRequest) (*commentv1.GetCommentTreeResponse, error) {
+	minID := request.GetMinId()
 	// 第一次查询
 	if minID <= 0 {
 		minID = math.MaxInt64
 	}
-	domainComments, err := c.svc.
-		GetCommentList(ctx,
-			request.GetBiz(),
-			request.GetBizid(),
-			request.GetMinId(),
-			request.GetLimit())
+	domainComments, err := c.svc.GetCommentTree(ctx,
+		request.GetBiz(),
+		request.GetBizid(),
+		request.GetUid(),
+		minID,
+		request.GetLimit(),
+		int(request.GetReplyLimit()))
 	if err != nil {
 		return nil, err
 	}
-	return &commentv1.CommentListResponse{
+	return &commentv1.GetCommentTreeResponse{
 		Comments: c.toDTO(domainComments),
 	}, nil
 }
@@ -61,22 +102,86 @@ func (c *CommentServiceServer) DeleteComment(ctx context.Context, request *comme
 	return &commentv1.DeleteCommentResponse{}, err
 }
 
+func (c *CommentServiceServer) RestoreComment(ctx context.Context, request *commentv1.RestoreCommentRequest) (*commentv1.RestoreCommentResponse, error) {
+	err := c.svc.RestoreComment(ctx, request.GetId(), request.GetOperator())
+	return &commentv1.RestoreCommentResponse{}, toStatusErr(err)
+}
+
 func (c *CommentServiceServer) CreateComment(ctx context.Context, request *commentv1.CreateCommentRequest) (*commentv1.CreateCommentResponse, error) {
 	err := c.svc.CreateComment(ctx, convertToDomain(request.GetComment()))
-	return &commentv1.CreateCommentResponse{}, err
+	return &commentv1.CreateCommentResponse{}, toStatusErr(err)
+}
+
+func (c *CommentServiceServer) LikeComment(ctx context.Context, request *commentv1.LikeCommentRequest) (*commentv1.LikeCommentResponse, error) {
+	err := c.svc.LikeComment(ctx, request.GetId(), request.GetUid())
+	return &commentv1.LikeCommentResponse{}, err
+}
+
+func (c *CommentServiceServer) CancelLikeComment(ctx context.Context, request *commentv1.CancelLikeCommentRequest) (*commentv1.CancelLikeCommentResponse, error) {
+	err := c.svc.CancelLikeComment(ctx, request.GetId(), request.GetUid())
+	return &commentv1.CancelLikeCommentResponse{}, err
+}
+
+func (c *CommentServiceServer) PinComment(ctx context.Context, request *commentv1.PinCommentRequest) (*commentv1.PinCommentResponse, error) {
+	err := c.svc.PinComment(ctx, request.GetId(), request.GetUid())
+	return &commentv1.PinCommentResponse{}, toStatusErr(err)
+}
+
+func (c *CommentServiceServer) UnpinComment(ctx context.Context, request *commentv1.UnpinCommentRequest) (*commentv1.UnpinCommentResponse, error) {
+	err := c.svc.UnpinComment(ctx, request.GetId(), request.GetUid())
+	return &commentv1.UnpinCommentResponse{}, toStatusErr(err)
+}
+
+func (c *CommentServiceServer) GetPendingComments(ctx context.Context, request *commentv1.GetPendingCommentsRequest) (*commentv1.GetPendingCommentsResponse, error) {
+	cs, err := c.svc.GetPendingComments(ctx, request.GetMaxId(), int(request.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return &commentv1.GetPendingCommentsResponse{
+		Comments: c.toDTO(cs),
+	}, nil
+}
+
+func (c *CommentServiceServer) ReviewComment(ctx context.Context, request *commentv1.ReviewCommentRequest) (*commentv1.ReviewCommentResponse, error) {
+	err := c.svc.ReviewComment(ctx, request.GetIds(),
+		domain.CommentStatus(request.GetStatus()), request.GetReviewer())
+	return &commentv1.ReviewCommentResponse{}, toStatusErr(err)
+}
+
+// toStatusErr 业务错误转成对应的 gRPC 错误码
+func toStatusErr(err error) error {
+	switch {
+	case errors.Is(err, service.ErrNotAuthor), errors.Is(err, service.ErrBlocked),
+		errors.Is(err, service.ErrNotAdmin):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrPinNotSupported), errors.Is(err, service.ErrPinReply),
+		errors.Is(err, service.ErrInvalidReviewStatus), errors.Is(err, service.ErrReplyDeleted):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrCommentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return err
+	}
 }
 
 func (c *CommentServiceServer) toDTO(domainComments []domain.Comment) []*commentv1.Comment {
 	rpcComments := make([]*commentv1.Comment, 0, len(domainComments))
 	for _, domainComment := range domainComments {
 		rpcComment := &commentv1.Comment{
-			Id:      domainComment.Id,
-			Uid:     domainComment.Commentator.ID,
-			Biz:     domainComment.Biz,
-			Bizid:   domainComment.BizID,
-			Content: domainComment.Content,
-			Ctime:   timestamppb.New(domainComment.CTime),
-			Utime:   timestamppb.New(domainComment.UTime),
+			Id:             domainComment.Id,
+			Uid:            domainComment.Commentator.ID,
+			Biz:            domainComment.Biz,
+			Bizid:          domainComment.BizID,
+			Content:        domainComment.Content,
+			LikeCnt:        domainComment.LikeCnt,
+			ReplyCnt:       domainComment.ReplyCnt,
+			Liked:          domainComment.Liked,
+			Pinned:         domainComment.Pinned,
+			Deleted:        domainComment.Deleted,
+			Status:         commentv1.CommentStatus(domainComment.Status),
+			SensitiveWords: domainComment.SensitiveWords,
+			Ctime:          timestamppb.New(domainComment.CTime),
+			Utime:          timestamppb.New(domainComment.UTime),
 		}
 		if domainComment.RootComment != nil {
 			rpcComment.RootComment = &commentv1.Comment{
@@ -88,6 +193,14 @@ func (c *CommentServiceServer) toDTO(domainComments []domain.Comment) []*comment
 				Id: domainComment.ParentComment.Id,
 			}
 		}
+		if len(domainComment.Children) > 0 {
+			// 回复单独转换，这样回复的 RootComment 就不会指回这条评论，避免出现环
+			children := make([]domain.Comment, 0, len(domainComment.Children))
+			for _, child := range domainComment.Children {
+				children = append(children, *child)
+			}
+			rpcComment.Children = c.toDTO(children)
+		}
 		rpcComments = append(rpcComments, rpcComment)
 	}
 	rpcCommentMap := make(map[int64]*commentv1.Comment, len(rpcComments))
@@ -0,0 +1,10 @@
+package startup
+
+import "basic-go/lmbook/comment/service"
+
+// AdminUid 测试用的管理员
+const AdminUid int64 = 1000
+
+func InitAdmins() service.Admins {
+	return service.Admins{AdminUid: {}}
+}
@@ -0,0 +1,7 @@
+package startup
+
+import "basic-go/lmbook/pkg/sensitive"
+
+func InitSensitiveFilter() sensitive.Filter {
+	return sensitive.NewACFilter([]string{"代开发票", "刷单", "赌博"})
+}
@@ -3,6 +3,9 @@
 package startup
 
 import (
+	articlev1 "basic-go/lmbook/api/proto/gen/article/v1"
+	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
+	intrv1 "basic-go/lmbook/api/proto/gen/intr/v1"
 	grpc2 "basic-go/lmbook/comment/grpc"
 	"basic-go/lmbook/comment/repository"
 	"basic-go/lmbook/comment/repository/dao"
@@ -21,9 +24,13 @@ var serviceProviderSet = wire.NewSet(
 var thirdProvider = wire.NewSet(
 	logger.NewNoOpLogger,
 	InitTestDB,
+	InitSensitiveFilter,
+	InitAdmins,
 )
 
-func InitGRPCServer() *grpc2.CommentServiceServer {
+func InitGRPCServer(intrSvc intrv1.InteractiveServiceClient,
+	artSvc articlev1.ArticleServiceClient,
+	followSvc followv1.FollowServiceClient) *grpc2.CommentServiceServer {
 	wire.Build(thirdProvider, serviceProviderSet)
 	return new(grpc2.CommentServiceServer)
 }
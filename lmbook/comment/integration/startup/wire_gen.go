@@ -7,6 +7,9 @@
 package startup
 
 import (
+	"basic-go/lmbook/api/proto/gen/article/v1"
+	"basic-go/lmbook/api/proto/gen/follow/v1"
+	"basic-go/lmbook/api/proto/gen/intr/v1"
 	"basic-go/lmbook/comment/grpc"
 	"basic-go/lmbook/comment/repository"
 	"basic-go/lmbook/comment/repository/dao"
@@ -17,12 +20,14 @@ import (
 
 // Injectors from wire.go:
 
-func InitGRPCServer() *grpc.CommentServiceServer {
+func InitGRPCServer(intrSvc intrv1.InteractiveServiceClient, artSvc articlev1.ArticleServiceClient, followSvc followv1.FollowServiceClient) *grpc.CommentServiceServer {
 	gormDB := InitTestDB()
 	commentDAO := dao.NewCommentDAO(gormDB)
 	loggerV1 := logger.NewNoOpLogger()
 	commentRepository := repository.NewCommentRepo(commentDAO, loggerV1)
-	commentService := service.NewCommentSvc(commentRepository)
+	filter := InitSensitiveFilter()
+	admins := InitAdmins()
+	commentService := service.NewCommentSvc(commentRepository, intrSvc, artSvc, followSvc, filter, admins, loggerV1)
 	commentServiceServer := grpc.NewGrpcServer(commentService)
 	return commentServiceServer
 }
@@ -31,4 +36,4 @@ func InitGRPCServer() *grpc.CommentServiceServer {
 
 var serviceProviderSet = wire.NewSet(dao.NewCommentDAO, repository.NewCommentRepo, service.NewCommentSvc, grpc.NewGrpcServer)
 
-var thirdProvider = wire.NewSet(logger.NewNoOpLogger, InitTestDB)
+var thirdProvider = wire.NewSet(logger.NewNoOpLogger, InitTestDB, InitSensitiveFilter, InitAdmins)
@@ -0,0 +1,23 @@
+package ioc
+
+import (
+	"basic-go/lmbook/comment/service"
+	"github.com/spf13/viper"
+)
+
+// InitAdmins 管理员是配置死的，没有配置的话谁都不能审核评论
+func InitAdmins() service.Admins {
+	type Config struct {
+		Uids []int64 `yaml:"uids"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("admin", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	res := make(service.Admins, len(cfg.Uids))
+	for _, uid := range cfg.Uids {
+		res[uid] = struct{}{}
+	}
+	return res
+}
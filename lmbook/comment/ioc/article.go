@@ -0,0 +1,25 @@
+package ioc
+
+import (
+	articlev1 "basic-go/lmbook/api/proto/gen/article/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func InitArticleRpcClient() articlev1.ArticleServiceClient {
+	type config struct {
+		Addr string `yaml:"addr"`
+	}
+	var cfg config
+	err := viper.UnmarshalKey("grpc.client.article", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	conn, err := grpc.Dial(cfg.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+	client := articlev1.NewArticleServiceClient(conn)
+	return client
+}
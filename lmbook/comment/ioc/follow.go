@@ -0,0 +1,25 @@
+package ioc
+
+import (
+	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func InitFollowRpcClient() followv1.FollowServiceClient {
+	type config struct {
+		Addr string `yaml:"addr"`
+	}
+	var cfg config
+	err := viper.UnmarshalKey("grpc.client.follow", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	conn, err := grpc.Dial(cfg.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+	client := followv1.NewFollowServiceClient(conn)
+	return client
+}
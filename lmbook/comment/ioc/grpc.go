@@ -3,23 +3,32 @@ package ioc
 import (
 	grpc2 "basic-go/lmbook/comment/grpc"
 	"basic-go/lmbook/pkg/grpcx"
+	"basic-go/lmbook/pkg/logger"
 	"github.com/spf13/viper"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc"
 )
 
-func InitGRPCxServer(comment *grpc2.CommentServiceServer) *grpcx.Server {
+func InitGRPCxServer(comment *grpc2.CommentServiceServer,
+	ecli *clientv3.Client,
+	l logger.LoggerV1) *grpcx.Server {
 	type Config struct {
-		Addr string `yaml:"addr"`
+		Port    int   `yaml:"port"`
+		EtcdTTL int64 `yaml:"etcdTTL"`
 	}
 	var cfg Config
-	err := viper.UnmarshalKey("grpc", &cfg)
+	err := viper.UnmarshalKey("grpc.server", &cfg)
 	if err != nil {
 		panic(err)
 	}
 	server := grpc.NewServer()
 	comment.Register(server)
 	return &grpcx.Server{
-		Server: server,
-		Addr:   cfg.Addr,
+		Server:     server,
+		Port:       cfg.Port,
+		Name:       "comment",
+		L:          l,
+		EtcdTTL:    cfg.EtcdTTL,
+		EtcdClient: ecli,
 	}
 }
@@ -0,0 +1,47 @@
+package ioc
+
+import (
+	"basic-go/lmbook/comment/job"
+	"basic-go/lmbook/comment/service"
+	"basic-go/lmbook/pkg/cronjobx"
+	"basic-go/lmbook/pkg/logger"
+	"github.com/robfig/cron/v3"
+	"github.com/spf13/viper"
+	"time"
+)
+
+func InitPurgeDeletedJob(svc service.CommentService, l logger.LoggerV1) *job.PurgeDeletedJob {
+	type Config struct {
+		// Retention 软删除的评论保留多久，在这之前都可以恢复
+		Retention time.Duration `yaml:"retention"`
+	}
+	cfg := Config{
+		Retention: time.Hour * 24 * 30,
+	}
+	err := viper.UnmarshalKey("job.purgeDeleted", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return job.NewPurgeDeletedJob(svc, cfg.Retention, l)
+}
+
+func InitJobs(l logger.LoggerV1, purgeJob *job.PurgeDeletedJob) *cron.Cron {
+	type Config struct {
+		// 秒级的 cron 表达式
+		Expression string `yaml:"expression"`
+	}
+	cfg := Config{
+		Expression: "0 0 3 * * ?",
+	}
+	err := viper.UnmarshalKey("job.purgeDeleted", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	res := cron.New(cron.WithSeconds())
+	cbd := cronjobx.NewCronJobBuilder(l)
+	_, err = res.AddJob(cfg.Expression, cbd.Build(purgeJob))
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
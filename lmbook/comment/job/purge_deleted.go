@@ -0,0 +1,58 @@
+package job
+
+import (
+	"basic-go/lmbook/comment/service"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"time"
+)
+
+// PurgeDeletedJob 彻底清理超过保留期限的软删除评论。
+// 清理是幂等的，多个实例同时运行也没关系
+type PurgeDeletedJob struct {
+	svc service.CommentService
+	l   logger.LoggerV1
+	// retention 软删除之后保留多久，在这之前都可以恢复
+	retention time.Duration
+	batchSize int
+	// maxBatch 一次运行最多处理多少批，剩下的等下一次
+	maxBatch int
+	timeout  time.Duration
+}
+
+func NewPurgeDeletedJob(svc service.CommentService,
+	retention time.Duration,
+	l logger.LoggerV1) *PurgeDeletedJob {
+	return &PurgeDeletedJob{
+		svc:       svc,
+		l:         l,
+		retention: retention,
+		batchSize: 100,
+		maxBatch:  100,
+		timeout:   time.Second * 10,
+	}
+}
+
+func (p *PurgeDeletedJob) Name() string {
+	return "comment_purge_deleted"
+}
+
+func (p *PurgeDeletedJob) Run() error {
+	before := time.Now().Add(-p.retention)
+	var total int
+	for i := 0; i < p.maxBatch; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+		cnt, err := p.svc.PurgeDeleted(ctx, before, p.batchSize)
+		cancel()
+		if err != nil {
+			return err
+		}
+		total += cnt
+		if cnt < p.batchSize {
+			break
+		}
+	}
+	p.l.Info("清理删除的评论",
+		logger.Int64("cnt", int64(total)))
+	return nil
+}
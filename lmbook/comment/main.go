@@ -2,6 +2,7 @@ package main
 
 import (
 	"basic-go/lmbook/pkg/grpcx"
+	"github.com/robfig/cron/v3"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
@@ -9,6 +10,11 @@ import (
 func main() {
 	initViperV2Watch()
 	app := Init()
+	app.cron.Start()
+	defer func() {
+		// 等待正在运行的定时任务结束
+		<-app.cron.Stop().Done()
+	}()
 	err := app.server.Serve()
 	if err != nil {
 		panic(err)
@@ -30,4 +36,5 @@ func initViperV2Watch() {
 
 type App struct {
 	server *grpcx.Server
+	cron   *cron.Cron
 }
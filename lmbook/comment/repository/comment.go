@@ -6,31 +6,50 @@ import (
 	"basic-go/lmbook/pkg/logger"
 	"context"
 	"database/sql"
-	"golang.org/x/sync/errgroup"
 	"time"
 )
 
+//go:generate mockgen -source=./comment.go -package=repomocks -destination=mocks/comment.mock.go CommentRepository
 type CommentRepository interface {
-	// FindByBiz 根据 ID 倒序查找
-	// 并且会返回每个评论的三条直接回复
+	// FindByBiz 根据 ID 倒序查找一级评论，回复要用 FindChildren 来查
+	// 没有审核通过的评论，只有 uid 自己发表的才会返回，下面的几个方法也一样
 	FindByBiz(ctx context.Context, biz string,
-		bizId, minID, limit int64) ([]domain.Comment, error)
-	// DeleteComment 删除评论，删除本评论何其子评论
+		bizId, uid, minID, limit int64) ([]domain.Comment, error)
+	// DeleteComment 软删除评论，子评论不受影响
 	DeleteComment(ctx context.Context, comment domain.Comment) error
+	// RestoreComment 恢复软删除的评论
+	RestoreComment(ctx context.Context, id int64) error
+	// PurgeDeleted 彻底删除 before 之前软删除，并且已经没有回复的评论
+	PurgeDeleted(ctx context.Context, before time.Time, limit int) (int, error)
 	// CreateComment 创建评论
 	CreateComment(ctx context.Context, comment domain.Comment) error
 	// GetCommentByIds 获取单条评论 支持批量获取
 	GetCommentByIds(ctx context.Context, id []int64) ([]domain.Comment, error)
-	GetMoreReplies(ctx context.Context, rid int64, id int64, limit int64) ([]domain.Comment, error)
+	GetMoreReplies(ctx context.Context, rid, uid int64, id int64, limit int64) ([]domain.Comment, error)
+	// FindHotCandidates 参与热度排序的一级评论，带上回复数，但是不带子评论
+	FindHotCandidates(ctx context.Context, biz string, bizId, uid int64, limit int) ([]domain.Comment, error)
+	// FindChildren 给每个一级评论带上最早的 limit 条回复，不管回复的层级
+	// 所有评论的回复一次查出来
+	FindChildren(ctx context.Context, uid int64, cms []domain.Comment, limit int) ([]domain.Comment, error)
+	// FindPinned 资源的置顶评论，没有的时候返回 ErrCommentNotFound
+	FindPinned(ctx context.Context, biz string, bizId int64) (domain.Comment, error)
+	Pin(ctx context.Context, id int64) error
+	Unpin(ctx context.Context, id int64) error
+	// FindPending 待审核的评论，按照 ID 升序
+	FindPending(ctx context.Context, maxID int64, limit int) ([]domain.Comment, error)
+	// Review 审核评论，已经审核过的不会被修改
+	Review(ctx context.Context, ids []int64, status domain.CommentStatus) error
 }
 
+var ErrCommentNotFound = dao.ErrDataNotFound
+
 type CachedCommentRepo struct {
 	dao dao.CommentDAO
 	l   logger.LoggerV1
 }
 
-func (c *CachedCommentRepo) GetMoreReplies(ctx context.Context, rid int64, maxID int64, limit int64) ([]domain.Comment, error) {
-	cs, err := c.dao.FindRepliesByRid(ctx, rid, maxID, limit)
+func (c *CachedCommentRepo) GetMoreReplies(ctx context.Context, rid, uid int64, maxID int64, limit int64) ([]domain.Comment, error) {
+	cs, err := c.dao.FindRepliesByRid(ctx, rid, uid, maxID, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -42,35 +61,89 @@ func (c *CachedCommentRepo) GetMoreReplies(ctx context.Context, rid int64, maxID
 }
 
 func (c *CachedCommentRepo) FindByBiz(ctx context.Context, biz string,
-	bizId, minID, limit int64) ([]domain.Comment, error) {
-	daoComments, err := c.dao.FindByBiz(ctx, biz, bizId, minID, limit)
+	bizId, uid, minID, limit int64) ([]domain.Comment, error) {
+	daoComments, err := c.dao.FindByBiz(ctx, biz, bizId, uid, minID, limit)
 	if err != nil {
 		return nil, err
 	}
 	res := make([]domain.Comment, 0, len(daoComments))
-	// 这时候要去找子评论了，找三条
-	var eg errgroup.Group
-	downgrade := ctx.Value("downgrade") == "true"
 	for _, dc := range daoComments {
-		dc := dc
-		cm := c.toDomain(dc)
-		res = append(res, cm)
-		if downgrade {
-			continue
-		}
-		eg.Go(func() error {
-			subComments, err := c.dao.FindRepliesByPid(ctx, dc.Id, 0, 3)
-			if err != nil {
-				return err
-			}
-			cm.Children = make([]domain.Comment, 0, len(subComments))
-			for _, sc := range subComments {
-				cm.Children = append(cm.Children, c.toDomain(sc))
-			}
-			return nil
-		})
+		res = append(res, c.toDomain(dc))
+	}
+	return res, nil
+}
+
+func (c *CachedCommentRepo) FindHotCandidates(ctx context.Context,
+	biz string, bizId, uid int64, limit int) ([]domain.Comment, error) {
+	daoComments, err := c.dao.FindRecentByBiz(ctx, biz, bizId, uid, limit)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.Comment, 0, len(daoComments))
+	for _, dc := range daoComments {
+		res = append(res, c.toDomain(dc))
+	}
+	return res, nil
+}
+
+func (c *CachedCommentRepo) FindChildren(ctx context.Context,
+	uid int64, cms []domain.Comment, limit int) ([]domain.Comment, error) {
+	downgrade := ctx.Value("downgrade") == "true"
+	if downgrade || len(cms) == 0 || limit <= 0 {
+		return cms, nil
+	}
+	rids := make([]int64, 0, len(cms))
+	for _, cm := range cms {
+		rids = append(rids, cm.Id)
+	}
+	replies, err := c.dao.FindRepliesByRids(ctx, rids, uid, limit)
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[int64][]*domain.Comment, len(cms))
+	for _, r := range replies {
+		child := c.toDomain(r)
+		children[r.RootID.Int64] = append(children[r.RootID.Int64], &child)
+	}
+	for i := range cms {
+		cms[i].Children = children[cms[i].Id]
+	}
+	return cms, nil
+}
+
+func (c *CachedCommentRepo) FindPinned(ctx context.Context,
+	biz string, bizId int64) (domain.Comment, error) {
+	dc, err := c.dao.FindPinned(ctx, biz, bizId)
+	if err != nil {
+		return domain.Comment{}, err
 	}
-	return res, eg.Wait()
+	return c.toDomain(dc), nil
+}
+
+func (c *CachedCommentRepo) Pin(ctx context.Context, id int64) error {
+	return c.dao.Pin(ctx, id)
+}
+
+func (c *CachedCommentRepo) Unpin(ctx context.Context, id int64) error {
+	return c.dao.Unpin(ctx, id)
+}
+
+func (c *CachedCommentRepo) FindPending(ctx context.Context,
+	maxID int64, limit int) ([]domain.Comment, error) {
+	cs, err := c.dao.FindPending(ctx, maxID, limit)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.Comment, 0, len(cs))
+	for _, cm := range cs {
+		res = append(res, c.toDomain(cm))
+	}
+	return res, nil
+}
+
+func (c *CachedCommentRepo) Review(ctx context.Context,
+	ids []int64, status domain.CommentStatus) error {
+	return c.dao.Review(ctx, ids, status.ToUint8())
 }
 
 func (c *CachedCommentRepo) DeleteComment(ctx context.Context, comment domain.Comment) error {
@@ -79,6 +152,15 @@ func (c *CachedCommentRepo) DeleteComment(ctx context.Context, comment domain.Co
 	})
 }
 
+func (c *CachedCommentRepo) RestoreComment(ctx context.Context, id int64) error {
+	return c.dao.Restore(ctx, id)
+}
+
+func (c *CachedCommentRepo) PurgeDeleted(ctx context.Context,
+	before time.Time, limit int) (int, error) {
+	return c.dao.PurgeDeleted(ctx, before.UnixMilli(), limit)
+}
+
 func (c *CachedCommentRepo) CreateComment(ctx context.Context, comment domain.Comment) error {
 	return c.dao.Insert(ctx, c.toEntity(comment))
 }
@@ -102,11 +184,22 @@ func (c *CachedCommentRepo) toDomain(daoComment dao.Comment) domain.Comment {
 		Commentator: domain.User{
 			ID: daoComment.Uid,
 		},
-		Biz:     daoComment.Biz,
-		BizID:   daoComment.BizID,
-		Content: daoComment.Content,
-		CTime:   time.UnixMilli(daoComment.Ctime),
-		UTime:   time.UnixMilli(daoComment.Utime),
+		Biz:      daoComment.Biz,
+		BizID:    daoComment.BizID,
+		Content:  daoComment.Content,
+		Pinned:   daoComment.Pinned,
+		ReplyCnt: daoComment.ReplyCnt,
+		Status:   domain.CommentStatus(daoComment.Status),
+		CTime:    time.UnixMilli(daoComment.Ctime),
+		UTime:    time.UnixMilli(daoComment.Utime),
+
+		SensitiveWords: daoComment.SensitiveWords,
+	}
+	if daoComment.DeleteTime > 0 {
+		// 删除之后只保留占位，原本的内容只留在数据库里面，用来恢复
+		val.Deleted = true
+		val.Content = domain.DeletedContent
+		val.SensitiveWords = nil
 	}
 	if daoComment.PID.Valid {
 		val.ParentComment = &domain.Comment{
@@ -128,6 +221,9 @@ func (c *CachedCommentRepo) toEntity(domainComment domain.Comment) dao.Comment {
 		Biz:     domainComment.Biz,
 		BizID:   domainComment.BizID,
 		Content: domainComment.Content,
+		Status:  domainComment.Status.ToUint8(),
+
+		SensitiveWords: domainComment.SensitiveWords,
 	}
 	if domainComment.RootComment != nil {
 		daoComment.RootID = sql.NullInt64{
@@ -3,7 +3,12 @@ package dao
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sort"
+	"strings"
+	"time"
 )
 
 // ErrDataNotFound 通用的数据没找到
@@ -13,17 +18,42 @@ var ErrDataNotFound = gorm.ErrRecordNotFound
 type CommentDAO interface {
 	Insert(ctx context.Context, u Comment) error
 	// FindByBiz 只查找一级评论
+	// 除了审核通过的，还会带上 uid 自己发表的
 	FindByBiz(ctx context.Context, biz string,
-		bizId, minID, limit int64) ([]Comment, error)
+		bizId, uid, minID, limit int64) ([]Comment, error)
 	// FindCommentList Comment的id为0 获取一级评论，如果不为0获取对应的评论，和其评论的所有回复
 	FindCommentList(ctx context.Context, u Comment) ([]Comment, error)
-	FindRepliesByPid(ctx context.Context, pid int64, offset, limit int) ([]Comment, error)
-	// Delete 删除本节点和其对应的子节点
+	FindRepliesByPid(ctx context.Context, pid, uid int64, offset, limit int) ([]Comment, error)
+	// Delete 软删除，只记录删除时间，子评论不受影响
 	Delete(ctx context.Context, u Comment) error
+	// Restore 恢复软删除的评论，已经被彻底清理的返回 ErrDataNotFound
+	Restore(ctx context.Context, id int64) error
+	// PurgeDeleted 彻底删除 before 之前软删除的评论，返回删除的数量
+	// 还有回复的不会删除，等回复都被清理掉之后再删
+	PurgeDeleted(ctx context.Context, before int64, limit int) (int, error)
 	FindOneByIDs(ctx context.Context, id []int64) ([]Comment, error)
-	FindRepliesByRid(ctx context.Context, rid int64, id int64, limit int64) ([]Comment, error)
+	FindRepliesByRid(ctx context.Context, rid, uid int64, id int64, limit int64) ([]Comment, error)
+	// FindRecentByBiz 最新的 limit 条一级评论，热度排序从这里面挑
+	FindRecentByBiz(ctx context.Context, biz string, bizId, uid int64, limit int) ([]Comment, error)
+	// FindRepliesByRids 每个根评论下面最早的 limit 条回复，不管层级
+	FindRepliesByRids(ctx context.Context, rids []int64, uid int64, limit int) ([]Comment, error)
+	// FindPinned 资源的置顶评论，没有的时候返回 ErrDataNotFound
+	FindPinned(ctx context.Context, biz string, bizId int64) (Comment, error)
+	// Pin 置顶 id 这条审核通过的一级评论，原本置顶的会被取消
+	Pin(ctx context.Context, id int64) error
+	Unpin(ctx context.Context, id int64) error
+	// FindPending 待审核的评论，按照 ID 升序
+	FindPending(ctx context.Context, maxID int64, limit int) ([]Comment, error)
+	// Review 把 ids 里面待审核的评论改成 status
+	Review(ctx context.Context, ids []int64, status uint8) error
 }
 
+// 和 domain.CommentStatus 保持一致
+const (
+	commentStatusPending  uint8 = 1
+	commentStatusApproved uint8 = 2
+)
+
 type TreeBase struct {
 	PID int64
 }
@@ -52,6 +82,22 @@ type Comment struct {
 
 	ParentComment *Comment `gorm:"ForeignKey:PID;AssociationForeignKey:ID;constraint:OnDelete:CASCADE"`
 
+	// 是否置顶，同一个资源只有一条一级评论是置顶的
+	Pinned bool
+
+	// 审核状态，加这个字段之前的评论都当作审核通过
+	Status uint8 `gorm:"index;default:2"`
+	// 命中的敏感词
+	SensitiveWords []string `gorm:"type:varchar(1024);serializer:json"`
+
+	// 根评论下面审核通过的回复总数，不管层级，只有根评论才维护这个字段
+	// 新增、清理、审核回复的时候在同一个事务里面更新
+	// 软删除的回复还会占位展示，所以也计算在内
+	ReplyCnt int64
+
+	// 软删除的时间，0 表示没有删除
+	DeleteTime int64 `gorm:"index"`
+
 	Ctime int64
 	// 事实上，大部分平台是不允许修改评论的
 	Utime int64
@@ -66,10 +112,11 @@ type GORMCommentDAO struct {
 }
 
 func (c *GORMCommentDAO) FindRepliesByRid(ctx context.Context,
-	rid int64, id int64, limit int64) ([]Comment, error) {
+	rid, uid int64, id int64, limit int64) ([]Comment, error) {
 	var res []Comment
 	err := c.db.WithContext(ctx).
 		Where("root_id = ? AND id > ?", rid, id).
+		Where(visible(uid)).
 		Order("id ASC").
 		Limit(int(limit)).Find(&res).Error
 	return res, err
@@ -85,16 +132,20 @@ func (c *GORMCommentDAO) FindOneByIDs(ctx context.Context, ids []int64) ([]Comme
 	var res []Comment
 	err := c.db.WithContext(ctx).
 		Where("id in ?", ids).
-		First(&res).
+		Find(&res).
 		Error
 	return res, err
 }
 
 func (c *GORMCommentDAO) FindByBiz(ctx context.Context, biz string,
-	bizId, minID, limit int64) ([]Comment, error) {
+	bizId, uid, minID, limit int64) ([]Comment, error) {
 	var res []Comment
 	err := c.db.WithContext(ctx).
 		Where("biz = ? AND biz_id = ? AND id < ? AND pid IS NULL", biz, bizId, minID).
+		Where(visible(uid)).
+		// 删除了但是还有回复的要保留占位
+		Where("delete_time = 0 OR reply_cnt > 0").
+		Order("id DESC").
 		Limit(int(limit)).
 		Find(&res).Error
 	return res, err
@@ -102,21 +153,33 @@ func (c *GORMCommentDAO) FindByBiz(ctx context.Context, biz string,
 
 // FindRepliesByPid 查找评论的直接评论
 func (c *GORMCommentDAO) FindRepliesByPid(ctx context.Context,
-	pid int64,
+	pid, uid int64,
 	offset,
 	limit int) ([]Comment, error) {
 	var res []Comment
 	err := c.db.WithContext(ctx).Where("pid = ?", pid).
+		Where(visible(uid)).
 		Order("id DESC").
 		Offset(offset).Limit(limit).Find(&res).Error
 	return res, err
 }
 
 func (c *GORMCommentDAO) Insert(ctx context.Context, u Comment) error {
-	return c.db.
-		WithContext(ctx).
-		Create(&u).
-		Error
+	if !u.RootID.Valid || u.Status != commentStatusApproved {
+		return c.db.
+			WithContext(ctx).
+			Create(&u).
+			Error
+	}
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&u).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&Comment{}).
+			Where("id = ?", u.RootID.Int64).
+			Update("reply_cnt", gorm.Expr("reply_cnt + 1")).Error
+	})
 }
 
 func (c *GORMCommentDAO) FindCommentList(ctx context.Context, u Comment) ([]Comment, error) {
@@ -136,7 +199,228 @@ func (c *GORMCommentDAO) FindCommentList(ctx context.Context, u Comment) ([]Comm
 }
 
 func (c *GORMCommentDAO) Delete(ctx context.Context, u Comment) error {
-	return c.db.WithContext(ctx).Delete(&Comment{
-		Id: u.Id,
-	}).Error
+	now := time.Now().UnixMilli()
+	// 删除的同时取消置顶
+	return c.db.WithContext(ctx).Model(&Comment{}).
+		Where("id = ? AND delete_time = 0", u.Id).
+		Updates(map[string]any{
+			"delete_time": now,
+			"pinned":      false,
+			"utime":       now,
+		}).Error
+}
+
+func (c *GORMCommentDAO) Restore(ctx context.Context, id int64) error {
+	res := c.db.WithContext(ctx).Model(&Comment{}).
+		Where("id = ? AND delete_time > 0", id).
+		Updates(map[string]any{
+			"delete_time": 0,
+			"utime":       time.Now().UnixMilli(),
+		})
+	if res.Error != nil || res.RowsAffected > 0 {
+		return res.Error
+	}
+	// 没有删除过的，恢复也算成功
+	var cm Comment
+	return c.db.WithContext(ctx).Select("id").
+		Where("id = ?", id).First(&cm).Error
+}
+
+func (c *GORMCommentDAO) PurgeDeleted(ctx context.Context, before int64, limit int) (int, error) {
+	var cnt int
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 只找没有回复的，有回复的删掉会把回复也级联删除了
+		var leaves []struct {
+			Id     int64
+			RootID sql.NullInt64
+		}
+		err := tx.Table("comments AS c").
+			Select("c.id, c.root_id").
+			Joins("LEFT JOIN comments AS r ON r.pid = c.id").
+			Where("c.delete_time > 0 AND c.delete_time < ? AND r.id IS NULL", before).
+			Limit(limit).
+			Scan(&leaves).Error
+		if err != nil || len(leaves) == 0 {
+			return err
+		}
+		ids := make([]int64, 0, len(leaves))
+		rids := make([]int64, 0, len(leaves))
+		for _, leaf := range leaves {
+			ids = append(ids, leaf.Id)
+			if leaf.RootID.Valid {
+				rids = append(rids, leaf.RootID.Int64)
+			}
+		}
+		// 查询之后可能被恢复了，所以还要再判断一下删除时间
+		res := tx.Where("id IN ? AND delete_time > 0 AND delete_time < ?", ids, before).
+			Delete(&Comment{})
+		if res.Error != nil {
+			return res.Error
+		}
+		cnt = int(res.RowsAffected)
+		if len(rids) == 0 {
+			return nil
+		}
+		return recountReplies(tx, rids)
+	})
+	return cnt, err
+}
+
+// recountReplies 重新计算根评论的回复数
+// MySQL 不允许 UPDATE 的子查询里面查同一张表，所以先查出来再逐个更新
+func recountReplies(tx *gorm.DB, rids []int64) error {
+	type replyCnt struct {
+		RootID int64
+		Cnt    int64
+	}
+	var cnts []replyCnt
+	err := tx.Model(&Comment{}).
+		Select("root_id, COUNT(*) AS cnt").
+		Where("root_id IN ? AND status = ?", rids, commentStatusApproved).
+		Group("root_id").
+		Scan(&cnts).Error
+	if err != nil {
+		return err
+	}
+	cntMap := make(map[int64]int64, len(cnts))
+	for _, cnt := range cnts {
+		cntMap[cnt.RootID] = cnt.Cnt
+	}
+	for _, rid := range rids {
+		err = tx.Model(&Comment{}).
+			Where("id = ?", rid).
+			Update("reply_cnt", cntMap[rid]).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *GORMCommentDAO) FindRecentByBiz(ctx context.Context,
+	biz string, bizId, uid int64, limit int) ([]Comment, error) {
+	var res []Comment
+	err := c.db.WithContext(ctx).
+		Where("biz = ? AND biz_id = ? AND pid IS NULL AND delete_time = 0", biz, bizId).
+		Where(visible(uid)).
+		Order("id DESC").
+		Limit(limit).Find(&res).Error
+	return res, err
+}
+
+// FindRepliesByRids 一条 SQL 查出每个根评论下面最早的 limit 条回复
+// 没有用 ROW_NUMBER，那个要 MySQL 8 才支持。每个根评论一个子查询，用 UNION ALL 拼起来，
+// 子查询都能走 root_id 的索引，rids 就是一页的一级评论，不会很多
+func (c *GORMCommentDAO) FindRepliesByRids(ctx context.Context,
+	rids []int64, uid int64, limit int) ([]Comment, error) {
+	if len(rids) == 0 {
+		return nil, nil
+	}
+	subs := make([]string, 0, len(rids))
+	args := make([]any, 0, len(rids))
+	for i, rid := range rids {
+		subs = append(subs, fmt.Sprintf("SELECT * FROM (?) AS t%d", i))
+		args = append(args, c.db.Model(&Comment{}).
+			Where("root_id = ?", rid).
+			Where(visible(uid)).
+			Order("id ASC").
+			Limit(limit))
+	}
+	var res []Comment
+	err := c.db.WithContext(ctx).
+		Raw(strings.Join(subs, " UNION ALL "), args...).
+		Scan(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Id < res[j].Id
+	})
+	return res, nil
+}
+
+func (c *GORMCommentDAO) FindPinned(ctx context.Context,
+	biz string, bizId int64) (Comment, error) {
+	var res Comment
+	err := c.db.WithContext(ctx).
+		Where("biz = ? AND biz_id = ? AND pinned = ? AND status = ? AND delete_time = 0",
+			biz, bizId, true, commentStatusApproved).
+		First(&res).Error
+	return res, err
+}
+
+func (c *GORMCommentDAO) Pin(ctx context.Context, id int64) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var cm Comment
+		err := tx.Where("id = ? AND pid IS NULL AND status = ? AND delete_time = 0",
+			id, commentStatusApproved).
+			First(&cm).Error
+		if err != nil {
+			return err
+		}
+		now := time.Now().UnixMilli()
+		err = tx.Model(&Comment{}).
+			Where("biz = ? AND biz_id = ? AND pinned = ?", cm.Biz, cm.BizID, true).
+			Updates(map[string]any{
+				"pinned": false,
+				"utime":  now,
+			}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&Comment{}).
+			Where("id = ?", id).
+			Updates(map[string]any{
+				"pinned": true,
+				"utime":  now,
+			}).Error
+	})
+}
+
+func (c *GORMCommentDAO) Unpin(ctx context.Context, id int64) error {
+	return c.db.WithContext(ctx).Model(&Comment{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"pinned": false,
+			"utime":  time.Now().UnixMilli(),
+		}).Error
+}
+
+func (c *GORMCommentDAO) FindPending(ctx context.Context,
+	maxID int64, limit int) ([]Comment, error) {
+	var res []Comment
+	err := c.db.WithContext(ctx).
+		Where("status = ? AND id > ? AND delete_time = 0", commentStatusPending, maxID).
+		Order("id ASC").
+		Limit(limit).Find(&res).Error
+	return res, err
+}
+
+func (c *GORMCommentDAO) Review(ctx context.Context, ids []int64, status uint8) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var rids []int64
+		err := tx.Model(&Comment{}).
+			Distinct("root_id").
+			Where("id IN ? AND status = ? AND root_id IS NOT NULL", ids, commentStatusPending).
+			Pluck("root_id", &rids).Error
+		if err != nil {
+			return err
+		}
+		// 只改待审核的，已经审核过的不会被覆盖
+		err = tx.Model(&Comment{}).
+			Where("id IN ? AND status = ?", ids, commentStatusPending).
+			Updates(map[string]any{
+				"status": status,
+				"utime":  time.Now().UnixMilli(),
+			}).Error
+		if err != nil || len(rids) == 0 || status != commentStatusApproved {
+			return err
+		}
+		return recountReplies(tx, rids)
+	})
+}
+
+// visible 审核通过的，或者是 uid 自己发表的
+func visible(uid int64) clause.Expr {
+	return gorm.Expr("status = ? OR uid = ?", commentStatusApproved, uid)
 }
@@ -0,0 +1,163 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// initTestDB 内存里面的 SQLite，只用来验证 SQL 的逻辑
+func initTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// 每个连接都是一个新的内存数据库
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&Comment{}))
+	return db
+}
+
+func reply(id, rid, pid int64, status uint8) Comment {
+	return Comment{
+		Id:     id,
+		RootID: sql.NullInt64{Int64: rid, Valid: true},
+		PID:    sql.NullInt64{Int64: pid, Valid: true},
+		Status: status,
+	}
+}
+
+func TestBackfillReplyCnt(t *testing.T) {
+	db := initTestDB(t)
+	// 模拟加字段之前的数据，回复数都是 0
+	cms := []Comment{
+		{Id: 1, Status: commentStatusApproved},
+		{Id: 2, Status: commentStatusApproved},
+		{Id: 3, Status: commentStatusApproved},
+		reply(11, 1, 1, commentStatusApproved),
+		reply(12, 1, 11, commentStatusApproved),
+		// 待审核的不算
+		reply(13, 1, 1, commentStatusPending),
+		reply(21, 2, 2, commentStatusApproved),
+	}
+	require.NoError(t, db.Create(&cms).Error)
+
+	require.NoError(t, BackfillReplyCnt(db))
+	var roots []Comment
+	require.NoError(t, db.Where("root_id IS NULL").Order("id ASC").Find(&roots).Error)
+	cnts := make(map[int64]int64, len(roots))
+	for _, root := range roots {
+		cnts[root.Id] = root.ReplyCnt
+	}
+	assert.Equal(t, map[int64]int64{1: 2, 2: 1, 3: 0}, cnts)
+}
+
+func TestGORMCommentDAO_FindRepliesByRids(t *testing.T) {
+	db := initTestDB(t)
+	cms := []Comment{
+		{Id: 1, Status: commentStatusApproved},
+		{Id: 2, Status: commentStatusApproved},
+		reply(11, 1, 1, commentStatusApproved),
+		// 别人待审核的看不到，自己的可以看到
+		{Id: 12, Uid: 100, RootID: sql.NullInt64{Int64: 1, Valid: true},
+			PID: sql.NullInt64{Int64: 1, Valid: true}, Status: commentStatusPending},
+		reply(13, 1, 11, commentStatusPending),
+		reply(14, 1, 11, commentStatusApproved),
+		reply(15, 1, 1, commentStatusApproved),
+		reply(21, 2, 2, commentStatusApproved),
+	}
+	require.NoError(t, db.Create(&cms).Error)
+	d := NewCommentDAO(db)
+
+	res, err := d.FindRepliesByRids(context.Background(), []int64{1, 2}, 100, 3)
+	require.NoError(t, err)
+	ids := make([]int64, 0, len(res))
+	for _, cm := range res {
+		ids = append(ids, cm.Id)
+	}
+	// 每个根评论最早的三条，按照 ID 排序
+	assert.Equal(t, []int64{11, 12, 14, 21}, ids)
+}
+
+func TestGORMCommentDAO_PurgeDeleted(t *testing.T) {
+	db := initTestDB(t)
+	deleted := func(cm Comment, deleteTime int64) Comment {
+		cm.DeleteTime = deleteTime
+		return cm
+	}
+	cms := []Comment{
+		{Id: 1, Status: commentStatusApproved, ReplyCnt: 4},
+		// 还有回复的不能删
+		deleted(reply(11, 1, 1, commentStatusApproved), 100),
+		reply(12, 1, 11, commentStatusApproved),
+		// 没有回复的删掉，根评论的回复数要重新计算
+		deleted(reply(13, 1, 1, commentStatusApproved), 100),
+		// 还没有过保留期限
+		deleted(reply(14, 1, 1, commentStatusApproved), 300),
+		// 一级评论没有回复的也删掉
+		deleted(Comment{Id: 2, Status: commentStatusApproved}, 100),
+	}
+	require.NoError(t, db.Create(&cms).Error)
+	d := NewCommentDAO(db)
+
+	cnt, err := d.PurgeDeleted(context.Background(), 200, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, cnt)
+	var ids []int64
+	require.NoError(t, db.Model(&Comment{}).Order("id ASC").Pluck("id", &ids).Error)
+	assert.Equal(t, []int64{1, 11, 12, 14}, ids)
+	var root Comment
+	require.NoError(t, db.Where("id = ?", 1).First(&root).Error)
+	assert.Equal(t, int64(3), root.ReplyCnt)
+
+	// 没有可以删的了
+	cnt, err = d.PurgeDeleted(context.Background(), 200, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, cnt)
+}
+
+func TestGORMCommentDAO_Restore(t *testing.T) {
+	db := initTestDB(t)
+	cms := []Comment{
+		{Id: 1, Status: commentStatusApproved, DeleteTime: 100},
+		{Id: 2, Status: commentStatusApproved},
+	}
+	require.NoError(t, db.Create(&cms).Error)
+	d := NewCommentDAO(db)
+	testCases := []struct {
+		name    string
+		id      int64
+		wantErr error
+	}{
+		{
+			name: "恢复软删除的评论",
+			id:   1,
+		},
+		{
+			name: "没有删除过的也算成功",
+			id:   2,
+		},
+		{
+			name:    "已经被清理掉了",
+			id:      3,
+			wantErr: ErrDataNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := d.Restore(context.Background(), tc.id)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			var cm Comment
+			require.NoError(t, db.Where("id = ?", tc.id).First(&cm).Error)
+			assert.Equal(t, int64(0), cm.DeleteTime)
+		})
+	}
+}
@@ -2,6 +2,46 @@ package dao
 
 import "gorm.io/gorm"
 
+// backfillBatch 回填 reply_cnt 的时候每次处理多少个根评论
+const backfillBatch = 500
+
 func InitTables(db *gorm.DB) error {
-	return db.AutoMigrate(&Comment{})
+	// 加 reply_cnt 字段之前的根评论，回复数都是 0，建字段的时候顺便回填
+	backfill := !db.Migrator().HasColumn(&Comment{}, "ReplyCnt")
+	err := db.AutoMigrate(&Comment{})
+	if err != nil || !backfill {
+		return err
+	}
+	return BackfillReplyCnt(db)
+}
+
+// BackfillReplyCnt 按照 root_id 分批重新计算所有根评论的回复数。
+// 计算的规则和 recountReplies 一样，所以重复执行也没有问题，回填中途失败了可以再手动调用一次
+func BackfillReplyCnt(db *gorm.DB) error {
+	var maxRid int64
+	for {
+		var rids []int64
+		err := db.Model(&Comment{}).
+			Distinct("root_id").
+			Where("root_id > ?", maxRid).
+			Order("root_id ASC").
+			Limit(backfillBatch).
+			Pluck("root_id", &rids).Error
+		if err != nil {
+			return err
+		}
+		if len(rids) == 0 {
+			return nil
+		}
+		err = db.Transaction(func(tx *gorm.DB) error {
+			return recountReplies(tx, rids)
+		})
+		if err != nil {
+			return err
+		}
+		if len(rids) < backfillBatch {
+			return nil
+		}
+		maxRid = rids[len(rids)-1]
+	}
 }
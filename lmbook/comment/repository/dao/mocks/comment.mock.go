@@ -54,18 +54,18 @@ func (mr *MockCommentDAOMockRecorder) Delete(ctx, u any) *gomock.Call {
 }
 
 // FindByBiz mocks base method.
-func (m *MockCommentDAO) FindByBiz(ctx context.Context, biz string, bizId, minID, limit int64) ([]dao.Comment, error) {
+func (m *MockCommentDAO) FindByBiz(ctx context.Context, biz string, bizId, uid, minID, limit int64) ([]dao.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByBiz", ctx, biz, bizId, minID, limit)
+	ret := m.ctrl.Call(m, "FindByBiz", ctx, biz, bizId, uid, minID, limit)
 	ret0, _ := ret[0].([]dao.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByBiz indicates an expected call of FindByBiz.
-func (mr *MockCommentDAOMockRecorder) FindByBiz(ctx, biz, bizId, minID, limit any) *gomock.Call {
+func (mr *MockCommentDAOMockRecorder) FindByBiz(ctx, biz, bizId, uid, minID, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBiz", reflect.TypeOf((*MockCommentDAO)(nil).FindByBiz), ctx, biz, bizId, minID, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBiz", reflect.TypeOf((*MockCommentDAO)(nil).FindByBiz), ctx, biz, bizId, uid, minID, limit)
 }
 
 // FindCommentList mocks base method.
@@ -98,34 +98,94 @@ func (mr *MockCommentDAOMockRecorder) FindOneByIDs(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByIDs", reflect.TypeOf((*MockCommentDAO)(nil).FindOneByIDs), ctx, id)
 }
 
+// FindPending mocks base method.
+func (m *MockCommentDAO) FindPending(ctx context.Context, maxID int64, limit int) ([]dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", ctx, maxID, limit)
+	ret0, _ := ret[0].([]dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockCommentDAOMockRecorder) FindPending(ctx, maxID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockCommentDAO)(nil).FindPending), ctx, maxID, limit)
+}
+
+// FindPinned mocks base method.
+func (m *MockCommentDAO) FindPinned(ctx context.Context, biz string, bizId int64) (dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPinned", ctx, biz, bizId)
+	ret0, _ := ret[0].(dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPinned indicates an expected call of FindPinned.
+func (mr *MockCommentDAOMockRecorder) FindPinned(ctx, biz, bizId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPinned", reflect.TypeOf((*MockCommentDAO)(nil).FindPinned), ctx, biz, bizId)
+}
+
+// FindRecentByBiz mocks base method.
+func (m *MockCommentDAO) FindRecentByBiz(ctx context.Context, biz string, bizId, uid int64, limit int) ([]dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRecentByBiz", ctx, biz, bizId, uid, limit)
+	ret0, _ := ret[0].([]dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRecentByBiz indicates an expected call of FindRecentByBiz.
+func (mr *MockCommentDAOMockRecorder) FindRecentByBiz(ctx, biz, bizId, uid, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecentByBiz", reflect.TypeOf((*MockCommentDAO)(nil).FindRecentByBiz), ctx, biz, bizId, uid, limit)
+}
+
 // FindRepliesByPid mocks base method.
-func (m *MockCommentDAO) FindRepliesByPid(ctx context.Context, pid int64, offset, limit int) ([]dao.Comment, error) {
+func (m *MockCommentDAO) FindRepliesByPid(ctx context.Context, pid, uid int64, offset, limit int) ([]dao.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindRepliesByPid", ctx, pid, offset, limit)
+	ret := m.ctrl.Call(m, "FindRepliesByPid", ctx, pid, uid, offset, limit)
 	ret0, _ := ret[0].([]dao.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindRepliesByPid indicates an expected call of FindRepliesByPid.
-func (mr *MockCommentDAOMockRecorder) FindRepliesByPid(ctx, pid, offset, limit any) *gomock.Call {
+func (mr *MockCommentDAOMockRecorder) FindRepliesByPid(ctx, pid, uid, offset, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRepliesByPid", reflect.TypeOf((*MockCommentDAO)(nil).FindRepliesByPid), ctx, pid, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRepliesByPid", reflect.TypeOf((*MockCommentDAO)(nil).FindRepliesByPid), ctx, pid, uid, offset, limit)
 }
 
 // FindRepliesByRid mocks base method.
-func (m *MockCommentDAO) FindRepliesByRid(ctx context.Context, rid, id, limit int64) ([]dao.Comment, error) {
+func (m *MockCommentDAO) FindRepliesByRid(ctx context.Context, rid, uid, id, limit int64) ([]dao.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindRepliesByRid", ctx, rid, id, limit)
+	ret := m.ctrl.Call(m, "FindRepliesByRid", ctx, rid, uid, id, limit)
 	ret0, _ := ret[0].([]dao.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindRepliesByRid indicates an expected call of FindRepliesByRid.
-func (mr *MockCommentDAOMockRecorder) FindRepliesByRid(ctx, rid, id, limit any) *gomock.Call {
+func (mr *MockCommentDAOMockRecorder) FindRepliesByRid(ctx, rid, uid, id, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRepliesByRid", reflect.TypeOf((*MockCommentDAO)(nil).FindRepliesByRid), ctx, rid, id, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRepliesByRid", reflect.TypeOf((*MockCommentDAO)(nil).FindRepliesByRid), ctx, rid, uid, id, limit)
+}
+
+// FindRepliesByRids mocks base method.
+func (m *MockCommentDAO) FindRepliesByRids(ctx context.Context, rids []int64, uid int64, limit int) ([]dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRepliesByRids", ctx, rids, uid, limit)
+	ret0, _ := ret[0].([]dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRepliesByRids indicates an expected call of FindRepliesByRids.
+func (mr *MockCommentDAOMockRecorder) FindRepliesByRids(ctx, rids, uid, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRepliesByRids", reflect.TypeOf((*MockCommentDAO)(nil).FindRepliesByRids), ctx, rids, uid, limit)
 }
 
 // Insert mocks base method.
@@ -141,3 +201,74 @@ func (mr *MockCommentDAOMockRecorder) Insert(ctx, u any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCommentDAO)(nil).Insert), ctx, u)
 }
+
+// Pin mocks base method.
+func (m *MockCommentDAO) Pin(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pin", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pin indicates an expected call of Pin.
+func (mr *MockCommentDAOMockRecorder) Pin(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pin", reflect.TypeOf((*MockCommentDAO)(nil).Pin), ctx, id)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockCommentDAO) PurgeDeleted(ctx context.Context, before int64, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx, before, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockCommentDAOMockRecorder) PurgeDeleted(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockCommentDAO)(nil).PurgeDeleted), ctx, before, limit)
+}
+
+// Restore mocks base method.
+func (m *MockCommentDAO) Restore(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockCommentDAOMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockCommentDAO)(nil).Restore), ctx, id)
+}
+
+// Review mocks base method.
+func (m *MockCommentDAO) Review(ctx context.Context, ids []int64, status uint8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Review", ctx, ids, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Review indicates an expected call of Review.
+func (mr *MockCommentDAOMockRecorder) Review(ctx, ids, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Review", reflect.TypeOf((*MockCommentDAO)(nil).Review), ctx, ids, status)
+}
+
+// Unpin mocks base method.
+func (m *MockCommentDAO) Unpin(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unpin", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unpin indicates an expected call of Unpin.
+func (mr *MockCommentDAOMockRecorder) Unpin(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpin", reflect.TypeOf((*MockCommentDAO)(nil).Unpin), ctx, id)
+}
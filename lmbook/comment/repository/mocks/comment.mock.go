@@ -0,0 +1,245 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./comment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./comment.go -package=repomocks -destination=mocks/comment.mock.go CommentRepository
+//
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "basic-go/lmbook/comment/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCommentRepository is a mock of CommentRepository interface.
+type MockCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentRepositoryMockRecorder
+}
+
+// MockCommentRepositoryMockRecorder is the mock recorder for MockCommentRepository.
+type MockCommentRepositoryMockRecorder struct {
+	mock *MockCommentRepository
+}
+
+// NewMockCommentRepository creates a new mock instance.
+func NewMockCommentRepository(ctrl *gomock.Controller) *MockCommentRepository {
+	mock := &MockCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentRepository) EXPECT() *MockCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateComment mocks base method.
+func (m *MockCommentRepository) CreateComment(ctx context.Context, comment domain.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockCommentRepositoryMockRecorder) CreateComment(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockCommentRepository)(nil).CreateComment), ctx, comment)
+}
+
+// DeleteComment mocks base method.
+func (m *MockCommentRepository) DeleteComment(ctx context.Context, comment domain.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockCommentRepositoryMockRecorder) DeleteComment(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommentRepository)(nil).DeleteComment), ctx, comment)
+}
+
+// FindByBiz mocks base method.
+func (m *MockCommentRepository) FindByBiz(ctx context.Context, biz string, bizId, uid, minID, limit int64) ([]domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByBiz", ctx, biz, bizId, uid, minID, limit)
+	ret0, _ := ret[0].([]domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByBiz indicates an expected call of FindByBiz.
+func (mr *MockCommentRepositoryMockRecorder) FindByBiz(ctx, biz, bizId, uid, minID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBiz", reflect.TypeOf((*MockCommentRepository)(nil).FindByBiz), ctx, biz, bizId, uid, minID, limit)
+}
+
+// FindChildren mocks base method.
+func (m *MockCommentRepository) FindChildren(ctx context.Context, uid int64, cms []domain.Comment, limit int) ([]domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChildren", ctx, uid, cms, limit)
+	ret0, _ := ret[0].([]domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChildren indicates an expected call of FindChildren.
+func (mr *MockCommentRepositoryMockRecorder) FindChildren(ctx, uid, cms, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChildren", reflect.TypeOf((*MockCommentRepository)(nil).FindChildren), ctx, uid, cms, limit)
+}
+
+// FindHotCandidates mocks base method.
+func (m *MockCommentRepository) FindHotCandidates(ctx context.Context, biz string, bizId, uid int64, limit int) ([]domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHotCandidates", ctx, biz, bizId, uid, limit)
+	ret0, _ := ret[0].([]domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHotCandidates indicates an expected call of FindHotCandidates.
+func (mr *MockCommentRepositoryMockRecorder) FindHotCandidates(ctx, biz, bizId, uid, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHotCandidates", reflect.TypeOf((*MockCommentRepository)(nil).FindHotCandidates), ctx, biz, bizId, uid, limit)
+}
+
+// FindPending mocks base method.
+func (m *MockCommentRepository) FindPending(ctx context.Context, maxID int64, limit int) ([]domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", ctx, maxID, limit)
+	ret0, _ := ret[0].([]domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockCommentRepositoryMockRecorder) FindPending(ctx, maxID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockCommentRepository)(nil).FindPending), ctx, maxID, limit)
+}
+
+// FindPinned mocks base method.
+func (m *MockCommentRepository) FindPinned(ctx context.Context, biz string, bizId int64) (domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPinned", ctx, biz, bizId)
+	ret0, _ := ret[0].(domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPinned indicates an expected call of FindPinned.
+func (mr *MockCommentRepositoryMockRecorder) FindPinned(ctx, biz, bizId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPinned", reflect.TypeOf((*MockCommentRepository)(nil).FindPinned), ctx, biz, bizId)
+}
+
+// GetCommentByIds mocks base method.
+func (m *MockCommentRepository) GetCommentByIds(ctx context.Context, id []int64) ([]domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentByIds", ctx, id)
+	ret0, _ := ret[0].([]domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentByIds indicates an expected call of GetCommentByIds.
+func (mr *MockCommentRepositoryMockRecorder) GetCommentByIds(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByIds", reflect.TypeOf((*MockCommentRepository)(nil).GetCommentByIds), ctx, id)
+}
+
+// GetMoreReplies mocks base method.
+func (m *MockCommentRepository) GetMoreReplies(ctx context.Context, rid, uid, id, limit int64) ([]domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMoreReplies", ctx, rid, uid, id, limit)
+	ret0, _ := ret[0].([]domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMoreReplies indicates an expected call of GetMoreReplies.
+func (mr *MockCommentRepositoryMockRecorder) GetMoreReplies(ctx, rid, uid, id, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMoreReplies", reflect.TypeOf((*MockCommentRepository)(nil).GetMoreReplies), ctx, rid, uid, id, limit)
+}
+
+// Pin mocks base method.
+func (m *MockCommentRepository) Pin(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pin", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pin indicates an expected call of Pin.
+func (mr *MockCommentRepositoryMockRecorder) Pin(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pin", reflect.TypeOf((*MockCommentRepository)(nil).Pin), ctx, id)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockCommentRepository) PurgeDeleted(ctx context.Context, before time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx, before, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockCommentRepositoryMockRecorder) PurgeDeleted(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockCommentRepository)(nil).PurgeDeleted), ctx, before, limit)
+}
+
+// RestoreComment mocks base method.
+func (m *MockCommentRepository) RestoreComment(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreComment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreComment indicates an expected call of RestoreComment.
+func (mr *MockCommentRepositoryMockRecorder) RestoreComment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreComment", reflect.TypeOf((*MockCommentRepository)(nil).RestoreComment), ctx, id)
+}
+
+// Review mocks base method.
+func (m *MockCommentRepository) Review(ctx context.Context, ids []int64, status domain.CommentStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Review", ctx, ids, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Review indicates an expected call of Review.
+func (mr *MockCommentRepositoryMockRecorder) Review(ctx, ids, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Review", reflect.TypeOf((*MockCommentRepository)(nil).Review), ctx, ids, status)
+}
+
+// Unpin mocks base method.
+func (m *MockCommentRepository) Unpin(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unpin", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unpin indicates an expected call of Unpin.
+func (mr *MockCommentRepositoryMockRecorder) Unpin(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpin", reflect.TypeOf((*MockCommentRepository)(nil).Unpin), ctx, id)
+}
@@ -1,45 +1,345 @@
 package service
 
 import (
+	articlev1 "basic-go/lmbook/api/proto/gen/article/v1"
+	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
+	intrv1 "basic-go/lmbook/api/proto/gen/intr/v1"
 	"basic-go/lmbook/comment/domain"
 	"basic-go/lmbook/comment/repository"
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/pkg/sensitive"
 	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
 )
 
+// intrBiz 评论在互动服务里面的业务标识
+const intrBiz = "comment"
+
+const (
+	// hotCandidates 热度排序最多从最新的这么多条一级评论里面挑
+	hotCandidates = 500
+	// defaultReplyLimit 评论列表里面每条一级评论带几条回复
+	defaultReplyLimit = 3
+	// maxReplyLimit 评论树里面每条一级评论最多带几条回复
+	maxReplyLimit = 10
+)
+
+var (
+	ErrPinNotSupported = errors.New("不支持置顶")
+	ErrNotAuthor       = errors.New("只有作者可以置顶")
+	ErrPinReply        = errors.New("只能置顶一级评论")
+	ErrReplyDeleted    = errors.New("不能回复已经删除的评论")
+	// ErrBlocked 被作者或者被回复的人拉黑了
+	ErrBlocked = errors.New("对方已将你拉黑")
+	// ErrInvalidReviewStatus 审核只能通过或者拒绝
+	ErrInvalidReviewStatus = errors.New("非法的审核状态")
+	// ErrNotAdmin 审核、恢复评论只有管理员可以操作
+	ErrNotAdmin = errors.New("只有管理员可以操作")
+)
+
+// Admins 管理员的 uid，只有管理员可以审核和恢复评论
+type Admins map[int64]struct{}
+
+func (a Admins) contains(uid int64) bool {
+	_, ok := a[uid]
+	return ok
+}
+
 type CommentService interface {
 	// GetCommentList Comment的id为0 获取一级评论
-	// 按照 ID 倒序排序
-	GetCommentList(ctx context.Context, biz string, bizId, minID, limit int64) ([]domain.Comment, error)
-	// DeleteComment 删除评论，删除本评论何其子评论
+	// 按照 ID 倒序排序，第一页会把置顶评论放在最前面
+	GetCommentList(ctx context.Context, biz string, bizId, uid, minID, limit int64) ([]domain.Comment, error)
+	// GetHotCommentList 按照热度排序，置顶评论始终在第一页最前面
+	GetHotCommentList(ctx context.Context, biz string, bizId, uid, offset, limit int64) ([]domain.Comment, error)
+	// GetCommentTree 和 GetCommentList 一样，但是每条一级评论带上最早的 replyLimit 条回复
+	// 回复不分层级，通过 ParentComment 知道回复的是谁
+	GetCommentTree(ctx context.Context, biz string, bizId, uid, minID, limit int64, replyLimit int) ([]domain.Comment, error)
+	// DeleteComment 软删除评论，内容替换成占位，回复仍然可见
 	DeleteComment(ctx context.Context, id int64) error
-	// CreateComment 创建评论
+	// RestoreComment 管理员恢复软删除的评论，超过保留期限被清理掉的就没办法恢复了
+	// operator 必须是管理员
+	RestoreComment(ctx context.Context, id, operator int64) error
+	// PurgeDeleted 彻底删除 before 之前软删除的评论，返回删除的数量
+	PurgeDeleted(ctx context.Context, before time.Time, limit int) (int, error)
+	// CreateComment 创建评论，命中敏感词的评论需要等待人工审核
 	CreateComment(ctx context.Context, comment domain.Comment) error
-	GetMoreReplies(ctx context.Context, rid int64, maxID int64, limit int64) ([]domain.Comment, error)
+	GetMoreReplies(ctx context.Context, rid, uid int64, maxID int64, limit int64) ([]domain.Comment, error)
+	LikeComment(ctx context.Context, id, uid int64) error
+	CancelLikeComment(ctx context.Context, id, uid int64) error
+	// PinComment 置顶，uid 必须是资源的作者
+	PinComment(ctx context.Context, id, uid int64) error
+	UnpinComment(ctx context.Context, id, uid int64) error
+	// GetPendingComments 审核队列，maxID 是上一批次最大的 ID
+	GetPendingComments(ctx context.Context, maxID int64, limit int) ([]domain.Comment, error)
+	// ReviewComment reviewer 必须是管理员
+	ReviewComment(ctx context.Context, ids []int64, status domain.CommentStatus, reviewer int64) error
 }
 
 type commentService struct {
-	repo repository.CommentRepository
+	repo      repository.CommentRepository
+	intrSvc   intrv1.InteractiveServiceClient
+	artSvc    articlev1.ArticleServiceClient
+	followSvc followv1.FollowServiceClient
+	filter    sensitive.Filter
+	admins    Admins
+	l         logger.LoggerV1
 }
 
 func (c *commentService) GetMoreReplies(ctx context.Context,
-	rid int64,
+	rid, uid int64,
 	maxID int64, limit int64) ([]domain.Comment, error) {
-	return c.repo.GetMoreReplies(ctx, rid, maxID, limit)
+	return c.repo.GetMoreReplies(ctx, rid, uid, maxID, limit)
 }
 
-func NewCommentSvc(repo repository.CommentRepository) CommentService {
+func NewCommentSvc(repo repository.CommentRepository,
+	intrSvc intrv1.InteractiveServiceClient,
+	artSvc articlev1.ArticleServiceClient,
+	followSvc followv1.FollowServiceClient,
+	filter sensitive.Filter,
+	admins Admins,
+	l logger.LoggerV1) CommentService {
 	return &commentService{
-		repo: repo,
+		repo:      repo,
+		intrSvc:   intrSvc,
+		artSvc:    artSvc,
+		followSvc: followSvc,
+		filter:    filter,
+		admins:    admins,
+		l:         l,
 	}
 }
 
 func (c *commentService) GetCommentList(ctx context.Context, biz string,
-	bizId, minID, limit int64) ([]domain.Comment, error) {
-	list, err := c.repo.FindByBiz(ctx, biz, bizId, minID, limit)
+	bizId, uid, minID, limit int64) ([]domain.Comment, error) {
+	return c.GetCommentTree(ctx, biz, bizId, uid, minID, limit, defaultReplyLimit)
+}
+
+func (c *commentService) GetCommentTree(ctx context.Context, biz string,
+	bizId, uid, minID, limit int64, replyLimit int) ([]domain.Comment, error) {
+	list, err := c.repo.FindByBiz(ctx, biz, bizId, uid, minID, limit)
+	if err != nil {
+		return nil, err
+	}
+	// 置顶评论只在第一页出现
+	list = c.withPinned(ctx, biz, bizId, minID == math.MaxInt64, list)
+	list, err = c.repo.FindChildren(ctx, uid, list, min(replyLimit, maxReplyLimit))
 	if err != nil {
 		return nil, err
 	}
-	return list, err
+	c.withIntr(ctx, uid, flatten(list))
+	return list, nil
+}
+
+func (c *commentService) GetHotCommentList(ctx context.Context, biz string,
+	bizId, uid, offset, limit int64) ([]domain.Comment, error) {
+	candidates, err := c.repo.FindHotCandidates(ctx, biz, bizId, uid, hotCandidates)
+	if err != nil {
+		return nil, err
+	}
+	// 要先知道点赞数才能排序，这里只需要批量查计数
+	c.withIntr(ctx, 0, flatten(candidates))
+	now := time.Now()
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return hotScore(candidates[i], now) > hotScore(candidates[j], now)
+	})
+	list := make([]domain.Comment, 0, len(candidates))
+	for _, cm := range candidates {
+		if cm.Pinned {
+			continue
+		}
+		list = append(list, cm)
+	}
+	if offset >= int64(len(list)) {
+		list = list[:0]
+	} else {
+		list = list[offset:min(offset+limit, int64(len(list)))]
+	}
+	list = c.withPinned(ctx, biz, bizId, offset == 0, list)
+	list, err = c.repo.FindChildren(ctx, uid, list, defaultReplyLimit)
+	if err != nil {
+		return nil, err
+	}
+	// 用户是否点赞过只查这一页的
+	c.withIntr(ctx, uid, flatten(list))
+	return list, nil
+}
+
+// flatten 一级评论和它们的回复，方便统一查点赞数
+func flatten(list []domain.Comment) []*domain.Comment {
+	res := make([]*domain.Comment, 0, len(list))
+	for i := range list {
+		res = append(res, &list[i])
+		res = append(res, list[i].Children...)
+	}
+	return res
+}
+
+// withPinned 去掉列表里面的置顶评论，需要的话把它放到最前面
+func (c *commentService) withPinned(ctx context.Context, biz string, bizId int64,
+	first bool, list []domain.Comment) []domain.Comment {
+	res := make([]domain.Comment, 0, len(list)+1)
+	if first {
+		pinned, err := c.repo.FindPinned(ctx, biz, bizId)
+		switch {
+		case err == nil:
+			res = append(res, pinned)
+		case errors.Is(err, repository.ErrCommentNotFound):
+		default:
+			// 置顶评论查不到，不影响正常的评论列表
+			c.l.Error("查询置顶评论失败",
+				logger.String("biz", biz),
+				logger.Int64("bizId", bizId),
+				logger.Error(err))
+		}
+	}
+	for _, cm := range list {
+		if !cm.Pinned {
+			res = append(res, cm)
+		}
+	}
+	return res
+}
+
+// withIntr 从互动服务查点赞数，uid 大于 0 的时候顺便查用户有没有点赞过。
+// 查询失败就当作没有人点赞
+func (c *commentService) withIntr(ctx context.Context, uid int64, list []*domain.Comment) {
+	if len(list) == 0 {
+		return
+	}
+	ids := make([]int64, 0, len(list))
+	for _, cm := range list {
+		ids = append(ids, cm.Id)
+	}
+	resp, err := c.intrSvc.GetByIds(ctx, &intrv1.GetByIdsRequest{
+		Biz: intrBiz, Ids: ids, Uid: uid,
+	})
+	if err != nil {
+		c.l.Error("查询评论点赞数失败", logger.Error(err))
+		return
+	}
+	intrs := resp.GetIntrs()
+	for _, cm := range list {
+		cm.LikeCnt = intrs[cm.Id].GetLikeCnt()
+		cm.Liked = intrs[cm.Id].GetLiked()
+	}
+}
+
+// hotScore 和文章热榜的思路一样，点赞、回复越多越靠前，越久越靠后
+// 回复比点赞更能说明评论引起了讨论，所以权重更高
+func hotScore(cm domain.Comment, now time.Time) float64 {
+	const likeWeight, replyWeight, factor = 1.0, 2.0, 1.5
+	hours := now.Sub(cm.CTime).Hours()
+	return (float64(cm.LikeCnt)*likeWeight + float64(cm.ReplyCnt)*replyWeight + 1) /
+		math.Pow(hours+2, factor)
+}
+
+func (c *commentService) LikeComment(ctx context.Context, id, uid int64) error {
+	cm, err := c.findById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !cm.Status.Visible() || cm.Deleted {
+		return repository.ErrCommentNotFound
+	}
+	_, err = c.intrSvc.Like(ctx, &intrv1.LikeRequest{
+		Biz: intrBiz, BizId: id, Uid: uid,
+	})
+	return err
+}
+
+func (c *commentService) CancelLikeComment(ctx context.Context, id, uid int64) error {
+	_, err := c.intrSvc.CancelLike(ctx, &intrv1.CancelLikeRequest{
+		Biz: intrBiz, BizId: id, Uid: uid,
+	})
+	return err
+}
+
+func (c *commentService) PinComment(ctx context.Context, id, uid int64) error {
+	err := c.checkAuthor(ctx, id, uid)
+	if err != nil {
+		return err
+	}
+	return c.repo.Pin(ctx, id)
+}
+
+func (c *commentService) UnpinComment(ctx context.Context, id, uid int64) error {
+	err := c.checkAuthor(ctx, id, uid)
+	if err != nil {
+		return err
+	}
+	return c.repo.Unpin(ctx, id)
+}
+
+// checkAuthor 目前只有文章的作者可以置顶评论
+func (c *commentService) checkAuthor(ctx context.Context, id, uid int64) error {
+	cm, err := c.findById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if cm.Biz != "article" {
+		return ErrPinNotSupported
+	}
+	if cm.RootComment != nil {
+		return ErrPinReply
+	}
+	resp, err := c.artSvc.GetById(ctx, &articlev1.GetByIdRequest{Id: cm.BizID})
+	if err != nil {
+		return err
+	}
+	if resp.GetArticle().GetAuthor().GetId() != uid {
+		return ErrNotAuthor
+	}
+	return nil
+}
+
+// checkBlocked 查询失败的时候放行，拉黑服务出问题不应该让所有人都评论不了
+func (c *commentService) checkBlocked(ctx context.Context, uid int64, owners []int64) error {
+	for _, owner := range owners {
+		if owner == 0 || owner == uid {
+			continue
+		}
+		resp, err := c.followSvc.IsBlocked(ctx, &followv1.IsBlockedRequest{
+			Uid:    owner,
+			Target: uid,
+		})
+		if err != nil {
+			c.l.Error("查询拉黑关系失败",
+				logger.Int64("uid", owner),
+				logger.Int64("target", uid),
+				logger.Error(err))
+			continue
+		}
+		if resp.GetBlocked() {
+			return ErrBlocked
+		}
+	}
+	return nil
+}
+
+// articleAuthor 查不到的时候返回 0，也就是不检查作者
+func (c *commentService) articleAuthor(ctx context.Context, aid int64) int64 {
+	resp, err := c.artSvc.GetById(ctx, &articlev1.GetByIdRequest{Id: aid})
+	if err != nil {
+		c.l.Error("查询文章作者失败",
+			logger.Int64("aid", aid),
+			logger.Error(err))
+		return 0
+	}
+	return resp.GetArticle().GetAuthor().GetId()
+}
+
+func (c *commentService) findById(ctx context.Context, id int64) (domain.Comment, error) {
+	cms, err := c.repo.GetCommentByIds(ctx, []int64{id})
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	if len(cms) == 0 {
+		return domain.Comment{}, repository.ErrCommentNotFound
+	}
+	return cms[0], nil
 }
 
 func (c *commentService) DeleteComment(ctx context.Context, id int64) error {
@@ -48,6 +348,75 @@ func (c *commentService) DeleteComment(ctx context.Context, id int64) error {
 	})
 }
 
+func (c *commentService) RestoreComment(ctx context.Context, id, operator int64) error {
+	if !c.admins.contains(operator) {
+		return ErrNotAdmin
+	}
+	err := c.repo.RestoreComment(ctx, id)
+	if err != nil {
+		return err
+	}
+	c.l.Info("恢复评论",
+		logger.Int64("operator", operator),
+		logger.Int64("cid", id))
+	return nil
+}
+
+func (c *commentService) PurgeDeleted(ctx context.Context,
+	before time.Time, limit int) (int, error) {
+	return c.repo.PurgeDeleted(ctx, before, limit)
+}
+
 func (c *commentService) CreateComment(ctx context.Context, comment domain.Comment) error {
+	// 被资源的作者、被回复的人拉黑了，都不能评论
+	owners := make([]int64, 0, 2)
+	if comment.ParentComment != nil {
+		// 不能回复已经删除的评论，否则清理的时候会连带把回复也删掉
+		parent, err := c.findById(ctx, comment.ParentComment.Id)
+		if err != nil {
+			return err
+		}
+		if parent.Deleted {
+			return ErrReplyDeleted
+		}
+		owners = append(owners, parent.Commentator.ID)
+	}
+	if comment.Biz == "article" {
+		owners = append(owners, c.articleAuthor(ctx, comment.BizID))
+	}
+	err := c.checkBlocked(ctx, comment.Commentator.ID, owners)
+	if err != nil {
+		return err
+	}
+	comment.SensitiveWords = c.filter.Find(comment.Content)
+	if len(comment.SensitiveWords) > 0 {
+		comment.Status = domain.CommentStatusPending
+	} else {
+		comment.Status = domain.CommentStatusApproved
+	}
 	return c.repo.CreateComment(ctx, comment)
 }
+
+func (c *commentService) GetPendingComments(ctx context.Context,
+	maxID int64, limit int) ([]domain.Comment, error) {
+	return c.repo.FindPending(ctx, maxID, limit)
+}
+
+func (c *commentService) ReviewComment(ctx context.Context,
+	ids []int64, status domain.CommentStatus, reviewer int64) error {
+	if !c.admins.contains(reviewer) {
+		return ErrNotAdmin
+	}
+	if status != domain.CommentStatusApproved && status != domain.CommentStatusRejected {
+		return ErrInvalidReviewStatus
+	}
+	err := c.repo.Review(ctx, ids, status)
+	if err != nil {
+		return err
+	}
+	c.l.Info("审核评论",
+		logger.Int64("reviewer", reviewer),
+		logger.Int64("cnt", int64(len(ids))),
+		logger.Int64("status", int64(status)))
+	return nil
+}
@@ -0,0 +1,402 @@
+package service
+
+import (
+	articlev1 "basic-go/lmbook/api/proto/gen/article/v1"
+	articlemocks "basic-go/lmbook/api/proto/gen/article/v1/mocks"
+	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
+	followmocks "basic-go/lmbook/api/proto/gen/follow/v1/mocks"
+	intrv1 "basic-go/lmbook/api/proto/gen/intr/v1"
+	intrmocks "basic-go/lmbook/api/proto/gen/intr/v1/mocks"
+	"basic-go/lmbook/comment/domain"
+	"basic-go/lmbook/comment/repository"
+	repomocks "basic-go/lmbook/comment/repository/mocks"
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/pkg/sensitive"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestHotScore(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name string
+		// higher 的得分应该比 lower 高
+		higher domain.Comment
+		lower  domain.Comment
+	}{
+		{
+			name:   "同一时间点赞多的高",
+			higher: domain.Comment{LikeCnt: 10, CTime: now},
+			lower:  domain.Comment{LikeCnt: 1, CTime: now},
+		},
+		{
+			name:   "回复比点赞权重高",
+			higher: domain.Comment{ReplyCnt: 10, CTime: now},
+			lower:  domain.Comment{LikeCnt: 10, CTime: now},
+		},
+		{
+			name:   "互动一样新的高",
+			higher: domain.Comment{LikeCnt: 10, CTime: now},
+			lower:  domain.Comment{LikeCnt: 10, CTime: now.Add(-time.Hour * 24)},
+		},
+		{
+			name:   "没有互动的新评论也有分数",
+			higher: domain.Comment{CTime: now},
+			lower:  domain.Comment{CTime: now.Add(-time.Hour)},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Greater(t, hotScore(tc.higher, now), hotScore(tc.lower, now))
+		})
+	}
+}
+
+func TestCommentService_CreateComment(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.CommentRepository
+		// rpcMock 为 nil 的时候不会调用文章服务和关注服务
+		rpcMock func(ctrl *gomock.Controller) (articlev1.ArticleServiceClient, followv1.FollowServiceClient)
+		comment domain.Comment
+		wantErr error
+	}{
+		{
+			name: "没有敏感词，直接通过",
+			mock: func(ctrl *gomock.Controller) repository.CommentRepository {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().CreateComment(gomock.Any(), domain.Comment{
+					Content:        "写得不错",
+					Status:         domain.CommentStatusApproved,
+					SensitiveWords: []string{},
+				}).Return(nil)
+				return repo
+			},
+			comment: domain.Comment{Content: "写得不错"},
+		},
+		{
+			name: "命中敏感词，等待审核",
+			mock: func(ctrl *gomock.Controller) repository.CommentRepository {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().CreateComment(gomock.Any(), domain.Comment{
+					Content:        "专业刷单，代开发票",
+					Status:         domain.CommentStatusPending,
+					SensitiveWords: []string{"刷单", "代开发票"},
+				}).Return(nil)
+				return repo
+			},
+			comment: domain.Comment{Content: "专业刷单，代开发票"},
+		},
+		{
+			name: "不能回复已经删除的评论",
+			mock: func(ctrl *gomock.Controller) repository.CommentRepository {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().GetCommentByIds(gomock.Any(), []int64{1}).
+					Return([]domain.Comment{{Id: 1, Deleted: true}}, nil)
+				return repo
+			},
+			comment: domain.Comment{
+				Content:       "回复一下",
+				RootComment:   &domain.Comment{Id: 1},
+				ParentComment: &domain.Comment{Id: 1},
+			},
+			wantErr: ErrReplyDeleted,
+		},
+		{
+			name: "被作者拉黑了",
+			mock: func(ctrl *gomock.Controller) repository.CommentRepository {
+				return repomocks.NewMockCommentRepository(ctrl)
+			},
+			rpcMock: func(ctrl *gomock.Controller) (articlev1.ArticleServiceClient, followv1.FollowServiceClient) {
+				artSvc := articlemocks.NewMockArticleServiceClient(ctrl)
+				artSvc.EXPECT().GetById(gomock.Any(), &articlev1.GetByIdRequest{Id: 10}).
+					Return(&articlev1.GetByIdResponse{
+						Article: &articlev1.Article{Author: &articlev1.Author{Id: 2}},
+					}, nil)
+				followSvc := followmocks.NewMockFollowServiceClient(ctrl)
+				followSvc.EXPECT().IsBlocked(gomock.Any(), &followv1.IsBlockedRequest{
+					Uid: 2, Target: 1,
+				}).Return(&followv1.IsBlockedResponse{Blocked: true}, nil)
+				return artSvc, followSvc
+			},
+			comment: domain.Comment{
+				Commentator: domain.User{ID: 1},
+				Biz:         "article",
+				BizID:       10,
+				Content:     "写得不错",
+			},
+			wantErr: ErrBlocked,
+		},
+		{
+			name: "被回复的人拉黑了",
+			mock: func(ctrl *gomock.Controller) repository.CommentRepository {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().GetCommentByIds(gomock.Any(), []int64{1}).
+					Return([]domain.Comment{{Id: 1, Commentator: domain.User{ID: 3}}}, nil)
+				return repo
+			},
+			rpcMock: func(ctrl *gomock.Controller) (articlev1.ArticleServiceClient, followv1.FollowServiceClient) {
+				followSvc := followmocks.NewMockFollowServiceClient(ctrl)
+				followSvc.EXPECT().IsBlocked(gomock.Any(), &followv1.IsBlockedRequest{
+					Uid: 3, Target: 1,
+				}).Return(&followv1.IsBlockedResponse{Blocked: true}, nil)
+				return nil, followSvc
+			},
+			comment: domain.Comment{
+				Commentator:   domain.User{ID: 1},
+				Content:       "回复一下",
+				RootComment:   &domain.Comment{Id: 1},
+				ParentComment: &domain.Comment{Id: 1},
+			},
+			wantErr: ErrBlocked,
+		},
+		{
+			name: "查询拉黑关系失败，放行",
+			mock: func(ctrl *gomock.Controller) repository.CommentRepository {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().GetCommentByIds(gomock.Any(), []int64{1}).
+					Return([]domain.Comment{{Id: 1, Commentator: domain.User{ID: 3}}}, nil)
+				repo.EXPECT().CreateComment(gomock.Any(), gomock.Any()).Return(nil)
+				return repo
+			},
+			rpcMock: func(ctrl *gomock.Controller) (articlev1.ArticleServiceClient, followv1.FollowServiceClient) {
+				followSvc := followmocks.NewMockFollowServiceClient(ctrl)
+				followSvc.EXPECT().IsBlocked(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock error"))
+				return nil, followSvc
+			},
+			comment: domain.Comment{
+				Commentator:   domain.User{ID: 1},
+				Content:       "回复一下",
+				RootComment:   &domain.Comment{Id: 1},
+				ParentComment: &domain.Comment{Id: 1},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			var (
+				artSvc    articlev1.ArticleServiceClient
+				followSvc followv1.FollowServiceClient
+			)
+			if tc.rpcMock != nil {
+				artSvc, followSvc = tc.rpcMock(ctrl)
+			}
+			svc := NewCommentSvc(tc.mock(ctrl), nil, artSvc, followSvc,
+				sensitive.NewACFilter([]string{"代开发票", "刷单"}), nil,
+				logger.NewNoOpLogger())
+			err := svc.CreateComment(context.Background(), tc.comment)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestCommentService_ReviewComment(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mock     func(ctrl *gomock.Controller) repository.CommentRepository
+		status   domain.CommentStatus
+		reviewer int64
+		wantErr  error
+	}{
+		{
+			name: "审核通过",
+			mock: func(ctrl *gomock.Controller) repository.CommentRepository {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().Review(gomock.Any(), []int64{1, 2},
+					domain.CommentStatusApproved).Return(nil)
+				return repo
+			},
+			status:   domain.CommentStatusApproved,
+			reviewer: 123,
+		},
+		{
+			name: "不能改回待审核",
+			mock: func(ctrl *gomock.Controller) repository.CommentRepository {
+				return repomocks.NewMockCommentRepository(ctrl)
+			},
+			status:   domain.CommentStatusPending,
+			reviewer: 123,
+			wantErr:  ErrInvalidReviewStatus,
+		},
+		{
+			name: "不是管理员",
+			mock: func(ctrl *gomock.Controller) repository.CommentRepository {
+				return repomocks.NewMockCommentRepository(ctrl)
+			},
+			status:   domain.CommentStatusApproved,
+			reviewer: 456,
+			wantErr:  ErrNotAdmin,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewCommentSvc(tc.mock(ctrl), nil, nil, nil,
+				sensitive.NewACFilter(nil), Admins{123: {}}, logger.NewNoOpLogger())
+			err := svc.ReviewComment(context.Background(), []int64{1, 2}, tc.status, tc.reviewer)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestCommentService_RestoreComment(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mock     func(ctrl *gomock.Controller) repository.CommentRepository
+		operator int64
+		wantErr  error
+	}{
+		{
+			name: "管理员恢复",
+			mock: func(ctrl *gomock.Controller) repository.CommentRepository {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				repo.EXPECT().RestoreComment(gomock.Any(), int64(1)).Return(nil)
+				return repo
+			},
+			operator: 123,
+		},
+		{
+			name: "不是管理员",
+			mock: func(ctrl *gomock.Controller) repository.CommentRepository {
+				return repomocks.NewMockCommentRepository(ctrl)
+			},
+			operator: 456,
+			wantErr:  ErrNotAdmin,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewCommentSvc(tc.mock(ctrl), nil, nil, nil,
+				sensitive.NewACFilter(nil), Admins{123: {}}, logger.NewNoOpLogger())
+			err := svc.RestoreComment(context.Background(), 1, tc.operator)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestCommentService_GetCommentTree(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.CommentRepository,
+			intrv1.InteractiveServiceClient)
+		uid        int64
+		minID      int64
+		replyLimit int
+
+		wantComments []domain.Comment
+		wantErr      error
+	}{
+		{
+			name: "第一页，置顶评论在最前面，回复也有点赞数",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository,
+				intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				intrSvc := intrmocks.NewMockInteractiveServiceClient(ctrl)
+				repo.EXPECT().FindByBiz(gomock.Any(), "article", int64(1), int64(0),
+					int64(math.MaxInt64), int64(10)).
+					Return([]domain.Comment{{Id: 3}, {Id: 2, Pinned: true}}, nil)
+				repo.EXPECT().FindPinned(gomock.Any(), "article", int64(1)).
+					Return(domain.Comment{Id: 2, Pinned: true}, nil)
+				// 最多只能查 10 条回复
+				repo.EXPECT().FindChildren(gomock.Any(), int64(0),
+					[]domain.Comment{{Id: 2, Pinned: true}, {Id: 3}}, 10).
+					Return([]domain.Comment{
+						{Id: 2, Pinned: true, ReplyCnt: 1, Children: []*domain.Comment{{Id: 4}}},
+						{Id: 3},
+					}, nil)
+				intrSvc.EXPECT().GetByIds(gomock.Any(), &intrv1.GetByIdsRequest{
+					Biz: "comment", Ids: []int64{2, 4, 3},
+				}).Return(&intrv1.GetByIdsResponse{
+					Intrs: map[int64]*intrv1.Interactive{
+						2: {LikeCnt: 20},
+						4: {LikeCnt: 4},
+					},
+				}, nil)
+				return repo, intrSvc
+			},
+			minID:      math.MaxInt64,
+			replyLimit: 100,
+			wantComments: []domain.Comment{
+				{Id: 2, Pinned: true, ReplyCnt: 1, LikeCnt: 20,
+					Children: []*domain.Comment{{Id: 4, LikeCnt: 4}}},
+				{Id: 3},
+			},
+		},
+		{
+			name: "登录用户，一次查询点赞数和是否点赞过",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository,
+				intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				intrSvc := intrmocks.NewMockInteractiveServiceClient(ctrl)
+				repo.EXPECT().FindByBiz(gomock.Any(), "article", int64(1), int64(123),
+					int64(100), int64(10)).
+					Return([]domain.Comment{{Id: 3}, {Id: 2}}, nil)
+				repo.EXPECT().FindChildren(gomock.Any(), int64(123),
+					[]domain.Comment{{Id: 3}, {Id: 2}}, 3).
+					Return([]domain.Comment{{Id: 3}, {Id: 2}}, nil)
+				intrSvc.EXPECT().GetByIds(gomock.Any(), &intrv1.GetByIdsRequest{
+					Biz: "comment", Ids: []int64{3, 2}, Uid: 123,
+				}).Return(&intrv1.GetByIdsResponse{
+					Intrs: map[int64]*intrv1.Interactive{
+						3: {LikeCnt: 3, Liked: true},
+						2: {LikeCnt: 2},
+					},
+				}, nil)
+				return repo, intrSvc
+			},
+			uid:        123,
+			minID:      100,
+			replyLimit: 3,
+			wantComments: []domain.Comment{
+				{Id: 3, LikeCnt: 3, Liked: true},
+				{Id: 2, LikeCnt: 2},
+			},
+		},
+		{
+			name: "查询点赞数失败，降级",
+			mock: func(ctrl *gomock.Controller) (repository.CommentRepository,
+				intrv1.InteractiveServiceClient) {
+				repo := repomocks.NewMockCommentRepository(ctrl)
+				intrSvc := intrmocks.NewMockInteractiveServiceClient(ctrl)
+				repo.EXPECT().FindByBiz(gomock.Any(), "article", int64(1), int64(0),
+					int64(100), int64(10)).
+					Return([]domain.Comment{{Id: 3}}, nil)
+				repo.EXPECT().FindChildren(gomock.Any(), int64(0),
+					[]domain.Comment{{Id: 3}}, 3).
+					Return([]domain.Comment{{Id: 3}}, nil)
+				intrSvc.EXPECT().GetByIds(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock error"))
+				return repo, intrSvc
+			},
+			minID:        100,
+			replyLimit:   3,
+			wantComments: []domain.Comment{{Id: 3}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, intrSvc := tc.mock(ctrl)
+			svc := NewCommentSvc(repo, intrSvc, nil, nil,
+				sensitive.NewACFilter(nil), nil, logger.NewNoOpLogger())
+			cms, err := svc.GetCommentTree(context.Background(), "article", 1, tc.uid,
+				tc.minID, 10, tc.replyLimit)
+			assert.Equal(t, tc.wantErr, err)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantComments, cms)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"basic-go/lmbook/comment/repository"
 	"basic-go/lmbook/comment/repository/dao"
 	"basic-go/lmbook/comment/service"
+	"basic-go/lmbook/pkg/sensitive"
 	"github.com/google/wire"
 )
 
@@ -21,6 +22,12 @@ var serviceProviderSet = wire.NewSet(
 var thirdProvider = wire.NewSet(
 	ioc.InitLogger,
 	ioc.InitDB,
+	ioc.InitEtcdClient,
+	ioc.InitInterActiveRpcClient,
+	ioc.InitArticleRpcClient,
+	ioc.InitFollowRpcClient,
+	sensitive.InitFilter,
+	ioc.InitAdmins,
 )
 
 func Init() *App {
@@ -28,6 +35,8 @@ func Init() *App {
 		thirdProvider,
 		serviceProviderSet,
 		ioc.InitGRPCxServer,
+		ioc.InitPurgeDeletedJob,
+		ioc.InitJobs,
 		wire.Struct(new(App), "*"),
 	)
 	return new(App)
@@ -12,6 +12,7 @@ import (
 	"basic-go/lmbook/comment/repository"
 	"basic-go/lmbook/comment/repository/dao"
 	"basic-go/lmbook/comment/service"
+	"basic-go/lmbook/pkg/sensitive"
 	"github.com/google/wire"
 )
 
@@ -21,12 +22,21 @@ func Init() *App {
 	loggerV1 := ioc.InitLogger()
 	db := ioc.InitDB(loggerV1)
 	commentDAO := dao.NewCommentDAO(db)
-	commentRepo := repository.NewCommentRepo(commentDAO)
-	commentService := service.NewCommentSvc(commentRepo)
+	commentRepository := repository.NewCommentRepo(commentDAO, loggerV1)
+	interactiveServiceClient := ioc.InitInterActiveRpcClient()
+	articleServiceClient := ioc.InitArticleRpcClient()
+	followServiceClient := ioc.InitFollowRpcClient()
+	filter := sensitive.InitFilter(loggerV1)
+	admins := ioc.InitAdmins()
+	commentService := service.NewCommentSvc(commentRepository, interactiveServiceClient, articleServiceClient, followServiceClient, filter, admins, loggerV1)
 	commentServiceServer := grpc.NewGrpcServer(commentService)
-	server := ioc.InitGRPCxServer(commentServiceServer)
+	client := ioc.InitEtcdClient()
+	server := ioc.InitGRPCxServer(commentServiceServer, client, loggerV1)
+	purgeDeletedJob := ioc.InitPurgeDeletedJob(commentService, loggerV1)
+	cron := ioc.InitJobs(loggerV1, purgeDeletedJob)
 	app := &App{
 		server: server,
+		cron:   cron,
 	}
 	return app
 }
@@ -35,4 +45,4 @@ func Init() *App {
 
 var serviceProviderSet = wire.NewSet(dao.NewCommentDAO, repository.NewCommentRepo, service.NewCommentSvc, grpc.NewGrpcServer)
 
-var thirdProvider = wire.NewSet(ioc.InitLogger, ioc.InitDB)
+var thirdProvider = wire.NewSet(ioc.InitLogger, ioc.InitDB, ioc.InitEtcdClient, ioc.InitInterActiveRpcClient, ioc.InitArticleRpcClient, ioc.InitFollowRpcClient, sensitive.InitFilter, ioc.InitAdmins)
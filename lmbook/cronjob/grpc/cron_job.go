@@ -5,7 +5,11 @@ import (
 	"basic-go/lmbook/cronjob/domain"
 	"basic-go/lmbook/cronjob/service"
 	"context"
+	"errors"
+
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -21,10 +25,32 @@ func NewCronJobServiceServer(svc service.CronJobService) *CronJobServiceServer {
 }
 
 func (c *CronJobServiceServer) Preempt(ctx context.Context, request *cronjobv1.PreemptRequest) (*cronjobv1.PreemptResponse, error) {
-	job, err := c.svc.Preempt(ctx)
+	job, err := c.svc.Preempt(ctx, request.GetNames()...)
+	if errors.Is(err, service.ErrNoMoreJob) {
+		return nil, status.Error(codes.NotFound, "没有可以执行的任务")
+	}
+	if err != nil {
+		return nil, err
+	}
 	return &cronjobv1.PreemptResponse{
 		Cronjob: convertToV(job),
-	}, err
+	}, nil
+}
+
+func (c *CronJobServiceServer) Release(ctx context.Context, request *cronjobv1.ReleaseRequest) (*cronjobv1.ReleaseResponse, error) {
+	err := c.svc.Release(ctx, request.GetId())
+	return &cronjobv1.ReleaseResponse{}, err
+}
+
+func (c *CronJobServiceServer) Refresh(ctx context.Context, request *cronjobv1.RefreshRequest) (*cronjobv1.RefreshResponse, error) {
+	err := c.svc.Refresh(ctx, request.GetId())
+	if errors.Is(err, service.ErrJobNotRunning) {
+		return nil, status.Error(codes.FailedPrecondition, "任务已经被释放了")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &cronjobv1.RefreshResponse{}, nil
 }
 func (c *CronJobServiceServer) Register(server grpc.ServiceRegistrar) {
 	cronjobv1.RegisterCronJobServiceServer(server, c)
@@ -7,11 +7,14 @@ import (
 	"time"
 )
 
-var ErrNoMoreJob = dao.ErrNoMoreJob
+var (
+	ErrNoMoreJob     = dao.ErrNoMoreJob
+	ErrJobNotRunning = dao.ErrJobNotRunning
+)
 
 //go:generate mockgen -source=./cron_job.go -package=repomocks -destination=mocks/cron_job.mock.go CronJobRepository
 type CronJobRepository interface {
-	Preempt(ctx context.Context) (domain.CronJob, error)
+	Preempt(ctx context.Context, names ...string) (domain.CronJob, error)
 	UpdateNextTime(ctx context.Context, id int64, t time.Time) error
 	UpdateUtime(ctx context.Context, id int64) error
 	Release(ctx context.Context, id int64) error
@@ -38,8 +41,8 @@ func (p *PreemptCronJobRepository) UpdateUtime(ctx context.Context, id int64) er
 	return p.dao.UpdateUtime(ctx, id)
 }
 
-func (p *PreemptCronJobRepository) Preempt(ctx context.Context) (domain.CronJob, error) {
-	j, err := p.dao.Preempt(ctx)
+func (p *PreemptCronJobRepository) Preempt(ctx context.Context, names ...string) (domain.CronJob, error) {
+	j, err := p.dao.Preempt(ctx, names...)
 	if err != nil {
 		return domain.CronJob{}, err
 	}
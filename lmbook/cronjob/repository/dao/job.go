@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"gorm.io/gorm"
@@ -9,8 +10,12 @@ import (
 
 var ErrNoMoreJob = gorm.ErrRecordNotFound
 
+// ErrJobNotRunning 续约的时候任务已经不在运行状态，也就是已经被释放了
+var ErrJobNotRunning = errors.New("任务不在运行状态")
+
 type JobDAO interface {
-	Preempt(ctx context.Context) (Job, error)
+	// Preempt 抢占一个到期的任务或者续约超时的任务，names 不为空的时候只抢占这些任务
+	Preempt(ctx context.Context, names ...string) (Job, error)
 	UpdateNextTime(ctx context.Context, id int64, t time.Time) error
 	UpdateUtime(ctx context.Context, id int64) error
 	Release(ctx context.Context, id int64) error
@@ -19,6 +24,8 @@ type JobDAO interface {
 
 type GORMJobDAO struct {
 	db *gorm.DB
+	// leaseTimeout 抢占的实例超过这么久没有续约，就认为它已经崩溃了，别人可以重新抢占
+	leaseTimeout time.Duration
 }
 
 func (dao *GORMJobDAO) Insert(ctx context.Context, j Job) error {
@@ -29,7 +36,7 @@ func (dao *GORMJobDAO) Insert(ctx context.Context, j Job) error {
 }
 
 func NewGORMJobDAO(db *gorm.DB) JobDAO {
-	return &GORMJobDAO{db: db}
+	return &GORMJobDAO{db: db, leaseTimeout: time.Minute}
 }
 
 func (dao *GORMJobDAO) Release(ctx context.Context, id int64) error {
@@ -41,22 +48,35 @@ func (dao *GORMJobDAO) Release(ctx context.Context, id int64) error {
 }
 
 func (dao *GORMJobDAO) UpdateUtime(ctx context.Context, id int64) error {
-	return dao.db.WithContext(ctx).Model(&Job{}).
-		Where("id=?", id).Updates(map[string]any{
+	res := dao.db.WithContext(ctx).Model(&Job{}).
+		Where("id=? AND status = ?", id, jobStatusRunning).Updates(map[string]any{
 		"utime": time.Now().UnixMilli(),
-	}).Error
+	})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrJobNotRunning
+	}
+	return nil
 }
 
-func (dao *GORMJobDAO) Preempt(ctx context.Context) (Job, error) {
+func (dao *GORMJobDAO) Preempt(ctx context.Context, names ...string) (Job, error) {
 	db := dao.db.WithContext(ctx)
 	for {
 		// 每一个循环都重新计算 time.Now，因为之前可能已经花了一些时间了
 		now := time.Now().UnixMilli()
 		var j Job
-		// 到了调度的时间
-		err := db.Where(
+		// 到了调度的时间，或者抢占的实例很久没有续约了
+		query := db.Where(db.Where(
 			"next_time <= ? AND status = ?",
-			now, jobStatusWaiting).First(&j).Error
+			now, jobStatusWaiting).
+			Or("status = ? AND utime < ?",
+				jobStatusRunning, now-dao.leaseTimeout.Milliseconds()))
+		if len(names) > 0 {
+			query = query.Where("name IN ?", names)
+		}
+		err := query.First(&j).Error
 		if err != nil {
 			// 数据库有问题
 			return Job{}, err
@@ -55,18 +55,23 @@ func (mr *MockCronJobRepositoryMockRecorder) AddJob(ctx, j any) *gomock.Call {
 }
 
 // Preempt mocks base method.
-func (m *MockCronJobRepository) Preempt(ctx context.Context) (domain.CronJob, error) {
+func (m *MockCronJobRepository) Preempt(ctx context.Context, names ...string) (domain.CronJob, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Preempt", ctx)
+	varargs := []any{ctx}
+	for _, a := range names {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Preempt", varargs...)
 	ret0, _ := ret[0].(domain.CronJob)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Preempt indicates an expected call of Preempt.
-func (mr *MockCronJobRepositoryMockRecorder) Preempt(ctx any) *gomock.Call {
+func (mr *MockCronJobRepositoryMockRecorder) Preempt(ctx any, names ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preempt", reflect.TypeOf((*MockCronJobRepository)(nil).Preempt), ctx)
+	varargs := append([]any{ctx}, names...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preempt", reflect.TypeOf((*MockCronJobRepository)(nil).Preempt), varargs...)
 }
 
 // Release mocks base method.
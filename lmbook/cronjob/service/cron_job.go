@@ -5,31 +5,40 @@ import (
 	"basic-go/lmbook/cronjob/repository"
 	"basic-go/lmbook/pkg/logger"
 	"context"
+	"sync"
 	"time"
 )
 
-var ErrNoMoreJob = repository.ErrNoMoreJob
+var (
+	ErrNoMoreJob     = repository.ErrNoMoreJob
+	ErrJobNotRunning = repository.ErrJobNotRunning
+)
 
 //go:generate mockgen -source=./cron_job.go -package=svcmocks -destination=mocks/cron_job.mock.go CronJobService
 type CronJobService interface {
-	Preempt(ctx context.Context) (domain.CronJob, error)
+	// Preempt 抢占任务，names 不为空的时候只抢占这些任务。
+	// 抢占之后由调用方定期调用 Refresh 续约，超过一段时间没有续约，别人就可以重新抢占。
+	// 这样调用方崩溃之后任务不会一直被占着
+	Preempt(ctx context.Context, names ...string) (domain.CronJob, error)
+	// Refresh 续约，任务已经被释放了的话返回 ErrJobNotRunning
+	Refresh(ctx context.Context, id int64) error
 	ResetNextTime(ctx context.Context, job domain.CronJob) error
 	AddJob(ctx context.Context, j domain.CronJob) error
+	// Release 释放任务，用于抢占的时候拿不到 CancelFunc 的场景，比如说通过 gRPC 释放
+	Release(ctx context.Context, id int64) error
 }
 
 type cronJobService struct {
-	repo            repository.CronJobRepository
-	l               logger.LoggerV1
-	refreshInterval time.Duration
+	repo repository.CronJobRepository
+	l    logger.LoggerV1
 }
 
 func NewCronJobService(
 	repo repository.CronJobRepository,
 	l logger.LoggerV1) CronJobService {
 	return &cronJobService{
-		repo:            repo,
-		l:               l,
-		refreshInterval: time.Second * 10,
+		repo: repo,
+		l:    l,
 	}
 }
 
@@ -38,50 +47,37 @@ func (s *cronJobService) AddJob(ctx context.Context, j domain.CronJob) error {
 	return s.repo.AddJob(ctx, j)
 }
 
-func (s *cronJobService) Preempt(ctx context.Context) (domain.CronJob, error) {
-	j, err := s.repo.Preempt(ctx)
+func (s *cronJobService) Release(ctx context.Context, id int64) error {
+	return s.repo.Release(ctx, id)
+}
+
+func (s *cronJobService) Refresh(ctx context.Context, id int64) error {
+	return s.repo.UpdateUtime(ctx, id)
+}
+
+func (s *cronJobService) Preempt(ctx context.Context, names ...string) (domain.CronJob, error) {
+	j, err := s.repo.Preempt(ctx, names...)
 	if err != nil {
 		return domain.CronJob{}, err
 	}
-	ch := make(chan struct{})
-	go func() {
-		// 这边要启动一个 goroutine 开始续约，也就是在持续占有期间
-		// 假定说我们这里是十秒钟续约一次
-		ticker := time.NewTicker(s.refreshInterval)
-		defer ticker.Stop()
-		for {
-			select {
-			case <-ch:
-				// 退出续约循环
-				return
-			case <-ticker.C:
-				s.refresh(j.Id)
-			}
-		}
-	}()
-	// 只能调用一次，也就是放弃续约。这时候要把状态还原回去
+	var once sync.Once
+	// 放弃任务，这时候要把状态还原回去。重复调用是安全的
 	j.CancelFunc = func() {
-		close(ch)
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-		defer cancel()
-		err := s.repo.Release(ctx, j.Id)
-		if err != nil {
-			s.l.Error("释放任务失败",
-				logger.Error(err),
-				logger.Int64("id", j.Id))
-		}
+		once.Do(func() {
+			s.release(j.Id)
+		})
 	}
 	return j, nil
 }
 
-func (s *cronJobService) refresh(id int64) {
+func (s *cronJobService) release(id int64) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	err := s.repo.UpdateUtime(ctx, id)
+	err := s.repo.Release(ctx, id)
 	if err != nil {
-		s.l.Error("续约失败",
-			logger.Int64("jid", id),
-			logger.Error(err))
+		s.l.Error("释放任务失败",
+			logger.Error(err),
+			logger.Int64("id", id))
 	}
 }
 
@@ -10,34 +10,27 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"testing"
-	"time"
 )
 
 func TestCronJobService_Preempt(t *testing.T) {
-	// 借助单元测试来验证续约逻辑
+	// 续约由调用方负责，service 自己不会续约
 	testCases := []struct {
-		name     string
-		mock     func(ctrl *gomock.Controller) repository.CronJobRepository
-		wantErr  error
-		wantJob  domain.CronJob
-		interval time.Duration
+		name    string
+		mock    func(ctrl *gomock.Controller) repository.CronJobRepository
+		wantErr error
+		wantJob domain.CronJob
 	}{
 		{
-			name: "抢占并且续约",
+			name: "抢占成功，CancelFunc 只释放一次",
 			mock: func(ctrl *gomock.Controller) repository.CronJobRepository {
 				repo := repomocks.NewMockCronJobRepository(ctrl)
 				repo.EXPECT().Preempt(gomock.Any()).Return(domain.CronJob{
 					Id: 1,
 				}, nil)
-				// interval 设置为三秒多，所以会续约三次
-				repo.EXPECT().UpdateUtime(gomock.Any(), int64(1)).Times(3).
-					Return(nil)
 				repo.EXPECT().Release(gomock.Any(), int64(1)).Return(nil)
 				return repo
 			},
-			// 多加 100 毫秒，规避边界条件
-			interval: time.Second*3 + time.Millisecond*100,
-			wantErr:  nil,
+			wantErr: nil,
 			wantJob: domain.CronJob{
 				Id: 1,
 			},
@@ -50,19 +43,16 @@ func TestCronJobService_Preempt(t *testing.T) {
 					Return(domain.CronJob{}, errors.New("db error"))
 				return repo
 			},
-			interval: time.Second*3 + time.Millisecond*100,
-			wantErr:  errors.New("db error"),
-			wantJob:  domain.CronJob{},
+			wantErr: errors.New("db error"),
+			wantJob: domain.CronJob{},
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			svc := NewCronJobService(tc.mock(ctrl), logger.NewNoOpLogger())
-			svc.(*cronJobService).refreshInterval = time.Second
 			job, err := svc.Preempt(context.Background())
 			assert.Equal(t, tc.wantErr, err)
 			// 因为我们后面还要处理，所以在 err != nil 的时候要返回
@@ -73,12 +63,45 @@ func TestCronJobService_Preempt(t *testing.T) {
 			cancelFunc := job.CancelFunc
 			job.CancelFunc = nil
 			assert.Equal(t, tc.wantJob, job)
-
-			time.Sleep(tc.interval)
-			// 模拟运行之后取消续约
 			cancelFunc()
-			// 再次 sleep，借助 mock 确定真的退出了续约循环
-			time.Sleep(tc.interval)
+			cancelFunc()
+		})
+	}
+}
+
+func TestCronJobService_Refresh(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) repository.CronJobRepository
+		wantErr error
+	}{
+		{
+			name: "续约成功",
+			mock: func(ctrl *gomock.Controller) repository.CronJobRepository {
+				repo := repomocks.NewMockCronJobRepository(ctrl)
+				repo.EXPECT().UpdateUtime(gomock.Any(), int64(1)).Return(nil)
+				return repo
+			},
+		},
+		{
+			name: "任务已经被释放了",
+			mock: func(ctrl *gomock.Controller) repository.CronJobRepository {
+				repo := repomocks.NewMockCronJobRepository(ctrl)
+				repo.EXPECT().UpdateUtime(gomock.Any(), int64(1)).
+					Return(repository.ErrJobNotRunning)
+				return repo
+			},
+			wantErr: ErrJobNotRunning,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewCronJobService(tc.mock(ctrl), logger.NewNoOpLogger())
+			err := svc.Refresh(context.Background(), 1)
+			assert.Equal(t, tc.wantErr, err)
 		})
 	}
 }
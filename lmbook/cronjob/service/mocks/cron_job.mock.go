@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=./cron_job.go -package=svcmocks -destination=mocks/cron_job.mock.go CronJobService
+//	mockgen -source=./cron_job.go -package=svcmocks -destination=./mocks/cron_job.mock.go
 //
 // Package svcmocks is a generated GoMock package.
 package svcmocks
@@ -54,18 +54,51 @@ func (mr *MockCronJobServiceMockRecorder) AddJob(ctx, j any) *gomock.Call {
 }
 
 // Preempt mocks base method.
-func (m *MockCronJobService) Preempt(ctx context.Context) (domain.CronJob, error) {
+func (m *MockCronJobService) Preempt(ctx context.Context, names ...string) (domain.CronJob, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Preempt", ctx)
+	varargs := []any{ctx}
+	for _, a := range names {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Preempt", varargs...)
 	ret0, _ := ret[0].(domain.CronJob)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Preempt indicates an expected call of Preempt.
-func (mr *MockCronJobServiceMockRecorder) Preempt(ctx any) *gomock.Call {
+func (mr *MockCronJobServiceMockRecorder) Preempt(ctx any, names ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preempt", reflect.TypeOf((*MockCronJobService)(nil).Preempt), ctx)
+	varargs := append([]any{ctx}, names...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preempt", reflect.TypeOf((*MockCronJobService)(nil).Preempt), varargs...)
+}
+
+// Refresh mocks base method.
+func (m *MockCronJobService) Refresh(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockCronJobServiceMockRecorder) Refresh(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockCronJobService)(nil).Refresh), ctx, id)
+}
+
+// Release mocks base method.
+func (m *MockCronJobService) Release(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockCronJobServiceMockRecorder) Release(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockCronJobService)(nil).Release), ctx, id)
 }
 
 // ResetNextTime mocks base method.
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -9,8 +11,27 @@ type FeedEvent struct {
 	// 以 A 发表了一篇文章为例
 	// 如果是 Pull Event，也就是拉模型，那么 Uid 是 A 的id
 	// 如果是 Push Event，也就是推模型，那么 Uid 是 A 的某个粉丝的 id
-	Uid   int64
-	Type  string
-	Ctime time.Time
-	Ext   ExtendFields
+	Uid int64
+	// Author 产生这个事件的人。拉模型下面和 Uid 是一样的，
+	// 推模型下面要靠它才知道是谁发的，0 代表不是关注的人产生的，比如说别人点赞了你
+	Author int64
+	Type   string
+	Ctime  time.Time
+	Ext    ExtendFields
+}
+
+// FolloweeFilter 按照关注分组过滤
+type FolloweeFilter struct {
+	// Gid 关注分组，0 代表不限分组
+	Gid int64
+	// Special 只看特别关注
+	Special bool
+}
+
+// DedupKey 同一个事件可能同时出现在收件箱和发件箱里面，
+// 比如说大 V 给活跃粉丝额外推了一份，合并的时候按照这个去重
+func (e FeedEvent) DedupKey() string {
+	// map 序列化的时候 key 是排好序的，所以同样的内容结果一样
+	ext, _ := json.Marshal(e.Ext)
+	return fmt.Sprintf("%s:%d:%d:%s", e.Type, e.Author, e.Ctime.UnixMilli(), ext)
 }
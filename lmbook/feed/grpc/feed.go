@@ -31,7 +31,18 @@ func (f *FeedEventGrpcSvc) CreateFeedEvent(ctx context.Context, request *feedv1.
 }
 
 func (f *FeedEventGrpcSvc) FindFeedEvents(ctx context.Context, request *feedv1.FindFeedEventsRequest) (*feedv1.FindFeedEventsResponse, error) {
-	eventList, err := f.svc.GetFeedEventList(ctx, request.GetUid(), request.Timestamp, request.Limit)
+	var (
+		eventList []domain.FeedEvent
+		err       error
+	)
+	if request.Gid > 0 || request.Special {
+		eventList, err = f.svc.GetGroupFeedEventList(ctx, request.GetUid(), domain.FolloweeFilter{
+			Gid:     request.Gid,
+			Special: request.Special,
+		}, request.Timestamp, request.Limit)
+	} else {
+		eventList, err = f.svc.GetFeedEventList(ctx, request.GetUid(), request.Timestamp, request.Limit)
+	}
 	if err != nil {
 		return &feedv1.FindFeedEventsResponse{}, err
 	}
@@ -49,7 +60,7 @@ func (f *FeedEventGrpcSvc) convertToDomain(event *feedv1.FeedEvent) domain.FeedE
 	_ = json.Unmarshal([]byte(event.Content), &ext)
 	return domain.FeedEvent{
 		ID:    event.Id,
-		Ctime: time.Unix(event.Ctime, 0),
+		Ctime: time.UnixMilli(event.Ctime),
 		Type:  event.GetType(),
 		Ext:   ext,
 	}
@@ -60,7 +71,7 @@ func (f *FeedEventGrpcSvc) convertToView(event domain.FeedEvent) *feedv1.FeedEve
 	return &feedv1.FeedEvent{
 		Id:      event.ID,
 		Type:    event.Type,
-		Ctime:   event.Ctime.Unix(),
+		Ctime:   event.Ctime.UnixMilli(),
 		Content: string(val),
 	}
 }
@@ -4,10 +4,31 @@ import (
 	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
 	"basic-go/lmbook/feed/repository"
 	"basic-go/lmbook/feed/service"
+	"basic-go/lmbook/pkg/logger"
+	"github.com/spf13/viper"
+	"time"
 )
 
-func RegisterHandler(repo repository.FeedEventRepo, followClient followv1.FollowServiceClient) map[string]service.Handler {
-	articleHandler := service.NewArticleEventHandler(repo, followClient)
+func InitFanoutPolicy(repo repository.FeedEventRepo,
+	followClient followv1.FollowServiceClient,
+	l logger.LoggerV1) *service.FanoutPolicy {
+	cfg := service.FanoutConfig{
+		Threshold:        1000,
+		ActiveWindow:     3 * 24 * time.Hour,
+		MaxPartialPush:   10000,
+		MaxScanFollowers: 100000,
+		BatchSize:        1000,
+		MaxPullFollowees: 2000,
+	}
+	err := viper.UnmarshalKey("feed.fanout", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return service.NewFanoutPolicy(repo, followClient, cfg, l)
+}
+
+func RegisterHandler(repo repository.FeedEventRepo, fanout *service.FanoutPolicy) map[string]service.Handler {
+	articleHandler := service.NewArticleEventHandler(fanout)
 	followHanlder := service.NewFollowEventHandler(repo)
 	likeHandler := service.NewLikeEventHandler(repo)
 	return map[string]service.Handler{
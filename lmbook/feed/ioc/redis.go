@@ -1,6 +1,7 @@
 package ioc
 
 import (
+	"basic-go/lmbook/feed/repository/cache"
 	"github.com/redis/go-redis/v9"
 	"github.com/spf13/viper"
 )
@@ -12,3 +13,12 @@ func InitRedis() redis.Cmdable {
 	})
 	return cmd
 }
+
+func InitFeedInboxCache(client redis.Cmdable) cache.FeedInboxCache {
+	// 每个人的收件箱最多保留多少条，更早的去数据库里面查
+	capacity := viper.GetInt64("feed.inbox.capacity")
+	if capacity <= 0 {
+		capacity = 1000
+	}
+	return cache.NewRedisFeedInboxCache(client, capacity)
+}
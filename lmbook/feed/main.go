@@ -1,8 +1,8 @@
 package main
 
 import (
-	"basic-go/lmbook/feed/events"
 	"basic-go/lmbook/pkg/grpcx"
+	"basic-go/lmbook/pkg/saramax"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
@@ -35,5 +35,5 @@ func initViperV2Watch() {
 
 type App struct {
 	server    *grpcx.Server
-	consumers []events.Consumer
+	consumers []saramax.Consumer
 }
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"github.com/redis/go-redis/v9"
+	"math/rand"
+	"strconv"
 	"time"
 )
 
@@ -14,6 +16,10 @@ var FolloweesNotFound = redis.Nil
 type FeedEventCache interface {
 	SetFollowees(ctx context.Context, follower int64, followees []int64) error
 	GetFollowees(ctx context.Context, follower int64) ([]int64, error)
+	// MarkActive 记录用户最近一次看 feed 的时间
+	MarkActive(ctx context.Context, uid int64, t time.Time) error
+	// FilterActive 在 uids 里面找出 since 之后看过 feed 的人
+	FilterActive(ctx context.Context, uids []int64, since time.Time) ([]int64, error)
 }
 
 type feedEventCache struct {
@@ -28,6 +34,16 @@ func NewFeedEventCache(client redis.Cmdable) FeedEventCache {
 
 const FolloweeKeyExpiration = 10 * time.Minute
 
+const (
+	// activeShards 活跃用户按照 uid 分散到多个 ZSET 里面，score 是最近一次看 feed 的时间。
+	// 每次刷 feed 都要写，放在一个 key 上面就是热点
+	activeShards = 64
+	// activeRetention 超过这个时间没看过 feed 的就从 ZSET 里面清理掉
+	activeRetention = 30 * 24 * time.Hour
+	// activeTrimRate 平均写多少次清理一次，清理要扫过期的成员，没必要每次都做
+	activeTrimRate = 1000
+)
+
 func (f *feedEventCache) SetFollowees(ctx context.Context, follower int64, followees []int64) error {
 	key := f.getFolloweeKey(follower)
 	followeesStr, err := json.Marshal(followees)
@@ -51,6 +67,62 @@ func (f *feedEventCache) GetFollowees(ctx context.Context, follower int64) ([]in
 	return followees, nil
 }
 
+func (f *feedEventCache) MarkActive(ctx context.Context, uid int64, t time.Time) error {
+	key := f.activeKey(uid)
+	err := f.client.ZAdd(ctx, key, redis.Z{Score: float64(t.Unix()), Member: uid}).Err()
+	if err != nil || rand.Intn(activeTrimRate) != 0 {
+		return err
+	}
+	return f.client.ZRemRangeByScore(ctx, key, "-inf",
+		strconv.FormatInt(t.Add(-activeRetention).Unix(), 10)).Err()
+}
+
+func (f *feedEventCache) FilterActive(ctx context.Context, uids []int64, since time.Time) ([]int64, error) {
+	if len(uids) == 0 {
+		return []int64{}, nil
+	}
+	// 同一个分片的一次查询
+	shards := make(map[string][]int64, activeShards)
+	for _, uid := range uids {
+		key := f.activeKey(uid)
+		shards[key] = append(shards[key], uid)
+	}
+	pipe := f.client.Pipeline()
+	cmds := make(map[string]*redis.FloatSliceCmd, len(shards))
+	for key, members := range shards {
+		args := make([]string, 0, len(members))
+		for _, uid := range members {
+			args = append(args, strconv.FormatInt(uid, 10))
+		}
+		cmds[key] = pipe.ZMScore(ctx, key, args...)
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	active := make(map[int64]struct{}, len(uids))
+	for key, cmd := range cmds {
+		// 不存在的成员 score 是 0
+		for i, score := range cmd.Val() {
+			if int64(score) >= since.Unix() {
+				active[shards[key][i]] = struct{}{}
+			}
+		}
+	}
+	// 保持 uids 原来的顺序
+	res := make([]int64, 0, len(active))
+	for _, uid := range uids {
+		if _, ok := active[uid]; ok {
+			res = append(res, uid)
+		}
+	}
+	return res, nil
+}
+
+func (f *feedEventCache) activeKey(uid int64) string {
+	return fmt.Sprintf("feed_active_users:%d", uid%activeShards)
+}
+
 func (f *feedEventCache) getFolloweeKey(follower int64) string {
 	return fmt.Sprintf("feed_event:%d", follower)
 }
@@ -0,0 +1,160 @@
+package cache
+
+import (
+	"basic-go/lmbook/feed/domain"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"math"
+	"strconv"
+	"time"
+)
+
+var (
+	//go:embed lua/inbox_add.lua
+	luaInboxAdd string
+
+	ErrInboxNotFound = errors.New("收件箱还没有加载")
+)
+
+const (
+	// inboxPlaceholder 分数是 +inf 的占位成员，用来区分空的收件箱和没有加载的收件箱
+	inboxPlaceholder  = "-"
+	inboxExpiration   = 7 * 24 * time.Hour
+	inboxPipelineSize = 500
+)
+
+// FeedInboxCache 每个人每种事件一个 ZSET 做收件箱，和 Handler 一一对应，
+// score 是事件的创建时间（毫秒），只保留最新的 capacity 条
+type FeedInboxCache interface {
+	// Add 把推模型的事件写到各自的收件箱，并且续期，没有加载的收件箱直接跳过
+	Add(ctx context.Context, events []domain.FeedEvent) error
+	// Range 按照时间倒序，取 timestamp 之前的 limit 条。
+	// full 代表收件箱已经满了，更早的数据可能被淘汰了
+	Range(ctx context.Context, uid int64, typ string, timestamp, limit int64) (events []domain.FeedEvent, full bool, err error)
+	// Rebuild 用数据库里面 typ 类型最新的事件重建收件箱
+	Rebuild(ctx context.Context, uid int64, typ string, events []domain.FeedEvent) error
+	Capacity() int64
+}
+
+type RedisFeedInboxCache struct {
+	client   redis.Cmdable
+	capacity int64
+}
+
+func NewRedisFeedInboxCache(client redis.Cmdable, capacity int64) FeedInboxCache {
+	return &RedisFeedInboxCache{
+		client:   client,
+		capacity: capacity,
+	}
+}
+
+// inboxItem 收件箱里面存的内容，收件人就是 key 里面的 uid，不需要再存一遍
+type inboxItem struct {
+	Id     int64               `json:"id"`
+	Author int64               `json:"author"`
+	Type   string              `json:"type"`
+	Ctime  int64               `json:"ctime"`
+	Ext    domain.ExtendFields `json:"ext"`
+}
+
+func (r *RedisFeedInboxCache) Add(ctx context.Context, events []domain.FeedEvent) error {
+	pipe := r.client.Pipeline()
+	for i, evt := range events {
+		member, err := r.member(evt)
+		if err != nil {
+			return err
+		}
+		pipe.Eval(ctx, luaInboxAdd, []string{r.key(evt.Uid, evt.Type)},
+			r.capacity, int64(inboxExpiration.Seconds()), evt.Ctime.UnixMilli(), member)
+		// 粉丝多的时候分批发，避免一个 pipeline 太大
+		if (i+1)%inboxPipelineSize == 0 {
+			_, err = pipe.Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *RedisFeedInboxCache) Range(ctx context.Context,
+	uid int64, typ string, timestamp, limit int64) ([]domain.FeedEvent, bool, error) {
+	key := r.key(uid, typ)
+	pipe := r.client.Pipeline()
+	cardCmd := pipe.ZCard(ctx, key)
+	rangeCmd := pipe.ZRevRangeByScore(ctx, key, &redis.ZRangeBy{
+		Max:   "(" + strconv.FormatInt(timestamp, 10),
+		Min:   "-inf",
+		Count: limit,
+	})
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	card := cardCmd.Val()
+	if card == 0 {
+		return nil, false, ErrInboxNotFound
+	}
+	members := rangeCmd.Val()
+	res := make([]domain.FeedEvent, 0, len(members))
+	for _, m := range members {
+		var item inboxItem
+		if json.Unmarshal([]byte(m), &item) != nil {
+			continue
+		}
+		res = append(res, domain.FeedEvent{
+			ID:     item.Id,
+			Uid:    uid,
+			Author: item.Author,
+			Type:   item.Type,
+			Ctime:  time.UnixMilli(item.Ctime),
+			Ext:    item.Ext,
+		})
+	}
+	// 减掉占位的那个
+	return res, card-1 >= r.capacity, nil
+}
+
+func (r *RedisFeedInboxCache) Rebuild(ctx context.Context, uid int64, typ string, events []domain.FeedEvent) error {
+	zs := make([]redis.Z, 0, len(events)+1)
+	zs = append(zs, redis.Z{Score: math.Inf(1), Member: inboxPlaceholder})
+	for _, evt := range events {
+		member, err := r.member(evt)
+		if err != nil {
+			return err
+		}
+		zs = append(zs, redis.Z{Score: float64(evt.Ctime.UnixMilli()), Member: member})
+	}
+	key := r.key(uid, typ)
+	// 不删除旧的 key，重建期间 Add 进来的数据也能保留下来
+	pipe := r.client.TxPipeline()
+	pipe.ZAdd(ctx, key, zs...)
+	pipe.ZRemRangeByRank(ctx, key, 0, -(r.capacity + 2))
+	pipe.Expire(ctx, key, inboxExpiration)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *RedisFeedInboxCache) Capacity() int64 {
+	return r.capacity
+}
+
+func (r *RedisFeedInboxCache) member(evt domain.FeedEvent) (string, error) {
+	val, err := json.Marshal(inboxItem{
+		Id:     evt.ID,
+		Author: evt.Author,
+		Type:   evt.Type,
+		Ctime:  evt.Ctime.UnixMilli(),
+		Ext:    evt.Ext,
+	})
+	return string(val), err
+}
+
+func (r *RedisFeedInboxCache) key(uid int64, typ string) string {
+	return fmt.Sprintf("feed:inbox:%d:%s", uid, typ)
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"basic-go/lmbook/feed/domain"
+	"basic-go/lmbook/feed/repository/cache/redismocks"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRedisFeedInboxCache_Add(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cmd := redismocks.NewMockCmdable(ctrl)
+	pipe := redismocks.NewMockPipeliner(ctrl)
+	now := time.Unix(100, 0)
+	events := []domain.FeedEvent{
+		{ID: 1, Uid: 2, Author: 3, Type: "article_event", Ctime: now},
+		{ID: 2, Uid: 4, Author: 3, Type: "like_event", Ctime: now},
+	}
+	cmd.EXPECT().Pipeline().Return(pipe)
+	// 每种事件一个收件箱，写进去的时候顺便续期
+	pipe.EXPECT().Eval(gomock.Any(), luaInboxAdd, []string{"feed:inbox:2:article_event"},
+		int64(10), int64(inboxExpiration.Seconds()), int64(100000),
+		`{"id":1,"author":3,"type":"article_event","ctime":100000,"ext":null}`).
+		Return(redis.NewCmdResult(int64(1), nil))
+	pipe.EXPECT().Eval(gomock.Any(), luaInboxAdd, []string{"feed:inbox:4:like_event"},
+		int64(10), int64(inboxExpiration.Seconds()), int64(100000),
+		`{"id":2,"author":3,"type":"like_event","ctime":100000,"ext":null}`).
+		Return(redis.NewCmdResult(int64(0), nil))
+	pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+	c := NewRedisFeedInboxCache(cmd, 10)
+	err := c.Add(context.Background(), events)
+	require.NoError(t, err)
+}
+
+func TestRedisFeedInboxCache_Range(t *testing.T) {
+	testCases := []struct {
+		name string
+		card int64
+
+		wantEvents []domain.FeedEvent
+		wantFull   bool
+		wantErr    error
+	}{
+		{
+			name:       "收件箱没满",
+			card:       2,
+			wantEvents: []domain.FeedEvent{{ID: 1, Uid: 2, Author: 3, Type: "article_event", Ctime: time.UnixMilli(90)}},
+		},
+		{
+			// 加上占位的一共 capacity + 1 个
+			name:       "收件箱满了",
+			card:       3,
+			wantEvents: []domain.FeedEvent{{ID: 1, Uid: 2, Author: 3, Type: "article_event", Ctime: time.UnixMilli(90)}},
+			wantFull:   true,
+		},
+		{
+			name:    "收件箱没有加载",
+			wantErr: ErrInboxNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cmd := redismocks.NewMockCmdable(ctrl)
+			pipe := redismocks.NewMockPipeliner(ctrl)
+			cmd.EXPECT().Pipeline().Return(pipe)
+			pipe.EXPECT().ZCard(gomock.Any(), "feed:inbox:2:article_event").
+				Return(redis.NewIntResult(tc.card, nil))
+			pipe.EXPECT().ZRevRangeByScore(gomock.Any(), "feed:inbox:2:article_event", &redis.ZRangeBy{
+				Max:   "(100",
+				Min:   "-inf",
+				Count: 1,
+			}).Return(redis.NewStringSliceResult([]string{
+				`{"id":1,"author":3,"type":"article_event","ctime":90,"ext":null}`,
+			}, nil))
+			pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+			c := NewRedisFeedInboxCache(cmd, 2)
+			events, full, err := c.Range(context.Background(), 2, "article_event", 100, 1)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantEvents, events)
+			assert.Equal(t, tc.wantFull, full)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+-- 收件箱不存在的时候不写，等读的时候从数据库重建，
+-- 不然只有这一条数据的收件箱会被当成完整的
+local key = KEYS[1]
+local capacity = tonumber(ARGV[1])
+local expiration = tonumber(ARGV[2])
+if redis.call("EXISTS", key) == 0 then
+    return 0
+end
+for i = 3, #ARGV, 2 do
+    redis.call("ZADD", key, ARGV[i], ARGV[i + 1])
+end
+-- 占位的成员分数是 +inf，一直排在最前面，所以要多留一个
+redis.call("ZREMRANGEBYRANK", key, 0, -(capacity + 2))
+-- 续期，不然一直有新事件推进来的收件箱也会到期，然后整个从数据库重建
+redis.call("EXPIRE", key, expiration)
+return 1
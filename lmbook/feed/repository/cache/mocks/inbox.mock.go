@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./inbox.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./inbox.go -package=cachemocks -destination=./mocks/inbox.mock.go
+//
+// Package cachemocks is a generated GoMock package.
+package cachemocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "basic-go/lmbook/feed/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedInboxCache is a mock of FeedInboxCache interface.
+type MockFeedInboxCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedInboxCacheMockRecorder
+}
+
+// MockFeedInboxCacheMockRecorder is the mock recorder for MockFeedInboxCache.
+type MockFeedInboxCacheMockRecorder struct {
+	mock *MockFeedInboxCache
+}
+
+// NewMockFeedInboxCache creates a new mock instance.
+func NewMockFeedInboxCache(ctrl *gomock.Controller) *MockFeedInboxCache {
+	mock := &MockFeedInboxCache{ctrl: ctrl}
+	mock.recorder = &MockFeedInboxCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedInboxCache) EXPECT() *MockFeedInboxCacheMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockFeedInboxCache) Add(ctx context.Context, events []domain.FeedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockFeedInboxCacheMockRecorder) Add(ctx, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockFeedInboxCache)(nil).Add), ctx, events)
+}
+
+// Capacity mocks base method.
+func (m *MockFeedInboxCache) Capacity() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capacity")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// Capacity indicates an expected call of Capacity.
+func (mr *MockFeedInboxCacheMockRecorder) Capacity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capacity", reflect.TypeOf((*MockFeedInboxCache)(nil).Capacity))
+}
+
+// Range mocks base method.
+func (m *MockFeedInboxCache) Range(ctx context.Context, uid int64, typ string, timestamp, limit int64) ([]domain.FeedEvent, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Range", ctx, uid, typ, timestamp, limit)
+	ret0, _ := ret[0].([]domain.FeedEvent)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Range indicates an expected call of Range.
+func (mr *MockFeedInboxCacheMockRecorder) Range(ctx, uid, typ, timestamp, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Range", reflect.TypeOf((*MockFeedInboxCache)(nil).Range), ctx, uid, typ, timestamp, limit)
+}
+
+// Rebuild mocks base method.
+func (m *MockFeedInboxCache) Rebuild(ctx context.Context, uid int64, typ string, events []domain.FeedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebuild", ctx, uid, typ, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rebuild indicates an expected call of Rebuild.
+func (mr *MockFeedInboxCacheMockRecorder) Rebuild(ctx, uid, typ, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebuild", reflect.TypeOf((*MockFeedInboxCache)(nil).Rebuild), ctx, uid, typ, events)
+}
@@ -10,6 +10,8 @@ type FeedPullEventDAO interface {
 	CreatePullEvent(ctx context.Context, event FeedPullEvent) error
 	FindPullEventList(ctx context.Context, uids []int64, timestamp, limit int64) ([]FeedPullEvent, error)
 	FindPullEventListWithTyp(ctx context.Context, typ string, uids []int64, timestamp, limit int64) ([]FeedPullEvent, error)
+	// FindPullAuthors uids 里面发件箱不为空的人
+	FindPullAuthors(ctx context.Context, uids []int64) ([]int64, error)
 }
 
 // FeedPullEvent 发件箱
@@ -20,7 +22,8 @@ type FeedPullEvent struct {
 	Type string
 	// 这边放的就是关键的扩展字段，不同的事件类型，有不同的解析方式
 	Content string
-	Ctime   int64
+	// 创建时间，毫秒
+	Ctime int64
 	// 正常来说，这个表的数据是不会被更新的
 	//Utime int64
 
@@ -63,3 +66,12 @@ func (f *feedPullEventDAO) FindPullEventList(ctx context.Context, uids []int64,
 		Find(&events).Error
 	return events, err
 }
+
+func (f *feedPullEventDAO) FindPullAuthors(ctx context.Context, uids []int64) ([]int64, error) {
+	var res []int64
+	err := f.db.WithContext(ctx).Model(&FeedPullEvent{}).
+		Distinct("uid").
+		Where("uid in ?", uids).
+		Pluck("uid", &res).Error
+	return res, err
+}
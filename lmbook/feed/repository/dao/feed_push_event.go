@@ -10,17 +10,22 @@ type FeedPushEventDAO interface {
 	CreatePushEvents(ctx context.Context, events []FeedPushEvent) error
 	GetPushEvents(ctx context.Context, uid int64, timestamp, limit int64) ([]FeedPushEvent, error)
 	GetPushEventsWithTyp(ctx context.Context, typ string, uid int64, timestamp, limit int64) ([]FeedPushEvent, error)
+	// GetPushEventsByAuthors 收件箱里面，只看某些人发出来的事件
+	GetPushEventsByAuthors(ctx context.Context, uid int64, authors []int64, timestamp, limit int64) ([]FeedPushEvent, error)
 }
 
 // FeedPushEvent 对应的是收件箱
 type FeedPushEvent struct {
 	Id int64 `gorm:"primaryKey,autoIncrement"`
 	// 收件人
-	UID  int64 `gorm:"index;column:uid"`
-	Type string
+	UID int64 `gorm:"index;column:uid;index:uid_author,priority:1"`
+	// 发件人，按照关注分组看动态的时候用
+	Author int64 `gorm:"index:uid_author,priority:2"`
+	Type   string
 	// 这边放的就是关键的扩展字段，不同的事件类型，有不同的解析方式
 	Content string
-	Ctime   int64
+	// 创建时间，毫秒
+	Ctime int64
 	// 正常来说，这个表的数据是不会被更新的
 	//Utime int64
 }
@@ -47,6 +52,18 @@ func (f *feedPushEventDAO) GetPushEventsWithTyp(ctx context.Context, typ string,
 	return events, err
 }
 
+func (f *feedPushEventDAO) GetPushEventsByAuthors(ctx context.Context, uid int64, authors []int64, timestamp, limit int64) ([]FeedPushEvent, error) {
+	var events []FeedPushEvent
+	err := f.db.WithContext(ctx).
+		Where("uid = ?", uid).
+		Where("author IN ?", authors).
+		Where("ctime < ?", timestamp).
+		Order("ctime desc").
+		Limit(int(limit)).
+		Find(&events).Error
+	return events, err
+}
+
 func (f *feedPushEventDAO) CreatePushEvents(ctx context.Context, events []FeedPushEvent) error {
 	return f.db.WithContext(ctx).Create(events).Error
 }
@@ -1,8 +1,130 @@
 package dao
 
-import "gorm.io/gorm"
+import (
+	"encoding/json"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// backfillBatch 回填、转换数据的时候每次处理多少条
+const backfillBatch = 500
+
+// articleEventType 只有发表文章的事件是关注的人产生的，其它事件的 author 本来就是 0
+const articleEventType = "article_event"
+
+// milliCtimeFloor ctime 原来存的是秒，改成了毫秒。
+// 秒数要到很久以后才会超过这个值，而毫秒数早就超过了，所以比它小的就是还没有转换的
+const milliCtimeFloor = int64(1e11)
 
 func InitTables(db *gorm.DB) error {
-	return db.AutoMigrate(
+	// 加 author 字段之前的收件箱事件，author 都是 0，建字段的时候顺便回填
+	backfill := !db.Migrator().HasColumn(&FeedPushEvent{}, "Author")
+	err := db.AutoMigrate(
 		&FeedPullEvent{}, &FeedPushEvent{})
+	if err != nil {
+		return err
+	}
+	if backfill {
+		err = BackfillPushEventAuthor(db)
+		if err != nil {
+			return err
+		}
+	}
+	// 最早的一条已经是毫秒了，说明转换过了，不用每次启动都扫一遍全表
+	for _, tbl := range []any{&FeedPullEvent{}, &FeedPushEvent{}} {
+		var first struct{ Ctime int64 }
+		err = db.Model(tbl).Select("ctime").Order("id ASC").Limit(1).Scan(&first).Error
+		if err != nil {
+			return err
+		}
+		if first.Ctime > 0 && first.Ctime < milliCtimeFloor {
+			err = MigrateCtimeToMilli(db, tbl)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MigrateCtimeToMilli 按照 id 分批把 ctime 从秒转换成毫秒，只处理还是秒的，所以重复执行也没有问题。
+// 滚动发布的时候老的实例还会写入秒，全部发布完之后要再手动调用一次
+func MigrateCtimeToMilli(db *gorm.DB, tbl any) error {
+	var maxId int64
+	for {
+		var ids []int64
+		err := db.Model(tbl).
+			Where("id > ? AND ctime > ? AND ctime < ?", maxId, 0, milliCtimeFloor).
+			Order("id ASC").
+			Limit(backfillBatch).
+			Pluck("id", &ids).Error
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		err = db.Model(tbl).
+			Where("id IN ?", ids).
+			Update("ctime", gorm.Expr("ctime * ?", 1000)).Error
+		if err != nil {
+			return err
+		}
+		if len(ids) < backfillBatch {
+			return nil
+		}
+		maxId = ids[len(ids)-1]
+	}
+}
+
+// BackfillPushEventAuthor 按照 id 分批，从扩展字段里面的 followee 解析出发表文章的人，回填到 author 上。
+// 只处理 author 还是 0 的，所以重复执行也没有问题，回填中途失败了可以再手动调用一次
+func BackfillPushEventAuthor(db *gorm.DB) error {
+	var maxId int64
+	for {
+		var events []FeedPushEvent
+		err := db.Select("id", "content").
+			Where("id > ? AND type = ? AND author = ?", maxId, articleEventType, 0).
+			Order("id ASC").
+			Limit(backfillBatch).
+			Find(&events).Error
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		// 同一个人发的文章会推给很多粉丝，按照 author 合并成一条 UPDATE
+		ids := make(map[int64][]int64, len(events))
+		for _, evt := range events {
+			var ext map[string]string
+			if json.Unmarshal([]byte(evt.Content), &ext) != nil {
+				continue
+			}
+			author, err := strconv.ParseInt(ext["followee"], 10, 64)
+			if err != nil || author <= 0 {
+				continue
+			}
+			ids[author] = append(ids[author], evt.Id)
+		}
+		err = db.Transaction(func(tx *gorm.DB) error {
+			for author, eids := range ids {
+				err := tx.Model(&FeedPushEvent{}).
+					Where("id IN ?", eids).
+					Update("author", author).Error
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(events) < backfillBatch {
+			return nil
+		}
+		maxId = events[len(events)-1].Id
+	}
 }
@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestBackfillPushEventAuthor(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// 每个连接都是一个新的内存数据库
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&FeedPushEvent{}))
+	// 模拟加字段之前的数据，author 都是 0
+	events := []FeedPushEvent{
+		{Id: 1, UID: 10, Type: articleEventType, Content: `{"followee":"100","biz_id":"1"}`},
+		{Id: 2, UID: 11, Type: articleEventType, Content: `{"followee":"100","biz_id":"1"}`},
+		{Id: 3, UID: 10, Type: articleEventType, Content: `{"followee":"200","biz_id":"2"}`},
+		// 不是发表文章的不处理
+		{Id: 4, UID: 10, Type: "like_event", Content: `{"liked":"10","liker":"300"}`},
+		// 解析不出来的跳过
+		{Id: 5, UID: 10, Type: articleEventType, Content: `{}`},
+		// 已经有 author 的不动
+		{Id: 6, UID: 10, Author: 400, Type: articleEventType, Content: `{"followee":"100"}`},
+	}
+	require.NoError(t, db.Create(&events).Error)
+
+	require.NoError(t, BackfillPushEventAuthor(db))
+	var res []FeedPushEvent
+	require.NoError(t, db.Order("id ASC").Find(&res).Error)
+	authors := make(map[int64]int64, len(res))
+	for _, evt := range res {
+		authors[evt.Id] = evt.Author
+	}
+	assert.Equal(t, map[int64]int64{1: 100, 2: 100, 3: 200, 4: 0, 5: 0, 6: 400}, authors)
+}
+
+func TestMigrateCtimeToMilli(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&FeedPushEvent{}))
+	events := []FeedPushEvent{
+		// 原来存的是秒
+		{Id: 1, UID: 10, Type: articleEventType, Ctime: 1700000000},
+		{Id: 2, UID: 10, Type: articleEventType, Ctime: 1700000001},
+		// 已经是毫秒的不动
+		{Id: 3, UID: 10, Type: articleEventType, Ctime: 1700000002000},
+	}
+	require.NoError(t, db.Create(&events).Error)
+
+	require.NoError(t, MigrateCtimeToMilli(db, &FeedPushEvent{}))
+	// 重复执行也没有问题
+	require.NoError(t, MigrateCtimeToMilli(db, &FeedPushEvent{}))
+	var res []FeedPushEvent
+	require.NoError(t, db.Order("id ASC").Find(&res).Error)
+	ctimes := make([]int64, 0, len(res))
+	for _, evt := range res {
+		ctimes = append(ctimes, evt.Ctime)
+	}
+	assert.Equal(t, []int64{1700000000000, 1700000001000, 1700000002000}, ctimes)
+}
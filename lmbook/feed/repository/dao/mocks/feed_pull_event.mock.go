@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./feed_pull_event.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./feed_pull_event.go -package=daomocks -destination=./mocks/feed_pull_event.mock.go
+//
+// Package daomocks is a generated GoMock package.
+package daomocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dao "basic-go/lmbook/feed/repository/dao"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedPullEventDAO is a mock of FeedPullEventDAO interface.
+type MockFeedPullEventDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedPullEventDAOMockRecorder
+}
+
+// MockFeedPullEventDAOMockRecorder is the mock recorder for MockFeedPullEventDAO.
+type MockFeedPullEventDAOMockRecorder struct {
+	mock *MockFeedPullEventDAO
+}
+
+// NewMockFeedPullEventDAO creates a new mock instance.
+func NewMockFeedPullEventDAO(ctrl *gomock.Controller) *MockFeedPullEventDAO {
+	mock := &MockFeedPullEventDAO{ctrl: ctrl}
+	mock.recorder = &MockFeedPullEventDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedPullEventDAO) EXPECT() *MockFeedPullEventDAOMockRecorder {
+	return m.recorder
+}
+
+// CreatePullEvent mocks base method.
+func (m *MockFeedPullEventDAO) CreatePullEvent(ctx context.Context, event dao.FeedPullEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePullEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePullEvent indicates an expected call of CreatePullEvent.
+func (mr *MockFeedPullEventDAOMockRecorder) CreatePullEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullEvent", reflect.TypeOf((*MockFeedPullEventDAO)(nil).CreatePullEvent), ctx, event)
+}
+
+// FindPullAuthors mocks base method.
+func (m *MockFeedPullEventDAO) FindPullAuthors(ctx context.Context, uids []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPullAuthors", ctx, uids)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPullAuthors indicates an expected call of FindPullAuthors.
+func (mr *MockFeedPullEventDAOMockRecorder) FindPullAuthors(ctx, uids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPullAuthors", reflect.TypeOf((*MockFeedPullEventDAO)(nil).FindPullAuthors), ctx, uids)
+}
+
+// FindPullEventList mocks base method.
+func (m *MockFeedPullEventDAO) FindPullEventList(ctx context.Context, uids []int64, timestamp, limit int64) ([]dao.FeedPullEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPullEventList", ctx, uids, timestamp, limit)
+	ret0, _ := ret[0].([]dao.FeedPullEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPullEventList indicates an expected call of FindPullEventList.
+func (mr *MockFeedPullEventDAOMockRecorder) FindPullEventList(ctx, uids, timestamp, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPullEventList", reflect.TypeOf((*MockFeedPullEventDAO)(nil).FindPullEventList), ctx, uids, timestamp, limit)
+}
+
+// FindPullEventListWithTyp mocks base method.
+func (m *MockFeedPullEventDAO) FindPullEventListWithTyp(ctx context.Context, typ string, uids []int64, timestamp, limit int64) ([]dao.FeedPullEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPullEventListWithTyp", ctx, typ, uids, timestamp, limit)
+	ret0, _ := ret[0].([]dao.FeedPullEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPullEventListWithTyp indicates an expected call of FindPullEventListWithTyp.
+func (mr *MockFeedPullEventDAOMockRecorder) FindPullEventListWithTyp(ctx, typ, uids, timestamp, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPullEventListWithTyp", reflect.TypeOf((*MockFeedPullEventDAO)(nil).FindPullEventListWithTyp), ctx, typ, uids, timestamp, limit)
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./feed_push_event.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./feed_push_event.go -package=daomocks -destination=./mocks/feed_push_event.mock.go
+//
+// Package daomocks is a generated GoMock package.
+package daomocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dao "basic-go/lmbook/feed/repository/dao"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedPushEventDAO is a mock of FeedPushEventDAO interface.
+type MockFeedPushEventDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedPushEventDAOMockRecorder
+}
+
+// MockFeedPushEventDAOMockRecorder is the mock recorder for MockFeedPushEventDAO.
+type MockFeedPushEventDAOMockRecorder struct {
+	mock *MockFeedPushEventDAO
+}
+
+// NewMockFeedPushEventDAO creates a new mock instance.
+func NewMockFeedPushEventDAO(ctrl *gomock.Controller) *MockFeedPushEventDAO {
+	mock := &MockFeedPushEventDAO{ctrl: ctrl}
+	mock.recorder = &MockFeedPushEventDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedPushEventDAO) EXPECT() *MockFeedPushEventDAOMockRecorder {
+	return m.recorder
+}
+
+// CreatePushEvents mocks base method.
+func (m *MockFeedPushEventDAO) CreatePushEvents(ctx context.Context, events []dao.FeedPushEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePushEvents", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePushEvents indicates an expected call of CreatePushEvents.
+func (mr *MockFeedPushEventDAOMockRecorder) CreatePushEvents(ctx, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePushEvents", reflect.TypeOf((*MockFeedPushEventDAO)(nil).CreatePushEvents), ctx, events)
+}
+
+// GetPushEvents mocks base method.
+func (m *MockFeedPushEventDAO) GetPushEvents(ctx context.Context, uid, timestamp, limit int64) ([]dao.FeedPushEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPushEvents", ctx, uid, timestamp, limit)
+	ret0, _ := ret[0].([]dao.FeedPushEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPushEvents indicates an expected call of GetPushEvents.
+func (mr *MockFeedPushEventDAOMockRecorder) GetPushEvents(ctx, uid, timestamp, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPushEvents", reflect.TypeOf((*MockFeedPushEventDAO)(nil).GetPushEvents), ctx, uid, timestamp, limit)
+}
+
+// GetPushEventsByAuthors mocks base method.
+func (m *MockFeedPushEventDAO) GetPushEventsByAuthors(ctx context.Context, uid int64, authors []int64, timestamp, limit int64) ([]dao.FeedPushEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPushEventsByAuthors", ctx, uid, authors, timestamp, limit)
+	ret0, _ := ret[0].([]dao.FeedPushEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPushEventsByAuthors indicates an expected call of GetPushEventsByAuthors.
+func (mr *MockFeedPushEventDAOMockRecorder) GetPushEventsByAuthors(ctx, uid, authors, timestamp, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPushEventsByAuthors", reflect.TypeOf((*MockFeedPushEventDAO)(nil).GetPushEventsByAuthors), ctx, uid, authors, timestamp, limit)
+}
+
+// GetPushEventsWithTyp mocks base method.
+func (m *MockFeedPushEventDAO) GetPushEventsWithTyp(ctx context.Context, typ string, uid, timestamp, limit int64) ([]dao.FeedPushEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPushEventsWithTyp", ctx, typ, uid, timestamp, limit)
+	ret0, _ := ret[0].([]dao.FeedPushEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPushEventsWithTyp indicates an expected call of GetPushEventsWithTyp.
+func (mr *MockFeedPushEventDAOMockRecorder) GetPushEventsWithTyp(ctx, typ, uid, timestamp, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPushEventsWithTyp", reflect.TypeOf((*MockFeedPushEventDAO)(nil).GetPushEventsWithTyp), ctx, typ, uid, timestamp, limit)
+}
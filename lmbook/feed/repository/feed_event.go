@@ -4,9 +4,11 @@ import (
 	"basic-go/lmbook/feed/domain"
 	"basic-go/lmbook/feed/repository/cache"
 	"basic-go/lmbook/feed/repository/dao"
+	"basic-go/lmbook/pkg/logger"
 	"context"
 	"encoding/json"
 	"errors"
+	"math"
 	"time"
 )
 
@@ -23,21 +25,38 @@ type FeedEventRepo interface {
 	FindPushEvents(ctx context.Context, uid, timestamp, limit int64) ([]domain.FeedEvent, error)
 	// FindPullEventsWithTyp 获取某个类型的拉事件，
 	FindPullEventsWithTyp(ctx context.Context, typ string, uids []int64, timestamp, limit int64) ([]domain.FeedEvent, error)
-	// FindPushEvents 获取某个类型的推事件，也就
+	// FindPushEventsWithTyp 获取某个类型的推事件，优先从 Redis 的收件箱里面读
 	FindPushEventsWithTyp(ctx context.Context, typ string, uid, timestamp, limit int64) ([]domain.FeedEvent, error)
+	// FindPushEventsByAuthors 获取自己收件箱里面，某些人发出来的事件
+	FindPushEventsByAuthors(ctx context.Context, uid int64, authors []int64, timestamp, limit int64) ([]domain.FeedEvent, error)
+	// MarkActive 记录用户最近一次看 feed 的时间，大 V 发事件的时候给活跃粉丝额外推一份
+	MarkActive(ctx context.Context, uid int64, t time.Time) error
+	// FilterActive 在 uids 里面找出 since 之后看过 feed 的人
+	FilterActive(ctx context.Context, uids []int64, since time.Time) ([]int64, error)
+	// FindPullAuthors uids 里面发件箱不为空的人，也就是走拉模型的人
+	FindPullAuthors(ctx context.Context, uids []int64) ([]int64, error)
+	// SetFollowees 缓存某个人关注的人，读 feed 的时候不用每次都去查关注服务
+	SetFollowees(ctx context.Context, follower int64, followees []int64) error
+	// GetFollowees 缓存里面没有的时候返回 FolloweesNotFound
+	GetFollowees(ctx context.Context, follower int64) ([]int64, error)
 }
 
 type feedEventRepo struct {
-	pullDao   dao.FeedPullEventDAO
-	pushDao   dao.FeedPushEventDAO
-	feedCache cache.FeedEventCache
+	pullDao    dao.FeedPullEventDAO
+	pushDao    dao.FeedPushEventDAO
+	feedCache  cache.FeedEventCache
+	inboxCache cache.FeedInboxCache
+	l          logger.LoggerV1
 }
 
-func NewFeedEventRepo(pullDao dao.FeedPullEventDAO, pushDao dao.FeedPushEventDAO, feedCache cache.FeedEventCache) FeedEventRepo {
+func NewFeedEventRepo(pullDao dao.FeedPullEventDAO, pushDao dao.FeedPushEventDAO,
+	feedCache cache.FeedEventCache, inboxCache cache.FeedInboxCache, l logger.LoggerV1) FeedEventRepo {
 	return &feedEventRepo{
-		pullDao:   pullDao,
-		pushDao:   pushDao,
-		feedCache: feedCache,
+		pullDao:    pullDao,
+		pushDao:    pushDao,
+		feedCache:  feedCache,
+		inboxCache: inboxCache,
+		l:          l,
 	}
 }
 
@@ -53,8 +72,11 @@ func (f *feedEventRepo) FindPullEventsWithTyp(ctx context.Context, typ string, u
 	return ans, nil
 }
 
-func (f *feedEventRepo) FindPushEventsWithTyp(ctx context.Context, typ string, uid, timestamp, limit int64) ([]domain.FeedEvent, error) {
-	events, err := f.pushDao.GetPushEventsWithTyp(ctx, typ, uid, timestamp, limit)
+func (f *feedEventRepo) FindPushEventsByAuthors(ctx context.Context, uid int64, authors []int64, timestamp, limit int64) ([]domain.FeedEvent, error) {
+	if len(authors) == 0 {
+		return []domain.FeedEvent{}, nil
+	}
+	events, err := f.pushDao.GetPushEventsByAuthors(ctx, uid, authors, timestamp, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +87,21 @@ func (f *feedEventRepo) FindPushEventsWithTyp(ctx context.Context, typ string, u
 	return ans, nil
 }
 
+func (f *feedEventRepo) MarkActive(ctx context.Context, uid int64, t time.Time) error {
+	return f.feedCache.MarkActive(ctx, uid, t)
+}
+
+func (f *feedEventRepo) FilterActive(ctx context.Context, uids []int64, since time.Time) ([]int64, error) {
+	return f.feedCache.FilterActive(ctx, uids, since)
+}
+
+func (f *feedEventRepo) FindPullAuthors(ctx context.Context, uids []int64) ([]int64, error) {
+	if len(uids) == 0 {
+		return []int64{}, nil
+	}
+	return f.pullDao.FindPullAuthors(ctx, uids)
+}
+
 func (f *feedEventRepo) SetFollowees(ctx context.Context, follower int64, followees []int64) error {
 	return f.feedCache.SetFollowees(ctx, follower, followees)
 }
@@ -101,12 +138,95 @@ func (f *feedEventRepo) FindPushEvents(ctx context.Context, uid, timestamp, limi
 	return ans, nil
 }
 
+func (f *feedEventRepo) FindPushEventsWithTyp(ctx context.Context, typ string, uid, timestamp, limit int64) ([]domain.FeedEvent, error) {
+	events, full, err := f.inboxCache.Range(ctx, uid, typ, timestamp, limit)
+	switch {
+	case err == nil:
+		// 收件箱没满说明数据库里面也没有更早的了
+		if int64(len(events)) >= limit || !full {
+			return events, nil
+		}
+	case errors.Is(err, cache.ErrInboxNotFound):
+		// 冷启动，从数据库里面重建收件箱，顺便把这一次的结果也算出来
+		events, ok := f.rebuildInbox(ctx, typ, uid, timestamp, limit)
+		if ok {
+			return events, nil
+		}
+	default:
+		f.l.Error("查询收件箱失败",
+			logger.Int64("uid", uid),
+			logger.String("type", typ),
+			logger.Error(err))
+	}
+	// 翻到了收件箱淘汰掉的部分，或者 Redis 出问题了
+	return f.findPushEventsFromDB(ctx, typ, uid, timestamp, limit)
+}
+
+// rebuildInbox 返回的 ok 为 false 的时候，要去数据库里面查
+func (f *feedEventRepo) rebuildInbox(ctx context.Context, typ string, uid, timestamp, limit int64) ([]domain.FeedEvent, bool) {
+	capacity := f.inboxCache.Capacity()
+	latest, err := f.findPushEventsFromDB(ctx, typ, uid, math.MaxInt64, capacity)
+	if err != nil {
+		return nil, false
+	}
+	err = f.inboxCache.Rebuild(ctx, uid, typ, latest)
+	if err != nil {
+		f.l.Error("重建收件箱失败",
+			logger.Int64("uid", uid),
+			logger.String("type", typ),
+			logger.Error(err))
+	}
+	res := make([]domain.FeedEvent, 0, limit)
+	for _, evt := range latest {
+		if evt.Ctime.UnixMilli() >= timestamp {
+			continue
+		}
+		res = append(res, evt)
+		if int64(len(res)) == limit {
+			return res, true
+		}
+	}
+	return res, int64(len(latest)) < capacity
+}
+
+func (f *feedEventRepo) findPushEventsFromDB(ctx context.Context, typ string, uid, timestamp, limit int64) ([]domain.FeedEvent, error) {
+	events, err := f.pushDao.GetPushEventsWithTyp(ctx, typ, uid, timestamp, limit)
+	if err != nil {
+		return nil, err
+	}
+	ans := make([]domain.FeedEvent, 0, len(events))
+	for _, e := range events {
+		ans = append(ans, convertToPushEventDomain(e))
+	}
+	return ans, nil
+}
+
 func (f *feedEventRepo) CreatePushEvents(ctx context.Context, events []domain.FeedEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
 	pushEvents := make([]dao.FeedPushEvent, 0, len(events))
 	for _, e := range events {
 		pushEvents = append(pushEvents, convertToPushEventDao(e))
 	}
-	return f.pushDao.CreatePushEvents(ctx, pushEvents)
+	err := f.pushDao.CreatePushEvents(ctx, pushEvents)
+	if err != nil {
+		return err
+	}
+	// 插入之后才有 ID
+	for i := range events {
+		events[i].ID = pushEvents[i].Id
+	}
+	err = f.inboxCache.Add(ctx, events)
+	if err != nil {
+		// 数据库已经写成功了，这里不返回错误，不然重试会重复推送。
+		// 收件箱会缺这几条，等过期之后重建就有了
+		f.l.Error("写入收件箱失败",
+			logger.String("type", events[0].Type),
+			logger.Int64("author", events[0].Author),
+			logger.Error(err))
+	}
+	return nil
 }
 
 func (f *feedEventRepo) CreatePullEvent(ctx context.Context, event domain.FeedEvent) error {
@@ -118,9 +238,10 @@ func convertToPushEventDao(event domain.FeedEvent) dao.FeedPushEvent {
 	return dao.FeedPushEvent{
 		Id:      event.ID,
 		UID:     event.Uid,
+		Author:  event.Author,
 		Type:    event.Type,
 		Content: string(val),
-		Ctime:   event.Ctime.Unix(),
+		Ctime:   event.Ctime.UnixMilli(),
 	}
 }
 
@@ -131,7 +252,7 @@ func convertToPullEventDao(event domain.FeedEvent) dao.FeedPullEvent {
 		UID:     event.Uid,
 		Type:    event.Type,
 		Content: string(val),
-		Ctime:   event.Ctime.Unix(),
+		Ctime:   event.Ctime.UnixMilli(),
 	}
 
 }
@@ -140,11 +261,12 @@ func convertToPushEventDomain(event dao.FeedPushEvent) domain.FeedEvent {
 	var ext map[string]string
 	_ = json.Unmarshal([]byte(event.Content), &ext)
 	return domain.FeedEvent{
-		ID:    event.Id,
-		Uid:   event.UID,
-		Type:  event.Type,
-		Ctime: time.Unix(event.Ctime, 0),
-		Ext:   ext,
+		ID:     event.Id,
+		Uid:    event.UID,
+		Author: event.Author,
+		Type:   event.Type,
+		Ctime:  time.UnixMilli(event.Ctime),
+		Ext:    ext,
 	}
 }
 
@@ -152,10 +274,11 @@ func convertToPullEventDomain(event dao.FeedPullEvent) domain.FeedEvent {
 	var ext map[string]string
 	_ = json.Unmarshal([]byte(event.Content), &ext)
 	return domain.FeedEvent{
-		ID:    event.Id,
-		Uid:   event.UID,
-		Type:  event.Type,
-		Ctime: time.Unix(event.Ctime, 0),
-		Ext:   ext,
+		ID:     event.Id,
+		Uid:    event.UID,
+		Author: event.UID,
+		Type:   event.Type,
+		Ctime:  time.UnixMilli(event.Ctime),
+		Ext:    ext,
 	}
 }
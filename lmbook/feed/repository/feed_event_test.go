@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"basic-go/lmbook/feed/domain"
+	"basic-go/lmbook/feed/repository/cache"
+	cachemocks "basic-go/lmbook/feed/repository/cache/mocks"
+	"basic-go/lmbook/feed/repository/dao"
+	daomocks "basic-go/lmbook/feed/repository/dao/mocks"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFeedEventRepo_FindPushEventsWithTyp(t *testing.T) {
+	const typ = "article_event"
+	evt := func(ctime int64) domain.FeedEvent {
+		return domain.FeedEvent{ID: ctime, Uid: 1, Author: 2, Type: typ, Ctime: time.UnixMilli(ctime)}
+	}
+	entity := func(ctime int64) dao.FeedPushEvent {
+		return dao.FeedPushEvent{Id: ctime, UID: 1, Author: 2, Type: typ, Ctime: ctime}
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (dao.FeedPushEventDAO, cache.FeedInboxCache)
+
+		want []domain.FeedEvent
+	}{
+		{
+			name: "收件箱里面有",
+			mock: func(ctrl *gomock.Controller) (dao.FeedPushEventDAO, cache.FeedInboxCache) {
+				pushDao := daomocks.NewMockFeedPushEventDAO(ctrl)
+				inbox := cachemocks.NewMockFeedInboxCache(ctrl)
+				inbox.EXPECT().Range(gomock.Any(), int64(1), typ, int64(100), int64(2)).
+					Return([]domain.FeedEvent{evt(90), evt(80)}, true, nil)
+				return pushDao, inbox
+			},
+			want: []domain.FeedEvent{evt(90), evt(80)},
+		},
+		{
+			name: "收件箱没满，不够也不用查数据库",
+			mock: func(ctrl *gomock.Controller) (dao.FeedPushEventDAO, cache.FeedInboxCache) {
+				pushDao := daomocks.NewMockFeedPushEventDAO(ctrl)
+				inbox := cachemocks.NewMockFeedInboxCache(ctrl)
+				inbox.EXPECT().Range(gomock.Any(), int64(1), typ, int64(100), int64(2)).
+					Return([]domain.FeedEvent{evt(90)}, false, nil)
+				return pushDao, inbox
+			},
+			want: []domain.FeedEvent{evt(90)},
+		},
+		{
+			name: "收件箱满了，翻到淘汰掉的部分查数据库",
+			mock: func(ctrl *gomock.Controller) (dao.FeedPushEventDAO, cache.FeedInboxCache) {
+				pushDao := daomocks.NewMockFeedPushEventDAO(ctrl)
+				inbox := cachemocks.NewMockFeedInboxCache(ctrl)
+				inbox.EXPECT().Range(gomock.Any(), int64(1), typ, int64(100), int64(2)).
+					Return([]domain.FeedEvent{evt(90)}, true, nil)
+				pushDao.EXPECT().GetPushEventsWithTyp(gomock.Any(), typ, int64(1), int64(100), int64(2)).
+					Return([]dao.FeedPushEvent{entity(90), entity(10)}, nil)
+				return pushDao, inbox
+			},
+			want: []domain.FeedEvent{evt(90), evt(10)},
+		},
+		{
+			name: "冷启动，重建收件箱",
+			mock: func(ctrl *gomock.Controller) (dao.FeedPushEventDAO, cache.FeedInboxCache) {
+				pushDao := daomocks.NewMockFeedPushEventDAO(ctrl)
+				inbox := cachemocks.NewMockFeedInboxCache(ctrl)
+				inbox.EXPECT().Range(gomock.Any(), int64(1), typ, int64(100), int64(2)).
+					Return(nil, false, cache.ErrInboxNotFound)
+				inbox.EXPECT().Capacity().Return(int64(3))
+				pushDao.EXPECT().GetPushEventsWithTyp(gomock.Any(), typ, int64(1), int64(math.MaxInt64), int64(3)).
+					Return([]dao.FeedPushEvent{entity(120), entity(90), entity(80)}, nil)
+				inbox.EXPECT().Rebuild(gomock.Any(), int64(1), typ,
+					[]domain.FeedEvent{evt(120), evt(90), evt(80)}).Return(nil)
+				return pushDao, inbox
+			},
+			// 比 timestamp 新的不要
+			want: []domain.FeedEvent{evt(90), evt(80)},
+		},
+		{
+			name: "Redis 出错，查数据库",
+			mock: func(ctrl *gomock.Controller) (dao.FeedPushEventDAO, cache.FeedInboxCache) {
+				pushDao := daomocks.NewMockFeedPushEventDAO(ctrl)
+				inbox := cachemocks.NewMockFeedInboxCache(ctrl)
+				inbox.EXPECT().Range(gomock.Any(), int64(1), typ, int64(100), int64(2)).
+					Return(nil, false, errors.New("mock redis error"))
+				pushDao.EXPECT().GetPushEventsWithTyp(gomock.Any(), typ, int64(1), int64(100), int64(2)).
+					Return([]dao.FeedPushEvent{entity(90)}, nil)
+				return pushDao, inbox
+			},
+			want: []domain.FeedEvent{evt(90)},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			pushDao, inbox := tc.mock(ctrl)
+			repo := NewFeedEventRepo(nil, pushDao, nil, inbox, logger.NewNoOpLogger())
+			events, err := repo.FindPushEventsWithTyp(context.Background(), typ, 1, 100, 2)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, events)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./feed_event.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./feed_event.go -package=repomocks -destination=./mocks/feed_event.mock.go
+//
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "basic-go/lmbook/feed/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedEventRepo is a mock of FeedEventRepo interface.
+type MockFeedEventRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedEventRepoMockRecorder
+}
+
+// MockFeedEventRepoMockRecorder is the mock recorder for MockFeedEventRepo.
+type MockFeedEventRepoMockRecorder struct {
+	mock *MockFeedEventRepo
+}
+
+// NewMockFeedEventRepo creates a new mock instance.
+func NewMockFeedEventRepo(ctrl *gomock.Controller) *MockFeedEventRepo {
+	mock := &MockFeedEventRepo{ctrl: ctrl}
+	mock.recorder = &MockFeedEventRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedEventRepo) EXPECT() *MockFeedEventRepoMockRecorder {
+	return m.recorder
+}
+
+// CreatePullEvent mocks base method.
+func (m *MockFeedEventRepo) CreatePullEvent(ctx context.Context, event domain.FeedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePullEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePullEvent indicates an expected call of CreatePullEvent.
+func (mr *MockFeedEventRepoMockRecorder) CreatePullEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullEvent", reflect.TypeOf((*MockFeedEventRepo)(nil).CreatePullEvent), ctx, event)
+}
+
+// CreatePushEvents mocks base method.
+func (m *MockFeedEventRepo) CreatePushEvents(ctx context.Context, events []domain.FeedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePushEvents", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePushEvents indicates an expected call of CreatePushEvents.
+func (mr *MockFeedEventRepoMockRecorder) CreatePushEvents(ctx, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePushEvents", reflect.TypeOf((*MockFeedEventRepo)(nil).CreatePushEvents), ctx, events)
+}
+
+// FilterActive mocks base method.
+func (m *MockFeedEventRepo) FilterActive(ctx context.Context, uids []int64, since time.Time) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterActive", ctx, uids, since)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterActive indicates an expected call of FilterActive.
+func (mr *MockFeedEventRepoMockRecorder) FilterActive(ctx, uids, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterActive", reflect.TypeOf((*MockFeedEventRepo)(nil).FilterActive), ctx, uids, since)
+}
+
+// FindPullAuthors mocks base method.
+func (m *MockFeedEventRepo) FindPullAuthors(ctx context.Context, uids []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPullAuthors", ctx, uids)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPullAuthors indicates an expected call of FindPullAuthors.
+func (mr *MockFeedEventRepoMockRecorder) FindPullAuthors(ctx, uids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPullAuthors", reflect.TypeOf((*MockFeedEventRepo)(nil).FindPullAuthors), ctx, uids)
+}
+
+// FindPullEvents mocks base method.
+func (m *MockFeedEventRepo) FindPullEvents(ctx context.Context, uids []int64, timestamp, limit int64) ([]domain.FeedEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPullEvents", ctx, uids, timestamp, limit)
+	ret0, _ := ret[0].([]domain.FeedEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPullEvents indicates an expected call of FindPullEvents.
+func (mr *MockFeedEventRepoMockRecorder) FindPullEvents(ctx, uids, timestamp, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPullEvents", reflect.TypeOf((*MockFeedEventRepo)(nil).FindPullEvents), ctx, uids, timestamp, limit)
+}
+
+// FindPullEventsWithTyp mocks base method.
+func (m *MockFeedEventRepo) FindPullEventsWithTyp(ctx context.Context, typ string, uids []int64, timestamp, limit int64) ([]domain.FeedEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPullEventsWithTyp", ctx, typ, uids, timestamp, limit)
+	ret0, _ := ret[0].([]domain.FeedEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPullEventsWithTyp indicates an expected call of FindPullEventsWithTyp.
+func (mr *MockFeedEventRepoMockRecorder) FindPullEventsWithTyp(ctx, typ, uids, timestamp, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPullEventsWithTyp", reflect.TypeOf((*MockFeedEventRepo)(nil).FindPullEventsWithTyp), ctx, typ, uids, timestamp, limit)
+}
+
+// FindPushEvents mocks base method.
+func (m *MockFeedEventRepo) FindPushEvents(ctx context.Context, uid, timestamp, limit int64) ([]domain.FeedEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPushEvents", ctx, uid, timestamp, limit)
+	ret0, _ := ret[0].([]domain.FeedEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPushEvents indicates an expected call of FindPushEvents.
+func (mr *MockFeedEventRepoMockRecorder) FindPushEvents(ctx, uid, timestamp, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPushEvents", reflect.TypeOf((*MockFeedEventRepo)(nil).FindPushEvents), ctx, uid, timestamp, limit)
+}
+
+// FindPushEventsByAuthors mocks base method.
+func (m *MockFeedEventRepo) FindPushEventsByAuthors(ctx context.Context, uid int64, authors []int64, timestamp, limit int64) ([]domain.FeedEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPushEventsByAuthors", ctx, uid, authors, timestamp, limit)
+	ret0, _ := ret[0].([]domain.FeedEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPushEventsByAuthors indicates an expected call of FindPushEventsByAuthors.
+func (mr *MockFeedEventRepoMockRecorder) FindPushEventsByAuthors(ctx, uid, authors, timestamp, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPushEventsByAuthors", reflect.TypeOf((*MockFeedEventRepo)(nil).FindPushEventsByAuthors), ctx, uid, authors, timestamp, limit)
+}
+
+// FindPushEventsWithTyp mocks base method.
+func (m *MockFeedEventRepo) FindPushEventsWithTyp(ctx context.Context, typ string, uid, timestamp, limit int64) ([]domain.FeedEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPushEventsWithTyp", ctx, typ, uid, timestamp, limit)
+	ret0, _ := ret[0].([]domain.FeedEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPushEventsWithTyp indicates an expected call of FindPushEventsWithTyp.
+func (mr *MockFeedEventRepoMockRecorder) FindPushEventsWithTyp(ctx, typ, uid, timestamp, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPushEventsWithTyp", reflect.TypeOf((*MockFeedEventRepo)(nil).FindPushEventsWithTyp), ctx, typ, uid, timestamp, limit)
+}
+
+// GetFollowees mocks base method.
+func (m *MockFeedEventRepo) GetFollowees(ctx context.Context, follower int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowees", ctx, follower)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowees indicates an expected call of GetFollowees.
+func (mr *MockFeedEventRepoMockRecorder) GetFollowees(ctx, follower any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowees", reflect.TypeOf((*MockFeedEventRepo)(nil).GetFollowees), ctx, follower)
+}
+
+// MarkActive mocks base method.
+func (m *MockFeedEventRepo) MarkActive(ctx context.Context, uid int64, t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkActive", ctx, uid, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkActive indicates an expected call of MarkActive.
+func (mr *MockFeedEventRepoMockRecorder) MarkActive(ctx, uid, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkActive", reflect.TypeOf((*MockFeedEventRepo)(nil).MarkActive), ctx, uid, t)
+}
+
+// SetFollowees mocks base method.
+func (m *MockFeedEventRepo) SetFollowees(ctx context.Context, follower int64, followees []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFollowees", ctx, follower, followees)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFollowees indicates an expected call of SetFollowees.
+func (mr *MockFeedEventRepoMockRecorder) SetFollowees(ctx, follower, followees any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFollowees", reflect.TypeOf((*MockFeedEventRepo)(nil).SetFollowees), ctx, follower, followees)
+}
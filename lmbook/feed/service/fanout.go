@@ -0,0 +1,251 @@
+package service
+
+import (
+	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
+	"basic-go/lmbook/feed/domain"
+	"basic-go/lmbook/feed/repository"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"golang.org/x/sync/errgroup"
+	"sort"
+	"time"
+)
+
+type FanoutConfig struct {
+	// Threshold 粉丝数超过这个值就算大 V，大 V 的事件写发件箱，读的时候拉
+	Threshold int64 `yaml:"threshold"`
+	// ActiveWindow 多久之内看过 feed 的粉丝算活跃粉丝
+	ActiveWindow time.Duration `yaml:"activeWindow"`
+	// MaxPartialPush 大 V 最多给多少个活跃粉丝额外推一份
+	MaxPartialPush int `yaml:"maxPartialPush"`
+	// MaxScanFollowers 找活跃粉丝的时候最多看多少个粉丝
+	MaxScanFollowers int `yaml:"maxScanFollowers"`
+	// BatchSize 一次查询多少个粉丝
+	BatchSize int64 `yaml:"batchSize"`
+	// MaxPullFollowees 读的时候最多看多少个关注的人的发件箱
+	MaxPullFollowees int `yaml:"maxPullFollowees"`
+}
+
+// FanoutPolicy 推拉结合。
+// 粉丝少的人直接写扩散到粉丝的收件箱；
+// 大 V 只写自己的发件箱，粉丝读的时候去拉，
+// 同时给最近活跃的一部分粉丝额外推一份，他们刷 feed 的时候就不用去拉大 V 的发件箱了。
+// 所以读的时候同一个事件可能在两边都有，合并的时候要去重
+type FanoutPolicy struct {
+	repo         repository.FeedEventRepo
+	followClient followv1.FollowServiceClient
+	cfg          FanoutConfig
+	l            logger.LoggerV1
+}
+
+func NewFanoutPolicy(repo repository.FeedEventRepo,
+	followClient followv1.FollowServiceClient,
+	cfg FanoutConfig, l logger.LoggerV1) *FanoutPolicy {
+	return &FanoutPolicy{
+		repo:         repo,
+		followClient: followClient,
+		cfg:          cfg,
+		l:            l,
+	}
+}
+
+// Fanout evt 里面的 Author 是产生事件的人，Uid 不需要设置
+func (p *FanoutPolicy) Fanout(ctx context.Context, evt domain.FeedEvent) error {
+	resp, err := p.followClient.GetFollowStatic(ctx, &followv1.GetFollowStaticRequest{Followee: evt.Author})
+	if err != nil {
+		return err
+	}
+	if resp.GetFollowStatic().GetFollowers() <= p.cfg.Threshold {
+		// 推模型，也就是写扩散，粉丝不多，一次查出来
+		fresp, err := p.followClient.GetFollower(ctx, &followv1.GetFollowerRequest{Followee: evt.Author})
+		if err != nil {
+			return err
+		}
+		followers := slice.Map(fresp.FollowRelations, func(idx int, src *followv1.FollowRelation) int64 {
+			return src.Follower
+		})
+		return p.push(ctx, evt, followers)
+	}
+	// 拉模型
+	pullEvt := evt
+	pullEvt.Uid = evt.Author
+	err = p.repo.CreatePullEvent(ctx, pullEvt)
+	if err != nil {
+		return err
+	}
+	err = p.pushActive(ctx, evt)
+	if err != nil {
+		// 没推过去也没关系，读的时候还能从发件箱里面拉到
+		p.l.Error("给大 V 的活跃粉丝推送事件失败",
+			logger.Int64("author", evt.Author),
+			logger.String("type", evt.Type),
+			logger.Error(err))
+	}
+	return nil
+}
+
+// FindEvents 合并自己的收件箱和关注的人的发件箱
+func (p *FanoutPolicy) FindEvents(ctx context.Context, uid int64, typ string, timestamp, limit int64) ([]domain.FeedEvent, error) {
+	var eg errgroup.Group
+	var pullEvents, pushEvents []domain.FeedEvent
+	eg.Go(func() error {
+		authors, err := p.pullAuthors(ctx, uid)
+		if err != nil || len(authors) == 0 {
+			return err
+		}
+		pullEvents, err = p.repo.FindPullEventsWithTyp(ctx, typ, authors, timestamp, limit)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		pushEvents, err = p.repo.FindPushEventsWithTyp(ctx, typ, uid, timestamp, limit)
+		return err
+	})
+	err := eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	events := dedupEvents(append(pushEvents, pullEvents...))
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Ctime.UnixMilli() > events[j].Ctime.UnixMilli()
+	})
+	return events[:slice.Min[int]([]int{int(limit), len(events)})], nil
+}
+
+// pullAuthors uid 关注的人里面走拉模型的那部分。
+// 只有大 V 的发件箱里面有数据，所以只把他们带到查询条件里面；
+// 结果缓存一段时间，新关注的大 V 最多晚这么久才能拉到
+func (p *FanoutPolicy) pullAuthors(ctx context.Context, uid int64) ([]int64, error) {
+	authors, err := p.repo.GetFollowees(ctx, uid)
+	if err == nil {
+		return authors, nil
+	}
+	if !errors.Is(err, repository.FolloweesNotFound) {
+		p.l.Error("查询缓存的关注列表失败",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+	}
+	followees := make([]int64, 0, p.cfg.BatchSize)
+	var cursor string
+	for len(followees) < p.cfg.MaxPullFollowees {
+		resp, er := p.followClient.GetFollowee(ctx, &followv1.GetFolloweeRequest{
+			Follower: uid,
+			Limit:    p.cfg.BatchSize,
+			Cursor:   cursor,
+		})
+		if er != nil {
+			return nil, er
+		}
+		for _, r := range resp.FollowRelations {
+			followees = append(followees, r.Followee)
+		}
+		cursor = resp.NextCursor
+		if cursor == "" {
+			break
+		}
+	}
+	if len(followees) > p.cfg.MaxPullFollowees {
+		followees = followees[:p.cfg.MaxPullFollowees]
+	}
+	authors, err = p.repo.FindPullAuthors(ctx, followees)
+	if err != nil {
+		return nil, err
+	}
+	err = p.repo.SetFollowees(ctx, uid, authors)
+	if err != nil {
+		p.l.Error("缓存关注列表失败",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+	}
+	return authors, nil
+}
+
+// pushActive 分批查粉丝，挑出最近活跃的推过去
+func (p *FanoutPolicy) pushActive(ctx context.Context, evt domain.FeedEvent) error {
+	since := evt.Ctime.Add(-p.cfg.ActiveWindow)
+	active := make([]int64, 0, p.cfg.BatchSize)
+	var (
+		cursor  string
+		scanned int
+	)
+	for scanned < p.cfg.MaxScanFollowers && len(active) < p.cfg.MaxPartialPush {
+		resp, err := p.followClient.GetFollower(ctx, &followv1.GetFollowerRequest{
+			Followee: evt.Author,
+			Limit:    p.cfg.BatchSize,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			return err
+		}
+		followers := slice.Map(resp.FollowRelations, func(idx int, src *followv1.FollowRelation) int64 {
+			return src.Follower
+		})
+		scanned += len(followers)
+		uids, err := p.repo.FilterActive(ctx, followers, since)
+		if err != nil {
+			return err
+		}
+		active = append(active, uids...)
+		cursor = resp.NextCursor
+		if cursor == "" {
+			break
+		}
+	}
+	if len(active) > p.cfg.MaxPartialPush {
+		active = active[:p.cfg.MaxPartialPush]
+	}
+	return p.push(ctx, evt, active)
+}
+
+func (p *FanoutPolicy) push(ctx context.Context, evt domain.FeedEvent, followers []int64) error {
+	followers = p.excludeMuted(ctx, evt.Author, followers)
+	if len(followers) == 0 {
+		return nil
+	}
+	events := slice.Map(followers, func(idx int, follower int64) domain.FeedEvent {
+		pushEvt := evt
+		pushEvt.Uid = follower
+		return pushEvt
+	})
+	return p.repo.CreatePushEvents(ctx, events)
+}
+
+// excludeMuted 屏蔽、拉黑了作者的粉丝不用推。
+// 查询失败就照样推，读的时候还会再过滤一遍
+func (p *FanoutPolicy) excludeMuted(ctx context.Context, author int64, followers []int64) []int64 {
+	if len(followers) == 0 {
+		return followers
+	}
+	resp, err := p.followClient.FilterMuted(ctx, &followv1.FilterMutedRequest{
+		Target: author,
+		Uids:   followers,
+	})
+	if err != nil || len(resp.Uids) == 0 {
+		return followers
+	}
+	muted := make(map[int64]struct{}, len(resp.Uids))
+	for _, uid := range resp.Uids {
+		muted[uid] = struct{}{}
+	}
+	return slice.FilterDelete(followers, func(idx int, src int64) bool {
+		_, ok := muted[src]
+		return ok
+	})
+}
+
+// dedupEvents 保留第一次出现的
+func dedupEvents(events []domain.FeedEvent) []domain.FeedEvent {
+	seen := make(map[string]struct{}, len(events))
+	res := make([]domain.FeedEvent, 0, len(events))
+	for _, evt := range events {
+		key := evt.DedupKey()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		res = append(res, evt)
+	}
+	return res
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
+	followmocks "basic-go/lmbook/api/proto/gen/follow/v1/mocks"
+	"basic-go/lmbook/feed/domain"
+	"basic-go/lmbook/feed/repository"
+	repomocks "basic-go/lmbook/feed/repository/mocks"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFanoutPolicy_Fanout(t *testing.T) {
+	now := time.Now()
+	evt := domain.FeedEvent{
+		Author: 1,
+		Type:   ArticleEventName,
+		Ctime:  now,
+		Ext:    domain.ExtendFields{"aid": "10"},
+	}
+	pushTo := func(uids ...int64) []domain.FeedEvent {
+		res := make([]domain.FeedEvent, 0, len(uids))
+		for _, uid := range uids {
+			e := evt
+			e.Uid = uid
+			res = append(res, e)
+		}
+		return res
+	}
+	followers := func(uids ...int64) *followv1.GetFollowerResponse {
+		res := &followv1.GetFollowerResponse{}
+		for _, uid := range uids {
+			res.FollowRelations = append(res.FollowRelations, &followv1.FollowRelation{Follower: uid, Followee: 1})
+		}
+		return res
+	}
+	static := func(cnt int64) *followv1.GetFollowStaticResponse {
+		return &followv1.GetFollowStaticResponse{FollowStatic: &followv1.FollowStatic{Followers: cnt}}
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient)
+
+		wantErr error
+	}{
+		{
+			name: "粉丝数等于阈值，推给所有粉丝",
+			mock: func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient) {
+				repo := repomocks.NewMockFeedEventRepo(ctrl)
+				client := followmocks.NewMockFollowServiceClient(ctrl)
+				client.EXPECT().GetFollowStatic(gomock.Any(), gomock.Any()).Return(static(3), nil)
+				client.EXPECT().GetFollower(gomock.Any(), gomock.Any()).Return(followers(2, 3, 4), nil)
+				// 4 屏蔽了作者
+				client.EXPECT().FilterMuted(gomock.Any(), gomock.Any()).
+					Return(&followv1.FilterMutedResponse{Uids: []int64{4}}, nil)
+				repo.EXPECT().CreatePushEvents(gomock.Any(), pushTo(2, 3)).Return(nil)
+				return repo, client
+			},
+		},
+		{
+			name: "粉丝数超过阈值，写发件箱，只推给活跃粉丝",
+			mock: func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient) {
+				repo := repomocks.NewMockFeedEventRepo(ctrl)
+				client := followmocks.NewMockFollowServiceClient(ctrl)
+				client.EXPECT().GetFollowStatic(gomock.Any(), gomock.Any()).Return(static(4), nil)
+				pullEvt := evt
+				pullEvt.Uid = 1
+				repo.EXPECT().CreatePullEvent(gomock.Any(), pullEvt).Return(nil)
+				// 分批找活跃粉丝
+				first := followers(2, 3)
+				first.NextCursor = "3"
+				client.EXPECT().GetFollower(gomock.Any(), &followv1.GetFollowerRequest{Followee: 1, Limit: 2}).
+					Return(first, nil)
+				client.EXPECT().GetFollower(gomock.Any(), &followv1.GetFollowerRequest{Followee: 1, Limit: 2, Cursor: "3"}).
+					Return(followers(4, 5), nil)
+				since := now.Add(-time.Hour)
+				repo.EXPECT().FilterActive(gomock.Any(), []int64{2, 3}, since).Return([]int64{3}, nil)
+				repo.EXPECT().FilterActive(gomock.Any(), []int64{4, 5}, since).Return([]int64{5}, nil)
+				client.EXPECT().FilterMuted(gomock.Any(), gomock.Any()).
+					Return(&followv1.FilterMutedResponse{}, nil)
+				repo.EXPECT().CreatePushEvents(gomock.Any(), pushTo(3, 5)).Return(nil)
+				return repo, client
+			},
+		},
+		{
+			name: "给活跃粉丝推送失败，发件箱写成功了就算成功",
+			mock: func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient) {
+				repo := repomocks.NewMockFeedEventRepo(ctrl)
+				client := followmocks.NewMockFollowServiceClient(ctrl)
+				client.EXPECT().GetFollowStatic(gomock.Any(), gomock.Any()).Return(static(4), nil)
+				repo.EXPECT().CreatePullEvent(gomock.Any(), gomock.Any()).Return(nil)
+				client.EXPECT().GetFollower(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock rpc error"))
+				return repo, client
+			},
+		},
+		{
+			name: "查询粉丝数失败",
+			mock: func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient) {
+				repo := repomocks.NewMockFeedEventRepo(ctrl)
+				client := followmocks.NewMockFollowServiceClient(ctrl)
+				client.EXPECT().GetFollowStatic(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock rpc error"))
+				return repo, client
+			},
+			wantErr: errors.New("mock rpc error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, client := tc.mock(ctrl)
+			p := NewFanoutPolicy(repo, client, FanoutConfig{
+				Threshold:        3,
+				ActiveWindow:     time.Hour,
+				MaxPartialPush:   10,
+				MaxScanFollowers: 10,
+				BatchSize:        2,
+				MaxPullFollowees: 10,
+			}, logger.NewNoOpLogger())
+			err := p.Fanout(context.Background(), evt)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestFanoutPolicy_FindEvents(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	evt := func(author int64, sec int) domain.FeedEvent {
+		return domain.FeedEvent{
+			Author: author,
+			Type:   ArticleEventName,
+			Ctime:  now.Add(-time.Duration(sec) * time.Second),
+			Ext:    domain.ExtendFields{"aid": "10"},
+		}
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient)
+
+		wantAuthors []int64
+	}{
+		{
+			name: "缓存里面有走拉模型的人，推给活跃粉丝的那份去重",
+			mock: func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient) {
+				repo := repomocks.NewMockFeedEventRepo(ctrl)
+				client := followmocks.NewMockFollowServiceClient(ctrl)
+				repo.EXPECT().GetFollowees(gomock.Any(), int64(1)).Return([]int64{2}, nil)
+				repo.EXPECT().FindPullEventsWithTyp(gomock.Any(), ArticleEventName, []int64{2}, int64(100), int64(3)).
+					Return([]domain.FeedEvent{evt(2, 1), evt(2, 3)}, nil)
+				// 2 是大 V，第一条同时推到了收件箱里面
+				repo.EXPECT().FindPushEventsWithTyp(gomock.Any(), ArticleEventName, int64(1), int64(100), int64(3)).
+					Return([]domain.FeedEvent{evt(2, 1), evt(4, 2)}, nil)
+				return repo, client
+			},
+			wantAuthors: []int64{2, 4, 2},
+		},
+		{
+			name: "缓存没有，分批查关注的人，只带上有发件箱的人",
+			mock: func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient) {
+				repo := repomocks.NewMockFeedEventRepo(ctrl)
+				client := followmocks.NewMockFollowServiceClient(ctrl)
+				repo.EXPECT().GetFollowees(gomock.Any(), int64(1)).Return(nil, repository.FolloweesNotFound)
+				client.EXPECT().GetFollowee(gomock.Any(), &followv1.GetFolloweeRequest{Follower: 1, Limit: 2}).
+					Return(&followv1.GetFolloweeResponse{
+						FollowRelations: []*followv1.FollowRelation{{Followee: 2}, {Followee: 3}},
+						NextCursor:      "3",
+					}, nil)
+				// 到了上限就不再往后查了
+				client.EXPECT().GetFollowee(gomock.Any(), &followv1.GetFolloweeRequest{Follower: 1, Limit: 2, Cursor: "3"}).
+					Return(&followv1.GetFolloweeResponse{
+						FollowRelations: []*followv1.FollowRelation{{Followee: 4}, {Followee: 5}},
+						NextCursor:      "5",
+					}, nil)
+				repo.EXPECT().FindPullAuthors(gomock.Any(), []int64{2, 3, 4}).Return([]int64{3}, nil)
+				repo.EXPECT().SetFollowees(gomock.Any(), int64(1), []int64{3}).Return(nil)
+				repo.EXPECT().FindPullEventsWithTyp(gomock.Any(), ArticleEventName, []int64{3}, int64(100), int64(3)).
+					Return([]domain.FeedEvent{evt(3, 2)}, nil)
+				repo.EXPECT().FindPushEventsWithTyp(gomock.Any(), ArticleEventName, int64(1), int64(100), int64(3)).
+					Return([]domain.FeedEvent{evt(4, 1)}, nil)
+				return repo, client
+			},
+			wantAuthors: []int64{4, 3},
+		},
+		{
+			name: "没有关注大 V，不查发件箱",
+			mock: func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient) {
+				repo := repomocks.NewMockFeedEventRepo(ctrl)
+				client := followmocks.NewMockFollowServiceClient(ctrl)
+				repo.EXPECT().GetFollowees(gomock.Any(), int64(1)).Return([]int64{}, nil)
+				repo.EXPECT().FindPushEventsWithTyp(gomock.Any(), ArticleEventName, int64(1), int64(100), int64(3)).
+					Return([]domain.FeedEvent{evt(4, 1)}, nil)
+				return repo, client
+			},
+			wantAuthors: []int64{4},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, client := tc.mock(ctrl)
+			p := NewFanoutPolicy(repo, client, FanoutConfig{
+				BatchSize:        2,
+				MaxPullFollowees: 3,
+			}, logger.NewNoOpLogger())
+			events, err := p.FindEvents(context.Background(), 1, ArticleEventName, 100, 3)
+			require.NoError(t, err)
+			authors := make([]int64, 0, len(events))
+			for _, e := range events {
+				authors = append(authors, e.Author)
+			}
+			assert.Equal(t, tc.wantAuthors, authors)
+		})
+	}
+}
+
+func TestDedupEvents(t *testing.T) {
+	now := time.Now()
+	a := domain.FeedEvent{ID: 1, Uid: 2, Author: 3, Type: ArticleEventName, Ctime: now,
+		Ext: domain.ExtendFields{"aid": "10", "title": "t"}}
+	// 发件箱里面的同一个事件，ID 和 Uid 不一样
+	b := domain.FeedEvent{ID: 7, Uid: 3, Author: 3, Type: ArticleEventName, Ctime: now,
+		Ext: domain.ExtendFields{"title": "t", "aid": "10"}}
+	// 同一个作者的另一篇文章
+	c := domain.FeedEvent{ID: 8, Uid: 3, Author: 3, Type: ArticleEventName, Ctime: now,
+		Ext: domain.ExtendFields{"aid": "11", "title": "t"}}
+	assert.Equal(t, []domain.FeedEvent{a, c}, dedupEvents([]domain.FeedEvent{a, b, c}))
+}
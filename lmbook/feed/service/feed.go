@@ -4,12 +4,14 @@ import (
 	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
 	"basic-go/lmbook/feed/domain"
 	"basic-go/lmbook/feed/repository"
+	"basic-go/lmbook/pkg/logger"
 	"context"
 	"fmt"
 	"github.com/ecodeclub/ekit/slice"
 	"golang.org/x/sync/errgroup"
 	"sort"
 	"sync"
+	"time"
 )
 
 type feedService struct {
@@ -17,12 +19,23 @@ type feedService struct {
 	// 对应的 string 就是 type
 	handlerMap   map[string]Handler
 	followClient followv1.FollowServiceClient
+	l            logger.LoggerV1
 }
 
-func NewFeedService(repo repository.FeedEventRepo, handlerMap map[string]Handler) FeedService {
+const (
+	// maxGroupFollowees 按照分组看动态的时候，一个分组最多取多少个人
+	maxGroupFollowees = 2000
+	// maxMutedRounds 屏蔽的人的动态被过滤掉之后，最多再往后补查几次
+	maxMutedRounds = 3
+)
+
+func NewFeedService(repo repository.FeedEventRepo, handlerMap map[string]Handler,
+	followClient followv1.FollowServiceClient, l logger.LoggerV1) FeedService {
 	return &feedService{
-		repo:       repo,
-		handlerMap: handlerMap,
+		repo:         repo,
+		handlerMap:   handlerMap,
+		followClient: followClient,
+		l:            l,
 	}
 }
 
@@ -85,6 +98,7 @@ func (f *feedService) GetFeedEventListV1(ctx context.Context, uid int64, timesta
 	if err != nil {
 		return nil, err
 	}
+	events = dedupEvents(events)
 	// 你已经查询所有的数据，现在要排序
 	sort.Slice(events, func(i, j int) bool {
 		return events[i].Ctime.UnixMilli() > events[j].Ctime.UnixMilli()
@@ -92,7 +106,38 @@ func (f *feedService) GetFeedEventListV1(ctx context.Context, uid int64, timesta
 	return events[:slice.Min[int]([]int{int(limit), len(events)})], nil
 }
 
+// GetFeedEventList 每种事件交给对应的 Handler 去查，再合并到一起。
+// timestamp 是上一页最后一条的时间（毫秒），第一页传 0
 func (f *feedService) GetFeedEventList(ctx context.Context, uid int64, timestamp, limit int64) ([]domain.FeedEvent, error) {
+	if timestamp <= 0 {
+		// 第一页，查询条件是 ctime < timestamp，所以要加一毫秒
+		timestamp = time.Now().UnixMilli() + 1
+	}
+	// 记录不下来最多就是大 V 不会给他额外推，读的时候照样能拉到
+	_ = f.repo.MarkActive(ctx, uid, time.Now())
+	muted := f.mutedAuthors(ctx, uid)
+	// 屏蔽的人的动态过滤掉之后不够 limit 条，就接着往后查，最多查 maxMutedRounds 次
+	res := make([]domain.FeedEvent, 0, limit)
+	for i := 0; i < maxMutedRounds; i++ {
+		events, err := f.findHandlerEvents(ctx, uid, timestamp, limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, evt := range events {
+			if _, ok := muted[evt.Author]; !ok {
+				res = append(res, evt)
+			}
+		}
+		if int64(len(events)) < limit || int64(len(res)) >= limit {
+			break
+		}
+		timestamp = events[len(events)-1].Ctime.UnixMilli()
+	}
+	return res[:slice.Min[int]([]int{int(limit), len(res)})], nil
+}
+
+// findHandlerEvents 所有 Handler 的结果合并，按照时间倒序取 limit 条
+func (f *feedService) findHandlerEvents(ctx context.Context, uid, timestamp, limit int64) ([]domain.FeedEvent, error) {
 	var eg errgroup.Group
 	var lock sync.Mutex
 	events := make([]domain.FeedEvent, 0, limit*int64(len(f.handlerMap)))
@@ -113,9 +158,105 @@ func (f *feedService) GetFeedEventList(ctx context.Context, uid int64, timestamp
 	if err != nil {
 		return nil, err
 	}
-	// 你已经查询所有的数据，现在要排序
+	events = dedupEvents(events)
 	sort.Slice(events, func(i, j int) bool {
 		return events[i].Ctime.UnixMilli() > events[j].Ctime.UnixMilli()
 	})
 	return events[:slice.Min[int]([]int{int(limit), len(events)})], nil
 }
+
+// GetGroupFeedEventList 分组里面的人，大 V 的事件在发件箱，其余的在自己的收件箱里面，
+// 两边都只查分组里面的人
+func (f *feedService) GetGroupFeedEventList(ctx context.Context, uid int64,
+	filter domain.FolloweeFilter, timestamp, limit int64) ([]domain.FeedEvent, error) {
+	if timestamp <= 0 {
+		timestamp = time.Now().UnixMilli() + 1
+	}
+	followees, err := f.groupFollowees(ctx, uid, filter)
+	if err != nil {
+		return nil, err
+	}
+	// 屏蔽的人直接不查，这样过滤之后不会少于 limit 条
+	followees = excludeAuthors(followees, f.mutedAuthors(ctx, uid))
+	if len(followees) == 0 {
+		return []domain.FeedEvent{}, nil
+	}
+	var eg errgroup.Group
+	var pullEvents, pushEvents []domain.FeedEvent
+	eg.Go(func() error {
+		var er error
+		pullEvents, er = f.repo.FindPullEvents(ctx, followees, timestamp, limit)
+		return er
+	})
+	eg.Go(func() error {
+		var er error
+		pushEvents, er = f.repo.FindPushEventsByAuthors(ctx, uid, followees, timestamp, limit)
+		return er
+	})
+	err = eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	_ = f.repo.MarkActive(ctx, uid, time.Now())
+	events := dedupEvents(append(pushEvents, pullEvents...))
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Ctime.UnixMilli() > events[j].Ctime.UnixMilli()
+	})
+	return events[:slice.Min[int]([]int{int(limit), len(events)})], nil
+}
+
+// groupFollowees 分组里面关注的人
+func (f *feedService) groupFollowees(ctx context.Context, uid int64, filter domain.FolloweeFilter) ([]int64, error) {
+	const batchSize = 500
+	res := make([]int64, 0, batchSize)
+	var cursor string
+	for len(res) < maxGroupFollowees {
+		resp, err := f.followClient.GetFolloweeByGroup(ctx, &followv1.GetFolloweeByGroupRequest{
+			Follower: uid,
+			Gid:      filter.Gid,
+			Special:  filter.Special,
+			Limit:    batchSize,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range resp.FollowRelations {
+			res = append(res, r.Followee)
+		}
+		cursor = resp.NextCursor
+		if cursor == "" {
+			break
+		}
+	}
+	return res, nil
+}
+
+// mutedAuthors uid 屏蔽、拉黑的人。
+// 推的时候已经过滤过一遍，读的时候主要是针对大 V 的发件箱，以及屏蔽之前就推过来的。
+// 查询失败就不过滤了，不能因为这个让整个 feed 都看不了
+func (f *feedService) mutedAuthors(ctx context.Context, uid int64) map[int64]struct{} {
+	resp, err := f.followClient.GetMuteList(ctx, &followv1.GetMuteListRequest{Uid: uid})
+	if err != nil {
+		f.l.Error("查询屏蔽列表失败，不过滤屏蔽的人",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+		return nil
+	}
+	muted := make(map[int64]struct{}, len(resp.Uids))
+	for _, id := range resp.Uids {
+		muted[id] = struct{}{}
+	}
+	return muted
+}
+
+// excludeAuthors 去掉 uids 里面屏蔽了的人
+func excludeAuthors(uids []int64, muted map[int64]struct{}) []int64 {
+	if len(muted) == 0 {
+		return uids
+	}
+	return slice.FilterDelete(uids, func(idx int, src int64) bool {
+		_, ok := muted[src]
+		return ok
+	})
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
+	followmocks "basic-go/lmbook/api/proto/gen/follow/v1/mocks"
+	"basic-go/lmbook/feed/domain"
+	"basic-go/lmbook/feed/repository"
+	repomocks "basic-go/lmbook/feed/repository/mocks"
+	svcmocks "basic-go/lmbook/feed/service/mocks"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFeedService_GetFeedEventList(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	evt := func(typ string, author int64, sec int) domain.FeedEvent {
+		return domain.FeedEvent{
+			Author: author,
+			Type:   typ,
+			Ctime:  now.Add(-time.Duration(sec) * time.Second),
+		}
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient, map[string]Handler)
+
+		wantEvents []domain.FeedEvent
+		wantErr    error
+	}{
+		{
+			name: "每种事件交给自己的 Handler，合并之后按时间倒序",
+			mock: func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient, map[string]Handler) {
+				repo := repomocks.NewMockFeedEventRepo(ctrl)
+				client := followmocks.NewMockFollowServiceClient(ctrl)
+				article := svcmocks.NewMockHandler(ctrl)
+				like := svcmocks.NewMockHandler(ctrl)
+				repo.EXPECT().MarkActive(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+				client.EXPECT().GetMuteList(gomock.Any(), gomock.Any()).
+					Return(&followv1.GetMuteListResponse{}, nil)
+				article.EXPECT().FindFeedEvents(gomock.Any(), int64(1), int64(100), int64(2)).
+					Return([]domain.FeedEvent{evt(ArticleEventName, 2, 1), evt(ArticleEventName, 3, 3)}, nil)
+				like.EXPECT().FindFeedEvents(gomock.Any(), int64(1), int64(100), int64(2)).
+					Return([]domain.FeedEvent{evt(LikeEventName, 4, 2)}, nil)
+				return repo, client, map[string]Handler{ArticleEventName: article, LikeEventName: like}
+			},
+			wantEvents: []domain.FeedEvent{evt(ArticleEventName, 2, 1), evt(LikeEventName, 4, 2)},
+		},
+		{
+			name: "屏蔽列表查询失败，不过滤",
+			mock: func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient, map[string]Handler) {
+				repo := repomocks.NewMockFeedEventRepo(ctrl)
+				client := followmocks.NewMockFollowServiceClient(ctrl)
+				article := svcmocks.NewMockHandler(ctrl)
+				repo.EXPECT().MarkActive(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+				client.EXPECT().GetMuteList(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock rpc error"))
+				article.EXPECT().FindFeedEvents(gomock.Any(), int64(1), int64(100), int64(2)).
+					Return([]domain.FeedEvent{evt(ArticleEventName, 2, 1), evt(ArticleEventName, 3, 2)}, nil)
+				return repo, client, map[string]Handler{ArticleEventName: article}
+			},
+			wantEvents: []domain.FeedEvent{evt(ArticleEventName, 2, 1), evt(ArticleEventName, 3, 2)},
+		},
+		{
+			name: "屏蔽的人过滤掉之后不够，往后补查",
+			mock: func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient, map[string]Handler) {
+				repo := repomocks.NewMockFeedEventRepo(ctrl)
+				client := followmocks.NewMockFollowServiceClient(ctrl)
+				article := svcmocks.NewMockHandler(ctrl)
+				repo.EXPECT().MarkActive(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+				client.EXPECT().GetMuteList(gomock.Any(), gomock.Any()).
+					Return(&followv1.GetMuteListResponse{Uids: []int64{3}}, nil)
+				// 第一页全是屏蔽的人的，从最后一条往后接着查
+				article.EXPECT().FindFeedEvents(gomock.Any(), int64(1), int64(100), int64(2)).
+					Return([]domain.FeedEvent{evt(ArticleEventName, 3, 1), evt(ArticleEventName, 3, 2)}, nil)
+				article.EXPECT().FindFeedEvents(gomock.Any(), int64(1), now.Add(-2*time.Second).UnixMilli(), int64(2)).
+					Return([]domain.FeedEvent{evt(ArticleEventName, 2, 3), evt(ArticleEventName, 3, 4)}, nil)
+				article.EXPECT().FindFeedEvents(gomock.Any(), int64(1), now.Add(-4*time.Second).UnixMilli(), int64(2)).
+					Return([]domain.FeedEvent{evt(ArticleEventName, 2, 5)}, nil)
+				return repo, client, map[string]Handler{ArticleEventName: article}
+			},
+			wantEvents: []domain.FeedEvent{evt(ArticleEventName, 2, 3), evt(ArticleEventName, 2, 5)},
+		},
+		{
+			name: "Handler 查询失败",
+			mock: func(ctrl *gomock.Controller) (repository.FeedEventRepo, followv1.FollowServiceClient, map[string]Handler) {
+				repo := repomocks.NewMockFeedEventRepo(ctrl)
+				client := followmocks.NewMockFollowServiceClient(ctrl)
+				article := svcmocks.NewMockHandler(ctrl)
+				repo.EXPECT().MarkActive(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+				client.EXPECT().GetMuteList(gomock.Any(), gomock.Any()).
+					Return(&followv1.GetMuteListResponse{}, nil)
+				article.EXPECT().FindFeedEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock db error"))
+				return repo, client, map[string]Handler{ArticleEventName: article}
+			},
+			wantErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, client, handlers := tc.mock(ctrl)
+			svc := NewFeedService(repo, handlers, client, logger.NewNoOpLogger())
+			events, err := svc.GetFeedEventList(context.Background(), 1, 100, 2)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantEvents, events)
+		})
+	}
+}
+
+func TestFeedService_GetGroupFeedEventList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := repomocks.NewMockFeedEventRepo(ctrl)
+	client := followmocks.NewMockFollowServiceClient(ctrl)
+	client.EXPECT().GetFolloweeByGroup(gomock.Any(), gomock.Any()).
+		Return(&followv1.GetFolloweeByGroupResponse{
+			FollowRelations: []*followv1.FollowRelation{{Followee: 2}, {Followee: 3}},
+		}, nil)
+	client.EXPECT().GetMuteList(gomock.Any(), gomock.Any()).
+		Return(&followv1.GetMuteListResponse{Uids: []int64{3}}, nil)
+	// 屏蔽的人在查询的时候就去掉了
+	repo.EXPECT().FindPullEvents(gomock.Any(), []int64{2}, int64(100), int64(10)).
+		Return([]domain.FeedEvent{}, nil)
+	repo.EXPECT().FindPushEventsByAuthors(gomock.Any(), int64(1), []int64{2}, int64(100), int64(10)).
+		Return([]domain.FeedEvent{}, nil)
+	repo.EXPECT().MarkActive(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+	svc := NewFeedService(repo, nil, client, logger.NewNoOpLogger())
+	_, err := svc.GetGroupFeedEventList(context.Background(), 1, domain.FolloweeFilter{Gid: 1}, 100, 10)
+	require.NoError(t, err)
+}
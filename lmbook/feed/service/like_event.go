@@ -8,7 +8,7 @@ import (
 )
 
 const (
-	LikeEventName = "follow_event"
+	LikeEventName = "like_event"
 )
 
 type LikeEventHandler struct {
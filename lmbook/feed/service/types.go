@@ -8,10 +8,12 @@ import (
 type FeedService interface {
 	CreateFeedEvent(ctx context.Context, feed domain.FeedEvent) error
 	GetFeedEventList(ctx context.Context, uid, timestamp, limit int64) ([]domain.FeedEvent, error)
+	// GetGroupFeedEventList 只看某个关注分组（或者特别关注）的人发出来的事件
+	GetGroupFeedEventList(ctx context.Context, uid int64, filter domain.FolloweeFilter, timestamp, limit int64) ([]domain.FeedEvent, error)
 }
 
 // Handler 具体业务处理逻辑
 type Handler interface {
-	CreateFeedEvent(ctx context.Context, feed domain.FeedEvent) error
+	CreateFeedEvent(ctx context.Context, ext domain.ExtendFields) error
 	FindFeedEvents(ctx context.Context, uid, timestamp, limit int64) ([]domain.FeedEvent, error)
 }
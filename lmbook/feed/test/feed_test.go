@@ -52,7 +52,7 @@ func (f *FeedTestSuite) Test_Feed() {
 	resp, err := server.FindFeedEvents(ctx, &feedv1.FindFeedEventsRequest{
 		Uid:       1,
 		Limit:     20,
-		Timestamp: time.Now().Unix() + 3,
+		Timestamp: time.Now().UnixMilli() + 3000,
 	})
 	require.NoError(f.T(), err)
 	assert.Equal(f.T(), len(wantEvents), len(resp.FeedEvents))
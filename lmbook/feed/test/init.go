@@ -21,11 +21,13 @@ func InitGrpcServer(t *testing.T) (feedv1.FeedSvcServer, *followMocks.MockFollow
 	feedPushEventDAO := dao.NewFeedPushEventDAO(db)
 	cmdable := ioc.InitRedis()
 	feedEventCache := cache.NewFeedEventCache(cmdable)
-	feedEventRepo := repository.NewFeedEventRepo(feedPullEventDAO, feedPushEventDAO, feedEventCache)
+	feedInboxCache := ioc.InitFeedInboxCache(cmdable)
+	feedEventRepo := repository.NewFeedEventRepo(feedPullEventDAO, feedPushEventDAO, feedEventCache, feedInboxCache, loggerV1)
 	mockCtrl := gomock.NewController(t)
 	followClient := followMocks.NewMockFollowServiceClient(mockCtrl)
-	v := ioc.RegisterHandler(feedEventRepo, followClient)
-	feedService := service.NewFeedService(feedEventRepo, v)
+	fanoutPolicy := ioc.InitFanoutPolicy(feedEventRepo, followClient, loggerV1)
+	v := ioc.RegisterHandler(feedEventRepo, fanoutPolicy)
+	feedService := service.NewFeedService(feedEventRepo, v, followClient, loggerV1)
 	feedEventGrpcSvc := grpc.NewFeedEventGrpcSvc(feedService)
 	return feedEventGrpcSvc, followClient, db
 }
@@ -132,11 +132,13 @@ func Test_Feed(t *testing.T) {
 	feedPushEventDAO := dao.NewFeedPushEventDAO(db)
 	cmdable := ioc.InitRedis()
 	feedEventCache := cache.NewFeedEventCache(cmdable)
-	feedEventRepo := repository.NewFeedEventRepo(feedPullEventDAO, feedPushEventDAO, feedEventCache)
+	feedInboxCache := ioc.InitFeedInboxCache(cmdable)
+	feedEventRepo := repository.NewFeedEventRepo(feedPullEventDAO, feedPushEventDAO, feedEventCache, feedInboxCache, loggerV1)
 	mockCtrl := gomock.NewController(t)
 	followClient := followMocks.NewMockFollowServiceClient(mockCtrl)
-	v := ioc.RegisterHandler(feedEventRepo, followClient)
-	feedService := service.NewFeedService(feedEventRepo, v)
+	fanoutPolicy := ioc.InitFanoutPolicy(feedEventRepo, followClient, loggerV1)
+	v := ioc.RegisterHandler(feedEventRepo, fanoutPolicy)
+	feedService := service.NewFeedService(feedEventRepo, v, followClient, loggerV1)
 	engine := gin.Default()
 	handler := web.NewFeedHandler(feedService)
 	handler.RegisterRoutes(engine)
@@ -24,6 +24,7 @@ var thirdProvider = wire.NewSet(
 	ioc.InitEtcdClient,
 	ioc.InitLogger,
 	ioc.InitRedis,
+	ioc.InitFeedInboxCache,
 	ioc.InitKafka,
 	ioc.InitDB,
 	ioc.InitFollowClient,
@@ -33,6 +34,7 @@ func Init() *App {
 	wire.Build(
 		thirdProvider,
 		serviceProviderSet,
+		ioc.InitFanoutPolicy,
 		ioc.RegisterHandler,
 		service.NewFeedService,
 		grpc.NewFeedEventGrpcSvc,
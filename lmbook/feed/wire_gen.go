@@ -27,10 +27,12 @@ func Init() *App {
 	feedPushEventDAO := dao.NewFeedPushEventDAO(db)
 	cmdable := ioc.InitRedis()
 	feedEventCache := cache.NewFeedEventCache(cmdable)
-	feedEventRepo := repository.NewFeedEventRepo(feedPullEventDAO, feedPushEventDAO, feedEventCache)
+	feedInboxCache := ioc.InitFeedInboxCache(cmdable)
+	feedEventRepo := repository.NewFeedEventRepo(feedPullEventDAO, feedPushEventDAO, feedEventCache, feedInboxCache, loggerV1)
 	followServiceClient := ioc.InitFollowClient()
-	v := ioc.RegisterHandler(feedEventRepo, followServiceClient)
-	feedService := service.NewFeedService(feedEventRepo, v)
+	fanoutPolicy := ioc.InitFanoutPolicy(feedEventRepo, followServiceClient, loggerV1)
+	v := ioc.RegisterHandler(feedEventRepo, fanoutPolicy)
+	feedService := service.NewFeedService(feedEventRepo, v, followServiceClient, loggerV1)
 	feedEventGrpcSvc := grpc.NewFeedEventGrpcSvc(feedService)
 	server := ioc.InitGRPCxServer(loggerV1, client, feedEventGrpcSvc)
 	saramaClient := ioc.InitKafka()
@@ -48,4 +50,4 @@ func Init() *App {
 
 var serviceProviderSet = wire.NewSet(dao.NewFeedPushEventDAO, dao.NewFeedPullEventDAO, cache.NewFeedEventCache, repository.NewFeedEventRepo)
 
-var thirdProvider = wire.NewSet(ioc.InitEtcdClient, ioc.InitLogger, ioc.InitRedis, ioc.InitKafka, ioc.InitDB, ioc.InitFollowClient)
+var thirdProvider = wire.NewSet(ioc.InitEtcdClient, ioc.InitLogger, ioc.InitRedis, ioc.InitFeedInboxCache, ioc.InitKafka, ioc.InitDB, ioc.InitFollowClient)
@@ -1,14 +1,33 @@
 package domain
 
+import "time"
+
 // FollowRelation 关注数据
 type FollowRelation struct {
+	Id int64
 	// 被关注的人
 	Followee int64
 	// 关注的人
 	Follower int64
-	// 根据你的业务需要，你可以在这里加字段
-	// 比如说备注啊，标签啊之类的
-	// Note string
+	// 下面几个字段只有关注者自己能看到
+	// Gid 分组，0 代表没有分组
+	Gid int64
+	// Note 备注
+	Note string
+	// Special 特别关注
+	Special bool
+
+	// 关注的时间
+	Ctime time.Time
+}
+
+// FollowRelationMeta 修改关注关系里面只有关注者自己能看到的字段，nil 代表不修改
+type FollowRelationMeta struct {
+	Follower int64
+	Followee int64
+	Gid      *int64
+	Note     *string
+	Special  *bool
 }
 
 type FollowStatics struct {
@@ -17,3 +36,28 @@ type FollowStatics struct {
 	// 自己关注了多少人
 	Followees int64
 }
+
+// FollowGroup 关注分组，一个人只能在一个分组里面
+type FollowGroup struct {
+	Id    int64
+	Uid   int64
+	Name  string
+	Ctime time.Time
+}
+
+// BlockRelation Uid 拉黑、屏蔽了 Target
+type BlockRelation struct {
+	Uid    int64
+	Target int64
+	// Block 拉黑，对方不能关注、评论、打赏自己
+	Block bool
+	// Mute 屏蔽，对方的动态不会出现在自己的 feed 里面
+	Mute bool
+}
+
+// FollowRecommendation 推荐关注
+type FollowRecommendation struct {
+	Uid int64
+	// Overlap 我关注的人里面，有多少人关注了他。是抽样统计的，只能用来排序
+	Overlap int64
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
+	"context"
+)
+
+func (f *FollowServiceServer) Block(ctx context.Context, request *followv1.BlockRequest) (*followv1.BlockResponse, error) {
+	err := f.svc.Block(ctx, request.Uid, request.Target)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &followv1.BlockResponse{}, nil
+}
+
+func (f *FollowServiceServer) CancelBlock(ctx context.Context, request *followv1.CancelBlockRequest) (*followv1.CancelBlockResponse, error) {
+	err := f.svc.CancelBlock(ctx, request.Uid, request.Target)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &followv1.CancelBlockResponse{}, nil
+}
+
+func (f *FollowServiceServer) Mute(ctx context.Context, request *followv1.MuteRequest) (*followv1.MuteResponse, error) {
+	err := f.svc.Mute(ctx, request.Uid, request.Target)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &followv1.MuteResponse{}, nil
+}
+
+func (f *FollowServiceServer) CancelMute(ctx context.Context, request *followv1.CancelMuteRequest) (*followv1.CancelMuteResponse, error) {
+	err := f.svc.CancelMute(ctx, request.Uid, request.Target)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &followv1.CancelMuteResponse{}, nil
+}
+
+func (f *FollowServiceServer) IsBlocked(ctx context.Context, request *followv1.IsBlockedRequest) (*followv1.IsBlockedResponse, error) {
+	blocked, err := f.svc.IsBlocked(ctx, request.Uid, request.Target)
+	if err != nil {
+		return nil, err
+	}
+	return &followv1.IsBlockedResponse{Blocked: blocked}, nil
+}
+
+func (f *FollowServiceServer) GetMuteList(ctx context.Context, request *followv1.GetMuteListRequest) (*followv1.GetMuteListResponse, error) {
+	uids, err := f.svc.GetMuteList(ctx, request.Uid)
+	if err != nil {
+		return nil, err
+	}
+	return &followv1.GetMuteListResponse{Uids: uids}, nil
+}
+
+func (f *FollowServiceServer) FilterMuted(ctx context.Context, request *followv1.FilterMutedRequest) (*followv1.FilterMutedResponse, error) {
+	uids, err := f.svc.FilterMuted(ctx, request.Target, request.Uids)
+	if err != nil {
+		return nil, err
+	}
+	return &followv1.FilterMutedResponse{Uids: uids}, nil
+}
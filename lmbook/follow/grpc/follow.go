@@ -4,8 +4,11 @@ import (
 	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
 	"basic-go/lmbook/follow/domain"
 	"basic-go/lmbook/follow/service"
+	"basic-go/lmbook/pkg/cursorx"
 	"context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type FollowServiceServer struct {
@@ -24,16 +27,32 @@ func (f *FollowServiceServer) Register(server grpc.ServiceRegistrar) {
 }
 
 func (f *FollowServiceServer) GetFollowee(ctx context.Context, request *followv1.GetFolloweeRequest) (*followv1.GetFolloweeResponse, error) {
-	relationList, err := f.svc.GetFollowee(ctx, request.Follower, request.Offset, request.Limit)
+	cur, err := cursorx.Decode(request.Cursor)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	res := make([]*followv1.FollowRelation, 0, len(relationList))
-	for _, relation := range relationList {
-		res = append(res, f.convertToView(relation))
+	relationList, err := f.svc.GetFollowee(ctx, request.Follower, cur, request.Limit)
+	if err != nil {
+		return nil, err
 	}
 	return &followv1.GetFolloweeResponse{
-		FollowRelations: res,
+		FollowRelations: f.convertToViews(relationList),
+		NextCursor:      f.nextCursor(relationList, request.Limit),
+	}, nil
+}
+
+func (f *FollowServiceServer) GetFollower(ctx context.Context, request *followv1.GetFollowerRequest) (*followv1.GetFollowerResponse, error) {
+	cur, err := cursorx.Decode(request.Cursor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	relationList, err := f.svc.GetFollower(ctx, request.Followee, cur, request.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &followv1.GetFollowerResponse{
+		FollowRelations: f.convertToFollowerViews(relationList),
+		NextCursor:      f.nextCursor(relationList, request.Limit),
 	}, nil
 }
 
@@ -50,7 +69,10 @@ func (f *FollowServiceServer) FollowInfo(ctx context.Context, request *followv1.
 func (f *FollowServiceServer) Follow(ctx context.Context, request *followv1.FollowRequest) (*followv1.FollowResponse, error) {
 	// 我要不要在这里校验输入
 	err := f.svc.Follow(ctx, request.Follower, request.Followee)
-	return &followv1.FollowResponse{}, err
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &followv1.FollowResponse{}, nil
 }
 
 func (f *FollowServiceServer) CancelFollow(ctx context.Context, request *followv1.CancelFollowRequest) (*followv1.CancelFollowResponse, error) {
@@ -58,9 +80,41 @@ func (f *FollowServiceServer) CancelFollow(ctx context.Context, request *followv
 	return &followv1.CancelFollowResponse{}, err
 }
 
+func (f *FollowServiceServer) nextCursor(relations []domain.FollowRelation, limit int64) string {
+	return cursorx.Next(relations, int(limit), func(r domain.FollowRelation) cursorx.Cursor {
+		return cursorx.Cursor{Time: r.Ctime.UnixMilli(), Id: r.Id}
+	})
+}
+
+func (f *FollowServiceServer) convertToViews(relations []domain.FollowRelation) []*followv1.FollowRelation {
+	res := make([]*followv1.FollowRelation, 0, len(relations))
+	for _, relation := range relations {
+		res = append(res, f.convertToView(relation))
+	}
+	return res
+}
+
+// convertToFollowerViews 粉丝列表是给被关注的人看的，
+// 分组、备注、特别关注是粉丝自己的设置，不能返回
+func (f *FollowServiceServer) convertToFollowerViews(relations []domain.FollowRelation) []*followv1.FollowRelation {
+	res := make([]*followv1.FollowRelation, 0, len(relations))
+	for _, relation := range relations {
+		res = append(res, &followv1.FollowRelation{
+			Id:       relation.Id,
+			Followee: relation.Followee,
+			Follower: relation.Follower,
+		})
+	}
+	return res
+}
+
 func (f *FollowServiceServer) convertToView(relation domain.FollowRelation) *followv1.FollowRelation {
 	return &followv1.FollowRelation{
+		Id:       relation.Id,
 		Followee: relation.Followee,
 		Follower: relation.Follower,
+		Gid:      relation.Gid,
+		Note:     relation.Note,
+		Special:  relation.Special,
 	}
 }
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
+	"basic-go/lmbook/follow/domain"
+	svcmocks "basic-go/lmbook/follow/service/mocks"
+	"basic-go/lmbook/pkg/cursorx"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFollowServiceServer_GetFollower(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc := svcmocks.NewMockFollowRelationService(ctrl)
+	svc.EXPECT().GetFollower(gomock.Any(), int64(2), cursorx.Cursor{}, int64(10)).
+		Return([]domain.FollowRelation{
+			{Id: 1, Follower: 1, Followee: 2, Gid: 3, Note: "备注", Special: true},
+		}, nil)
+	server := NewFollowRelationServiceServer(svc)
+	resp, err := server.GetFollower(context.Background(), &followv1.GetFollowerRequest{
+		Followee: 2,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	// 粉丝自己的分组、备注、特别关注不能给被关注的人看到
+	assert.Equal(t, 1, len(resp.FollowRelations))
+	assert.True(t, proto.Equal(&followv1.FollowRelation{Id: 1, Follower: 1, Followee: 2},
+		resp.FollowRelations[0]))
+}
+
+func TestFollowServiceServer_UpdateFollowRelation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc := svcmocks.NewMockFollowRelationService(ctrl)
+	// 只传了备注，分组和特别关注都是 nil
+	svc.EXPECT().UpdateFollowRelation(gomock.Any(), domain.FollowRelationMeta{
+		Follower: 1,
+		Followee: 2,
+		Note:     proto.String("备注"),
+	}).Return(nil)
+	server := NewFollowRelationServiceServer(svc)
+	_, err := server.UpdateFollowRelation(context.Background(), &followv1.UpdateFollowRelationRequest{
+		Follower: 1,
+		Followee: 2,
+		Note:     proto.String("备注"),
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
+	"basic-go/lmbook/follow/domain"
+	"basic-go/lmbook/follow/service"
+	"basic-go/lmbook/pkg/cursorx"
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (f *FollowServiceServer) UpdateFollowRelation(ctx context.Context, request *followv1.UpdateFollowRelationRequest) (*followv1.UpdateFollowRelationResponse, error) {
+	err := f.svc.UpdateFollowRelation(ctx, domain.FollowRelationMeta{
+		Follower: request.Follower,
+		Followee: request.Followee,
+		Gid:      request.Gid,
+		Note:     request.Note,
+		Special:  request.Special,
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &followv1.UpdateFollowRelationResponse{}, nil
+}
+
+func (f *FollowServiceServer) GetFolloweeByGroup(ctx context.Context, request *followv1.GetFolloweeByGroupRequest) (*followv1.GetFolloweeByGroupResponse, error) {
+	cur, err := cursorx.Decode(request.Cursor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	relationList, err := f.svc.GetFolloweeByGroup(ctx, request.Follower,
+		request.Gid, request.Special, cur, request.Limit)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &followv1.GetFolloweeByGroupResponse{
+		FollowRelations: f.convertToViews(relationList),
+		NextCursor:      f.nextCursor(relationList, request.Limit),
+	}, nil
+}
+
+func (f *FollowServiceServer) CreateFollowGroup(ctx context.Context, request *followv1.CreateFollowGroupRequest) (*followv1.CreateFollowGroupResponse, error) {
+	id, err := f.svc.CreateGroup(ctx, domain.FollowGroup{
+		Uid:  request.Uid,
+		Name: request.Name,
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &followv1.CreateFollowGroupResponse{Id: id}, nil
+}
+
+func (f *FollowServiceServer) RenameFollowGroup(ctx context.Context, request *followv1.RenameFollowGroupRequest) (*followv1.RenameFollowGroupResponse, error) {
+	err := f.svc.RenameGroup(ctx, request.Uid, request.Gid, request.Name)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &followv1.RenameFollowGroupResponse{}, nil
+}
+
+func (f *FollowServiceServer) DeleteFollowGroup(ctx context.Context, request *followv1.DeleteFollowGroupRequest) (*followv1.DeleteFollowGroupResponse, error) {
+	err := f.svc.DeleteGroup(ctx, request.Uid, request.Gid)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &followv1.DeleteFollowGroupResponse{}, nil
+}
+
+func (f *FollowServiceServer) GetFollowGroups(ctx context.Context, request *followv1.GetFollowGroupsRequest) (*followv1.GetFollowGroupsResponse, error) {
+	groups, err := f.svc.ListGroups(ctx, request.Uid)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*followv1.FollowGroup, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, &followv1.FollowGroup{
+			Id:    g.Id,
+			Uid:   g.Uid,
+			Name:  g.Name,
+			Ctime: g.Ctime.UnixMilli(),
+		})
+	}
+	return &followv1.GetFollowGroupsResponse{Groups: res}, nil
+}
+
+// toStatusErr 把业务错误转换成对应的 gRPC 错误码，调用方据此区分用户错误和系统错误
+func toStatusErr(err error) error {
+	switch {
+	case errors.Is(err, service.ErrFollowGroupNotFound),
+		errors.Is(err, service.ErrNotFollowing):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidGroupName),
+		errors.Is(err, service.ErrInvalidNote),
+		errors.Is(err, service.ErrBlockSelf),
+		errors.Is(err, service.ErrTooManyTargets):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrBlocked):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrTooManyGroups),
+		errors.Is(err, service.ErrTooManyBlocks):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
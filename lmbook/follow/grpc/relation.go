@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
+	"context"
+)
+
+func (f *FollowServiceServer) GetMutualFollow(ctx context.Context, request *followv1.GetMutualFollowRequest) (*followv1.GetMutualFollowResponse, error) {
+	mutual, err := f.svc.MutualFollow(ctx, request.Uid, request.Targets)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &followv1.GetMutualFollowResponse{Mutual: mutual}, nil
+}
+
+func (f *FollowServiceServer) GetCommonFollowee(ctx context.Context, request *followv1.GetCommonFolloweeRequest) (*followv1.GetCommonFolloweeResponse, error) {
+	uids, total, err := f.svc.CommonFollowees(ctx, request.Uid, request.Target, request.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &followv1.GetCommonFolloweeResponse{
+		Uids:  uids,
+		Total: total,
+	}, nil
+}
+
+func (f *FollowServiceServer) GetFollowRecommendations(ctx context.Context, request *followv1.GetFollowRecommendationsRequest) (*followv1.GetFollowRecommendationsResponse, error) {
+	recs, err := f.svc.Recommendations(ctx, request.Uid, request.Limit)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*followv1.FollowRecommendation, 0, len(recs))
+	for _, rec := range recs {
+		res = append(res, &followv1.FollowRecommendation{
+			Uid:     rec.Uid,
+			Overlap: rec.Overlap,
+		})
+	}
+	return &followv1.GetFollowRecommendationsResponse{Recommendations: res}, nil
+}
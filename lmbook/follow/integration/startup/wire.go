@@ -17,8 +17,13 @@ func InitServer() *grpc.FollowServiceServer {
 		InitLog,
 		InitTestDB,
 		dao.NewGORMFollowRelationDAO,
+		dao.NewGORMFollowGroupDAO,
+		dao.NewGORMBlockDAO,
 		cache.NewRedisFollowCache,
+		cache.NewRedisBlockCache,
 		repository.NewFollowRelationRepository,
+		repository.NewFollowGroupRepository,
+		repository.NewBlockRepository,
 		service.NewFollowRelationService,
 		grpc.NewFollowRelationServiceServer,
 	)
@@ -23,7 +23,12 @@ func InitServer() *grpc.FollowServiceServer {
 	followCache := cache.NewRedisFollowCache(cmdable)
 	loggerV1 := InitLog()
 	followRepository := repository.NewFollowRelationRepository(followRelationDao, followCache, loggerV1)
-	followRelationService := service.NewFollowRelationService(followRepository)
+	followGroupDAO := dao.NewGORMFollowGroupDAO(gormDB)
+	followGroupRepository := repository.NewFollowGroupRepository(followGroupDAO)
+	blockDAO := dao.NewGORMBlockDAO(gormDB)
+	blockCache := cache.NewRedisBlockCache(cmdable)
+	blockRepository := repository.NewBlockRepository(blockDAO, blockCache, loggerV1)
+	followRelationService := service.NewFollowRelationService(followRepository, followGroupRepository, blockRepository)
 	followServiceServer := grpc.NewFollowRelationServiceServer(followRelationService)
 	return followServiceServer
 }
@@ -3,23 +3,32 @@ package ioc
 import (
 	grpc2 "basic-go/lmbook/follow/grpc"
 	"basic-go/lmbook/pkg/grpcx"
+	"basic-go/lmbook/pkg/logger"
 	"github.com/spf13/viper"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc"
 )
 
-func InitGRPCxServer(followService *grpc2.FollowServiceServer) *grpcx.Server {
+func InitGRPCxServer(l logger.LoggerV1,
+	ecli *clientv3.Client,
+	followService *grpc2.FollowServiceServer) *grpcx.Server {
 	type Config struct {
-		Addr string `yaml:"addr"`
+		Port    int   `yaml:"port"`
+		EtcdTTL int64 `yaml:"etcdTTL"`
 	}
 	var cfg Config
-	err := viper.UnmarshalKey("grpc", &cfg)
+	err := viper.UnmarshalKey("grpc.server", &cfg)
 	if err != nil {
 		panic(err)
 	}
 	server := grpc.NewServer()
-	followRelation.Register(server)
+	followService.Register(server)
 	return &grpcx.Server{
-		Server: server,
-		Addr:   cfg.Addr,
+		Server:     server,
+		Port:       cfg.Port,
+		Name:       "follow",
+		L:          l,
+		EtcdTTL:    cfg.EtcdTTL,
+		EtcdClient: ecli,
 	}
 }
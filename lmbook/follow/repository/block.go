@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"basic-go/lmbook/follow/domain"
+	"basic-go/lmbook/follow/repository/cache"
+	"basic-go/lmbook/follow/repository/dao"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+)
+
+// ErrTooManyBlocks 拉黑、屏蔽的人已经到上限了
+var ErrTooManyBlocks = dao.ErrTooManyBlocks
+
+type BlockRepository interface {
+	// IsBlocked uid 是否拉黑了 target，评论、关注、打赏都会查，所以走缓存
+	IsBlocked(ctx context.Context, uid, target int64) (bool, error)
+	SetBlock(ctx context.Context, uid, target int64, block bool) error
+	SetMute(ctx context.Context, uid, target int64, mute bool) error
+	// MuteList uid 屏蔽或者拉黑的人
+	MuteList(ctx context.Context, uid int64) ([]int64, error)
+	// FilterMuted 在 uids 里面找出屏蔽或者拉黑了 target 的人
+	FilterMuted(ctx context.Context, target int64, uids []int64) ([]int64, error)
+}
+
+type CachedBlockRepository struct {
+	dao   dao.BlockDAO
+	cache cache.BlockCache
+	l     logger.LoggerV1
+}
+
+func NewBlockRepository(dao dao.BlockDAO, cache cache.BlockCache, l logger.LoggerV1) BlockRepository {
+	return &CachedBlockRepository{
+		dao:   dao,
+		cache: cache,
+		l:     l,
+	}
+}
+
+func (r *CachedBlockRepository) IsBlocked(ctx context.Context, uid, target int64) (bool, error) {
+	rel, err := r.cache.Relation(ctx, uid, target)
+	if err == nil {
+		return rel.Block, nil
+	}
+	rels, err := r.load(ctx, uid)
+	if err != nil {
+		return false, err
+	}
+	for _, rel := range rels {
+		if rel.Target == target {
+			return rel.Block, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *CachedBlockRepository) SetBlock(ctx context.Context, uid, target int64, block bool) error {
+	err := r.dao.SetBlock(ctx, uid, target, block)
+	if err != nil {
+		return err
+	}
+	return r.cache.Del(ctx, uid)
+}
+
+func (r *CachedBlockRepository) SetMute(ctx context.Context, uid, target int64, mute bool) error {
+	err := r.dao.SetMute(ctx, uid, target, mute)
+	if err != nil {
+		return err
+	}
+	return r.cache.Del(ctx, uid)
+}
+
+func (r *CachedBlockRepository) MuteList(ctx context.Context, uid int64) ([]int64, error) {
+	rels, err := r.cache.Relations(ctx, uid)
+	if err != nil {
+		rels, err = r.load(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res := make([]int64, 0, len(rels))
+	for _, rel := range rels {
+		if rel.Block || rel.Mute {
+			res = append(res, rel.Target)
+		}
+	}
+	return res, nil
+}
+
+func (r *CachedBlockRepository) FilterMuted(ctx context.Context, target int64, uids []int64) ([]int64, error) {
+	// 扩散的时候 uids 是一大批粉丝，一个个查缓存不划算，直接查数据库
+	return r.dao.FindMutedBy(ctx, target, uids)
+}
+
+// load 从数据库里面加载 uid 拉黑、屏蔽的所有人，并且回写缓存
+func (r *CachedBlockRepository) load(ctx context.Context, uid int64) ([]domain.BlockRelation, error) {
+	brs, err := r.dao.FindByUid(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.BlockRelation, 0, len(brs))
+	for _, br := range brs {
+		res = append(res, domain.BlockRelation{
+			Uid:    br.Uid,
+			Target: br.Target,
+			Block:  br.Block,
+			Mute:   br.Mute,
+		})
+	}
+	err = r.cache.SetRelations(ctx, uid, res)
+	if err != nil {
+		// 缓存写不进去，下次还是查数据库，问题不大
+		r.l.Error("回写拉黑缓存失败",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+	}
+	return res, nil
+}
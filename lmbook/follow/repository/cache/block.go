@@ -0,0 +1,117 @@
+package cache
+
+import (
+	"basic-go/lmbook/follow/domain"
+	"context"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+const (
+	// blockLoadedField 用来区分"没有拉黑任何人"和"还没有加载"
+	blockLoadedField = "0"
+	blockFlagBlock   = 1
+	blockFlagMute    = 2
+	blockExpiration  = time.Hour * 24
+)
+
+type BlockCache interface {
+	// Relation uid 对 target 的拉黑、屏蔽状态，还没有加载的时候返回 ErrKeyNotExist
+	Relation(ctx context.Context, uid, target int64) (domain.BlockRelation, error)
+	// Relations uid 拉黑、屏蔽的所有人，还没有加载的时候返回 ErrKeyNotExist
+	Relations(ctx context.Context, uid int64) ([]domain.BlockRelation, error)
+	// SetRelations 缓存 uid 拉黑、屏蔽的所有人
+	SetRelations(ctx context.Context, uid int64, rs []domain.BlockRelation) error
+	// Del 拉黑、屏蔽有变化的时候删除缓存，下一次查询重新加载
+	Del(ctx context.Context, uid int64) error
+}
+
+// RedisBlockCache 一个人一个 hash，field 是 target，value 是拉黑、屏蔽的标记位。
+// 一般人拉黑的人都很少，所以整个加载进来
+type RedisBlockCache struct {
+	client redis.Cmdable
+}
+
+func NewRedisBlockCache(client redis.Cmdable) BlockCache {
+	return &RedisBlockCache{
+		client: client,
+	}
+}
+
+func (r *RedisBlockCache) Relation(ctx context.Context, uid, target int64) (domain.BlockRelation, error) {
+	vals, err := r.client.HMGet(ctx, r.key(uid), blockLoadedField, strconv.FormatInt(target, 10)).Result()
+	if err != nil {
+		return domain.BlockRelation{}, err
+	}
+	if vals[0] == nil {
+		return domain.BlockRelation{}, ErrKeyNotExist
+	}
+	res := domain.BlockRelation{Uid: uid, Target: target}
+	if val, ok := vals[1].(string); ok {
+		r.setFlags(&res, val)
+	}
+	return res, nil
+}
+
+func (r *RedisBlockCache) Relations(ctx context.Context, uid int64) ([]domain.BlockRelation, error) {
+	data, err := r.client.HGetAll(ctx, r.key(uid)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := data[blockLoadedField]; !ok {
+		return nil, ErrKeyNotExist
+	}
+	res := make([]domain.BlockRelation, 0, len(data)-1)
+	for field, val := range data {
+		if field == blockLoadedField {
+			continue
+		}
+		target, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			continue
+		}
+		rel := domain.BlockRelation{Uid: uid, Target: target}
+		r.setFlags(&rel, val)
+		res = append(res, rel)
+	}
+	return res, nil
+}
+
+func (r *RedisBlockCache) SetRelations(ctx context.Context, uid int64, rs []domain.BlockRelation) error {
+	vals := make([]any, 0, len(rs)*2+2)
+	vals = append(vals, blockLoadedField, 1)
+	for _, rel := range rs {
+		var flags int
+		if rel.Block {
+			flags |= blockFlagBlock
+		}
+		if rel.Mute {
+			flags |= blockFlagMute
+		}
+		vals = append(vals, strconv.FormatInt(rel.Target, 10), flags)
+	}
+	key := r.key(uid)
+	pipe := r.client.TxPipeline()
+	// 先删掉，避免残留已经取消的数据
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, vals...)
+	pipe.Expire(ctx, key, blockExpiration)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *RedisBlockCache) Del(ctx context.Context, uid int64) error {
+	return r.client.Del(ctx, r.key(uid)).Err()
+}
+
+func (r *RedisBlockCache) setFlags(rel *domain.BlockRelation, val string) {
+	flags, _ := strconv.Atoi(val)
+	rel.Block = flags&blockFlagBlock != 0
+	rel.Mute = flags&blockFlagMute != 0
+}
+
+func (r *RedisBlockCache) key(uid int64) string {
+	return fmt.Sprintf("follow:block:%d", uid)
+}
@@ -0,0 +1,176 @@
+package cache
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+var (
+	//go:embed lua/followee_set_update.lua
+	luaFolloweeSetUpdate string
+)
+
+const (
+	// followeeSetPlaceholder 集合里面固定放一个 0，
+	// 这样关注了 0 个人的集合也存在，可以和没有加载区分开
+	followeeSetPlaceholder = "0"
+	// followeeSetTruncated 关注的人太多，集合里面只放了一部分的时候加上这个标记
+	followeeSetTruncated  = "-1"
+	followeeSetExpiration = time.Hour * 24 * 3
+)
+
+func (r *RedisFollowCache) MissingFolloweeSets(ctx context.Context, uids []int64) ([]int64, error) {
+	pipe := r.client.Pipeline()
+	cmds := make([]interface{ Val() int64 }, 0, len(uids))
+	for _, uid := range uids {
+		cmds = append(cmds, pipe.Exists(ctx, r.followeeKey(uid)))
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var res []int64
+	for i, cmd := range cmds {
+		if cmd.Val() == 0 {
+			res = append(res, uids[i])
+		}
+	}
+	return res, nil
+}
+
+func (r *RedisFollowCache) SetFollowees(ctx context.Context, uid int64, followees []int64, complete bool) error {
+	members := make([]any, 0, len(followees)+2)
+	members = append(members, followeeSetPlaceholder)
+	if !complete {
+		members = append(members, followeeSetTruncated)
+	}
+	for _, f := range followees {
+		members = append(members, f)
+	}
+	key := r.followeeKey(uid)
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.SAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, followeeSetExpiration)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *RedisFollowCache) AreFollowing(ctx context.Context, follower int64, followees []int64) ([]bool, bool, error) {
+	members := make([]any, 0, len(followees)+1)
+	for _, f := range followees {
+		members = append(members, f)
+	}
+	// 最后一个顺便看一下集合是不是完整的
+	members = append(members, followeeSetTruncated)
+	res, err := r.client.SMIsMember(ctx, r.followeeKey(follower), members...).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if len(res) != len(members) {
+		return nil, false, fmt.Errorf("SMISMEMBER 返回的数量不对 %d", len(res))
+	}
+	return res[:len(followees)], !res[len(followees)], nil
+}
+
+func (r *RedisFollowCache) AreFollowedBy(ctx context.Context, followee int64, followers []int64) ([]bool, []bool, error) {
+	pipe := r.client.Pipeline()
+	cmds := make([]*redis.BoolSliceCmd, 0, len(followers))
+	for _, f := range followers {
+		cmds = append(cmds, pipe.SMIsMember(ctx, r.followeeKey(f), followee, followeeSetTruncated))
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	following := make([]bool, 0, len(cmds))
+	complete := make([]bool, 0, len(cmds))
+	for _, cmd := range cmds {
+		val := cmd.Val()
+		if len(val) != 2 {
+			return nil, nil, fmt.Errorf("SMISMEMBER 返回的数量不对 %d", len(val))
+		}
+		following = append(following, val[0])
+		complete = append(complete, !val[1])
+	}
+	return following, complete, nil
+}
+
+func (r *RedisFollowCache) AllFollowees(ctx context.Context, uid int64) ([]int64, bool, error) {
+	vals, err := r.client.SMembers(ctx, r.followeeKey(uid)).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	complete := true
+	for _, val := range vals {
+		if val == followeeSetTruncated {
+			complete = false
+			break
+		}
+	}
+	return r.toUids(vals), complete, nil
+}
+
+func (r *RedisFollowCache) Followees(ctx context.Context, uid int64, limit int64) ([]int64, error) {
+	// 超过 limit 的时候随机挑一部分，每次推荐的结果也会有点变化。
+	// 多取两个，占位的 0 和不完整的标记可能也在里面
+	vals, err := r.client.SRandMemberN(ctx, r.followeeKey(uid), limit+2).Result()
+	if err != nil {
+		return nil, err
+	}
+	res := r.toUids(vals)
+	if int64(len(res)) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+func (r *RedisFollowCache) SampleFollowees(ctx context.Context, uids []int64, n int64) (map[int64][]int64, error) {
+	pipe := r.client.Pipeline()
+	cmds := make([]*redis.StringSliceCmd, 0, len(uids))
+	for _, uid := range uids {
+		cmds = append(cmds, pipe.SRandMemberN(ctx, r.followeeKey(uid), n+2))
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64][]int64, len(uids))
+	for i, cmd := range cmds {
+		followees := r.toUids(cmd.Val())
+		if int64(len(followees)) > n {
+			followees = followees[:n]
+		}
+		res[uids[i]] = followees
+	}
+	return res, nil
+}
+
+// updateFolloweeSet 关注、取消关注的时候同步更新集合
+func (r *RedisFollowCache) updateFolloweeSet(ctx context.Context, follower, followee int64, op string) error {
+	return r.client.Eval(ctx, luaFolloweeSetUpdate,
+		[]string{r.followeeKey(follower)}, op, followee).Err()
+}
+
+func (r *RedisFollowCache) toUids(vals []string) []int64 {
+	res := make([]int64, 0, len(vals))
+	for _, val := range vals {
+		id, err := strconv.ParseInt(val, 10, 64)
+		// 跳过占位的 0 和不完整的标记
+		if err != nil || id <= 0 {
+			continue
+		}
+		res = append(res, id)
+	}
+	return res
+}
+
+// followeeKey uid 是 hash tag。不同人的集合在不同的 slot 上面，
+// 所以不能用 SINTER、ZUNIONSTORE 这种一次操作多个人的集合的命令
+func (r *RedisFollowCache) followeeKey(uid int64) string {
+	return fmt.Sprintf("follow:followee:{%d}", uid)
+}
@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"basic-go/lmbook/follow/repository/cache/redismocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRedisFollowCache_SetFollowees(t *testing.T) {
+	testCases := []struct {
+		name      string
+		followees []int64
+		complete  bool
+
+		wantMembers []any
+	}{
+		{
+			name:        "完整的集合",
+			followees:   []int64{2, 3},
+			complete:    true,
+			wantMembers: []any{"0", int64(2), int64(3)},
+		},
+		{
+			name:        "不完整的集合加上标记",
+			followees:   []int64{2, 3},
+			wantMembers: []any{"0", "-1", int64(2), int64(3)},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cmd := redismocks.NewMockCmdable(ctrl)
+			pipe := redismocks.NewMockPipeliner(ctrl)
+			cmd.EXPECT().TxPipeline().Return(pipe)
+			pipe.EXPECT().Del(gomock.Any(), "follow:followee:{1}").Return(redis.NewIntResult(1, nil))
+			pipe.EXPECT().SAdd(gomock.Any(), "follow:followee:{1}", tc.wantMembers...).
+				Return(redis.NewIntResult(int64(len(tc.wantMembers)), nil))
+			pipe.EXPECT().Expire(gomock.Any(), "follow:followee:{1}", followeeSetExpiration).
+				Return(redis.NewBoolResult(true, nil))
+			pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+			c := NewRedisFollowCache(cmd)
+			err := c.SetFollowees(context.Background(), 1, tc.followees, tc.complete)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRedisFollowCache_AreFollowing(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) redis.Cmdable
+
+		wantFollowing []bool
+		wantComplete  bool
+		wantErr       error
+	}{
+		{
+			name: "完整的集合",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				cmd := redismocks.NewMockCmdable(ctrl)
+				cmd.EXPECT().SMIsMember(gomock.Any(), "follow:followee:{1}", int64(2), int64(3), "-1").
+					Return(redis.NewBoolSliceResult([]bool{true, false, false}, nil))
+				return cmd
+			},
+			wantFollowing: []bool{true, false},
+			wantComplete:  true,
+		},
+		{
+			name: "不完整的集合",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				cmd := redismocks.NewMockCmdable(ctrl)
+				cmd.EXPECT().SMIsMember(gomock.Any(), "follow:followee:{1}", int64(2), int64(3), "-1").
+					Return(redis.NewBoolSliceResult([]bool{true, false, true}, nil))
+				return cmd
+			},
+			wantFollowing: []bool{true, false},
+		},
+		{
+			name: "Redis 出错",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				cmd := redismocks.NewMockCmdable(ctrl)
+				cmd.EXPECT().SMIsMember(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(redis.NewBoolSliceResult(nil, errors.New("mock redis error")))
+				return cmd
+			},
+			wantErr: errors.New("mock redis error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := NewRedisFollowCache(tc.mock(ctrl))
+			following, complete, err := c.AreFollowing(context.Background(), 1, []int64{2, 3})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantFollowing, following)
+			assert.Equal(t, tc.wantComplete, complete)
+		})
+	}
+}
+
+func TestRedisFollowCache_AllFollowees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cmd := redismocks.NewMockCmdable(ctrl)
+	cmd.EXPECT().SMembers(gomock.Any(), "follow:followee:{1}").
+		Return(redis.NewStringSliceResult([]string{"0", "-1", "2", "3"}, nil))
+	c := NewRedisFollowCache(cmd)
+	followees, complete, err := c.AllFollowees(context.Background(), 1)
+	require.NoError(t, err)
+	// 占位的 0 和不完整的标记都不算
+	assert.Equal(t, []int64{2, 3}, followees)
+	assert.False(t, complete)
+}
+
+func TestRedisFollowCache_SampleFollowees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cmd := redismocks.NewMockCmdable(ctrl)
+	pipe := redismocks.NewMockPipeliner(ctrl)
+	cmd.EXPECT().Pipeline().Return(pipe)
+	// 每个人的集合单独一个命令，不会跨 slot
+	pipe.EXPECT().SRandMemberN(gomock.Any(), "follow:followee:{1}", int64(4)).
+		Return(redis.NewStringSliceResult([]string{"0", "5", "6", "7"}, nil))
+	pipe.EXPECT().SRandMemberN(gomock.Any(), "follow:followee:{2}", int64(4)).
+		Return(redis.NewStringSliceResult([]string{"-1", "0", "5"}, nil))
+	pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+	c := NewRedisFollowCache(cmd)
+	res, err := c.SampleFollowees(context.Background(), []int64{1, 2}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64][]int64{1: {5, 6}, 2: {5}}, res)
+}
@@ -0,0 +1,7 @@
+-- 只有集合已经加载过的时候才更新，
+-- 没加载过的话，加进去一个元素会被当成完整的集合
+if redis.call("EXISTS", KEYS[1]) == 1 then
+    redis.call(ARGV[1], KEYS[1], ARGV[2])
+    return 1
+end
+return 0
@@ -0,0 +1,204 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./types.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./types.go -package=cachemocks -destination=./mocks/follow.mock.go
+//
+// Package cachemocks is a generated GoMock package.
+package cachemocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "basic-go/lmbook/follow/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFollowCache is a mock of FollowCache interface.
+type MockFollowCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowCacheMockRecorder
+}
+
+// MockFollowCacheMockRecorder is the mock recorder for MockFollowCache.
+type MockFollowCacheMockRecorder struct {
+	mock *MockFollowCache
+}
+
+// NewMockFollowCache creates a new mock instance.
+func NewMockFollowCache(ctrl *gomock.Controller) *MockFollowCache {
+	mock := &MockFollowCache{ctrl: ctrl}
+	mock.recorder = &MockFollowCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowCache) EXPECT() *MockFollowCacheMockRecorder {
+	return m.recorder
+}
+
+// AllFollowees mocks base method.
+func (m *MockFollowCache) AllFollowees(ctx context.Context, uid int64) ([]int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllFollowees", ctx, uid)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AllFollowees indicates an expected call of AllFollowees.
+func (mr *MockFollowCacheMockRecorder) AllFollowees(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllFollowees", reflect.TypeOf((*MockFollowCache)(nil).AllFollowees), ctx, uid)
+}
+
+// AreFollowedBy mocks base method.
+func (m *MockFollowCache) AreFollowedBy(ctx context.Context, followee int64, followers []int64) ([]bool, []bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AreFollowedBy", ctx, followee, followers)
+	ret0, _ := ret[0].([]bool)
+	ret1, _ := ret[1].([]bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AreFollowedBy indicates an expected call of AreFollowedBy.
+func (mr *MockFollowCacheMockRecorder) AreFollowedBy(ctx, followee, followers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AreFollowedBy", reflect.TypeOf((*MockFollowCache)(nil).AreFollowedBy), ctx, followee, followers)
+}
+
+// AreFollowing mocks base method.
+func (m *MockFollowCache) AreFollowing(ctx context.Context, follower int64, followees []int64) ([]bool, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AreFollowing", ctx, follower, followees)
+	ret0, _ := ret[0].([]bool)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AreFollowing indicates an expected call of AreFollowing.
+func (mr *MockFollowCacheMockRecorder) AreFollowing(ctx, follower, followees any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AreFollowing", reflect.TypeOf((*MockFollowCache)(nil).AreFollowing), ctx, follower, followees)
+}
+
+// CancelFollow mocks base method.
+func (m *MockFollowCache) CancelFollow(ctx context.Context, follower, followee int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelFollow", ctx, follower, followee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelFollow indicates an expected call of CancelFollow.
+func (mr *MockFollowCacheMockRecorder) CancelFollow(ctx, follower, followee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelFollow", reflect.TypeOf((*MockFollowCache)(nil).CancelFollow), ctx, follower, followee)
+}
+
+// Follow mocks base method.
+func (m *MockFollowCache) Follow(ctx context.Context, follower, followee int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, follower, followee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockFollowCacheMockRecorder) Follow(ctx, follower, followee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockFollowCache)(nil).Follow), ctx, follower, followee)
+}
+
+// Followees mocks base method.
+func (m *MockFollowCache) Followees(ctx context.Context, uid, limit int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Followees", ctx, uid, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Followees indicates an expected call of Followees.
+func (mr *MockFollowCacheMockRecorder) Followees(ctx, uid, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Followees", reflect.TypeOf((*MockFollowCache)(nil).Followees), ctx, uid, limit)
+}
+
+// MissingFolloweeSets mocks base method.
+func (m *MockFollowCache) MissingFolloweeSets(ctx context.Context, uids []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MissingFolloweeSets", ctx, uids)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MissingFolloweeSets indicates an expected call of MissingFolloweeSets.
+func (mr *MockFollowCacheMockRecorder) MissingFolloweeSets(ctx, uids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MissingFolloweeSets", reflect.TypeOf((*MockFollowCache)(nil).MissingFolloweeSets), ctx, uids)
+}
+
+// SampleFollowees mocks base method.
+func (m *MockFollowCache) SampleFollowees(ctx context.Context, uids []int64, n int64) (map[int64][]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SampleFollowees", ctx, uids, n)
+	ret0, _ := ret[0].(map[int64][]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SampleFollowees indicates an expected call of SampleFollowees.
+func (mr *MockFollowCacheMockRecorder) SampleFollowees(ctx, uids, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SampleFollowees", reflect.TypeOf((*MockFollowCache)(nil).SampleFollowees), ctx, uids, n)
+}
+
+// SetFollowees mocks base method.
+func (m *MockFollowCache) SetFollowees(ctx context.Context, uid int64, followees []int64, complete bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFollowees", ctx, uid, followees, complete)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFollowees indicates an expected call of SetFollowees.
+func (mr *MockFollowCacheMockRecorder) SetFollowees(ctx, uid, followees, complete any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFollowees", reflect.TypeOf((*MockFollowCache)(nil).SetFollowees), ctx, uid, followees, complete)
+}
+
+// SetStaticsInfo mocks base method.
+func (m *MockFollowCache) SetStaticsInfo(ctx context.Context, uid int64, statics domain.FollowStatics) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStaticsInfo", ctx, uid, statics)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStaticsInfo indicates an expected call of SetStaticsInfo.
+func (mr *MockFollowCacheMockRecorder) SetStaticsInfo(ctx, uid, statics any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStaticsInfo", reflect.TypeOf((*MockFollowCache)(nil).SetStaticsInfo), ctx, uid, statics)
+}
+
+// StaticsInfo mocks base method.
+func (m *MockFollowCache) StaticsInfo(ctx context.Context, uid int64) (domain.FollowStatics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StaticsInfo", ctx, uid)
+	ret0, _ := ret[0].(domain.FollowStatics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StaticsInfo indicates an expected call of StaticsInfo.
+func (mr *MockFollowCacheMockRecorder) StaticsInfo(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StaticsInfo", reflect.TypeOf((*MockFollowCache)(nil).StaticsInfo), ctx, uid)
+}
@@ -22,10 +22,18 @@ const (
 )
 
 func (r *RedisFollowCache) Follow(ctx context.Context, follower, followee int64) error {
+	err := r.updateFolloweeSet(ctx, follower, followee, "SADD")
+	if err != nil {
+		return err
+	}
 	return r.updateStaticsInfo(ctx, follower, followee, 1)
 }
 
 func (r *RedisFollowCache) CancelFollow(ctx context.Context, follower, followee int64) error {
+	err := r.updateFolloweeSet(ctx, follower, followee, "SREM")
+	if err != nil {
+		return err
+	}
 	return r.updateStaticsInfo(ctx, follower, followee, -1)
 }
 
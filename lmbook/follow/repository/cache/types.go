@@ -10,4 +10,25 @@ type FollowCache interface {
 	SetStaticsInfo(ctx context.Context, uid int64, statics domain.FollowStatics) error
 	Follow(ctx context.Context, follower, followee int64) error
 	CancelFollow(ctx context.Context, follower, followee int64) error
+
+	// 下面是关注集合，一个人一个 SET，放他关注的人。
+	// 关注、取消关注的时候在 Follow、CancelFollow 里面同步更新。
+	// 关注的人太多的时候只放最近关注的那部分，这时候集合是不完整的，
+	// 不在集合里面不代表没有关注，调用方要回数据库确认。
+	// 每个命令都只操作一个 key，Redis Cluster 下面不会有 CROSSSLOT 的问题
+
+	// MissingFolloweeSets 找出集合还没有加载的人
+	MissingFolloweeSets(ctx context.Context, uids []int64) ([]int64, error)
+	// SetFollowees complete 为 false 代表 followees 只是一部分
+	SetFollowees(ctx context.Context, uid int64, followees []int64, complete bool) error
+	// AreFollowing follower 是否关注了 followees 里面的每一个人，同时返回集合是否完整
+	AreFollowing(ctx context.Context, follower int64, followees []int64) ([]bool, bool, error)
+	// AreFollowedBy followers 里面的每一个人是否关注了 followee，同时返回每一个人的集合是否完整
+	AreFollowedBy(ctx context.Context, followee int64, followers []int64) ([]bool, []bool, error)
+	// AllFollowees 集合里面的所有人，同时返回集合是否完整
+	AllFollowees(ctx context.Context, uid int64) ([]int64, bool, error)
+	// Followees 最多返回 limit 个，超过的时候随机挑
+	Followees(ctx context.Context, uid int64, limit int64) ([]int64, error)
+	// SampleFollowees uids 里面每一个人随机挑最多 n 个关注的人
+	SampleFollowees(ctx context.Context, uids []int64, n int64) (map[int64][]int64, error)
 }
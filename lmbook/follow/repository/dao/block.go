@@ -0,0 +1,127 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// maxBlockList 一个人最多拉黑、屏蔽多少人，一次性全部查出来
+const maxBlockList = 2000
+
+// ErrTooManyBlocks 拉黑、屏蔽的人已经到上限了
+var ErrTooManyBlocks = errors.New("拉黑、屏蔽的人太多了")
+
+type BlockDAO interface {
+	// SetBlock 拉黑或者取消拉黑，拉黑、屏蔽的人超过 maxBlockList 返回 ErrTooManyBlocks
+	SetBlock(ctx context.Context, uid, target int64, block bool) error
+	// SetMute 屏蔽或者取消屏蔽
+	SetMute(ctx context.Context, uid, target int64, mute bool) error
+	// FindByUid uid 拉黑、屏蔽的所有人
+	FindByUid(ctx context.Context, uid int64) ([]BlockRelation, error)
+	// FindMutedBy 在 uids 里面找出屏蔽或者拉黑了 target 的人
+	FindMutedBy(ctx context.Context, target int64, uids []int64) ([]int64, error)
+}
+
+// BlockRelation 拉黑、屏蔽。
+// 和关注关系分开存，UserRelation 那种把所有关系放在一起的做法，
+// 会让关注列表这种最主要的查询变得很复杂
+type BlockRelation struct {
+	ID int64 `gorm:"column:id;autoIncrement;primaryKey;"`
+	// 拉黑、屏蔽的人
+	Uid int64 `gorm:"uniqueIndex:uid_target"`
+	// 被拉黑、屏蔽的人，FindMutedBy 按照它来查
+	Target int64 `gorm:"uniqueIndex:uid_target;index"`
+	Block  bool
+	Mute   bool
+	Ctime  int64
+	Utime  int64
+}
+
+type GORMBlockDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMBlockDAO(db *gorm.DB) BlockDAO {
+	return &GORMBlockDAO{
+		db: db,
+	}
+}
+
+func (g *GORMBlockDAO) SetBlock(ctx context.Context, uid, target int64, block bool) error {
+	return g.upsert(ctx, BlockRelation{Uid: uid, Target: target, Block: block}, "block")
+}
+
+func (g *GORMBlockDAO) SetMute(ctx context.Context, uid, target int64, mute bool) error {
+	return g.upsert(ctx, BlockRelation{Uid: uid, Target: target, Mute: mute}, "mute")
+}
+
+// upsert 只更新 col 这一列，另外一列保持原样。
+// 新拉黑、屏蔽一个人的时候要检查上限，不然 FindByUid 查出来的就不全了
+func (g *GORMBlockDAO) upsert(ctx context.Context, r BlockRelation, col string) error {
+	now := time.Now().UnixMilli()
+	r.Ctime = now
+	r.Utime = now
+	val := r.Block
+	if col == "mute" {
+		val = r.Mute
+	}
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if val {
+			err := g.checkLimit(tx, r.Uid, r.Target)
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.Assignments(map[string]any{
+				col:     val,
+				"utime": now,
+			}),
+		}).Create(&r).Error
+	})
+}
+
+// checkLimit 已经拉黑或者屏蔽了 target 的，只是换一种关系，不占新的名额
+func (g *GORMBlockDAO) checkLimit(tx *gorm.DB, uid, target int64) error {
+	var rels []BlockRelation
+	// 锁住 uid 的这些行，避免并发拉黑超过上限
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("target").
+		Where("uid = ? AND (block = ? OR mute = ?)", uid, true, true).
+		Find(&rels).Error
+	if err != nil {
+		return err
+	}
+	if len(rels) < maxBlockList {
+		return nil
+	}
+	for _, rel := range rels {
+		if rel.Target == target {
+			return nil
+		}
+	}
+	return ErrTooManyBlocks
+}
+
+func (g *GORMBlockDAO) FindByUid(ctx context.Context, uid int64) ([]BlockRelation, error) {
+	var res []BlockRelation
+	err := g.db.WithContext(ctx).
+		Where("uid = ? AND (block = ? OR mute = ?)", uid, true, true).
+		Order("id").Limit(maxBlockList).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMBlockDAO) FindMutedBy(ctx context.Context, target int64, uids []int64) ([]int64, error) {
+	var res []int64
+	if len(uids) == 0 {
+		return res, nil
+	}
+	err := g.db.WithContext(ctx).Model(&BlockRelation{}).
+		Where("target = ? AND uid IN ? AND (block = ? OR mute = ?)", target, uids, true, true).
+		Pluck("uid", &res).Error
+	return res, err
+}
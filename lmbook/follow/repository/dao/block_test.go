@@ -0,0 +1,36 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGORMBlockDAO_SetBlock(t *testing.T) {
+	db := initTestDB(t)
+	dao := NewGORMBlockDAO(db)
+	ctx := context.Background()
+	rels := make([]BlockRelation, 0, maxBlockList)
+	for i := 0; i < maxBlockList-1; i++ {
+		rels = append(rels, BlockRelation{Uid: 1, Target: int64(i + 100), Block: true})
+	}
+	// 取消了的不算名额
+	rels = append(rels, BlockRelation{Uid: 1, Target: 2})
+	require.NoError(t, db.CreateInBatches(rels, 500).Error)
+
+	// 还差一个才到上限
+	require.NoError(t, dao.SetMute(ctx, 1, 3, true))
+	// 到上限了
+	assert.Equal(t, ErrTooManyBlocks, dao.SetBlock(ctx, 1, 2, true))
+	// 已经屏蔽了的人再拉黑，不占新的名额
+	require.NoError(t, dao.SetBlock(ctx, 1, 3, true))
+	// 取消不受限制
+	require.NoError(t, dao.SetBlock(ctx, 1, 100, false))
+	require.NoError(t, dao.SetBlock(ctx, 1, 2, true))
+
+	res, err := dao.FindByUid(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, maxBlockList, len(res))
+}
@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"basic-go/lmbook/pkg/cursorx"
 	"context"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -35,20 +36,122 @@ func (g *GORMFollowRelationDAO) CntFollowee(ctx context.Context, uid int64) (int
 func (g *GORMFollowRelationDAO) UpdateStatus(ctx context.Context, followee int64, follower int64, status uint8) error {
 	// 当前 status 就是 inactive 的呢？
 	// 不需要多次一举去检测我这个数据在不在，状态对不对
-	return g.db.WithContext(ctx).
+	updates := map[string]any{
+		"status": status,
+		"utime":  time.Now().UnixMilli(),
+	}
+	if status == FollowRelationStatusInactive {
+		// 取消关注之后，分组、备注这些也一起清掉，再关注的时候从头开始
+		updates["gid"] = 0
+		updates["note"] = ""
+		updates["special"] = false
+	}
+	return g.db.WithContext(ctx).Model(&FollowRelation{}).
 		Where("follower = ? AND followee = ?", follower, followee).
-		Updates(map[string]any{
-			"status": status,
-			"utime":  time.Now().UnixMilli(),
-		}).Error
+		Updates(updates).Error
+}
+
+func (g *GORMFollowRelationDAO) UpdateRelationMeta(ctx context.Context, m FollowRelationMeta) error {
+	updates := map[string]any{
+		"utime": time.Now().UnixMilli(),
+	}
+	if m.Gid != nil {
+		updates["gid"] = *m.Gid
+	}
+	if m.Note != nil {
+		updates["note"] = *m.Note
+	}
+	if m.Special != nil {
+		updates["special"] = *m.Special
+	}
+	res := g.db.WithContext(ctx).Model(&FollowRelation{}).
+		Where("follower = ? AND followee = ? AND status = ?",
+			m.Follower, m.Followee, FollowRelationStatusActive).
+		Updates(updates)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		// utime 每次都会变，所以这里只可能是没有关注
+		return ErrFollowerNotFound
+	}
+	return nil
+}
+
+func (g *GORMFollowRelationDAO) FollowRelationListByGroup(ctx context.Context,
+	follower, gid int64, special bool, cur cursorx.Cursor, limit int64) ([]FollowRelation, error) {
+	var res []FollowRelation
+	query := g.db.WithContext(ctx).
+		Where("follower = ? AND status = ?", follower, FollowRelationStatusActive)
+	if gid > 0 {
+		query = query.Where("gid = ?", gid)
+	}
+	if special {
+		query = query.Where("special = ?", true)
+	}
+	err := query.Scopes(cursorx.Scope(cur, "ctime", "id", int(limit))).
+		Find(&res).Error
+	return res, err
 }
 
 func (g *GORMFollowRelationDAO) FollowRelationList(ctx context.Context,
-	follower, offset, limit int64) ([]FollowRelation, error) {
+	follower int64, cur cursorx.Cursor, limit int64) ([]FollowRelation, error) {
 	var res []FollowRelation
 	err := g.db.WithContext(ctx).
 		Where("follower = ? AND status = ?", follower, FollowRelationStatusActive).
-		Offset(int(offset)).Limit(int(limit)).
+		Scopes(cursorx.Scope(cur, "ctime", "id", int(limit))).
+		Find(&res).Error
+	return res, err
+}
+
+func (g *GORMFollowRelationDAO) FolloweeIds(ctx context.Context, follower int64, limit int) ([]int64, error) {
+	var res []int64
+	err := g.db.WithContext(ctx).Model(&FollowRelation{}).
+		Where("follower = ? AND status = ?", follower, FollowRelationStatusActive).
+		Order("ctime DESC").Limit(limit).
+		Pluck("followee", &res).Error
+	return res, err
+}
+
+func (g *GORMFollowRelationDAO) FolloweesIn(ctx context.Context, follower int64, followees []int64) ([]int64, error) {
+	var res []int64
+	if len(followees) == 0 {
+		return res, nil
+	}
+	err := g.db.WithContext(ctx).Model(&FollowRelation{}).
+		Where("follower = ? AND followee IN ? AND status = ?", follower, followees, FollowRelationStatusActive).
+		Pluck("followee", &res).Error
+	return res, err
+}
+
+func (g *GORMFollowRelationDAO) FollowersIn(ctx context.Context, followee int64, followers []int64) ([]int64, error) {
+	var res []int64
+	if len(followers) == 0 {
+		return res, nil
+	}
+	err := g.db.WithContext(ctx).Model(&FollowRelation{}).
+		Where("followee = ? AND follower IN ? AND status = ?", followee, followers, FollowRelationStatusActive).
+		Pluck("follower", &res).Error
+	return res, err
+}
+
+func (g *GORMFollowRelationDAO) CommonFollowees(ctx context.Context, uid, target int64) ([]int64, error) {
+	var res []int64
+	err := g.db.WithContext(ctx).Table("follow_relations AS a").
+		Joins("JOIN follow_relations AS b ON a.followee = b.followee").
+		Where("a.follower = ? AND a.status = ? AND b.follower = ? AND b.status = ?",
+			uid, FollowRelationStatusActive, target, FollowRelationStatusActive).
+		Order("a.followee").
+		Pluck("a.followee", &res).Error
+	return res, err
+}
+
+func (g *GORMFollowRelationDAO) FollowerRelationList(ctx context.Context,
+	followee int64, cur cursorx.Cursor, limit int64) ([]FollowRelation, error) {
+	var res []FollowRelation
+	err := g.db.WithContext(ctx).
+		Where("followee = ? AND status = ?", followee, FollowRelationStatusActive).
+		Scopes(cursorx.Scope(cur, "ctime", "id", int(limit))).
 		Find(&res).Error
 	return res, err
 }
@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// initTestDB 内存里面的 SQLite，只用来验证 SQL 的逻辑
+func initTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// 每个连接都是一个新的内存数据库
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&FollowRelation{}, &BlockRelation{}))
+	return db
+}
+
+func TestGORMFollowRelationDAO_UpdateRelationMeta(t *testing.T) {
+	db := initTestDB(t)
+	dao := NewGORMFollowRelationDAO(db)
+	ctx := context.Background()
+	require.NoError(t, db.Create(&FollowRelation{
+		Follower: 1, Followee: 2, Status: FollowRelationStatusActive,
+		Gid: 10, Note: "老同学", Special: true,
+	}).Error)
+	require.NoError(t, db.Create(&FollowRelation{
+		Follower: 1, Followee: 3, Status: FollowRelationStatusInactive,
+	}).Error)
+
+	// 只改备注，分组和特别关注不动
+	note := "同事"
+	err := dao.UpdateRelationMeta(ctx, FollowRelationMeta{Follower: 1, Followee: 2, Note: &note})
+	require.NoError(t, err)
+	fr, err := dao.FollowRelationDetail(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), fr.Gid)
+	assert.Equal(t, "同事", fr.Note)
+	assert.True(t, fr.Special)
+
+	// 移出分组，取消特别关注
+	gid, special := int64(0), false
+	err = dao.UpdateRelationMeta(ctx, FollowRelationMeta{Follower: 1, Followee: 2, Gid: &gid, Special: &special})
+	require.NoError(t, err)
+	fr, err = dao.FollowRelationDetail(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), fr.Gid)
+	assert.Equal(t, "同事", fr.Note)
+	assert.False(t, fr.Special)
+
+	// 已经取消关注的不能改
+	err = dao.UpdateRelationMeta(ctx, FollowRelationMeta{Follower: 1, Followee: 3, Note: &note})
+	assert.Equal(t, ErrFollowerNotFound, err)
+}
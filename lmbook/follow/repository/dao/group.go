@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+type FollowGroupDAO interface {
+	Insert(ctx context.Context, g FollowGroup) (int64, error)
+	// Rename 只能修改自己的分组
+	Rename(ctx context.Context, uid, gid int64, name string) error
+	// Delete 删除分组，分组里面的关注关系变成没有分组
+	Delete(ctx context.Context, uid, gid int64) error
+	GetById(ctx context.Context, gid int64) (FollowGroup, error)
+	FindByUid(ctx context.Context, uid int64) ([]FollowGroup, error)
+	CountByUid(ctx context.Context, uid int64) (int64, error)
+}
+
+// FollowGroup 关注分组
+type FollowGroup struct {
+	ID    int64  `gorm:"column:id;autoIncrement;primaryKey;"`
+	Uid   int64  `gorm:"index"`
+	Name  string `gorm:"type:varchar(64)"`
+	Ctime int64
+	Utime int64
+}
+
+type GORMFollowGroupDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMFollowGroupDAO(db *gorm.DB) FollowGroupDAO {
+	return &GORMFollowGroupDAO{
+		db: db,
+	}
+}
+
+func (g *GORMFollowGroupDAO) Insert(ctx context.Context, group FollowGroup) (int64, error) {
+	now := time.Now().UnixMilli()
+	group.Ctime = now
+	group.Utime = now
+	err := g.db.WithContext(ctx).Create(&group).Error
+	return group.ID, err
+}
+
+func (g *GORMFollowGroupDAO) Rename(ctx context.Context, uid, gid int64, name string) error {
+	res := g.db.WithContext(ctx).Model(&FollowGroup{}).
+		Where("id = ? AND uid = ?", gid, uid).
+		Updates(map[string]any{
+			"name":  name,
+			"utime": time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		// 要么分组不存在，要么不是自己的
+		return ErrFollowerNotFound
+	}
+	return nil
+}
+
+func (g *GORMFollowGroupDAO) Delete(ctx context.Context, uid, gid int64) error {
+	return g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("id = ? AND uid = ?", gid, uid).Delete(&FollowGroup{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrFollowerNotFound
+		}
+		return tx.Model(&FollowRelation{}).
+			Where("follower = ? AND gid = ?", uid, gid).
+			Updates(map[string]any{
+				"gid":   0,
+				"utime": time.Now().UnixMilli(),
+			}).Error
+	})
+}
+
+func (g *GORMFollowGroupDAO) GetById(ctx context.Context, gid int64) (FollowGroup, error) {
+	var res FollowGroup
+	err := g.db.WithContext(ctx).Where("id = ?", gid).First(&res).Error
+	return res, err
+}
+
+func (g *GORMFollowGroupDAO) FindByUid(ctx context.Context, uid int64) ([]FollowGroup, error) {
+	var res []FollowGroup
+	err := g.db.WithContext(ctx).Where("uid = ?", uid).
+		Order("id").Find(&res).Error
+	return res, err
+}
+
+func (g *GORMFollowGroupDAO) CountByUid(ctx context.Context, uid int64) (int64, error) {
+	var res int64
+	err := g.db.WithContext(ctx).Model(&FollowGroup{}).
+		Where("uid = ?", uid).Count(&res).Error
+	return res, err
+}
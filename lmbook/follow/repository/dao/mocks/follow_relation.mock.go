@@ -0,0 +1,233 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./types.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./types.go -package=daomocks -destination=./mocks/follow_relation.mock.go
+//
+// Package daomocks is a generated GoMock package.
+package daomocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dao "basic-go/lmbook/follow/repository/dao"
+	cursorx "basic-go/lmbook/pkg/cursorx"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFollowRelationDao is a mock of FollowRelationDao interface.
+type MockFollowRelationDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowRelationDaoMockRecorder
+}
+
+// MockFollowRelationDaoMockRecorder is the mock recorder for MockFollowRelationDao.
+type MockFollowRelationDaoMockRecorder struct {
+	mock *MockFollowRelationDao
+}
+
+// NewMockFollowRelationDao creates a new mock instance.
+func NewMockFollowRelationDao(ctrl *gomock.Controller) *MockFollowRelationDao {
+	mock := &MockFollowRelationDao{ctrl: ctrl}
+	mock.recorder = &MockFollowRelationDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowRelationDao) EXPECT() *MockFollowRelationDaoMockRecorder {
+	return m.recorder
+}
+
+// CntFollowee mocks base method.
+func (m *MockFollowRelationDao) CntFollowee(ctx context.Context, uid int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CntFollowee", ctx, uid)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CntFollowee indicates an expected call of CntFollowee.
+func (mr *MockFollowRelationDaoMockRecorder) CntFollowee(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CntFollowee", reflect.TypeOf((*MockFollowRelationDao)(nil).CntFollowee), ctx, uid)
+}
+
+// CntFollower mocks base method.
+func (m *MockFollowRelationDao) CntFollower(ctx context.Context, uid int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CntFollower", ctx, uid)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CntFollower indicates an expected call of CntFollower.
+func (mr *MockFollowRelationDaoMockRecorder) CntFollower(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CntFollower", reflect.TypeOf((*MockFollowRelationDao)(nil).CntFollower), ctx, uid)
+}
+
+// CommonFollowees mocks base method.
+func (m *MockFollowRelationDao) CommonFollowees(ctx context.Context, uid, target int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommonFollowees", ctx, uid, target)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommonFollowees indicates an expected call of CommonFollowees.
+func (mr *MockFollowRelationDaoMockRecorder) CommonFollowees(ctx, uid, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommonFollowees", reflect.TypeOf((*MockFollowRelationDao)(nil).CommonFollowees), ctx, uid, target)
+}
+
+// CreateFollowRelation mocks base method.
+func (m *MockFollowRelationDao) CreateFollowRelation(ctx context.Context, c dao.FollowRelation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFollowRelation", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFollowRelation indicates an expected call of CreateFollowRelation.
+func (mr *MockFollowRelationDaoMockRecorder) CreateFollowRelation(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFollowRelation", reflect.TypeOf((*MockFollowRelationDao)(nil).CreateFollowRelation), ctx, c)
+}
+
+// FollowRelationDetail mocks base method.
+func (m *MockFollowRelationDao) FollowRelationDetail(ctx context.Context, follower, followee int64) (dao.FollowRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowRelationDetail", ctx, follower, followee)
+	ret0, _ := ret[0].(dao.FollowRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowRelationDetail indicates an expected call of FollowRelationDetail.
+func (mr *MockFollowRelationDaoMockRecorder) FollowRelationDetail(ctx, follower, followee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowRelationDetail", reflect.TypeOf((*MockFollowRelationDao)(nil).FollowRelationDetail), ctx, follower, followee)
+}
+
+// FollowRelationList mocks base method.
+func (m *MockFollowRelationDao) FollowRelationList(ctx context.Context, follower int64, cur cursorx.Cursor, limit int64) ([]dao.FollowRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowRelationList", ctx, follower, cur, limit)
+	ret0, _ := ret[0].([]dao.FollowRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowRelationList indicates an expected call of FollowRelationList.
+func (mr *MockFollowRelationDaoMockRecorder) FollowRelationList(ctx, follower, cur, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowRelationList", reflect.TypeOf((*MockFollowRelationDao)(nil).FollowRelationList), ctx, follower, cur, limit)
+}
+
+// FollowRelationListByGroup mocks base method.
+func (m *MockFollowRelationDao) FollowRelationListByGroup(ctx context.Context, follower, gid int64, special bool, cur cursorx.Cursor, limit int64) ([]dao.FollowRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowRelationListByGroup", ctx, follower, gid, special, cur, limit)
+	ret0, _ := ret[0].([]dao.FollowRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowRelationListByGroup indicates an expected call of FollowRelationListByGroup.
+func (mr *MockFollowRelationDaoMockRecorder) FollowRelationListByGroup(ctx, follower, gid, special, cur, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowRelationListByGroup", reflect.TypeOf((*MockFollowRelationDao)(nil).FollowRelationListByGroup), ctx, follower, gid, special, cur, limit)
+}
+
+// FolloweeIds mocks base method.
+func (m *MockFollowRelationDao) FolloweeIds(ctx context.Context, follower int64, limit int) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FolloweeIds", ctx, follower, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FolloweeIds indicates an expected call of FolloweeIds.
+func (mr *MockFollowRelationDaoMockRecorder) FolloweeIds(ctx, follower, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FolloweeIds", reflect.TypeOf((*MockFollowRelationDao)(nil).FolloweeIds), ctx, follower, limit)
+}
+
+// FolloweesIn mocks base method.
+func (m *MockFollowRelationDao) FolloweesIn(ctx context.Context, follower int64, followees []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FolloweesIn", ctx, follower, followees)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FolloweesIn indicates an expected call of FolloweesIn.
+func (mr *MockFollowRelationDaoMockRecorder) FolloweesIn(ctx, follower, followees any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FolloweesIn", reflect.TypeOf((*MockFollowRelationDao)(nil).FolloweesIn), ctx, follower, followees)
+}
+
+// FollowerRelationList mocks base method.
+func (m *MockFollowRelationDao) FollowerRelationList(ctx context.Context, followee int64, cur cursorx.Cursor, limit int64) ([]dao.FollowRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowerRelationList", ctx, followee, cur, limit)
+	ret0, _ := ret[0].([]dao.FollowRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowerRelationList indicates an expected call of FollowerRelationList.
+func (mr *MockFollowRelationDaoMockRecorder) FollowerRelationList(ctx, followee, cur, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowerRelationList", reflect.TypeOf((*MockFollowRelationDao)(nil).FollowerRelationList), ctx, followee, cur, limit)
+}
+
+// FollowersIn mocks base method.
+func (m *MockFollowRelationDao) FollowersIn(ctx context.Context, followee int64, followers []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowersIn", ctx, followee, followers)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowersIn indicates an expected call of FollowersIn.
+func (mr *MockFollowRelationDaoMockRecorder) FollowersIn(ctx, followee, followers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowersIn", reflect.TypeOf((*MockFollowRelationDao)(nil).FollowersIn), ctx, followee, followers)
+}
+
+// UpdateRelationMeta mocks base method.
+func (m_2 *MockFollowRelationDao) UpdateRelationMeta(ctx context.Context, m dao.FollowRelationMeta) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "UpdateRelationMeta", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRelationMeta indicates an expected call of UpdateRelationMeta.
+func (mr *MockFollowRelationDaoMockRecorder) UpdateRelationMeta(ctx, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRelationMeta", reflect.TypeOf((*MockFollowRelationDao)(nil).UpdateRelationMeta), ctx, m)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockFollowRelationDao) UpdateStatus(ctx context.Context, followee, follower int64, status uint8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, followee, follower, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockFollowRelationDaoMockRecorder) UpdateStatus(ctx, followee, follower, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockFollowRelationDao)(nil).UpdateStatus), ctx, followee, follower, status)
+}
@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"basic-go/lmbook/pkg/cursorx"
 	"context"
 	"fmt"
 	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
@@ -18,29 +19,48 @@ type TableStoreFollowRelationDao struct {
 	client *tablestore.TableStoreClient
 }
 
-func (t *TableStoreFollowRelationDao) FollowRelationList(ctx context.Context, follower, offset, limit int64) ([]FollowRelation, error) {
-	request := &tablestore.SQLQueryRequest{
-		// tablestore 的 API 不允许 SQL 里面带参数
-		// SELECT * FROM `users` WHERE id = ?; []any{123}
-		// select id,follower,followee from follow_relations where follower = 1 OR 1=1
-		// follower 前端输入了 1 OR 1=1
-		// 核心原则就是，千万不要用前端输入的数据来拼接 SQL
-		// 最好就是用占位符
-		// WHERE username = 'abc' or 1=1 AND password = '';
-		// WHERE username = 'abc' AND password = ''; TRUNCATE table mysql.`users`;
-		Query: fmt.Sprintf("select id,follower,followee from %s where follower = %d AND status = %d OFFSET %d LIMIT %d",
-			FollowRelationTableName, follower, FollowRelationStatusActive, offset, limit)}
-	response, err := t.client.SQLQuery(request)
+func (t *TableStoreFollowRelationDao) FollowRelationList(ctx context.Context, follower int64, cur cursorx.Cursor, limit int64) ([]FollowRelation, error) {
+	return t.relationList(ctx, "follower", follower, cur, limit)
+}
+
+func (t *TableStoreFollowRelationDao) FollowerRelationList(ctx context.Context, followee int64, cur cursorx.Cursor, limit int64) ([]FollowRelation, error) {
+	return t.relationList(ctx, "followee", followee, cur, limit)
+}
+
+// relationList col 只能是 follower 或者 followee
+func (t *TableStoreFollowRelationDao) relationList(ctx context.Context, col string, uid int64, cur cursorx.Cursor, limit int64) ([]FollowRelation, error) {
+	// tablestore 的 API 不允许 SQL 里面带参数
+	// SELECT * FROM `users` WHERE id = ?; []any{123}
+	// select id,follower,followee from follow_relations where follower = 1 OR 1=1
+	// follower 前端输入了 1 OR 1=1
+	// 核心原则就是，千万不要用前端输入的数据来拼接 SQL
+	// 最好就是用占位符
+	// WHERE username = 'abc' or 1=1 AND password = '';
+	// WHERE username = 'abc' AND password = ''; TRUNCATE table mysql.`users`;
+	// 这里拼进去的都是 int64，不会有注入的问题
+	query := fmt.Sprintf("select id,follower,followee,ctime from %s where %s = %d AND status = %d",
+		FollowRelationTableName, col, uid, FollowRelationStatusActive)
+	if !cur.IsZero() {
+		query += fmt.Sprintf(" AND (ctime < %d OR (ctime = %d AND id < %d))",
+			cur.Time, cur.Time, cur.Id)
+	}
+	query += " ORDER BY ctime DESC, id DESC"
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	response, err := t.client.SQLQuery(&tablestore.SQLQueryRequest{Query: query})
 	if err != nil {
 		return nil, err
 	}
 	resultSet := response.ResultSet
-	followRelations := make([]FollowRelation, 0, limit)
+	followRelations := make([]FollowRelation, 0, max(limit, 0))
 	for resultSet.HasNext() {
 		row := resultSet.Next()
 		followRelation := FollowRelation{}
+		followRelation.ID, _ = row.GetInt64ByName("id")
 		followRelation.Follower, _ = row.GetInt64ByName("follower")
 		followRelation.Followee, _ = row.GetInt64ByName("followee")
+		followRelation.Ctime, _ = row.GetInt64ByName("ctime")
 		followRelations = append(followRelations, followRelation)
 	}
 	return followRelations, nil
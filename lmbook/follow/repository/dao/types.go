@@ -1,6 +1,9 @@
 package dao
 
-import "context"
+import (
+	"basic-go/lmbook/pkg/cursorx"
+	"context"
+)
 
 // 存储用户的关注数据
 type FollowRelation struct {
@@ -12,21 +15,34 @@ type FollowRelation struct {
 	// 如果你认为查询一个人有哪些粉丝，是主要查询场景
 	// <followee, follower>
 	// 我查我关注了哪些人？ WHERE follower = 123(我的 uid)
-	Follower int64 `gorm:"uniqueIndex:follower_followee"`
-	Followee int64 `gorm:"uniqueIndex:follower_followee"`
+	// follower_ctime 和 followee_ctime 是给关注列表、粉丝列表翻页用的
+	// follower_gid 是给按照分组查关注列表用的
+	Follower int64 `gorm:"uniqueIndex:follower_followee;index:follower_ctime,priority:1;index:follower_gid,priority:1"`
+	Followee int64 `gorm:"uniqueIndex:follower_followee;index:followee_ctime,priority:1"`
 
 	// 软删除策略
 	Status uint8
 
-	// 如果你的关注有类型的，有优先级，有一些备注数据的
-	// Type string
-	// Priority string
-	// Gid 分组ID
+	// Gid 分组ID，0 代表没有分组
+	Gid int64 `gorm:"index:follower_gid,priority:2"`
+	// Note 备注，只有关注者自己能看到
+	Note string `gorm:"type:varchar(256)"`
+	// Special 特别关注
+	Special bool
 
-	Ctime int64
+	Ctime int64 `gorm:"index:follower_ctime,priority:2;index:followee_ctime,priority:2"`
 	Utime int64
 }
 
+// FollowRelationMeta 要修改的分组、备注、特别关注，nil 的不修改
+type FollowRelationMeta struct {
+	Follower int64
+	Followee int64
+	Gid      *int64
+	Note     *string
+	Special  *bool
+}
+
 const (
 	FollowRelationStatusUnknown uint8 = iota
 	FollowRelationStatusActive
@@ -34,13 +50,28 @@ const (
 )
 
 type FollowRelationDao interface {
-	// FollowRelationList 获取某人的关注列表
-	FollowRelationList(ctx context.Context, follower, offset, limit int64) ([]FollowRelation, error)
+	// FollowRelationList 获取某人的关注列表，按照 (ctime, id) 倒序翻页
+	FollowRelationList(ctx context.Context, follower int64, cur cursorx.Cursor, limit int64) ([]FollowRelation, error)
+	// FollowerRelationList 获取某人的粉丝列表，limit 小于等于 0 代表不分页
+	FollowerRelationList(ctx context.Context, followee int64, cur cursorx.Cursor, limit int64) ([]FollowRelation, error)
 	FollowRelationDetail(ctx context.Context, follower int64, followee int64) (FollowRelation, error)
 	// CreateFollowRelation 创建联系人
 	CreateFollowRelation(ctx context.Context, c FollowRelation) error
 	// UpdateStatus 更新状态
 	UpdateStatus(ctx context.Context, followee int64, follower int64, status uint8) error
+	// UpdateRelationMeta 修改分组、备注、特别关注，只有关注着的才能修改
+	UpdateRelationMeta(ctx context.Context, m FollowRelationMeta) error
+	// FollowRelationListByGroup 按照分组获取关注列表，gid 为 0 代表不限分组，
+	// special 为 true 的时候只返回特别关注。按照 (ctime, id) 倒序翻页
+	FollowRelationListByGroup(ctx context.Context, follower, gid int64, special bool, cur cursorx.Cursor, limit int64) ([]FollowRelation, error)
+	// FolloweeIds 某人关注的所有人的 ID，最多 limit 个，按照关注时间倒序
+	FolloweeIds(ctx context.Context, follower int64, limit int) ([]int64, error)
+	// FolloweesIn followees 里面 follower 关注了的人
+	FolloweesIn(ctx context.Context, follower int64, followees []int64) ([]int64, error)
+	// FollowersIn followers 里面关注了 followee 的人
+	FollowersIn(ctx context.Context, followee int64, followers []int64) ([]int64, error)
+	// CommonFollowees uid 和 target 共同关注的人，关注集合不完整的时候用
+	CommonFollowees(ctx context.Context, uid, target int64) ([]int64, error)
 	// CntFollower 统计计算关注自己的人有多少
 	CntFollower(ctx context.Context, uid int64) (int64, error)
 	// CntFollowee 统计自己关注了多少人
@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"basic-go/lmbook/follow/domain"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"golang.org/x/sync/errgroup"
+	"sort"
+	"time"
+)
+
+const (
+	// maxFolloweeSetSize 关注集合最多放多少人，关注了更多人的只放最近关注的这部分，并且标记为不完整
+	maxFolloweeSetSize = 5000
+	// maxRecommendVia 推荐的时候最多看多少个我关注的人
+	maxRecommendVia = 200
+	// recommendSampleSize 推荐的时候每个我关注的人最多看他关注的多少人，
+	// 所以一次推荐最多处理 maxRecommendVia * recommendSampleSize 个人
+	recommendSampleSize = 50
+	// recommendCandidateFactor 按照重合次数取 limit 的多少倍出来，再去掉已经关注了的
+	recommendCandidateFactor = 3
+	// loadFolloweeSetsTimeout 推荐的时候在后台加载关注集合的超时时间
+	loadFolloweeSetsTimeout = time.Minute
+)
+
+func (d *CachedRelationRepository) MutualFollow(ctx context.Context, uid int64, targets []int64) (map[int64]bool, error) {
+	err := d.ensureFolloweeSets(ctx, append([]int64{uid}, targets...))
+	if err != nil {
+		return nil, err
+	}
+	following, err := d.areFollowing(ctx, uid, targets)
+	if err != nil {
+		return nil, err
+	}
+	followed, complete, err := d.cache.AreFollowedBy(ctx, uid, targets)
+	if err != nil {
+		return nil, err
+	}
+	// 对方的集合不完整，不在集合里面的要回数据库确认
+	var unknown []int64
+	for i, target := range targets {
+		if !followed[i] && !complete[i] {
+			unknown = append(unknown, target)
+		}
+	}
+	followedSet := map[int64]struct{}{}
+	if len(unknown) > 0 {
+		confirmed, er := d.dao.FollowersIn(ctx, uid, unknown)
+		if er != nil {
+			return nil, er
+		}
+		followedSet = toSet(confirmed)
+	}
+	res := make(map[int64]bool, len(targets))
+	for i, target := range targets {
+		_, ok := followedSet[target]
+		res[target] = following[target] && (followed[i] || ok)
+	}
+	return res, nil
+}
+
+func (d *CachedRelationRepository) CommonFollowees(ctx context.Context, uid, target int64) ([]int64, error) {
+	err := d.ensureFolloweeSets(ctx, []int64{uid, target})
+	if err != nil {
+		return nil, err
+	}
+	followees, complete, err := d.cache.AllFollowees(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if !complete {
+		// 关注了很多人的，集合里面只有一部分，直接查数据库
+		return d.dao.CommonFollowees(ctx, uid, target)
+	}
+	if len(followees) == 0 {
+		return []int64{}, nil
+	}
+	following, complete, err := d.cache.AreFollowing(ctx, target, followees)
+	if err != nil {
+		return nil, err
+	}
+	if !complete {
+		return d.dao.CommonFollowees(ctx, uid, target)
+	}
+	res := make([]int64, 0, len(followees))
+	for i, f := range followees {
+		if following[i] {
+			res = append(res, f)
+		}
+	}
+	return res, nil
+}
+
+// Recommendations 从我关注的人里面挑一部分，再从他们关注的人里面各挑一部分，
+// 按照出现的次数排序。都是抽样的，所以 Overlap 是一个估计值，
+// 这样不管关注了多少人，一次推荐处理的数据量都是有上限的
+func (d *CachedRelationRepository) Recommendations(ctx context.Context,
+	uid int64, limit int64) ([]domain.FollowRecommendation, error) {
+	err := d.ensureFolloweeSets(ctx, []int64{uid})
+	if err != nil {
+		return nil, err
+	}
+	via, err := d.cache.Followees(ctx, uid, maxRecommendVia)
+	if err != nil {
+		return nil, err
+	}
+	if len(via) == 0 {
+		return []domain.FollowRecommendation{}, nil
+	}
+	missing, err := d.cache.MissingFolloweeSets(ctx, via)
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		// 没有加载的不在请求里面等，放到后台加载，这一次先用已经加载了的
+		go func() {
+			loadCtx, cancel := context.WithTimeout(context.Background(), loadFolloweeSetsTimeout)
+			defer cancel()
+			er := d.loadFolloweeSets(loadCtx, missing)
+			if er != nil {
+				d.l.Error("后台加载关注集合失败",
+					logger.Int64("uid", uid),
+					logger.Error(er))
+			}
+		}()
+		missingSet := toSet(missing)
+		loaded := make([]int64, 0, len(via))
+		for _, v := range via {
+			if _, ok := missingSet[v]; !ok {
+				loaded = append(loaded, v)
+			}
+		}
+		via = loaded
+	}
+	if len(via) == 0 {
+		return []domain.FollowRecommendation{}, nil
+	}
+	samples, err := d.cache.SampleFollowees(ctx, via, recommendSampleSize)
+	if err != nil {
+		return nil, err
+	}
+	overlaps := make(map[int64]int64, len(via)*recommendSampleSize)
+	for _, followees := range samples {
+		for _, f := range followees {
+			if f != uid {
+				overlaps[f]++
+			}
+		}
+	}
+	candidates := make([]domain.FollowRecommendation, 0, len(overlaps))
+	for id, cnt := range overlaps {
+		candidates = append(candidates, domain.FollowRecommendation{Uid: id, Overlap: cnt})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Overlap != candidates[j].Overlap {
+			return candidates[i].Overlap > candidates[j].Overlap
+		}
+		return candidates[i].Uid < candidates[j].Uid
+	})
+	if int64(len(candidates)) > limit*recommendCandidateFactor {
+		candidates = candidates[:limit*recommendCandidateFactor]
+	}
+	ids := make([]int64, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.Uid)
+	}
+	following, err := d.areFollowing(ctx, uid, ids)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.FollowRecommendation, 0, limit)
+	for _, c := range candidates {
+		if following[c.Uid] {
+			continue
+		}
+		res = append(res, c)
+		if int64(len(res)) == limit {
+			break
+		}
+	}
+	return res, nil
+}
+
+// areFollowing uid 关注了 targets 里面的哪些人，集合不完整的时候不在集合里面的要回数据库确认
+func (d *CachedRelationRepository) areFollowing(ctx context.Context, uid int64, targets []int64) (map[int64]bool, error) {
+	res := make(map[int64]bool, len(targets))
+	if len(targets) == 0 {
+		return res, nil
+	}
+	following, complete, err := d.cache.AreFollowing(ctx, uid, targets)
+	if err != nil {
+		return nil, err
+	}
+	var unknown []int64
+	for i, target := range targets {
+		res[target] = following[i]
+		if !following[i] && !complete {
+			unknown = append(unknown, target)
+		}
+	}
+	if len(unknown) == 0 {
+		return res, nil
+	}
+	confirmed, err := d.dao.FolloweesIn(ctx, uid, unknown)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range confirmed {
+		res[f] = true
+	}
+	return res, nil
+}
+
+// ensureFolloweeSets 把还没有加载的关注集合从数据库里面加载进来
+func (d *CachedRelationRepository) ensureFolloweeSets(ctx context.Context, uids []int64) error {
+	missing, err := d.cache.MissingFolloweeSets(ctx, uids)
+	if err != nil {
+		return err
+	}
+	return d.loadFolloweeSets(ctx, missing)
+}
+
+func (d *CachedRelationRepository) loadFolloweeSets(ctx context.Context, uids []int64) error {
+	var eg errgroup.Group
+	eg.SetLimit(10)
+	for _, uid := range uids {
+		uid := uid
+		eg.Go(func() error {
+			// 多查一个，用来判断是不是全部都查出来了
+			followees, er := d.dao.FolloweeIds(ctx, uid, maxFolloweeSetSize+1)
+			if er != nil {
+				return er
+			}
+			complete := len(followees) <= maxFolloweeSetSize
+			if !complete {
+				followees = followees[:maxFolloweeSetSize]
+			}
+			er = d.cache.SetFollowees(ctx, uid, followees, complete)
+			if er != nil {
+				d.l.Error("加载关注集合失败",
+					logger.Int64("uid", uid),
+					logger.Error(er))
+			}
+			return er
+		})
+	}
+	return eg.Wait()
+}
+
+func toSet(ids []int64) map[int64]struct{} {
+	res := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		res[id] = struct{}{}
+	}
+	return res
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"basic-go/lmbook/follow/domain"
+	"basic-go/lmbook/follow/repository/cache"
+	cachemocks "basic-go/lmbook/follow/repository/cache/mocks"
+	"basic-go/lmbook/follow/repository/dao"
+	daomocks "basic-go/lmbook/follow/repository/dao/mocks"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCachedRelationRepository_MutualFollow(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (dao.FollowRelationDao, cache.FollowCache)
+
+		want map[int64]bool
+	}{
+		{
+			name: "集合都是完整的",
+			mock: func(ctrl *gomock.Controller) (dao.FollowRelationDao, cache.FollowCache) {
+				d := daomocks.NewMockFollowRelationDao(ctrl)
+				c := cachemocks.NewMockFollowCache(ctrl)
+				c.EXPECT().MissingFolloweeSets(gomock.Any(), []int64{1, 2, 3}).Return(nil, nil)
+				c.EXPECT().AreFollowing(gomock.Any(), int64(1), []int64{2, 3}).
+					Return([]bool{true, true}, true, nil)
+				c.EXPECT().AreFollowedBy(gomock.Any(), int64(1), []int64{2, 3}).
+					Return([]bool{true, false}, []bool{true, true}, nil)
+				return d, c
+			},
+			want: map[int64]bool{2: true, 3: false},
+		},
+		{
+			name: "集合不完整，回数据库确认",
+			mock: func(ctrl *gomock.Controller) (dao.FollowRelationDao, cache.FollowCache) {
+				d := daomocks.NewMockFollowRelationDao(ctrl)
+				c := cachemocks.NewMockFollowCache(ctrl)
+				// 3 还没有加载，并且关注的人超过了上限
+				c.EXPECT().MissingFolloweeSets(gomock.Any(), []int64{1, 2, 3}).Return([]int64{3}, nil)
+				followees := make([]int64, maxFolloweeSetSize+1)
+				d.EXPECT().FolloweeIds(gomock.Any(), int64(3), maxFolloweeSetSize+1).Return(followees, nil)
+				c.EXPECT().SetFollowees(gomock.Any(), int64(3), followees[:maxFolloweeSetSize], false).Return(nil)
+				// 1 的集合也不完整，不在集合里面的 3 要回数据库确认
+				c.EXPECT().AreFollowing(gomock.Any(), int64(1), []int64{2, 3}).
+					Return([]bool{true, false}, false, nil)
+				d.EXPECT().FolloweesIn(gomock.Any(), int64(1), []int64{3}).Return([]int64{3}, nil)
+				c.EXPECT().AreFollowedBy(gomock.Any(), int64(1), []int64{2, 3}).
+					Return([]bool{true, false}, []bool{true, false}, nil)
+				d.EXPECT().FollowersIn(gomock.Any(), int64(1), []int64{3}).Return([]int64{3}, nil)
+				return d, c
+			},
+			want: map[int64]bool{2: true, 3: true},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			d, c := tc.mock(ctrl)
+			repo := NewFollowRelationRepository(d, c, logger.NewNoOpLogger())
+			res, err := repo.MutualFollow(context.Background(), 1, []int64{2, 3})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestCachedRelationRepository_CommonFollowees(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (dao.FollowRelationDao, cache.FollowCache)
+
+		want []int64
+	}{
+		{
+			name: "在缓存里面求交集",
+			mock: func(ctrl *gomock.Controller) (dao.FollowRelationDao, cache.FollowCache) {
+				d := daomocks.NewMockFollowRelationDao(ctrl)
+				c := cachemocks.NewMockFollowCache(ctrl)
+				c.EXPECT().MissingFolloweeSets(gomock.Any(), []int64{1, 2}).Return(nil, nil)
+				c.EXPECT().AllFollowees(gomock.Any(), int64(1)).Return([]int64{3, 4, 5}, true, nil)
+				c.EXPECT().AreFollowing(gomock.Any(), int64(2), []int64{3, 4, 5}).
+					Return([]bool{true, false, true}, true, nil)
+				return d, c
+			},
+			want: []int64{3, 5},
+		},
+		{
+			name: "集合不完整，查数据库",
+			mock: func(ctrl *gomock.Controller) (dao.FollowRelationDao, cache.FollowCache) {
+				d := daomocks.NewMockFollowRelationDao(ctrl)
+				c := cachemocks.NewMockFollowCache(ctrl)
+				c.EXPECT().MissingFolloweeSets(gomock.Any(), []int64{1, 2}).Return(nil, nil)
+				c.EXPECT().AllFollowees(gomock.Any(), int64(1)).Return([]int64{3, 4, 5}, true, nil)
+				c.EXPECT().AreFollowing(gomock.Any(), int64(2), []int64{3, 4, 5}).
+					Return([]bool{true, false, false}, false, nil)
+				d.EXPECT().CommonFollowees(gomock.Any(), int64(1), int64(2)).Return([]int64{3, 5}, nil)
+				return d, c
+			},
+			want: []int64{3, 5},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			d, c := tc.mock(ctrl)
+			repo := NewFollowRelationRepository(d, c, logger.NewNoOpLogger())
+			res, err := repo.CommonFollowees(context.Background(), 1, 2)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestCachedRelationRepository_Recommendations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := daomocks.NewMockFollowRelationDao(ctrl)
+	c := cachemocks.NewMockFollowCache(ctrl)
+	c.EXPECT().MissingFolloweeSets(gomock.Any(), []int64{1}).Return(nil, nil)
+	c.EXPECT().Followees(gomock.Any(), int64(1), int64(maxRecommendVia)).Return([]int64{2, 3, 4}, nil)
+	// 4 的集合还没有加载，这一次不用它，放到后台加载
+	c.EXPECT().MissingFolloweeSets(gomock.Any(), []int64{2, 3, 4}).Return([]int64{4}, nil)
+	loaded := make(chan struct{})
+	d.EXPECT().FolloweeIds(gomock.Any(), int64(4), maxFolloweeSetSize+1).Return([]int64{5}, nil)
+	c.EXPECT().SetFollowees(gomock.Any(), int64(4), []int64{5}, true).
+		DoAndReturn(func(ctx context.Context, uid int64, followees []int64, complete bool) error {
+			close(loaded)
+			return nil
+		})
+	c.EXPECT().SampleFollowees(gomock.Any(), []int64{2, 3}, int64(recommendSampleSize)).
+		Return(map[int64][]int64{
+			// 1 是自己，3 已经关注了
+			2: {1, 3, 5, 6},
+			3: {5, 7},
+		}, nil)
+	// 按照重合次数排序，次数一样的按照 id 排序，最多取 limit 的三倍
+	c.EXPECT().AreFollowing(gomock.Any(), int64(1), []int64{5, 3, 6}).
+		Return([]bool{false, true, false}, true, nil)
+
+	repo := NewFollowRelationRepository(d, c, logger.NewNoOpLogger())
+	res, err := repo.Recommendations(context.Background(), 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.FollowRecommendation{{Uid: 5, Overlap: 2}}, res)
+	select {
+	case <-loaded:
+	case <-time.After(time.Second * 3):
+		t.Fatal("没有在后台加载关注集合")
+	}
+}
@@ -4,13 +4,20 @@ import (
 	"basic-go/lmbook/follow/domain"
 	"basic-go/lmbook/follow/repository/cache"
 	"basic-go/lmbook/follow/repository/dao"
+	"basic-go/lmbook/pkg/cursorx"
 	"basic-go/lmbook/pkg/logger"
 	"context"
+	"time"
 )
 
+// ErrRecordNotFound 没有关注，或者分组不存在
+var ErrRecordNotFound = dao.ErrFollowerNotFound
+
 type FollowRepository interface {
 	// GetFollowee 获取某人的关注列表
-	GetFollowee(ctx context.Context, follower, offset, limit int64) ([]domain.FollowRelation, error)
+	GetFollowee(ctx context.Context, follower int64, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error)
+	// GetFollower 获取某人的粉丝列表，limit 小于等于 0 代表全部
+	GetFollower(ctx context.Context, followee int64, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error)
 	// FollowInfo 查看关注人的详情
 	FollowInfo(ctx context.Context, follower int64, followee int64) (domain.FollowRelation, error)
 	// AddFollowRelation 创建关注关系
@@ -18,6 +25,16 @@ type FollowRepository interface {
 	// InactiveFollowRelation 取消关注
 	InactiveFollowRelation(ctx context.Context, follower int64, followee int64) error
 	GetFollowStatics(ctx context.Context, uid int64) (domain.FollowStatics, error)
+	// UpdateFollowRelation 修改分组、备注、特别关注
+	UpdateFollowRelation(ctx context.Context, m domain.FollowRelationMeta) error
+	// GetFolloweeByGroup 按照分组获取关注列表，gid 为 0 代表不限分组
+	GetFolloweeByGroup(ctx context.Context, follower, gid int64, special bool, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error)
+	// MutualFollow uid 和 targets 里面的每一个人是否互相关注
+	MutualFollow(ctx context.Context, uid int64, targets []int64) (map[int64]bool, error)
+	// CommonFollowees uid 和 target 共同关注的人
+	CommonFollowees(ctx context.Context, uid, target int64) ([]int64, error)
+	// Recommendations 我关注的人关注了谁，按照重合次数倒序
+	Recommendations(ctx context.Context, uid int64, limit int64) ([]domain.FollowRecommendation, error)
 }
 
 type CachedRelationRepository struct {
@@ -57,9 +74,36 @@ func (d *CachedRelationRepository) InactiveFollowRelation(ctx context.Context, f
 	return d.cache.CancelFollow(ctx, follower, followee)
 }
 
-func (d *CachedRelationRepository) GetFollowee(ctx context.Context, follower, offset, limit int64) ([]domain.FollowRelation, error) {
+func (d *CachedRelationRepository) GetFollowee(ctx context.Context, follower int64, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error) {
 	// 你可以考虑在这里缓存关注者列表的第一页
-	followerList, err := d.dao.FollowRelationList(ctx, follower, offset, limit)
+	followerList, err := d.dao.FollowRelationList(ctx, follower, cur, limit)
+	if err != nil {
+		return nil, err
+	}
+	return d.genFollowRelationList(followerList), nil
+}
+
+func (d *CachedRelationRepository) GetFolloweeByGroup(ctx context.Context,
+	follower, gid int64, special bool, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error) {
+	followeeList, err := d.dao.FollowRelationListByGroup(ctx, follower, gid, special, cur, limit)
+	if err != nil {
+		return nil, err
+	}
+	return d.genFollowRelationList(followeeList), nil
+}
+
+func (d *CachedRelationRepository) UpdateFollowRelation(ctx context.Context, m domain.FollowRelationMeta) error {
+	return d.dao.UpdateRelationMeta(ctx, dao.FollowRelationMeta{
+		Follower: m.Follower,
+		Followee: m.Followee,
+		Gid:      m.Gid,
+		Note:     m.Note,
+		Special:  m.Special,
+	})
+}
+
+func (d *CachedRelationRepository) GetFollower(ctx context.Context, followee int64, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error) {
+	followerList, err := d.dao.FollowerRelationList(ctx, followee, cur, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -94,8 +138,13 @@ func (d *CachedRelationRepository) AddFollowRelation(ctx context.Context, c doma
 
 func (d *CachedRelationRepository) toDomain(fr dao.FollowRelation) domain.FollowRelation {
 	return domain.FollowRelation{
+		Id:       fr.ID,
 		Followee: fr.Followee,
 		Follower: fr.Follower,
+		Gid:      fr.Gid,
+		Note:     fr.Note,
+		Special:  fr.Special,
+		Ctime:    time.UnixMilli(fr.Ctime),
 	}
 }
 
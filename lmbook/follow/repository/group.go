@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"basic-go/lmbook/follow/domain"
+	"basic-go/lmbook/follow/repository/dao"
+	"context"
+	"time"
+)
+
+type FollowGroupRepository interface {
+	Create(ctx context.Context, g domain.FollowGroup) (int64, error)
+	Rename(ctx context.Context, uid, gid int64, name string) error
+	Delete(ctx context.Context, uid, gid int64) error
+	GetById(ctx context.Context, gid int64) (domain.FollowGroup, error)
+	FindByUid(ctx context.Context, uid int64) ([]domain.FollowGroup, error)
+	CountByUid(ctx context.Context, uid int64) (int64, error)
+}
+
+type followGroupRepository struct {
+	dao dao.FollowGroupDAO
+}
+
+func NewFollowGroupRepository(dao dao.FollowGroupDAO) FollowGroupRepository {
+	return &followGroupRepository{
+		dao: dao,
+	}
+}
+
+func (r *followGroupRepository) Create(ctx context.Context, g domain.FollowGroup) (int64, error) {
+	return r.dao.Insert(ctx, dao.FollowGroup{
+		Uid:  g.Uid,
+		Name: g.Name,
+	})
+}
+
+func (r *followGroupRepository) Rename(ctx context.Context, uid, gid int64, name string) error {
+	return r.dao.Rename(ctx, uid, gid, name)
+}
+
+func (r *followGroupRepository) Delete(ctx context.Context, uid, gid int64) error {
+	return r.dao.Delete(ctx, uid, gid)
+}
+
+func (r *followGroupRepository) GetById(ctx context.Context, gid int64) (domain.FollowGroup, error) {
+	g, err := r.dao.GetById(ctx, gid)
+	if err != nil {
+		return domain.FollowGroup{}, err
+	}
+	return r.toDomain(g), nil
+}
+
+func (r *followGroupRepository) FindByUid(ctx context.Context, uid int64) ([]domain.FollowGroup, error) {
+	groups, err := r.dao.FindByUid(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.FollowGroup, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, r.toDomain(g))
+	}
+	return res, nil
+}
+
+func (r *followGroupRepository) CountByUid(ctx context.Context, uid int64) (int64, error) {
+	return r.dao.CountByUid(ctx, uid)
+}
+
+func (r *followGroupRepository) toDomain(g dao.FollowGroup) domain.FollowGroup {
+	return domain.FollowGroup{
+		Id:    g.ID,
+		Uid:   g.Uid,
+		Name:  g.Name,
+		Ctime: time.UnixMilli(g.Ctime),
+	}
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./block.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./block.go -package=repomocks -destination=./mocks/block.mock.go
+//
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBlockRepository is a mock of BlockRepository interface.
+type MockBlockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockRepositoryMockRecorder
+}
+
+// MockBlockRepositoryMockRecorder is the mock recorder for MockBlockRepository.
+type MockBlockRepositoryMockRecorder struct {
+	mock *MockBlockRepository
+}
+
+// NewMockBlockRepository creates a new mock instance.
+func NewMockBlockRepository(ctrl *gomock.Controller) *MockBlockRepository {
+	mock := &MockBlockRepository{ctrl: ctrl}
+	mock.recorder = &MockBlockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockRepository) EXPECT() *MockBlockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FilterMuted mocks base method.
+func (m *MockBlockRepository) FilterMuted(ctx context.Context, target int64, uids []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterMuted", ctx, target, uids)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterMuted indicates an expected call of FilterMuted.
+func (mr *MockBlockRepositoryMockRecorder) FilterMuted(ctx, target, uids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterMuted", reflect.TypeOf((*MockBlockRepository)(nil).FilterMuted), ctx, target, uids)
+}
+
+// IsBlocked mocks base method.
+func (m *MockBlockRepository) IsBlocked(ctx context.Context, uid, target int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", ctx, uid, target)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockBlockRepositoryMockRecorder) IsBlocked(ctx, uid, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockBlockRepository)(nil).IsBlocked), ctx, uid, target)
+}
+
+// MuteList mocks base method.
+func (m *MockBlockRepository) MuteList(ctx context.Context, uid int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MuteList", ctx, uid)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MuteList indicates an expected call of MuteList.
+func (mr *MockBlockRepositoryMockRecorder) MuteList(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MuteList", reflect.TypeOf((*MockBlockRepository)(nil).MuteList), ctx, uid)
+}
+
+// SetBlock mocks base method.
+func (m *MockBlockRepository) SetBlock(ctx context.Context, uid, target int64, block bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlock", ctx, uid, target, block)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBlock indicates an expected call of SetBlock.
+func (mr *MockBlockRepositoryMockRecorder) SetBlock(ctx, uid, target, block any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlock", reflect.TypeOf((*MockBlockRepository)(nil).SetBlock), ctx, uid, target, block)
+}
+
+// SetMute mocks base method.
+func (m *MockBlockRepository) SetMute(ctx context.Context, uid, target int64, mute bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMute", ctx, uid, target, mute)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMute indicates an expected call of SetMute.
+func (mr *MockBlockRepositoryMockRecorder) SetMute(ctx, uid, target, mute any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMute", reflect.TypeOf((*MockBlockRepository)(nil).SetMute), ctx, uid, target, mute)
+}
@@ -0,0 +1,203 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./followrelation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./followrelation.go -package=repomocks -destination=./mocks/followrelation.mock.go
+//
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "basic-go/lmbook/follow/domain"
+	cursorx "basic-go/lmbook/pkg/cursorx"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFollowRepository is a mock of FollowRepository interface.
+type MockFollowRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowRepositoryMockRecorder
+}
+
+// MockFollowRepositoryMockRecorder is the mock recorder for MockFollowRepository.
+type MockFollowRepositoryMockRecorder struct {
+	mock *MockFollowRepository
+}
+
+// NewMockFollowRepository creates a new mock instance.
+func NewMockFollowRepository(ctrl *gomock.Controller) *MockFollowRepository {
+	mock := &MockFollowRepository{ctrl: ctrl}
+	mock.recorder = &MockFollowRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowRepository) EXPECT() *MockFollowRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddFollowRelation mocks base method.
+func (m *MockFollowRepository) AddFollowRelation(ctx context.Context, f domain.FollowRelation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFollowRelation", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFollowRelation indicates an expected call of AddFollowRelation.
+func (mr *MockFollowRepositoryMockRecorder) AddFollowRelation(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFollowRelation", reflect.TypeOf((*MockFollowRepository)(nil).AddFollowRelation), ctx, f)
+}
+
+// CommonFollowees mocks base method.
+func (m *MockFollowRepository) CommonFollowees(ctx context.Context, uid, target int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommonFollowees", ctx, uid, target)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommonFollowees indicates an expected call of CommonFollowees.
+func (mr *MockFollowRepositoryMockRecorder) CommonFollowees(ctx, uid, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommonFollowees", reflect.TypeOf((*MockFollowRepository)(nil).CommonFollowees), ctx, uid, target)
+}
+
+// FollowInfo mocks base method.
+func (m *MockFollowRepository) FollowInfo(ctx context.Context, follower, followee int64) (domain.FollowRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowInfo", ctx, follower, followee)
+	ret0, _ := ret[0].(domain.FollowRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowInfo indicates an expected call of FollowInfo.
+func (mr *MockFollowRepositoryMockRecorder) FollowInfo(ctx, follower, followee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowInfo", reflect.TypeOf((*MockFollowRepository)(nil).FollowInfo), ctx, follower, followee)
+}
+
+// GetFollowStatics mocks base method.
+func (m *MockFollowRepository) GetFollowStatics(ctx context.Context, uid int64) (domain.FollowStatics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowStatics", ctx, uid)
+	ret0, _ := ret[0].(domain.FollowStatics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowStatics indicates an expected call of GetFollowStatics.
+func (mr *MockFollowRepositoryMockRecorder) GetFollowStatics(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowStatics", reflect.TypeOf((*MockFollowRepository)(nil).GetFollowStatics), ctx, uid)
+}
+
+// GetFollowee mocks base method.
+func (m *MockFollowRepository) GetFollowee(ctx context.Context, follower int64, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowee", ctx, follower, cur, limit)
+	ret0, _ := ret[0].([]domain.FollowRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowee indicates an expected call of GetFollowee.
+func (mr *MockFollowRepositoryMockRecorder) GetFollowee(ctx, follower, cur, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowee", reflect.TypeOf((*MockFollowRepository)(nil).GetFollowee), ctx, follower, cur, limit)
+}
+
+// GetFolloweeByGroup mocks base method.
+func (m *MockFollowRepository) GetFolloweeByGroup(ctx context.Context, follower, gid int64, special bool, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFolloweeByGroup", ctx, follower, gid, special, cur, limit)
+	ret0, _ := ret[0].([]domain.FollowRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolloweeByGroup indicates an expected call of GetFolloweeByGroup.
+func (mr *MockFollowRepositoryMockRecorder) GetFolloweeByGroup(ctx, follower, gid, special, cur, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolloweeByGroup", reflect.TypeOf((*MockFollowRepository)(nil).GetFolloweeByGroup), ctx, follower, gid, special, cur, limit)
+}
+
+// GetFollower mocks base method.
+func (m *MockFollowRepository) GetFollower(ctx context.Context, followee int64, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollower", ctx, followee, cur, limit)
+	ret0, _ := ret[0].([]domain.FollowRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollower indicates an expected call of GetFollower.
+func (mr *MockFollowRepositoryMockRecorder) GetFollower(ctx, followee, cur, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollower", reflect.TypeOf((*MockFollowRepository)(nil).GetFollower), ctx, followee, cur, limit)
+}
+
+// InactiveFollowRelation mocks base method.
+func (m *MockFollowRepository) InactiveFollowRelation(ctx context.Context, follower, followee int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InactiveFollowRelation", ctx, follower, followee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InactiveFollowRelation indicates an expected call of InactiveFollowRelation.
+func (mr *MockFollowRepositoryMockRecorder) InactiveFollowRelation(ctx, follower, followee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InactiveFollowRelation", reflect.TypeOf((*MockFollowRepository)(nil).InactiveFollowRelation), ctx, follower, followee)
+}
+
+// MutualFollow mocks base method.
+func (m *MockFollowRepository) MutualFollow(ctx context.Context, uid int64, targets []int64) (map[int64]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MutualFollow", ctx, uid, targets)
+	ret0, _ := ret[0].(map[int64]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MutualFollow indicates an expected call of MutualFollow.
+func (mr *MockFollowRepositoryMockRecorder) MutualFollow(ctx, uid, targets any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MutualFollow", reflect.TypeOf((*MockFollowRepository)(nil).MutualFollow), ctx, uid, targets)
+}
+
+// Recommendations mocks base method.
+func (m *MockFollowRepository) Recommendations(ctx context.Context, uid, limit int64) ([]domain.FollowRecommendation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recommendations", ctx, uid, limit)
+	ret0, _ := ret[0].([]domain.FollowRecommendation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recommendations indicates an expected call of Recommendations.
+func (mr *MockFollowRepositoryMockRecorder) Recommendations(ctx, uid, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recommendations", reflect.TypeOf((*MockFollowRepository)(nil).Recommendations), ctx, uid, limit)
+}
+
+// UpdateFollowRelation mocks base method.
+func (m_2 *MockFollowRepository) UpdateFollowRelation(ctx context.Context, m domain.FollowRelationMeta) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "UpdateFollowRelation", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFollowRelation indicates an expected call of UpdateFollowRelation.
+func (mr *MockFollowRepositoryMockRecorder) UpdateFollowRelation(ctx, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFollowRelation", reflect.TypeOf((*MockFollowRepository)(nil).UpdateFollowRelation), ctx, m)
+}
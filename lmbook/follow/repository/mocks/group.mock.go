@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./group.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./group.go -package=repomocks -destination=./mocks/group.mock.go
+//
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "basic-go/lmbook/follow/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFollowGroupRepository is a mock of FollowGroupRepository interface.
+type MockFollowGroupRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowGroupRepositoryMockRecorder
+}
+
+// MockFollowGroupRepositoryMockRecorder is the mock recorder for MockFollowGroupRepository.
+type MockFollowGroupRepositoryMockRecorder struct {
+	mock *MockFollowGroupRepository
+}
+
+// NewMockFollowGroupRepository creates a new mock instance.
+func NewMockFollowGroupRepository(ctrl *gomock.Controller) *MockFollowGroupRepository {
+	mock := &MockFollowGroupRepository{ctrl: ctrl}
+	mock.recorder = &MockFollowGroupRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowGroupRepository) EXPECT() *MockFollowGroupRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByUid mocks base method.
+func (m *MockFollowGroupRepository) CountByUid(ctx context.Context, uid int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByUid", ctx, uid)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByUid indicates an expected call of CountByUid.
+func (mr *MockFollowGroupRepositoryMockRecorder) CountByUid(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUid", reflect.TypeOf((*MockFollowGroupRepository)(nil).CountByUid), ctx, uid)
+}
+
+// Create mocks base method.
+func (m *MockFollowGroupRepository) Create(ctx context.Context, g domain.FollowGroup) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, g)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFollowGroupRepositoryMockRecorder) Create(ctx, g any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFollowGroupRepository)(nil).Create), ctx, g)
+}
+
+// Delete mocks base method.
+func (m *MockFollowGroupRepository) Delete(ctx context.Context, uid, gid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uid, gid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFollowGroupRepositoryMockRecorder) Delete(ctx, uid, gid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFollowGroupRepository)(nil).Delete), ctx, uid, gid)
+}
+
+// FindByUid mocks base method.
+func (m *MockFollowGroupRepository) FindByUid(ctx context.Context, uid int64) ([]domain.FollowGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUid", ctx, uid)
+	ret0, _ := ret[0].([]domain.FollowGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUid indicates an expected call of FindByUid.
+func (mr *MockFollowGroupRepositoryMockRecorder) FindByUid(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUid", reflect.TypeOf((*MockFollowGroupRepository)(nil).FindByUid), ctx, uid)
+}
+
+// GetById mocks base method.
+func (m *MockFollowGroupRepository) GetById(ctx context.Context, gid int64) (domain.FollowGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, gid)
+	ret0, _ := ret[0].(domain.FollowGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockFollowGroupRepositoryMockRecorder) GetById(ctx, gid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockFollowGroupRepository)(nil).GetById), ctx, gid)
+}
+
+// Rename mocks base method.
+func (m *MockFollowGroupRepository) Rename(ctx context.Context, uid, gid int64, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", ctx, uid, gid, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockFollowGroupRepositoryMockRecorder) Rename(ctx, uid, gid, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockFollowGroupRepository)(nil).Rename), ctx, uid, gid, name)
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"basic-go/lmbook/follow/repository"
+	"context"
+	"errors"
+)
+
+var (
+	// ErrBlocked 被对方拉黑了，不能关注对方
+	ErrBlocked   = errors.New("对方已将你拉黑")
+	ErrBlockSelf = errors.New("不能拉黑、屏蔽自己")
+	// ErrTooManyBlocks 拉黑、屏蔽的人到上限了，要先取消一些
+	ErrTooManyBlocks = repository.ErrTooManyBlocks
+)
+
+func (f *followRelationService) Block(ctx context.Context, uid, target int64) error {
+	if uid == target {
+		return ErrBlockSelf
+	}
+	err := f.blockRepo.SetBlock(ctx, uid, target, true)
+	if err != nil {
+		return err
+	}
+	// 拉黑之后，双方的关注关系都取消掉
+	err = f.cancelIfFollowing(ctx, uid, target)
+	if err != nil {
+		return err
+	}
+	return f.cancelIfFollowing(ctx, target, uid)
+}
+
+func (f *followRelationService) CancelBlock(ctx context.Context, uid, target int64) error {
+	// 取消拉黑不会恢复关注关系
+	return f.blockRepo.SetBlock(ctx, uid, target, false)
+}
+
+func (f *followRelationService) Mute(ctx context.Context, uid, target int64) error {
+	if uid == target {
+		return ErrBlockSelf
+	}
+	return f.blockRepo.SetMute(ctx, uid, target, true)
+}
+
+func (f *followRelationService) CancelMute(ctx context.Context, uid, target int64) error {
+	return f.blockRepo.SetMute(ctx, uid, target, false)
+}
+
+func (f *followRelationService) IsBlocked(ctx context.Context, uid, target int64) (bool, error) {
+	return f.blockRepo.IsBlocked(ctx, uid, target)
+}
+
+func (f *followRelationService) GetMuteList(ctx context.Context, uid int64) ([]int64, error) {
+	return f.blockRepo.MuteList(ctx, uid)
+}
+
+func (f *followRelationService) FilterMuted(ctx context.Context, target int64, uids []int64) ([]int64, error) {
+	return f.blockRepo.FilterMuted(ctx, target, uids)
+}
+
+// cancelIfFollowing 只在确实关注了的时候才取消，
+// 不然缓存里面的关注数、粉丝数会被多减一次
+func (f *followRelationService) cancelIfFollowing(ctx context.Context, follower, followee int64) error {
+	_, err := f.repo.FollowInfo(ctx, follower, followee)
+	switch {
+	case err == nil:
+		return f.repo.InactiveFollowRelation(ctx, follower, followee)
+	case errors.Is(err, repository.ErrRecordNotFound):
+		return nil
+	default:
+		return err
+	}
+}
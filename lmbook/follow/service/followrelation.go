@@ -3,34 +3,90 @@ package service
 import (
 	"basic-go/lmbook/follow/domain"
 	"basic-go/lmbook/follow/repository"
+	"basic-go/lmbook/pkg/cursorx"
 	"context"
 )
 
 type FollowRelationService interface {
-	GetFollowee(ctx context.Context, follower, offset, limit int64) ([]domain.FollowRelation, error)
+	// GetFollowee 关注列表，按照关注时间倒序，cur 为零值代表第一页
+	GetFollowee(ctx context.Context, follower int64, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error)
+	// GetFollower 粉丝列表，limit 小于等于 0 代表一次性查出全部
+	GetFollower(ctx context.Context, followee int64, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error)
 	FollowInfo(ctx context.Context,
 		follower, followee int64) (domain.FollowRelation, error)
 	Follow(ctx context.Context, follower, followee int64) error
 	CancelFollow(ctx context.Context, follower, followee int64) error
+	// UpdateFollowRelation 修改分组、备注、特别关注，只修改传了的字段
+	UpdateFollowRelation(ctx context.Context, m domain.FollowRelationMeta) error
+	// GetFolloweeByGroup 按照分组查看关注列表，gid 为 0 代表不限分组，
+	// special 为 true 的时候只看特别关注
+	GetFolloweeByGroup(ctx context.Context, follower, gid int64, special bool, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error)
+
+	CreateGroup(ctx context.Context, g domain.FollowGroup) (int64, error)
+	RenameGroup(ctx context.Context, uid, gid int64, name string) error
+	// DeleteGroup 删除分组，分组里面的人变成没有分组
+	DeleteGroup(ctx context.Context, uid, gid int64) error
+	ListGroups(ctx context.Context, uid int64) ([]domain.FollowGroup, error)
+
+	// Block 拉黑，同时取消双方的关注关系
+	Block(ctx context.Context, uid, target int64) error
+	CancelBlock(ctx context.Context, uid, target int64) error
+	// Mute 屏蔽，对方的动态不再出现在自己的 feed 里面
+	Mute(ctx context.Context, uid, target int64) error
+	CancelMute(ctx context.Context, uid, target int64) error
+	// IsBlocked uid 是否拉黑了 target
+	IsBlocked(ctx context.Context, uid, target int64) (bool, error)
+	// GetMuteList uid 屏蔽或者拉黑的人
+	GetMuteList(ctx context.Context, uid int64) ([]int64, error)
+	// FilterMuted 在 uids 里面找出屏蔽或者拉黑了 target 的人
+	FilterMuted(ctx context.Context, target int64, uids []int64) ([]int64, error)
+
+	// MutualFollow uid 和 targets 里面的每一个人是否互相关注
+	MutualFollow(ctx context.Context, uid int64, targets []int64) (map[int64]bool, error)
+	// CommonFollowees 共同关注的人，limit 小于等于 0 代表全部，同时返回总人数
+	CommonFollowees(ctx context.Context, uid, target int64, limit int64) ([]int64, int64, error)
+	// Recommendations 推荐关注，我关注的人里面关注他的越多越靠前
+	Recommendations(ctx context.Context, uid int64, limit int64) ([]domain.FollowRecommendation, error)
 }
 
 type followRelationService struct {
-	repo repository.FollowRepository
+	repo      repository.FollowRepository
+	groupRepo repository.FollowGroupRepository
+	blockRepo repository.BlockRepository
 }
 
 func (f *followRelationService) CancelFollow(ctx context.Context, follower, followee int64) error {
 	return f.repo.InactiveFollowRelation(ctx, follower, followee)
 }
 
-func NewFollowRelationService(repo repository.FollowRepository) FollowRelationService {
+func NewFollowRelationService(repo repository.FollowRepository,
+	groupRepo repository.FollowGroupRepository,
+	blockRepo repository.BlockRepository) FollowRelationService {
 	return &followRelationService{
-		repo: repo,
+		repo:      repo,
+		groupRepo: groupRepo,
+		blockRepo: blockRepo,
 	}
 }
 
 func (f *followRelationService) GetFollowee(ctx context.Context,
-	follower, offset, limit int64) ([]domain.FollowRelation, error) {
-	return f.repo.GetFollowee(ctx, follower, offset, limit)
+	follower int64, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error) {
+	return f.repo.GetFollowee(ctx, follower, cur, limit)
+}
+
+func (f *followRelationService) GetFollower(ctx context.Context,
+	followee int64, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error) {
+	res, err := f.repo.GetFollower(ctx, followee, cur, limit)
+	if err != nil {
+		return nil, err
+	}
+	// 粉丝给自己的分组、备注，是粉丝的隐私
+	for i := range res {
+		res[i].Gid = 0
+		res[i].Note = ""
+		res[i].Special = false
+	}
+	return res, nil
 }
 
 func (f *followRelationService) FollowInfo(ctx context.Context, follower, followee int64) (domain.FollowRelation, error) {
@@ -39,6 +95,13 @@ func (f *followRelationService) FollowInfo(ctx context.Context, follower, follow
 }
 
 func (f *followRelationService) Follow(ctx context.Context, follower, followee int64) error {
+	blocked, err := f.blockRepo.IsBlocked(ctx, followee, follower)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrBlocked
+	}
 	return f.repo.AddFollowRelation(ctx, domain.FollowRelation{
 		Followee: followee,
 		Follower: follower,
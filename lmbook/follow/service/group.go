@@ -0,0 +1,114 @@
+package service
+
+import (
+	"basic-go/lmbook/follow/domain"
+	"basic-go/lmbook/follow/repository"
+	"basic-go/lmbook/pkg/cursorx"
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxGroupNameLen = 32
+	// maxGroupCnt 一个人最多创建多少个分组
+	maxGroupCnt = 50
+	maxNoteLen  = 64
+)
+
+var (
+	// ErrFollowGroupNotFound 分组不存在，操作别人的分组也当作不存在
+	ErrFollowGroupNotFound = errors.New("关注分组不存在")
+	ErrInvalidGroupName    = errors.New("分组名字不能为空，并且不能超过 32 个字")
+	ErrTooManyGroups       = errors.New("关注分组太多了")
+	ErrInvalidNote         = errors.New("备注不能超过 64 个字")
+	// ErrNotFollowing 还没有关注，不能修改分组、备注
+	ErrNotFollowing = errors.New("还没有关注")
+)
+
+func (f *followRelationService) UpdateFollowRelation(ctx context.Context, m domain.FollowRelationMeta) error {
+	if m.Note != nil {
+		note := strings.TrimSpace(*m.Note)
+		if utf8.RuneCountInString(note) > maxNoteLen {
+			return ErrInvalidNote
+		}
+		m.Note = &note
+	}
+	if m.Gid != nil && *m.Gid > 0 {
+		err := f.checkGroupOwner(ctx, m.Follower, *m.Gid)
+		if err != nil {
+			return err
+		}
+	}
+	err := f.repo.UpdateFollowRelation(ctx, m)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return ErrNotFollowing
+	}
+	return err
+}
+
+func (f *followRelationService) GetFolloweeByGroup(ctx context.Context,
+	follower, gid int64, special bool, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error) {
+	if gid > 0 {
+		err := f.checkGroupOwner(ctx, follower, gid)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return f.repo.GetFolloweeByGroup(ctx, follower, gid, special, cur, limit)
+}
+
+func (f *followRelationService) CreateGroup(ctx context.Context, g domain.FollowGroup) (int64, error) {
+	g.Name = strings.TrimSpace(g.Name)
+	if !validGroupName(g.Name) {
+		return 0, ErrInvalidGroupName
+	}
+	cnt, err := f.groupRepo.CountByUid(ctx, g.Uid)
+	if err != nil {
+		return 0, err
+	}
+	// 并发创建的时候可能会超过一点，问题不大
+	if cnt >= maxGroupCnt {
+		return 0, ErrTooManyGroups
+	}
+	return f.groupRepo.Create(ctx, g)
+}
+
+func (f *followRelationService) RenameGroup(ctx context.Context, uid, gid int64, name string) error {
+	name = strings.TrimSpace(name)
+	if !validGroupName(name) {
+		return ErrInvalidGroupName
+	}
+	return toGroupErr(f.groupRepo.Rename(ctx, uid, gid, name))
+}
+
+func (f *followRelationService) DeleteGroup(ctx context.Context, uid, gid int64) error {
+	return toGroupErr(f.groupRepo.Delete(ctx, uid, gid))
+}
+
+func (f *followRelationService) ListGroups(ctx context.Context, uid int64) ([]domain.FollowGroup, error) {
+	return f.groupRepo.FindByUid(ctx, uid)
+}
+
+func (f *followRelationService) checkGroupOwner(ctx context.Context, uid, gid int64) error {
+	g, err := f.groupRepo.GetById(ctx, gid)
+	if err != nil {
+		return toGroupErr(err)
+	}
+	if g.Uid != uid {
+		return ErrFollowGroupNotFound
+	}
+	return nil
+}
+
+func validGroupName(name string) bool {
+	return name != "" && utf8.RuneCountInString(name) <= maxGroupNameLen
+}
+
+func toGroupErr(err error) error {
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return ErrFollowGroupNotFound
+	}
+	return err
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"basic-go/lmbook/follow/domain"
+	"basic-go/lmbook/follow/repository"
+	repomocks "basic-go/lmbook/follow/repository/mocks"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFollowRelationService_UpdateFollowRelation(t *testing.T) {
+	gid := int64(10)
+	note := "  老同学  "
+	trimmed := "老同学"
+	longNote := strings.Repeat("备", maxNoteLen+1)
+	special := true
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.FollowRepository, repository.FollowGroupRepository)
+		meta domain.FollowRelationMeta
+
+		wantErr error
+	}{
+		{
+			name: "只改备注，不查分组",
+			mock: func(ctrl *gomock.Controller) (repository.FollowRepository, repository.FollowGroupRepository) {
+				repo := repomocks.NewMockFollowRepository(ctrl)
+				groupRepo := repomocks.NewMockFollowGroupRepository(ctrl)
+				repo.EXPECT().UpdateFollowRelation(gomock.Any(), domain.FollowRelationMeta{
+					Follower: 1,
+					Followee: 2,
+					Note:     &trimmed,
+				}).Return(nil)
+				return repo, groupRepo
+			},
+			meta: domain.FollowRelationMeta{Follower: 1, Followee: 2, Note: &note},
+		},
+		{
+			name: "改分组和特别关注",
+			mock: func(ctrl *gomock.Controller) (repository.FollowRepository, repository.FollowGroupRepository) {
+				repo := repomocks.NewMockFollowRepository(ctrl)
+				groupRepo := repomocks.NewMockFollowGroupRepository(ctrl)
+				groupRepo.EXPECT().GetById(gomock.Any(), gid).
+					Return(domain.FollowGroup{Id: gid, Uid: 1}, nil)
+				repo.EXPECT().UpdateFollowRelation(gomock.Any(), domain.FollowRelationMeta{
+					Follower: 1,
+					Followee: 2,
+					Gid:      &gid,
+					Special:  &special,
+				}).Return(nil)
+				return repo, groupRepo
+			},
+			meta: domain.FollowRelationMeta{Follower: 1, Followee: 2, Gid: &gid, Special: &special},
+		},
+		{
+			name: "别人的分组",
+			mock: func(ctrl *gomock.Controller) (repository.FollowRepository, repository.FollowGroupRepository) {
+				repo := repomocks.NewMockFollowRepository(ctrl)
+				groupRepo := repomocks.NewMockFollowGroupRepository(ctrl)
+				groupRepo.EXPECT().GetById(gomock.Any(), gid).
+					Return(domain.FollowGroup{Id: gid, Uid: 3}, nil)
+				return repo, groupRepo
+			},
+			meta:    domain.FollowRelationMeta{Follower: 1, Followee: 2, Gid: &gid},
+			wantErr: ErrFollowGroupNotFound,
+		},
+		{
+			name: "备注太长",
+			mock: func(ctrl *gomock.Controller) (repository.FollowRepository, repository.FollowGroupRepository) {
+				return repomocks.NewMockFollowRepository(ctrl), repomocks.NewMockFollowGroupRepository(ctrl)
+			},
+			meta:    domain.FollowRelationMeta{Follower: 1, Followee: 2, Note: &longNote},
+			wantErr: ErrInvalidNote,
+		},
+		{
+			name: "没有关注",
+			mock: func(ctrl *gomock.Controller) (repository.FollowRepository, repository.FollowGroupRepository) {
+				repo := repomocks.NewMockFollowRepository(ctrl)
+				groupRepo := repomocks.NewMockFollowGroupRepository(ctrl)
+				repo.EXPECT().UpdateFollowRelation(gomock.Any(), gomock.Any()).
+					Return(repository.ErrRecordNotFound)
+				return repo, groupRepo
+			},
+			meta:    domain.FollowRelationMeta{Follower: 1, Followee: 2, Special: &special},
+			wantErr: ErrNotFollowing,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, groupRepo := tc.mock(ctrl)
+			svc := NewFollowRelationService(repo, groupRepo, nil)
+			err := svc.UpdateFollowRelation(context.Background(), tc.meta)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,348 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./followrelation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./followrelation.go -package=svcmocks -destination=./mocks/followrelation.mock.go
+//
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "basic-go/lmbook/follow/domain"
+	cursorx "basic-go/lmbook/pkg/cursorx"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFollowRelationService is a mock of FollowRelationService interface.
+type MockFollowRelationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowRelationServiceMockRecorder
+}
+
+// MockFollowRelationServiceMockRecorder is the mock recorder for MockFollowRelationService.
+type MockFollowRelationServiceMockRecorder struct {
+	mock *MockFollowRelationService
+}
+
+// NewMockFollowRelationService creates a new mock instance.
+func NewMockFollowRelationService(ctrl *gomock.Controller) *MockFollowRelationService {
+	mock := &MockFollowRelationService{ctrl: ctrl}
+	mock.recorder = &MockFollowRelationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollowRelationService) EXPECT() *MockFollowRelationServiceMockRecorder {
+	return m.recorder
+}
+
+// Block mocks base method.
+func (m *MockFollowRelationService) Block(ctx context.Context, uid, target int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Block", ctx, uid, target)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockFollowRelationServiceMockRecorder) Block(ctx, uid, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockFollowRelationService)(nil).Block), ctx, uid, target)
+}
+
+// CancelBlock mocks base method.
+func (m *MockFollowRelationService) CancelBlock(ctx context.Context, uid, target int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBlock", ctx, uid, target)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelBlock indicates an expected call of CancelBlock.
+func (mr *MockFollowRelationServiceMockRecorder) CancelBlock(ctx, uid, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBlock", reflect.TypeOf((*MockFollowRelationService)(nil).CancelBlock), ctx, uid, target)
+}
+
+// CancelFollow mocks base method.
+func (m *MockFollowRelationService) CancelFollow(ctx context.Context, follower, followee int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelFollow", ctx, follower, followee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelFollow indicates an expected call of CancelFollow.
+func (mr *MockFollowRelationServiceMockRecorder) CancelFollow(ctx, follower, followee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelFollow", reflect.TypeOf((*MockFollowRelationService)(nil).CancelFollow), ctx, follower, followee)
+}
+
+// CancelMute mocks base method.
+func (m *MockFollowRelationService) CancelMute(ctx context.Context, uid, target int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelMute", ctx, uid, target)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelMute indicates an expected call of CancelMute.
+func (mr *MockFollowRelationServiceMockRecorder) CancelMute(ctx, uid, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMute", reflect.TypeOf((*MockFollowRelationService)(nil).CancelMute), ctx, uid, target)
+}
+
+// CommonFollowees mocks base method.
+func (m *MockFollowRelationService) CommonFollowees(ctx context.Context, uid, target, limit int64) ([]int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommonFollowees", ctx, uid, target, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CommonFollowees indicates an expected call of CommonFollowees.
+func (mr *MockFollowRelationServiceMockRecorder) CommonFollowees(ctx, uid, target, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommonFollowees", reflect.TypeOf((*MockFollowRelationService)(nil).CommonFollowees), ctx, uid, target, limit)
+}
+
+// CreateGroup mocks base method.
+func (m *MockFollowRelationService) CreateGroup(ctx context.Context, g domain.FollowGroup) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, g)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *MockFollowRelationServiceMockRecorder) CreateGroup(ctx, g any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockFollowRelationService)(nil).CreateGroup), ctx, g)
+}
+
+// DeleteGroup mocks base method.
+func (m *MockFollowRelationService) DeleteGroup(ctx context.Context, uid, gid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", ctx, uid, gid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroup indicates an expected call of DeleteGroup.
+func (mr *MockFollowRelationServiceMockRecorder) DeleteGroup(ctx, uid, gid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockFollowRelationService)(nil).DeleteGroup), ctx, uid, gid)
+}
+
+// FilterMuted mocks base method.
+func (m *MockFollowRelationService) FilterMuted(ctx context.Context, target int64, uids []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterMuted", ctx, target, uids)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterMuted indicates an expected call of FilterMuted.
+func (mr *MockFollowRelationServiceMockRecorder) FilterMuted(ctx, target, uids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterMuted", reflect.TypeOf((*MockFollowRelationService)(nil).FilterMuted), ctx, target, uids)
+}
+
+// Follow mocks base method.
+func (m *MockFollowRelationService) Follow(ctx context.Context, follower, followee int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, follower, followee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockFollowRelationServiceMockRecorder) Follow(ctx, follower, followee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockFollowRelationService)(nil).Follow), ctx, follower, followee)
+}
+
+// FollowInfo mocks base method.
+func (m *MockFollowRelationService) FollowInfo(ctx context.Context, follower, followee int64) (domain.FollowRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowInfo", ctx, follower, followee)
+	ret0, _ := ret[0].(domain.FollowRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowInfo indicates an expected call of FollowInfo.
+func (mr *MockFollowRelationServiceMockRecorder) FollowInfo(ctx, follower, followee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowInfo", reflect.TypeOf((*MockFollowRelationService)(nil).FollowInfo), ctx, follower, followee)
+}
+
+// GetFollowee mocks base method.
+func (m *MockFollowRelationService) GetFollowee(ctx context.Context, follower int64, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowee", ctx, follower, cur, limit)
+	ret0, _ := ret[0].([]domain.FollowRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowee indicates an expected call of GetFollowee.
+func (mr *MockFollowRelationServiceMockRecorder) GetFollowee(ctx, follower, cur, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowee", reflect.TypeOf((*MockFollowRelationService)(nil).GetFollowee), ctx, follower, cur, limit)
+}
+
+// GetFolloweeByGroup mocks base method.
+func (m *MockFollowRelationService) GetFolloweeByGroup(ctx context.Context, follower, gid int64, special bool, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFolloweeByGroup", ctx, follower, gid, special, cur, limit)
+	ret0, _ := ret[0].([]domain.FollowRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolloweeByGroup indicates an expected call of GetFolloweeByGroup.
+func (mr *MockFollowRelationServiceMockRecorder) GetFolloweeByGroup(ctx, follower, gid, special, cur, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolloweeByGroup", reflect.TypeOf((*MockFollowRelationService)(nil).GetFolloweeByGroup), ctx, follower, gid, special, cur, limit)
+}
+
+// GetFollower mocks base method.
+func (m *MockFollowRelationService) GetFollower(ctx context.Context, followee int64, cur cursorx.Cursor, limit int64) ([]domain.FollowRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollower", ctx, followee, cur, limit)
+	ret0, _ := ret[0].([]domain.FollowRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollower indicates an expected call of GetFollower.
+func (mr *MockFollowRelationServiceMockRecorder) GetFollower(ctx, followee, cur, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollower", reflect.TypeOf((*MockFollowRelationService)(nil).GetFollower), ctx, followee, cur, limit)
+}
+
+// GetMuteList mocks base method.
+func (m *MockFollowRelationService) GetMuteList(ctx context.Context, uid int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMuteList", ctx, uid)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMuteList indicates an expected call of GetMuteList.
+func (mr *MockFollowRelationServiceMockRecorder) GetMuteList(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMuteList", reflect.TypeOf((*MockFollowRelationService)(nil).GetMuteList), ctx, uid)
+}
+
+// IsBlocked mocks base method.
+func (m *MockFollowRelationService) IsBlocked(ctx context.Context, uid, target int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", ctx, uid, target)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockFollowRelationServiceMockRecorder) IsBlocked(ctx, uid, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockFollowRelationService)(nil).IsBlocked), ctx, uid, target)
+}
+
+// ListGroups mocks base method.
+func (m *MockFollowRelationService) ListGroups(ctx context.Context, uid int64) ([]domain.FollowGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroups", ctx, uid)
+	ret0, _ := ret[0].([]domain.FollowGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroups indicates an expected call of ListGroups.
+func (mr *MockFollowRelationServiceMockRecorder) ListGroups(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockFollowRelationService)(nil).ListGroups), ctx, uid)
+}
+
+// Mute mocks base method.
+func (m *MockFollowRelationService) Mute(ctx context.Context, uid, target int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mute", ctx, uid, target)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Mute indicates an expected call of Mute.
+func (mr *MockFollowRelationServiceMockRecorder) Mute(ctx, uid, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mute", reflect.TypeOf((*MockFollowRelationService)(nil).Mute), ctx, uid, target)
+}
+
+// MutualFollow mocks base method.
+func (m *MockFollowRelationService) MutualFollow(ctx context.Context, uid int64, targets []int64) (map[int64]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MutualFollow", ctx, uid, targets)
+	ret0, _ := ret[0].(map[int64]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MutualFollow indicates an expected call of MutualFollow.
+func (mr *MockFollowRelationServiceMockRecorder) MutualFollow(ctx, uid, targets any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MutualFollow", reflect.TypeOf((*MockFollowRelationService)(nil).MutualFollow), ctx, uid, targets)
+}
+
+// Recommendations mocks base method.
+func (m *MockFollowRelationService) Recommendations(ctx context.Context, uid, limit int64) ([]domain.FollowRecommendation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recommendations", ctx, uid, limit)
+	ret0, _ := ret[0].([]domain.FollowRecommendation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recommendations indicates an expected call of Recommendations.
+func (mr *MockFollowRelationServiceMockRecorder) Recommendations(ctx, uid, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recommendations", reflect.TypeOf((*MockFollowRelationService)(nil).Recommendations), ctx, uid, limit)
+}
+
+// RenameGroup mocks base method.
+func (m *MockFollowRelationService) RenameGroup(ctx context.Context, uid, gid int64, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameGroup", ctx, uid, gid, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameGroup indicates an expected call of RenameGroup.
+func (mr *MockFollowRelationServiceMockRecorder) RenameGroup(ctx, uid, gid, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameGroup", reflect.TypeOf((*MockFollowRelationService)(nil).RenameGroup), ctx, uid, gid, name)
+}
+
+// UpdateFollowRelation mocks base method.
+func (m_2 *MockFollowRelationService) UpdateFollowRelation(ctx context.Context, m domain.FollowRelationMeta) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "UpdateFollowRelation", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFollowRelation indicates an expected call of UpdateFollowRelation.
+func (mr *MockFollowRelationServiceMockRecorder) UpdateFollowRelation(ctx, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFollowRelation", reflect.TypeOf((*MockFollowRelationService)(nil).UpdateFollowRelation), ctx, m)
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"basic-go/lmbook/follow/domain"
+	"context"
+	"errors"
+)
+
+const (
+	// maxMutualTargets 一次最多判断多少个人是不是互相关注
+	maxMutualTargets = 100
+	// maxRecommendLimit 一次最多推荐多少人
+	maxRecommendLimit = 100
+)
+
+var ErrTooManyTargets = errors.New("一次最多查询 100 个人")
+
+func (f *followRelationService) MutualFollow(ctx context.Context, uid int64, targets []int64) (map[int64]bool, error) {
+	if len(targets) > maxMutualTargets {
+		return nil, ErrTooManyTargets
+	}
+	if len(targets) == 0 {
+		return map[int64]bool{}, nil
+	}
+	return f.repo.MutualFollow(ctx, uid, targets)
+}
+
+func (f *followRelationService) CommonFollowees(ctx context.Context,
+	uid, target int64, limit int64) ([]int64, int64, error) {
+	uids, err := f.repo.CommonFollowees(ctx, uid, target)
+	if err != nil {
+		return nil, 0, err
+	}
+	total := int64(len(uids))
+	if limit > 0 && total > limit {
+		uids = uids[:limit]
+	}
+	return uids, total, nil
+}
+
+func (f *followRelationService) Recommendations(ctx context.Context,
+	uid int64, limit int64) ([]domain.FollowRecommendation, error) {
+	if limit <= 0 || limit > maxRecommendLimit {
+		limit = maxRecommendLimit
+	}
+	// 屏蔽、拉黑了的人不推荐，所以多取一点
+	muted, err := f.blockRepo.MuteList(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	recs, err := f.repo.Recommendations(ctx, uid, limit+int64(len(muted)))
+	if err != nil {
+		return nil, err
+	}
+	mutedSet := make(map[int64]struct{}, len(muted))
+	for _, m := range muted {
+		mutedSet[m] = struct{}{}
+	}
+	res := make([]domain.FollowRecommendation, 0, limit)
+	for _, rec := range recs {
+		if _, ok := mutedSet[rec.Uid]; ok {
+			continue
+		}
+		res = append(res, rec)
+		if int64(len(res)) == limit {
+			break
+		}
+	}
+	return res, nil
+}
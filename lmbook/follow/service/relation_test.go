@@ -0,0 +1,78 @@
+package service
+
+import (
+	"basic-go/lmbook/follow/domain"
+	"basic-go/lmbook/follow/repository"
+	repomocks "basic-go/lmbook/follow/repository/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFollowRelationService_Recommendations(t *testing.T) {
+	testCases := []struct {
+		name  string
+		mock  func(ctrl *gomock.Controller) (repository.FollowRepository, repository.BlockRepository)
+		limit int64
+
+		want    []domain.FollowRecommendation
+		wantErr error
+	}{
+		{
+			name: "去掉屏蔽的人",
+			mock: func(ctrl *gomock.Controller) (repository.FollowRepository, repository.BlockRepository) {
+				repo := repomocks.NewMockFollowRepository(ctrl)
+				blockRepo := repomocks.NewMockBlockRepository(ctrl)
+				blockRepo.EXPECT().MuteList(gomock.Any(), int64(1)).Return([]int64{3}, nil)
+				// 屏蔽了一个人，所以多取一个
+				repo.EXPECT().Recommendations(gomock.Any(), int64(1), int64(3)).
+					Return([]domain.FollowRecommendation{
+						{Uid: 2, Overlap: 5}, {Uid: 3, Overlap: 4}, {Uid: 4, Overlap: 1},
+					}, nil)
+				return repo, blockRepo
+			},
+			limit: 2,
+			want:  []domain.FollowRecommendation{{Uid: 2, Overlap: 5}, {Uid: 4, Overlap: 1}},
+		},
+		{
+			name: "limit 超过上限",
+			mock: func(ctrl *gomock.Controller) (repository.FollowRepository, repository.BlockRepository) {
+				repo := repomocks.NewMockFollowRepository(ctrl)
+				blockRepo := repomocks.NewMockBlockRepository(ctrl)
+				blockRepo.EXPECT().MuteList(gomock.Any(), int64(1)).Return(nil, nil)
+				repo.EXPECT().Recommendations(gomock.Any(), int64(1), int64(maxRecommendLimit)).
+					Return([]domain.FollowRecommendation{}, nil)
+				return repo, blockRepo
+			},
+			limit: 1000,
+			want:  []domain.FollowRecommendation{},
+		},
+		{
+			name: "查询推荐失败",
+			mock: func(ctrl *gomock.Controller) (repository.FollowRepository, repository.BlockRepository) {
+				repo := repomocks.NewMockFollowRepository(ctrl)
+				blockRepo := repomocks.NewMockBlockRepository(ctrl)
+				blockRepo.EXPECT().MuteList(gomock.Any(), int64(1)).Return(nil, nil)
+				repo.EXPECT().Recommendations(gomock.Any(), int64(1), int64(2)).
+					Return(nil, errors.New("mock redis error"))
+				return repo, blockRepo
+			},
+			limit:   2,
+			wantErr: errors.New("mock redis error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, blockRepo := tc.mock(ctrl)
+			svc := NewFollowRelationService(repo, nil, blockRepo)
+			res, err := svc.Recommendations(context.Background(), 1, tc.limit)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	grpc2 "basic-go/lmbook/follow/grpc"
 	"basic-go/lmbook/follow/ioc"
 	"basic-go/lmbook/follow/repository"
+	"basic-go/lmbook/follow/repository/cache"
 	"basic-go/lmbook/follow/repository/dao"
 	"basic-go/lmbook/follow/service"
 	"github.com/google/wire"
@@ -13,7 +14,13 @@ import (
 
 var serviceProviderSet = wire.NewSet(
 	dao.NewGORMFollowRelationDAO,
+	dao.NewGORMFollowGroupDAO,
+	dao.NewGORMBlockDAO,
+	cache.NewRedisFollowCache,
+	cache.NewRedisBlockCache,
 	repository.NewFollowRelationRepository,
+	repository.NewFollowGroupRepository,
+	repository.NewBlockRepository,
 	service.NewFollowRelationService,
 	grpc2.NewFollowRelationServiceServer,
 )
@@ -21,6 +28,8 @@ var serviceProviderSet = wire.NewSet(
 var thirdProvider = wire.NewSet(
 	ioc.InitDB,
 	ioc.InitLogger,
+	ioc.InitRedis,
+	ioc.InitEtcdClient,
 )
 
 func Init() *App {
@@ -10,6 +10,7 @@ import (
 	"basic-go/lmbook/follow/grpc"
 	"basic-go/lmbook/follow/ioc"
 	"basic-go/lmbook/follow/repository"
+	"basic-go/lmbook/follow/repository/cache"
 	"basic-go/lmbook/follow/repository/dao"
 	"basic-go/lmbook/follow/service"
 	"github.com/google/wire"
@@ -19,12 +20,20 @@ import (
 
 func Init() *App {
 	loggerV1 := ioc.InitLogger()
+	client := ioc.InitEtcdClient()
 	db := ioc.InitDB(loggerV1)
 	followRelationDao := dao.NewGORMFollowRelationDAO(db)
-	followRelationRepository := repository.NewFollowRelationRepository(followRelationDao)
-	followRelationService := service.NewFollowRelationService(followRelationRepository)
-	followRelationServiceServer := grpc.NewFollowRelationServiceServer(followRelationService)
-	server := ioc.InitGRPCxServer(followRelationServiceServer)
+	cmdable := ioc.InitRedis()
+	followCache := cache.NewRedisFollowCache(cmdable)
+	followRepository := repository.NewFollowRelationRepository(followRelationDao, followCache, loggerV1)
+	followGroupDAO := dao.NewGORMFollowGroupDAO(db)
+	followGroupRepository := repository.NewFollowGroupRepository(followGroupDAO)
+	blockDAO := dao.NewGORMBlockDAO(db)
+	blockCache := cache.NewRedisBlockCache(cmdable)
+	blockRepository := repository.NewBlockRepository(blockDAO, blockCache, loggerV1)
+	followRelationService := service.NewFollowRelationService(followRepository, followGroupRepository, blockRepository)
+	followServiceServer := grpc.NewFollowRelationServiceServer(followRelationService)
+	server := ioc.InitGRPCxServer(loggerV1, client, followServiceServer)
 	app := &App{
 		server: server,
 	}
@@ -33,6 +42,6 @@ func Init() *App {
 
 // wire.go:
 
-var serviceProviderSet = wire.NewSet(dao.NewGORMFollowRelationDAO, repository.NewFollowRelationRepository, service.NewFollowRelationService, grpc.NewFollowRelationServiceServer)
+var serviceProviderSet = wire.NewSet(dao.NewGORMFollowRelationDAO, dao.NewGORMFollowGroupDAO, dao.NewGORMBlockDAO, cache.NewRedisFollowCache, cache.NewRedisBlockCache, repository.NewFollowRelationRepository, repository.NewFollowGroupRepository, repository.NewBlockRepository, service.NewFollowRelationService, grpc.NewFollowRelationServiceServer)
 
-var thirdProvider = wire.NewSet(ioc.InitDB, ioc.InitLogger)
+var thirdProvider = wire.NewSet(ioc.InitDB, ioc.InitLogger, ioc.InitRedis, ioc.InitEtcdClient)
@@ -1,13 +1,19 @@
 package main
 
 import (
+	"basic-go/lmbook/interactive/repository"
 	"basic-go/lmbook/pkg/ginx"
 	"basic-go/lmbook/pkg/grpcx"
 	"basic-go/lmbook/pkg/saramax"
+	"github.com/robfig/cron/v3"
 )
 
 type App struct {
 	consumers   []saramax.Consumer
 	server      *grpcx.Server
 	adminServer *ginx.Server
+	// deltaBuffer 阅读数、点赞数的后台落库
+	deltaBuffer *repository.CntDeltaBuffer
+	// cron 重建布隆过滤器、同步热度之类的定时任务
+	cron *cron.Cron
 }
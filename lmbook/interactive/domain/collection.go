@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// Collection 收藏夹
+type Collection struct {
+	Id   int64
+	Uid  int64
+	Name string
+	// Public 公开的收藏夹，别人也可以看
+	Public bool
+	// ItemCnt 收藏夹里面有多少内容
+	ItemCnt int64
+	Ctime   time.Time
+	Utime   time.Time
+}
+
+// CollectionItem 收藏的内容
+type CollectionItem struct {
+	Id    int64
+	Biz   string
+	BizId int64
+	Ctime time.Time
+}
@@ -6,6 +6,27 @@ type Interactive struct {
 	ReadCnt    int64
 	LikeCnt    int64
 	CollectCnt int64
-	Liked      bool
-	Collected  bool
+	// ReactionCnts 每一种表态的数量，点赞的数量和 LikeCnt 是一样的
+	ReactionCnts map[ReactionType]int64
+	// Liked 等价于 Reaction == ReactionLike，为了兼容老的客户端保留
+	Liked     bool
+	Collected bool
+	// Reaction 当前用户的表态，ReactionNone 代表没有表态
+	Reaction ReactionType
+}
+
+// ReactionType 表态，类似于表情回应。
+// 一个人对一个东西只能有一种表态，点赞是其中的一种
+type ReactionType uint8
+
+const (
+	ReactionNone ReactionType = iota
+	ReactionLike
+	ReactionLaugh
+	ReactionAngry
+	ReactionInsightful
+)
+
+func (r ReactionType) Valid() bool {
+	return r >= ReactionLike && r <= ReactionInsightful
 }
@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./producer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./producer.go -package=evtmocks -destination=./mocks/producer.mock.go
+//
+// Package evtmocks is a generated GoMock package.
+package evtmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	events "basic-go/lmbook/interactive/events"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProducer is a mock of Producer interface.
+type MockProducer struct {
+	ctrl     *gomock.Controller
+	recorder *MockProducerMockRecorder
+}
+
+// MockProducerMockRecorder is the mock recorder for MockProducer.
+type MockProducerMockRecorder struct {
+	mock *MockProducer
+}
+
+// NewMockProducer creates a new mock instance.
+func NewMockProducer(ctrl *gomock.Controller) *MockProducer {
+	mock := &MockProducer{ctrl: ctrl}
+	mock.recorder = &MockProducerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProducer) EXPECT() *MockProducerMockRecorder {
+	return m.recorder
+}
+
+// ProduceLikeEvent mocks base method.
+func (m *MockProducer) ProduceLikeEvent(ctx context.Context, evt events.LikeEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProduceLikeEvent", ctx, evt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProduceLikeEvent indicates an expected call of ProduceLikeEvent.
+func (mr *MockProducerMockRecorder) ProduceLikeEvent(ctx, evt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceLikeEvent", reflect.TypeOf((*MockProducer)(nil).ProduceLikeEvent), ctx, evt)
+}
+
+// ProducePopularityEvents mocks base method.
+func (m *MockProducer) ProducePopularityEvents(ctx context.Context, evts []events.PopularityEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProducePopularityEvents", ctx, evts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProducePopularityEvents indicates an expected call of ProducePopularityEvents.
+func (mr *MockProducerMockRecorder) ProducePopularityEvents(ctx, evts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProducePopularityEvents", reflect.TypeOf((*MockProducer)(nil).ProducePopularityEvents), ctx, evts)
+}
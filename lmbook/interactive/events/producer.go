@@ -0,0 +1,76 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/IBM/sarama"
+)
+
+const (
+	topicLikeEvent      = "interactive_like_event"
+	topicSyncPopularity = "sync_popularity_event"
+)
+
+// LikeEvent 点赞和取消点赞都会发出来，Liked 为 false 表示取消点赞
+type LikeEvent struct {
+	Biz   string `json:"biz"`
+	BizId int64  `json:"biz_id"`
+	Uid   int64  `json:"uid"`
+	Liked bool   `json:"liked"`
+}
+
+// PopularityEvent 计数变了之后同步给搜索算热度，字段和 search 那边的保持一致
+type PopularityEvent struct {
+	Biz        string `json:"biz"`
+	BizId      int64  `json:"biz_id"`
+	ReadCnt    int64  `json:"read_cnt"`
+	LikeCnt    int64  `json:"like_cnt"`
+	CollectCnt int64  `json:"collect_cnt"`
+}
+
+//go:generate mockgen -source=./producer.go -package=evtmocks -destination=mocks/producer.mock.go Producer
+type Producer interface {
+	ProduceLikeEvent(ctx context.Context, evt LikeEvent) error
+	// ProducePopularityEvents 批量发送，要么都成功，要么返回 error
+	ProducePopularityEvents(ctx context.Context, evts []PopularityEvent) error
+}
+
+type SaramaSyncProducer struct {
+	producer sarama.SyncProducer
+}
+
+func NewSaramaSyncProducer(producer sarama.SyncProducer) Producer {
+	return &SaramaSyncProducer{
+		producer: producer,
+	}
+}
+
+func (s *SaramaSyncProducer) ProduceLikeEvent(ctx context.Context, evt LikeEvent) error {
+	val, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	_, _, err = s.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: topicLikeEvent,
+		Value: sarama.ByteEncoder(val),
+	})
+	return err
+}
+
+func (s *SaramaSyncProducer) ProducePopularityEvents(ctx context.Context, evts []PopularityEvent) error {
+	msgs := make([]*sarama.ProducerMessage, 0, len(evts))
+	for _, evt := range evts {
+		val, err := json.Marshal(evt)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, &sarama.ProducerMessage{
+			Topic: topicSyncPopularity,
+			// 同一个东西的热度发到同一个分区，旧的计数不会覆盖新的
+			Key:   sarama.StringEncoder(fmt.Sprintf("%s:%d", evt.Biz, evt.BizId)),
+			Value: sarama.ByteEncoder(val),
+		})
+	}
+	return s.producer.SendMessages(msgs)
+}
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	intrv1 "basic-go/lmbook/api/proto/gen/intr/v1"
+	"basic-go/lmbook/interactive/domain"
+	"basic-go/lmbook/interactive/service"
+	"basic-go/lmbook/pkg/cursorx"
+	"context"
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *InteractiveServiceServer) CreateCollection(ctx context.Context, request *intrv1.CreateCollectionRequest) (*intrv1.CreateCollectionResponse, error) {
+	id, err := i.svc.CreateCollection(ctx, domain.Collection{
+		Uid:    request.GetUid(),
+		Name:   request.GetName(),
+		Public: request.GetPublic(),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &intrv1.CreateCollectionResponse{Id: id}, nil
+}
+
+func (i *InteractiveServiceServer) UpdateCollection(ctx context.Context, request *intrv1.UpdateCollectionRequest) (*intrv1.UpdateCollectionResponse, error) {
+	err := i.svc.UpdateCollection(ctx, domain.Collection{
+		Id:     request.GetId(),
+		Uid:    request.GetUid(),
+		Name:   request.GetName(),
+		Public: request.GetPublic(),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &intrv1.UpdateCollectionResponse{}, nil
+}
+
+func (i *InteractiveServiceServer) DeleteCollection(ctx context.Context, request *intrv1.DeleteCollectionRequest) (*intrv1.DeleteCollectionResponse, error) {
+	err := i.svc.DeleteCollection(ctx, request.GetUid(), request.GetId())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &intrv1.DeleteCollectionResponse{}, nil
+}
+
+func (i *InteractiveServiceServer) ListCollections(ctx context.Context, request *intrv1.ListCollectionsRequest) (*intrv1.ListCollectionsResponse, error) {
+	cols, err := i.svc.ListCollections(ctx, request.GetUid(), request.GetViewer())
+	if err != nil {
+		return nil, err
+	}
+	return &intrv1.ListCollectionsResponse{
+		Collections: slice.Map(cols, func(idx int, src domain.Collection) *intrv1.Collection {
+			return collectionToDTO(src)
+		}),
+	}, nil
+}
+
+func (i *InteractiveServiceServer) GetCollectionItems(ctx context.Context, request *intrv1.GetCollectionItemsRequest) (*intrv1.GetCollectionItemsResponse, error) {
+	cur, err := cursorx.Decode(request.GetCursor())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limit := int(request.GetLimit())
+	items, err := i.svc.GetCollectionItems(ctx, request.GetCid(), request.GetViewer(), cur, limit)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &intrv1.GetCollectionItemsResponse{
+		Items: slice.Map(items, func(idx int, src domain.CollectionItem) *intrv1.CollectionItem {
+			return &intrv1.CollectionItem{
+				Biz:   src.Biz,
+				BizId: src.BizId,
+				Ctime: src.Ctime.UnixMilli(),
+			}
+		}),
+		NextCursor: cursorx.Next(items, limit, func(c domain.CollectionItem) cursorx.Cursor {
+			return cursorx.Cursor{Time: c.Ctime.UnixMilli(), Id: c.Id}
+		}),
+	}, nil
+}
+
+func (i *InteractiveServiceServer) RemoveCollectionItem(ctx context.Context, request *intrv1.RemoveCollectionItemRequest) (*intrv1.RemoveCollectionItemResponse, error) {
+	err := i.svc.RemoveCollectionItem(ctx, request.GetUid(), request.GetCid(),
+		request.GetBiz(), request.GetBizId())
+	return &intrv1.RemoveCollectionItemResponse{}, err
+}
+
+func (i *InteractiveServiceServer) MoveCollectionItem(ctx context.Context, request *intrv1.MoveCollectionItemRequest) (*intrv1.MoveCollectionItemResponse, error) {
+	err := i.svc.MoveCollectionItem(ctx, request.GetUid(), request.GetBiz(), request.GetBizId(),
+		request.GetFromCid(), request.GetToCid())
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &intrv1.MoveCollectionItemResponse{}, nil
+}
+
+func collectionToDTO(c domain.Collection) *intrv1.Collection {
+	return &intrv1.Collection{
+		Id:      c.Id,
+		Uid:     c.Uid,
+		Name:    c.Name,
+		Public:  c.Public,
+		ItemCnt: c.ItemCnt,
+		Ctime:   c.Ctime.UnixMilli(),
+		Utime:   c.Utime.UnixMilli(),
+	}
+}
+
+// toStatusErr 把业务错误转成 gRPC 的错误码，调用方可以据此给出提示
+func toStatusErr(err error) error {
+	switch {
+	case errors.Is(err, service.ErrCollectionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidCollectionName),
+		errors.Is(err, service.ErrInvalidReaction):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
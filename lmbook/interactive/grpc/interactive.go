@@ -4,8 +4,11 @@ import (
 	"basic-go/lmbook/api/proto/gen/intr/v1"
 	"basic-go/lmbook/interactive/domain"
 	"basic-go/lmbook/interactive/service"
+	"basic-go/lmbook/pkg/cursorx"
 	"context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type InteractiveServiceServer struct {
@@ -36,10 +39,21 @@ func (i *InteractiveServiceServer) CancelLike(ctx context.Context, request *intr
 	return &intrv1.CancelLikeResponse{}, err
 }
 
+func (i *InteractiveServiceServer) React(ctx context.Context, request *intrv1.ReactRequest) (*intrv1.ReactResponse, error) {
+	err := i.svc.React(ctx, request.GetBiz(), request.GetBizId(), request.GetUid(),
+		domain.ReactionType(request.GetReaction()))
+	return &intrv1.ReactResponse{}, toStatusErr(err)
+}
+
+func (i *InteractiveServiceServer) CancelReaction(ctx context.Context, request *intrv1.CancelReactionRequest) (*intrv1.CancelReactionResponse, error) {
+	err := i.svc.CancelReaction(ctx, request.GetBiz(), request.GetBizId(), request.GetUid())
+	return &intrv1.CancelReactionResponse{}, err
+}
+
 func (i *InteractiveServiceServer) Collect(ctx context.Context, request *intrv1.CollectRequest) (*intrv1.CollectResponse, error) {
 	err := i.svc.Collect(ctx, request.GetBiz(), request.GetBizId(),
 		request.GetCid(), request.GetUid())
-	return &intrv1.CollectResponse{}, err
+	return &intrv1.CollectResponse{}, toStatusErr(err)
 }
 
 func (i *InteractiveServiceServer) Get(ctx context.Context, request *intrv1.GetRequest) (*intrv1.GetResponse, error) {
@@ -54,7 +68,7 @@ func (i *InteractiveServiceServer) Get(ctx context.Context, request *intrv1.GetR
 }
 
 func (i *InteractiveServiceServer) GetByIds(ctx context.Context, request *intrv1.GetByIdsRequest) (*intrv1.GetByIdsResponse, error) {
-	res, err := i.svc.GetByIds(ctx, request.GetBiz(), request.GetIds())
+	res, err := i.svc.GetByIds(ctx, request.GetBiz(), request.GetIds(), request.GetUid())
 	if err != nil {
 		return nil, err
 	}
@@ -73,31 +87,53 @@ func (i *InteractiveServiceServer) CancelCollect(ctx context.Context, request *i
 }
 
 func (i *InteractiveServiceServer) GetCollections(ctx context.Context, request *intrv1.GetCollectionsRequest) (*intrv1.GetCollectionsResponse, error) {
-	bizIds, err := i.svc.GetCollections(ctx, request.GetUid(), request.GetBiz(),
-		int(request.GetOffset()), int(request.GetLimit()))
+	cur, err := cursorx.Decode(request.GetCursor())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limit := int(request.GetLimit())
+	collections, err := i.svc.GetCollections(ctx, request.GetUid(), request.GetBiz(), cur, limit)
 	if err != nil {
 		return nil, err
 	}
-	items := make([]*intrv1.CollectionItem, len(bizIds))
-	for idx, bizId := range bizIds {
+	items := make([]*intrv1.CollectionItem, len(collections))
+	for idx, c := range collections {
 		items[idx] = &intrv1.CollectionItem{
-			BizId: bizId,
+			BizId: c.BizId,
+			Ctime: c.Ctime.UnixMilli(),
 		}
 	}
 	return &intrv1.GetCollectionsResponse{
 		Items: items,
-		Total: int64(len(bizIds)),
+		Total: int64(len(collections)),
+		NextCursor: cursorx.Next(collections, limit,
+			func(c domain.CollectionItem) cursorx.Cursor {
+				return cursorx.Cursor{Time: c.Ctime.UnixMilli(), Id: c.Id}
+			}),
 	}, nil
 }
 
 func (i *InteractiveServiceServer) toDTO(intr domain.Interactive) *intrv1.Interactive {
 	return &intrv1.Interactive{
-		Biz:        intr.Biz,
-		BizId:      intr.BizId,
-		ReadCnt:    intr.ReadCnt,
-		CollectCnt: intr.CollectCnt,
-		Collected:  intr.Collected,
-		Liked:      intr.Liked,
-		LikeCnt:    intr.LikeCnt,
+		Biz:          intr.Biz,
+		BizId:        intr.BizId,
+		ReadCnt:      intr.ReadCnt,
+		CollectCnt:   intr.CollectCnt,
+		Collected:    intr.Collected,
+		Liked:        intr.Liked,
+		LikeCnt:      intr.LikeCnt,
+		ReactionCnts: reactionCntsToDTO(intr.ReactionCnts),
+		Reaction:     intrv1.ReactionType(intr.Reaction),
+	}
+}
+
+func reactionCntsToDTO(cnts map[domain.ReactionType]int64) map[int32]int64 {
+	if len(cnts) == 0 {
+		return nil
+	}
+	res := make(map[int32]int64, len(cnts))
+	for k, v := range cnts {
+		res[int32(k)] = v
 	}
+	return res
 }
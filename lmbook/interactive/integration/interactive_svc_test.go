@@ -4,11 +4,17 @@ import (
 	intrv1 "basic-go/lmbook/api/proto/gen/intr/v1"
 	"basic-go/lmbook/interactive/grpc"
 	"basic-go/lmbook/interactive/integration/startup"
+	"basic-go/lmbook/interactive/repository"
+	"basic-go/lmbook/interactive/repository/cache"
 	"basic-go/lmbook/interactive/repository/dao"
+	"basic-go/lmbook/interactive/service"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 	"testing"
 	"time"
@@ -36,6 +42,14 @@ func (s *InteractiveTestSuite) TearDownTest() {
 	assert.NoError(s.T(), err)
 	err = s.db.Exec("TRUNCATE TABLE `user_collection_bizs`").Error
 	assert.NoError(s.T(), err)
+	err = s.db.Exec("TRUNCATE TABLE `collections`").Error
+	assert.NoError(s.T(), err)
+	err = s.db.Exec("TRUNCATE TABLE `collection_items`").Error
+	assert.NoError(s.T(), err)
+	err = s.db.Exec("TRUNCATE TABLE `delta_flush_logs`").Error
+	assert.NoError(s.T(), err)
+	err = s.db.Exec("TRUNCATE TABLE `reaction_cnts`").Error
+	assert.NoError(s.T(), err)
 	// 清空 Redis
 	err = s.rdb.FlushDB(ctx).Err()
 	assert.NoError(s.T(), err)
@@ -254,10 +268,11 @@ func (s *InteractiveTestSuite) TestLike() {
 				likeBiz.Ctime = 0
 				likeBiz.Utime = 0
 				assert.Equal(t, dao.UserLikeBiz{
-					Biz:    "test",
-					BizId:  2,
-					Uid:    123,
-					Status: 1,
+					Biz:      "test",
+					BizId:    2,
+					Uid:      123,
+					Status:   1,
+					Reaction: 1,
 				}, likeBiz)
 
 				cnt, err := s.rdb.HGet(ctx, "interactive:test:2", "like_cnt").Int()
@@ -303,10 +318,11 @@ func (s *InteractiveTestSuite) TestLike() {
 				likeBiz.Ctime = 0
 				likeBiz.Utime = 0
 				assert.Equal(t, dao.UserLikeBiz{
-					Biz:    "test",
-					BizId:  3,
-					Uid:    123,
-					Status: 1,
+					Biz:      "test",
+					BizId:    3,
+					Uid:      123,
+					Status:   1,
+					Reaction: 1,
 				}, likeBiz)
 
 				cnt, err := s.rdb.Exists(ctx, "interactive:test:2").Result()
@@ -401,12 +417,13 @@ func (s *InteractiveTestSuite) TestDislike() {
 				assert.True(t, likeBiz.Utime > 7)
 				likeBiz.Utime = 0
 				assert.Equal(t, dao.UserLikeBiz{
-					Id:     1,
-					Biz:    "test",
-					BizId:  2,
-					Uid:    123,
-					Ctime:  6,
-					Status: 0,
+					Id:       1,
+					Biz:      "test",
+					BizId:    2,
+					Uid:      123,
+					Ctime:    6,
+					Status:   0,
+					Reaction: 1,
 				}, likeBiz)
 
 				cnt, err := s.rdb.HGet(ctx, "interactive:test:2", "like_cnt").Int()
@@ -435,6 +452,74 @@ func (s *InteractiveTestSuite) TestDislike() {
 	}
 }
 
+func (s *InteractiveTestSuite) TestReact() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	react := func(reaction intrv1.ReactionType) {
+		_, err := s.server.React(ctx, &intrv1.ReactRequest{
+			Biz: "test", BizId: 2, Uid: 123, Reaction: reaction,
+		})
+		require.NoError(t, err)
+	}
+	getByIds := func() *intrv1.Interactive {
+		resp, err := s.server.GetByIds(ctx, &intrv1.GetByIdsRequest{
+			Biz: "test", Ids: []int64{1}, Uid: 123,
+		})
+		require.NoError(t, err)
+		return resp.Intrs[2]
+	}
+	err := s.db.Create(&dao.Interactive{
+		Id:      1,
+		Biz:     "test",
+		BizId:   2,
+		LikeCnt: 5,
+		Ctime:   6,
+		Utime:   7,
+	}).Error
+	require.NoError(t, err)
+
+	// 不支持的表态
+	_, err = s.server.React(ctx, &intrv1.ReactRequest{
+		Biz: "test", BizId: 2, Uid: 123, Reaction: 100,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// 点赞，重复点赞不会重复计数
+	react(intrv1.ReactionType_ReactionTypeLike)
+	react(intrv1.ReactionType_ReactionTypeLike)
+	intr := getByIds()
+	assert.Equal(t, int64(6), intr.LikeCnt)
+	assert.Equal(t, map[int32]int64{1: 6}, intr.ReactionCnts)
+	assert.Equal(t, intrv1.ReactionType_ReactionTypeLike, intr.Reaction)
+	assert.True(t, intr.Liked)
+
+	// 换成别的表态，点赞数要减回去
+	react(intrv1.ReactionType_ReactionTypeLaugh)
+	intr = getByIds()
+	assert.Equal(t, int64(5), intr.LikeCnt)
+	assert.Equal(t, map[int32]int64{1: 5, 2: 1}, intr.ReactionCnts)
+	assert.Equal(t, intrv1.ReactionType_ReactionTypeLaugh, intr.Reaction)
+	assert.False(t, intr.Liked)
+
+	// 老的客户端取消点赞，当前不是点赞，什么也不做
+	_, err = s.server.CancelLike(ctx, &intrv1.CancelLikeRequest{
+		Biz: "test", BizId: 2, Uid: 123,
+	})
+	require.NoError(t, err)
+	intr = getByIds()
+	assert.Equal(t, intrv1.ReactionType_ReactionTypeLaugh, intr.Reaction)
+
+	// 取消表态
+	_, err = s.server.CancelReaction(ctx, &intrv1.CancelReactionRequest{
+		Biz: "test", BizId: 2, Uid: 123,
+	})
+	require.NoError(t, err)
+	intr = getByIds()
+	assert.Equal(t, map[int32]int64{1: 5}, intr.ReactionCnts)
+	assert.Equal(t, intrv1.ReactionType_ReactionTypeUnknown, intr.Reaction)
+}
+
 func (s *InteractiveTestSuite) TestCollect() {
 	testCases := []struct {
 		name string
@@ -620,8 +705,29 @@ func (s *InteractiveTestSuite) TestCollect() {
 			uid:      1,
 			wantResp: &intrv1.CollectResponse{},
 		},
+		{
+			name:   "不能放进别人的收藏夹",
+			before: func(t *testing.T) {},
+			after: func(t *testing.T) {
+				var cnt int64
+				err := s.db.Model(&dao.UserCollectionBiz{}).
+					Where("uid = ? AND biz = ? AND biz_id = ?", 2, "test", 4).
+					Count(&cnt).Error
+				assert.NoError(t, err)
+				assert.Equal(t, int64(0), cnt)
+			},
+			bizId:    4,
+			biz:      "test",
+			cid:      1,
+			uid:      2,
+			wantErr:  status.Error(codes.NotFound, service.ErrCollectionNotFound.Error()),
+			wantResp: &intrv1.CollectResponse{},
+		},
 	}
 
+	// 所有测试用例都用 uid 1 的收藏夹
+	err := s.db.Create(&dao.Collection{Id: 1, Uid: 1, Name: "默认收藏夹"}).Error
+	require.NoError(s.T(), err)
 	for _, tc := range testCases {
 		s.T().Run(tc.name, func(t *testing.T) {
 			tc.before(t)
@@ -635,6 +741,64 @@ func (s *InteractiveTestSuite) TestCollect() {
 	}
 }
 
+func (s *InteractiveTestSuite) TestCollectionFolder() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+	// uid 1 创建一个公开的，一个私密的收藏夹
+	pub, err := s.server.CreateCollection(ctx, &intrv1.CreateCollectionRequest{
+		Uid: 1, Name: "公开", Public: true,
+	})
+	require.NoError(t, err)
+	priv, err := s.server.CreateCollection(ctx, &intrv1.CreateCollectionRequest{
+		Uid: 1, Name: "私密",
+	})
+	require.NoError(t, err)
+	_, err = s.server.CreateCollection(ctx, &intrv1.CreateCollectionRequest{
+		Uid: 1, Name: "  ",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// 同一篇文章放进两个收藏夹，收藏数只加一次
+	for _, cid := range []int64{pub.Id, priv.Id} {
+		_, err = s.server.Collect(ctx, &intrv1.CollectRequest{
+			Biz: "test", BizId: 1, Uid: 1, Cid: cid,
+		})
+		require.NoError(t, err)
+	}
+	var intr dao.Interactive
+	err = s.db.Where("biz = ? AND biz_id = ?", "test", 1).First(&intr).Error
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), intr.CollectCnt)
+
+	// 自己能看到两个，别人只能看到公开的
+	mine, err := s.server.ListCollections(ctx, &intrv1.ListCollectionsRequest{Uid: 1, Viewer: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(mine.Collections))
+	assert.Equal(t, int64(1), mine.Collections[0].ItemCnt)
+	others, err := s.server.ListCollections(ctx, &intrv1.ListCollectionsRequest{Uid: 1, Viewer: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(others.Collections))
+	assert.Equal(t, pub.Id, others.Collections[0].Id)
+
+	items, err := s.server.GetCollectionItems(ctx, &intrv1.GetCollectionItemsRequest{
+		Cid: pub.Id, Viewer: 2, Limit: 10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(items.Items))
+	_, err = s.server.GetCollectionItems(ctx, &intrv1.GetCollectionItemsRequest{
+		Cid: priv.Id, Viewer: 2, Limit: 10,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// 删掉收藏夹，文章依旧是收藏状态
+	_, err = s.server.DeleteCollection(ctx, &intrv1.DeleteCollectionRequest{Uid: 1, Id: pub.Id})
+	require.NoError(t, err)
+	resp, err := s.server.Get(ctx, &intrv1.GetRequest{Biz: "test", BizId: 1, Uid: 1})
+	require.NoError(t, err)
+	assert.True(t, resp.Intr.Collected)
+}
+
 func (s *InteractiveTestSuite) TestGet() {
 	testCases := []struct {
 		name string
@@ -669,11 +833,12 @@ func (s *InteractiveTestSuite) TestGet() {
 			},
 			wantRes: &intrv1.GetResponse{
 				Intr: &intrv1.Interactive{
-					Biz:        "test",
-					BizId:      12,
-					ReadCnt:    100,
-					CollectCnt: 200,
-					LikeCnt:    300,
+					Biz:          "test",
+					BizId:        12,
+					ReadCnt:      100,
+					CollectCnt:   200,
+					LikeCnt:      300,
+					ReactionCnts: map[int32]int64{1: 300},
 				},
 			},
 		},
@@ -715,6 +880,7 @@ func (s *InteractiveTestSuite) TestGet() {
 					CollectCnt: 1,
 					Collected:  true,
 					Liked:      true,
+					Reaction:   intrv1.ReactionType_ReactionTypeLike,
 				},
 			},
 		},
@@ -767,18 +933,20 @@ func (s *InteractiveTestSuite) TestGetByIds() {
 			wantRes: &intrv1.GetByIdsResponse{
 				Intrs: map[int64]*intrv1.Interactive{
 					1: {
-						Biz:        "test",
-						BizId:      1,
-						ReadCnt:    1,
-						CollectCnt: 2,
-						LikeCnt:    3,
+						Biz:          "test",
+						BizId:        1,
+						ReadCnt:      1,
+						CollectCnt:   2,
+						LikeCnt:      3,
+						ReactionCnts: map[int32]int64{1: 3},
 					},
 					2: {
-						Biz:        "test",
-						BizId:      2,
-						ReadCnt:    2,
-						CollectCnt: 3,
-						LikeCnt:    4,
+						Biz:          "test",
+						BizId:        2,
+						ReadCnt:      2,
+						CollectCnt:   3,
+						LikeCnt:      4,
+						ReactionCnts: map[int32]int64{1: 4},
 					},
 				},
 			},
@@ -804,6 +972,143 @@ func (s *InteractiveTestSuite) TestGetByIds() {
 	}
 }
 
+func (s *InteractiveTestSuite) TestCntDeltaBuffer() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	deltaCache := cache.NewRedisDeltaCache(s.rdb)
+	buffer := repository.NewCntDeltaBuffer(deltaCache, dao.NewGORMDeltaDAO(s.db),
+		repository.DeltaBufferConfig{
+			BatchSize: 100,
+			// 立刻认为没有 Ack 的批次都需要重放
+			AckTimeout:        0,
+			FlushLogRetention: time.Hour,
+		}, startup.InitLog())
+	err := s.db.Create(&dao.Interactive{
+		Id:      1,
+		Biz:     "test",
+		BizId:   2,
+		ReadCnt: 3,
+		LikeCnt: 5,
+		Ctime:   6,
+		Utime:   7,
+	}).Error
+	require.NoError(t, err)
+
+	// 在缓冲里面合并
+	for i := 0; i < 3; i++ {
+		err = buffer.Add(ctx, cache.CntDelta{Biz: "test", BizId: 2, ReadCnt: 1})
+		require.NoError(t, err)
+	}
+	err = buffer.Add(ctx, cache.CntDelta{Biz: "test", BizId: 2, LikeCnt: 1})
+	require.NoError(t, err)
+	err = buffer.Add(ctx, cache.CntDelta{Biz: "test", BizId: 3, LikeCnt: 1})
+	require.NoError(t, err)
+	err = buffer.Add(ctx, cache.CntDelta{Biz: "test", BizId: 3, LikeCnt: -1})
+	require.NoError(t, err)
+	d, err := buffer.Peek(ctx, "test", 2)
+	require.NoError(t, err)
+	assert.Equal(t, cache.CntDelta{Biz: "test", BizId: 2, ReadCnt: 3, LikeCnt: 1}, d)
+
+	n, err := buffer.Flush(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	var data dao.Interactive
+	err = s.db.Where("biz = ? AND biz_id = ?", "test", 2).First(&data).Error
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), data.ReadCnt)
+	assert.Equal(t, int64(6), data.LikeCnt)
+	// 点赞之后又取消，互相抵消，不会插入数据
+	err = s.db.Where("biz = ? AND biz_id = ?", "test", 3).First(&data).Error
+	assert.Equal(t, gorm.ErrRecordNotFound, err)
+	d, err = buffer.Peek(ctx, "test", 2)
+	require.NoError(t, err)
+	assert.Equal(t, cache.CntDelta{Biz: "test", BizId: 2}, d)
+
+	// 模拟取出来之后还没落库就崩溃了
+	err = buffer.Add(ctx, cache.CntDelta{Biz: "test", BizId: 2, ReadCnt: 2})
+	require.NoError(t, err)
+	var deltas []cache.CntDelta
+	for shard := 0; shard < deltaCache.Shards(); shard++ {
+		res, err := deltaCache.Drain(ctx, shard, "crashed", 100)
+		require.NoError(t, err)
+		deltas = append(deltas, res...)
+	}
+	assert.Equal(t, []cache.CntDelta{{Biz: "test", BizId: 2, ReadCnt: 2}}, deltas)
+	buffer.Recover(ctx)
+	// 再重放一次，也只会加一次
+	err = dao.NewGORMDeltaDAO(s.db).ApplyDeltas(ctx, "crashed", []dao.CntDelta{{Biz: "test", BizId: 2, ReadCnt: 2}})
+	require.NoError(t, err)
+	err = s.db.Where("biz = ? AND biz_id = ?", "test", 2).First(&data).Error
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), data.ReadCnt)
+	for shard := 0; shard < deltaCache.Shards(); shard++ {
+		pending, err := deltaCache.Pending(ctx, shard, time.Now())
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	}
+}
+
 func TestInteractiveService(t *testing.T) {
 	suite.Run(t, &InteractiveTestSuite{})
 }
+
+func (s *InteractiveTestSuite) TestBloomCache() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	bloom := cache.NewRedisBloomCache(s.rdb, cache.BloomConfig{
+		Bits:           1 << 16,
+		Hashes:         7,
+		Expiration:     time.Minute,
+		RebuildTimeout: time.Minute,
+	})
+
+	// 还没有构建
+	_, err := bloom.MightContain(ctx, cache.BloomKindLike, 123, "test", 1)
+	assert.Equal(t, cache.ErrBloomNotReady, err)
+	// 不存在的时候 Add 什么也不做，不然会有假阴性
+	err = bloom.Add(ctx, cache.BloomKindLike, 123, "test", 1)
+	require.NoError(t, err)
+	_, err = bloom.MightContain(ctx, cache.BloomKindLike, 123, "test", 1)
+	assert.Equal(t, cache.ErrBloomNotReady, err)
+
+	err = bloom.MarkMissing(ctx, cache.BloomKindLike, 123)
+	require.NoError(t, err)
+	err = bloom.MarkMissing(ctx, cache.BloomKindCollect, 123)
+	require.NoError(t, err)
+	targets, err := bloom.PopMissing(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []cache.BloomTarget{
+		{Kind: cache.BloomKindLike, Uid: 123},
+		{Kind: cache.BloomKindCollect, Uid: 123},
+	}, targets)
+
+	err = bloom.BeginRebuild(ctx, cache.BloomKindLike, 123)
+	require.NoError(t, err)
+	// 重建期间新增的数据
+	err = bloom.Add(ctx, cache.BloomKindLike, 123, "test", 3)
+	require.NoError(t, err)
+	err = bloom.FinishRebuild(ctx, cache.BloomKindLike, 123, []cache.BloomItem{
+		{Biz: "test", BizId: 1},
+		{Biz: "test", BizId: 2},
+	})
+	require.NoError(t, err)
+	for _, id := range []int64{1, 2, 3} {
+		ok, err := bloom.MightContain(ctx, cache.BloomKindLike, 123, "test", id)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	}
+	ok, err := bloom.MightContain(ctx, cache.BloomKindLike, 123, "test", 4)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	// 构建好之后的新增
+	err = bloom.Add(ctx, cache.BloomKindLike, 123, "test", 4)
+	require.NoError(t, err)
+	ok, err = bloom.MightContain(ctx, cache.BloomKindLike, 123, "test", 4)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	// 收藏的过滤器是另外一个
+	_, err = bloom.MightContain(ctx, cache.BloomKindCollect, 123, "test", 1)
+	assert.Equal(t, cache.ErrBloomNotReady, err)
+}
@@ -13,3 +13,11 @@ func InitKafka() sarama.Client {
 	}
 	return client
 }
+
+func InitSyncProducer(client sarama.Client) sarama.SyncProducer {
+	res, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
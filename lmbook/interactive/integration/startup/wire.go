@@ -3,6 +3,7 @@
 package startup
 
 import (
+	"basic-go/lmbook/interactive/events"
 	"basic-go/lmbook/interactive/grpc"
 	"basic-go/lmbook/interactive/repository"
 	"basic-go/lmbook/interactive/repository/cache"
@@ -22,9 +23,13 @@ func InitGRPCServer() *grpc.InteractiveServiceServer {
 		grpc.NewInteractiveServiceServer,
 		thirdProvider,
 		dao.NewGORMInteractiveDAO,
+		dao.NewGORMCollectionDAO,
 		cache.NewRedisInteractiveCache,
 		repository.NewCachedInteractiveRepository,
+		repository.NewCollectionRepository,
 		service.NewInteractiveService,
+		events.NewSaramaSyncProducer,
+		InitSyncProducer,
 	)
 	return new(grpc.InteractiveServiceServer)
 }
@@ -7,6 +7,7 @@
 package startup
 
 import (
+	"basic-go/lmbook/interactive/events"
 	"basic-go/lmbook/interactive/grpc"
 	"basic-go/lmbook/interactive/repository"
 	"basic-go/lmbook/interactive/repository/cache"
@@ -24,7 +25,12 @@ func InitGRPCServer() *grpc.InteractiveServiceServer {
 	interactiveCache := cache.NewRedisInteractiveCache(cmdable)
 	loggerV1 := InitLog()
 	interactiveRepository := repository.NewCachedInteractiveRepository(interactiveDAO, interactiveCache, loggerV1)
-	interactiveService := service.NewInteractiveService(interactiveRepository, loggerV1)
+	collectionDAO := dao.NewGORMCollectionDAO(gormDB)
+	collectionRepository := repository.NewCollectionRepository(collectionDAO)
+	client := InitKafka()
+	syncProducer := InitSyncProducer(client)
+	producer := events.NewSaramaSyncProducer(syncProducer)
+	interactiveService := service.NewInteractiveService(interactiveRepository, collectionRepository, producer, loggerV1)
 	interactiveServiceServer := grpc.NewInteractiveServiceServer(interactiveService)
 	return interactiveServiceServer
 }
@@ -0,0 +1,34 @@
+package ioc
+
+import (
+	"basic-go/lmbook/interactive/repository/cache"
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+	"time"
+)
+
+func InitBloomCache(client redis.Cmdable) cache.BloomCache {
+	type Config struct {
+		Bits           uint64        `yaml:"bits"`
+		Hashes         int           `yaml:"hashes"`
+		Expiration     time.Duration `yaml:"expiration"`
+		RebuildTimeout time.Duration `yaml:"rebuildTimeout"`
+	}
+	// 默认值
+	cfg := Config{
+		Bits:           1 << 16,
+		Hashes:         7,
+		Expiration:     time.Hour * 24 * 7,
+		RebuildTimeout: time.Minute,
+	}
+	err := viper.UnmarshalKey("intr.bloom", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return cache.NewRedisBloomCache(client, cache.BloomConfig{
+		Bits:           cfg.Bits,
+		Hashes:         cfg.Hashes,
+		Expiration:     cfg.Expiration,
+		RebuildTimeout: cfg.RebuildTimeout,
+	})
+}
@@ -0,0 +1,41 @@
+package ioc
+
+import (
+	"basic-go/lmbook/interactive/repository"
+	"basic-go/lmbook/interactive/repository/cache"
+	"basic-go/lmbook/interactive/repository/dao"
+	"basic-go/lmbook/pkg/logger"
+	"fmt"
+	"github.com/spf13/viper"
+	"time"
+)
+
+func InitCntDeltaBuffer(c cache.DeltaCache, d dao.DeltaDAO, l logger.LoggerV1) *repository.CntDeltaBuffer {
+	type Config struct {
+		BatchSize         int64         `yaml:"batchSize"`
+		Interval          time.Duration `yaml:"interval"`
+		AckTimeout        time.Duration `yaml:"ackTimeout"`
+		FlushLogRetention time.Duration `yaml:"flushLogRetention"`
+	}
+	// 默认值
+	cfg := Config{
+		BatchSize:         500,
+		Interval:          time.Second,
+		AckTimeout:        time.Minute,
+		FlushLogRetention: time.Hour * 24,
+	}
+	err := viper.UnmarshalKey("intr.delta", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	// time.NewTicker 不接受 0
+	if cfg.BatchSize <= 0 || cfg.Interval <= 0 || cfg.AckTimeout <= 0 {
+		panic(fmt.Errorf("intr.delta 配置错误 %+v", cfg))
+	}
+	return repository.NewCntDeltaBuffer(c, d, repository.DeltaBufferConfig{
+		BatchSize:         cfg.BatchSize,
+		Interval:          cfg.Interval,
+		AckTimeout:        cfg.AckTimeout,
+		FlushLogRetention: cfg.FlushLogRetention,
+	}, l)
+}
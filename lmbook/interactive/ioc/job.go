@@ -0,0 +1,58 @@
+package ioc
+
+import (
+	"basic-go/lmbook/interactive/events"
+	"basic-go/lmbook/interactive/job"
+	"basic-go/lmbook/interactive/repository"
+	"basic-go/lmbook/pkg/cronjobx"
+	"basic-go/lmbook/pkg/logger"
+	"github.com/robfig/cron/v3"
+	"github.com/spf13/viper"
+	"time"
+)
+
+func InitJobs(l logger.LoggerV1,
+	bloomJob *job.BloomRebuildJob,
+	popularityJob *job.PopularitySyncJob) *cron.Cron {
+	res := cron.New(cron.WithSeconds())
+	cbd := cronjobx.NewCronJobBuilder(l)
+	addJob(res, cbd, "job.bloomRebuild", bloomJob)
+	addJob(res, cbd, "job.popularitySync", popularityJob)
+	return res
+}
+
+func addJob(c *cron.Cron, cbd *cronjobx.CronJobBuilder, key string, j cronjobx.Job) {
+	type Config struct {
+		// 秒级的 cron 表达式
+		Expression string `yaml:"expression"`
+	}
+	cfg := Config{
+		Expression: "0 * * * * ?",
+	}
+	err := viper.UnmarshalKey(key, &cfg)
+	if err != nil {
+		panic(err)
+	}
+	_, err = c.AddJob(cfg.Expression, cbd.Build(j))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func InitPopularitySyncJob(repo repository.InteractiveRepository,
+	producer events.Producer,
+	l logger.LoggerV1) *job.PopularitySyncJob {
+	type Config struct {
+		// Lookback 启动的时候往前补多久
+		Lookback time.Duration `yaml:"lookback"`
+	}
+	cfg := Config{
+		Lookback: time.Minute * 10,
+	}
+	err := viper.UnmarshalKey("job.popularitySync", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	// 搜索目前只用得上文章的热度
+	return job.NewPopularitySyncJob(repo, producer, l, cfg.Lookback, "article")
+}
@@ -0,0 +1,69 @@
+package job
+
+import (
+	"basic-go/lmbook/interactive/repository"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"time"
+)
+
+// BloomRebuildJob 重建查询的时候发现不存在（没有建过，或者已经过期）的布隆过滤器。
+// 重建是幂等的，多个实例同时运行也没关系
+type BloomRebuildJob struct {
+	repo *repository.BloomInteractiveRepository
+	l    logger.LoggerV1
+	// batchSize 一次取多少个用户
+	batchSize int64
+	// maxBatch 一次运行最多处理多少批，剩下的等下一次
+	maxBatch int
+	timeout  time.Duration
+}
+
+func NewBloomRebuildJob(repo *repository.BloomInteractiveRepository,
+	l logger.LoggerV1) *BloomRebuildJob {
+	return &BloomRebuildJob{
+		repo:      repo,
+		l:         l,
+		batchSize: 100,
+		maxBatch:  10,
+		timeout:   time.Second * 10,
+	}
+}
+
+func (b *BloomRebuildJob) Name() string {
+	return "interactive_bloom_rebuild"
+}
+
+func (b *BloomRebuildJob) Run() error {
+	var total int
+	for i := 0; i < b.maxBatch; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+		targets, err := b.repo.PopBloomMissing(ctx, b.batchSize)
+		cancel()
+		if err != nil {
+			return err
+		}
+		for _, t := range targets {
+			ctx, cancel = context.WithTimeout(context.Background(), b.timeout)
+			err = b.repo.RebuildBloom(ctx, t.Kind, t.Uid)
+			cancel()
+			if err != nil {
+				// 已经从队列里面拿出来了，下一次查询的时候会再标记一次
+				b.l.Error("重建布隆过滤器失败",
+					logger.String("kind", t.Kind),
+					logger.Int64("uid", t.Uid),
+					logger.Error(err))
+				continue
+			}
+			total++
+		}
+		if int64(len(targets)) < b.batchSize {
+			break
+		}
+	}
+	if total > 0 {
+		b.l.Info("重建布隆过滤器",
+			logger.Int64("cnt", int64(total)))
+	}
+	return nil
+}
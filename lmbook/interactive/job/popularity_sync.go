@@ -0,0 +1,114 @@
+package job
+
+import (
+	"basic-go/lmbook/interactive/domain"
+	"basic-go/lmbook/interactive/events"
+	"basic-go/lmbook/interactive/repository"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"sync"
+	"time"
+)
+
+// PopularitySyncJob 把最近计数变过的东西同步给搜索算热度。
+// 按照 utime 扫描，而不是计数每变一次就发一条，这样阅读这种高频的计数在一次运行里面只会发一条。
+// 每个实例各自记录扫描到哪里了，多个实例重复发也没关系，搜索那边是直接覆盖热度的
+type PopularitySyncJob struct {
+	repo     repository.InteractiveRepository
+	producer events.Producer
+	l        logger.LoggerV1
+	// bizs 只同步这些业务的，搜索目前只用得上文章的热度
+	bizs map[string]struct{}
+
+	lock sync.Mutex
+	cur  repository.UtimeCursor
+	// delay 只扫描这么久之前更新的，给还没提交的事务和写缓冲留出时间，
+	// 不然游标越过去就漏掉了
+	delay time.Duration
+	// batchSize 一次取多少条
+	batchSize int
+	// maxBatch 一次运行最多处理多少批，剩下的等下一次
+	maxBatch int
+	timeout  time.Duration
+}
+
+// NewPopularitySyncJob lookback 启动的时候往前补多久，
+// 覆盖掉重启期间变过的计数
+func NewPopularitySyncJob(repo repository.InteractiveRepository,
+	producer events.Producer,
+	l logger.LoggerV1,
+	lookback time.Duration,
+	bizs ...string) *PopularitySyncJob {
+	set := make(map[string]struct{}, len(bizs))
+	for _, biz := range bizs {
+		set[biz] = struct{}{}
+	}
+	return &PopularitySyncJob{
+		repo:      repo,
+		producer:  producer,
+		l:         l,
+		bizs:      set,
+		cur:       repository.UtimeCursor{Utime: time.Now().Add(-lookback).UnixMilli()},
+		delay:     time.Second * 5,
+		batchSize: 500,
+		maxBatch:  20,
+		timeout:   time.Second * 10,
+	}
+}
+
+func (p *PopularitySyncJob) Name() string {
+	return "interactive_popularity_sync"
+}
+
+func (p *PopularitySyncJob) Run() error {
+	// 上一次还没跑完就跳过，游标只能有一个人推进
+	if !p.lock.TryLock() {
+		return nil
+	}
+	defer p.lock.Unlock()
+	maxUtime := time.Now().Add(-p.delay).UnixMilli()
+	var total int
+	for i := 0; i < p.maxBatch; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+		intrs, next, err := p.repo.ListUpdated(ctx, p.cur, maxUtime, p.batchSize)
+		if err == nil {
+			err = p.produce(ctx, intrs)
+		}
+		cancel()
+		if err != nil {
+			// 游标没有动，下一次从这里重新发
+			return err
+		}
+		p.cur = next
+		total += len(intrs)
+		if len(intrs) < p.batchSize {
+			break
+		}
+	}
+	if total > 0 {
+		p.l.Info("同步热度",
+			logger.Int64("cnt", int64(total)))
+	}
+	return nil
+}
+
+func (p *PopularitySyncJob) produce(ctx context.Context, intrs []domain.Interactive) error {
+	intrs = slice.FilterDelete(intrs, func(idx int, src domain.Interactive) bool {
+		_, ok := p.bizs[src.Biz]
+		return !ok
+	})
+	if len(intrs) == 0 {
+		return nil
+	}
+	return p.producer.ProducePopularityEvents(ctx, slice.Map(intrs,
+		func(idx int, src domain.Interactive) events.PopularityEvent {
+			return events.PopularityEvent{
+				Biz:        src.Biz,
+				BizId:      src.BizId,
+				ReadCnt:    src.ReadCnt,
+				LikeCnt:    src.LikeCnt,
+				CollectCnt: src.CollectCnt,
+			}
+		}))
+}
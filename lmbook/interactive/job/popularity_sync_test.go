@@ -0,0 +1,103 @@
+package job
+
+import (
+	"basic-go/lmbook/interactive/domain"
+	"basic-go/lmbook/interactive/events"
+	evtmocks "basic-go/lmbook/interactive/events/mocks"
+	"basic-go/lmbook/interactive/repository"
+	repomocks "basic-go/lmbook/interactive/repository/mocks"
+	"basic-go/lmbook/pkg/logger"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPopularitySyncJob_Run(t *testing.T) {
+	start := repository.UtimeCursor{Utime: 100}
+	intr := func(biz string, bizId int64) domain.Interactive {
+		return domain.Interactive{Biz: biz, BizId: bizId, ReadCnt: bizId, LikeCnt: 2, CollectCnt: 3}
+	}
+	evt := func(bizId int64) events.PopularityEvent {
+		return events.PopularityEvent{Biz: "article", BizId: bizId, ReadCnt: bizId, LikeCnt: 2, CollectCnt: 3}
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.InteractiveRepository, events.Producer)
+
+		wantCur repository.UtimeCursor
+		wantErr error
+	}{
+		{
+			name: "分批同步，只发文章的",
+			mock: func(ctrl *gomock.Controller) (repository.InteractiveRepository, events.Producer) {
+				repo := repomocks.NewMockInteractiveRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				first := repository.UtimeCursor{Utime: 200, Id: 2}
+				repo.EXPECT().ListUpdated(gomock.Any(), start, gomock.Any(), 2).
+					Return([]domain.Interactive{intr("article", 1), intr("comment", 2)}, first, nil)
+				producer.EXPECT().ProducePopularityEvents(gomock.Any(), []events.PopularityEvent{evt(1)}).
+					Return(nil)
+				// 不够一批，说明扫完了
+				repo.EXPECT().ListUpdated(gomock.Any(), first, gomock.Any(), 2).
+					Return([]domain.Interactive{intr("article", 3)}, repository.UtimeCursor{Utime: 300, Id: 3}, nil)
+				producer.EXPECT().ProducePopularityEvents(gomock.Any(), []events.PopularityEvent{evt(3)}).
+					Return(nil)
+				return repo, producer
+			},
+			wantCur: repository.UtimeCursor{Utime: 300, Id: 3},
+		},
+		{
+			name: "没有文章的，不用发",
+			mock: func(ctrl *gomock.Controller) (repository.InteractiveRepository, events.Producer) {
+				repo := repomocks.NewMockInteractiveRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				repo.EXPECT().ListUpdated(gomock.Any(), start, gomock.Any(), 2).
+					Return([]domain.Interactive{intr("comment", 2)}, repository.UtimeCursor{Utime: 200, Id: 2}, nil)
+				return repo, producer
+			},
+			wantCur: repository.UtimeCursor{Utime: 200, Id: 2},
+		},
+		{
+			name: "发送失败，游标不动",
+			mock: func(ctrl *gomock.Controller) (repository.InteractiveRepository, events.Producer) {
+				repo := repomocks.NewMockInteractiveRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				repo.EXPECT().ListUpdated(gomock.Any(), start, gomock.Any(), 2).
+					Return([]domain.Interactive{intr("article", 1)}, repository.UtimeCursor{Utime: 200, Id: 1}, nil)
+				producer.EXPECT().ProducePopularityEvents(gomock.Any(), gomock.Any()).
+					Return(errors.New("mock kafka error"))
+				return repo, producer
+			},
+			wantCur: start,
+			wantErr: errors.New("mock kafka error"),
+		},
+		{
+			name: "查询失败",
+			mock: func(ctrl *gomock.Controller) (repository.InteractiveRepository, events.Producer) {
+				repo := repomocks.NewMockInteractiveRepository(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				repo.EXPECT().ListUpdated(gomock.Any(), start, gomock.Any(), 2).
+					Return(nil, start, errors.New("mock db error"))
+				return repo, producer
+			},
+			wantCur: start,
+			wantErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, producer := tc.mock(ctrl)
+			j := NewPopularitySyncJob(repo, producer, logger.NewNoOpLogger(), time.Minute, "article")
+			j.cur = start
+			j.batchSize = 2
+			err := j.Run()
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantCur, j.cur)
+		})
+	}
+}
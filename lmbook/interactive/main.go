@@ -1,18 +1,40 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	_ "github.com/spf13/viper/remote"
+	"google.golang.org/grpc"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 )
 
 func main() {
 	initViperV1()
 	app := InitApp()
 	initPrometheus()
+	// 收到退出信号之后停止 gRPC 服务，再等增量落库
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	deltaDone := app.deltaBuffer.Start(ctx)
+	app.cron.Start()
+	defer func() {
+		// 等待正在运行的定时任务结束
+		<-app.cron.Stop().Done()
+	}()
+	go func() {
+		<-ctx.Done()
+		err := app.server.Close()
+		if err != nil {
+			log.Println("关闭 gRPC 服务失败", err)
+		}
+	}()
 	for _, c := range app.consumers {
 		err := c.Start()
 		if err != nil {
@@ -24,9 +46,11 @@ func main() {
 		panic(err1)
 	}()
 	err := app.server.Serve()
-	if err != nil {
+	if err != nil && !errors.Is(err, grpc.ErrServerStopped) {
 		panic(err)
 	}
+	cancel()
+	<-deltaDone
 }
 
 func initPrometheus() {
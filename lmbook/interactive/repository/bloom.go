@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"basic-go/lmbook/interactive/domain"
+	"basic-go/lmbook/interactive/repository/cache"
+	"basic-go/lmbook/interactive/repository/dao"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// 布隆过滤器判断的结果。
+// 误判率 = false_positive / (positive + false_positive)
+const (
+	bloomNegative      = "negative"
+	bloomPositive      = "positive"
+	bloomFalsePositive = "false_positive"
+	bloomNotReady      = "not_ready"
+	bloomError         = "error"
+)
+
+// BloomInteractiveRepository 在查询用户有没有点赞、收藏之前，先问一下布隆过滤器。
+// 绝大多数人看文章的时候都没有点赞、收藏，这部分查询就不用打到数据库上了。
+// 过滤器说可能有的时候，还是以数据库为准
+type BloomInteractiveRepository struct {
+	InteractiveRepository
+	dao    dao.InteractiveDAO
+	bloom  cache.BloomCache
+	l      logger.LoggerV1
+	vector *prometheus.CounterVec
+	// rebuildBatch 重建的时候一次从数据库里面查多少条
+	rebuildBatch int
+}
+
+func NewBloomInteractiveRepository(dao dao.InteractiveDAO,
+	intrCache cache.InteractiveCache,
+	buffer *CntDeltaBuffer,
+	bloom cache.BloomCache,
+	l logger.LoggerV1) *BloomInteractiveRepository {
+	vector := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "geektime_daming",
+		Subsystem: "lmbook",
+		Name:      "interactive_bloom_lookup",
+		Help:      "统计布隆过滤器的判断结果，用来计算误判率",
+	}, []string{"kind", "result"})
+	// 集成测试之类的会多次创建，这时候复用已经注册的那个
+	if err := prometheus.Register(vector); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if !errors.As(err, &are) {
+			panic(err)
+		}
+		vector = are.ExistingCollector.(*prometheus.CounterVec)
+	}
+	return &BloomInteractiveRepository{
+		InteractiveRepository: NewBufferedInteractiveRepository(dao, intrCache, buffer, l),
+		dao:                   dao,
+		bloom:                 bloom,
+		l:                     l,
+		vector:                vector,
+		rebuildBatch:          1000,
+	}
+}
+
+func (b *BloomInteractiveRepository) React(ctx context.Context,
+	biz string, bizId, uid int64, reaction domain.ReactionType) (domain.ReactionType, error) {
+	old, err := b.InteractiveRepository.React(ctx, biz, bizId, uid, reaction)
+	if err != nil {
+		return old, err
+	}
+	b.add(ctx, cache.BloomKindLike, uid, biz, bizId)
+	return old, nil
+}
+
+func (b *BloomInteractiveRepository) AddCollectionItem(ctx context.Context,
+	biz string, bizId, cid, uid int64) error {
+	err := b.InteractiveRepository.AddCollectionItem(ctx, biz, bizId, cid, uid)
+	if err != nil {
+		return err
+	}
+	b.add(ctx, cache.BloomKindCollect, uid, biz, bizId)
+	return nil
+}
+
+func (b *BloomInteractiveRepository) Reaction(ctx context.Context,
+	biz string, id int64, uid int64) (domain.ReactionType, error) {
+	maybe, checked := b.mightContain(ctx, cache.BloomKindLike, uid, biz, id)
+	if !maybe {
+		return domain.ReactionNone, nil
+	}
+	res, err := b.InteractiveRepository.Reaction(ctx, biz, id, uid)
+	if err == nil && checked {
+		b.observe(cache.BloomKindLike, res != domain.ReactionNone)
+	}
+	return res, err
+}
+
+func (b *BloomInteractiveRepository) Collected(ctx context.Context,
+	biz string, id int64, uid int64) (bool, error) {
+	maybe, checked := b.mightContain(ctx, cache.BloomKindCollect, uid, biz, id)
+	if !maybe {
+		return false, nil
+	}
+	res, err := b.InteractiveRepository.Collected(ctx, biz, id, uid)
+	if err == nil && checked {
+		b.observe(cache.BloomKindCollect, res)
+	}
+	return res, err
+}
+
+// RebuildBloom 用数据库里面的全量数据重建某个用户的过滤器
+func (b *BloomInteractiveRepository) RebuildBloom(ctx context.Context, kind string, uid int64) error {
+	// 先开始记录重建期间新增的数据，再去查数据库，两边合起来不会漏
+	err := b.bloom.BeginRebuild(ctx, kind, uid)
+	if err != nil {
+		return err
+	}
+	var items []cache.BloomItem
+	var minId int64
+	for {
+		var (
+			batch []cache.BloomItem
+			n     int
+		)
+		switch kind {
+		case cache.BloomKindLike:
+			ubs, er := b.dao.ListReactionsByUser(ctx, uid, minId, b.rebuildBatch)
+			if er != nil {
+				return er
+			}
+			for _, ub := range ubs {
+				batch = append(batch, cache.BloomItem{Biz: ub.Biz, BizId: ub.BizId})
+				minId = ub.Id
+			}
+			n = len(ubs)
+		case cache.BloomKindCollect:
+			cbs, er := b.dao.ListCollectionBizByUser(ctx, uid, minId, b.rebuildBatch)
+			if er != nil {
+				return er
+			}
+			for _, cb := range cbs {
+				batch = append(batch, cache.BloomItem{Biz: cb.Biz, BizId: cb.BizId})
+				minId = cb.Id
+			}
+			n = len(cbs)
+		default:
+			// 不认识的类型，直接丢掉
+			return nil
+		}
+		items = append(items, batch...)
+		if n < b.rebuildBatch {
+			break
+		}
+	}
+	return b.bloom.FinishRebuild(ctx, kind, uid, items)
+}
+
+// PopBloomMissing 取出等着重建过滤器的用户
+func (b *BloomInteractiveRepository) PopBloomMissing(ctx context.Context, limit int64) ([]cache.BloomTarget, error) {
+	return b.bloom.PopMissing(ctx, limit)
+}
+
+// mightContain 返回 false 的时候一定没有，其余情况都要查数据库。
+// checked 代表过滤器有没有真的判断过，没有判断过的不计入误判率
+func (b *BloomInteractiveRepository) mightContain(ctx context.Context,
+	kind string, uid int64, biz string, bizId int64) (maybe bool, checked bool) {
+	ok, err := b.bloom.MightContain(ctx, kind, uid, biz, bizId)
+	switch err {
+	case nil:
+		if !ok {
+			b.vector.WithLabelValues(kind, bloomNegative).Inc()
+		}
+		return ok, true
+	case cache.ErrBloomNotReady:
+		b.vector.WithLabelValues(kind, bloomNotReady).Inc()
+		if er := b.bloom.MarkMissing(ctx, kind, uid); er != nil {
+			b.l.Error("记录需要重建的布隆过滤器失败",
+				logger.String("kind", kind),
+				logger.Int64("uid", uid),
+				logger.Error(er))
+		}
+		return true, false
+	default:
+		// Redis 出问题了，退化成直接查数据库
+		b.vector.WithLabelValues(kind, bloomError).Inc()
+		b.l.Error("查询布隆过滤器失败",
+			logger.String("kind", kind),
+			logger.Int64("uid", uid),
+			logger.Error(err))
+		return true, false
+	}
+}
+
+// observe 过滤器说可能有，数据库给出真正的答案
+func (b *BloomInteractiveRepository) observe(kind string, exists bool) {
+	if exists {
+		b.vector.WithLabelValues(kind, bloomPositive).Inc()
+		return
+	}
+	b.vector.WithLabelValues(kind, bloomFalsePositive).Inc()
+}
+
+// add 加不进去的话，在过滤器过期重建之前，会误判成没有点赞（收藏）。
+// 不过一般是 Redis 整个不可用，这个时候查询也退化成直接查数据库了
+func (b *BloomInteractiveRepository) add(ctx context.Context,
+	kind string, uid int64, biz string, bizId int64) {
+	err := b.bloom.Add(ctx, kind, uid, biz, bizId)
+	if err != nil {
+		b.l.Error("更新布隆过滤器失败",
+			logger.String("kind", kind),
+			logger.Int64("uid", uid),
+			logger.Int64("bizId", bizId),
+			logger.Error(err))
+	}
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"basic-go/lmbook/pkg/logger"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBloomInteractiveRepository(t *testing.T) {
+	// 创建两次不能因为重复注册 prometheus 而 panic，并且共用一个指标
+	r1 := NewBloomInteractiveRepository(nil, nil, nil, nil, logger.NewNoOpLogger())
+	r2 := NewBloomInteractiveRepository(nil, nil, nil, nil, logger.NewNoOpLogger())
+	assert.Same(t, r1.vector, r2.vector)
+}
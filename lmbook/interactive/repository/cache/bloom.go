@@ -0,0 +1,193 @@
+package cache
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	//go:embed lua/bloom_check.lua
+	luaBloomCheck string
+	//go:embed lua/bloom_add.lua
+	luaBloomAdd string
+	//go:embed lua/bloom_rebuild.lua
+	luaBloomRebuild string
+	// ErrBloomNotReady 过滤器还没有构建，或者已经过期了，只能查数据库
+	ErrBloomNotReady = errors.New("布隆过滤器还没有构建")
+)
+
+const (
+	// BloomKindLike 表态过的东西，不管是哪一种表态
+	BloomKindLike = "like"
+	// BloomKindCollect 收藏过的东西
+	BloomKindCollect = "collect"
+
+	bloomMissingKey = "interactive:bloom:missing"
+)
+
+type BloomItem struct {
+	Biz   string
+	BizId int64
+}
+
+// BloomTarget 需要构建过滤器的用户
+type BloomTarget struct {
+	Kind string
+	Uid  int64
+}
+
+//go:generate mockgen -source=./bloom.go -package=cachemocks -destination=mocks/bloom.mock.go BloomCache
+type BloomCache interface {
+	// MightContain 返回 false 代表用户一定没有点赞（收藏）过，true 代表可能有。
+	// 过滤器还没有构建的时候返回 ErrBloomNotReady
+	MightContain(ctx context.Context, kind string, uid int64, biz string, bizId int64) (bool, error)
+	// Add 点赞、收藏之后加进过滤器。过滤器不存在的时候什么也不做
+	Add(ctx context.Context, kind string, uid int64, biz string, bizId int64) error
+	// BeginRebuild 开始重建，从这之后 Add 的数据也会进入新的过滤器
+	BeginRebuild(ctx context.Context, kind string, uid int64) error
+	// FinishRebuild 用全量数据替换旧的过滤器。
+	// 布隆过滤器不支持删除，取消点赞留下的比特位只能靠重建清理
+	FinishRebuild(ctx context.Context, kind string, uid int64, items []BloomItem) error
+	// MarkMissing 记录过滤器不存在的用户，交给重建任务处理
+	MarkMissing(ctx context.Context, kind string, uid int64) error
+	// PopMissing 取出最早标记的 limit 个用户
+	PopMissing(ctx context.Context, limit int64) ([]BloomTarget, error)
+}
+
+type BloomConfig struct {
+	// Bits 每个用户的过滤器有多少位
+	Bits uint64
+	// Hashes 哈希函数的个数
+	Hashes int
+	// Expiration 过期之后会重建，顺便清理取消点赞留下的比特位
+	Expiration time.Duration
+	// RebuildTimeout 重建最多花多长时间，超过这个时间新增的数据就不会合并进去了
+	RebuildTimeout time.Duration
+}
+
+// RedisBloomCache 直接用 Redis 的 bitmap 实现的布隆过滤器，不依赖 RedisBloom 模块。
+// 默认 65536 位、7 个哈希函数，也就是每个用户 8KB，
+// 一个用户点赞五千篇以内，误判率在千分之二左右
+type RedisBloomCache struct {
+	client redis.Cmdable
+	cfg    BloomConfig
+}
+
+func NewRedisBloomCache(client redis.Cmdable, cfg BloomConfig) BloomCache {
+	return &RedisBloomCache{
+		client: client,
+		cfg:    cfg,
+	}
+}
+
+func (r *RedisBloomCache) MightContain(ctx context.Context,
+	kind string, uid int64, biz string, bizId int64) (bool, error) {
+	res, err := r.client.Eval(ctx, luaBloomCheck,
+		[]string{r.key(kind, uid)}, r.offsetArgs(biz, bizId)...).Int()
+	if err != nil {
+		return false, err
+	}
+	if res < 0 {
+		return false, ErrBloomNotReady
+	}
+	return res == 1, nil
+}
+
+func (r *RedisBloomCache) Add(ctx context.Context,
+	kind string, uid int64, biz string, bizId int64) error {
+	key := r.key(kind, uid)
+	return r.client.Eval(ctx, luaBloomAdd,
+		[]string{key, r.rebuildingKey(key)}, r.offsetArgs(biz, bizId)...).Err()
+}
+
+func (r *RedisBloomCache) BeginRebuild(ctx context.Context, kind string, uid int64) error {
+	key := r.rebuildingKey(r.key(kind, uid))
+	pipe := r.client.TxPipeline()
+	// 把最后一位设置成 0，Redis 会分配整个位图，key 也就存在了
+	pipe.SetBit(ctx, key, int64(r.cfg.Bits-1), 0)
+	pipe.PExpire(ctx, key, r.cfg.RebuildTimeout)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *RedisBloomCache) FinishRebuild(ctx context.Context,
+	kind string, uid int64, items []BloomItem) error {
+	bitmap := make([]byte, (r.cfg.Bits+7)/8)
+	for _, item := range items {
+		for _, pos := range r.offsets(item.Biz, item.BizId) {
+			// Redis 的 bitmap 里面，第 0 位是第一个字节的最高位
+			bitmap[pos/8] |= 0x80 >> (pos % 8)
+		}
+	}
+	key := r.key(kind, uid)
+	return r.client.Eval(ctx, luaBloomRebuild,
+		[]string{key, r.rebuildingKey(key)},
+		bitmap, r.cfg.Expiration.Milliseconds()).Err()
+}
+
+func (r *RedisBloomCache) MarkMissing(ctx context.Context, kind string, uid int64) error {
+	// NX 保证排队的顺序是第一次标记的时间
+	return r.client.ZAddNX(ctx, bloomMissingKey, redis.Z{
+		Score:  float64(time.Now().UnixMilli()),
+		Member: fmt.Sprintf("%s:%d", kind, uid),
+	}).Err()
+}
+
+func (r *RedisBloomCache) PopMissing(ctx context.Context, limit int64) ([]BloomTarget, error) {
+	zs, err := r.client.ZPopMin(ctx, bloomMissingKey, limit).Result()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]BloomTarget, 0, len(zs))
+	for _, z := range zs {
+		member, _ := z.Member.(string)
+		kind, uidStr, ok := strings.Cut(member, ":")
+		if !ok {
+			continue
+		}
+		uid, err := strconv.ParseInt(uidStr, 10, 64)
+		if err != nil {
+			continue
+		}
+		res = append(res, BloomTarget{Kind: kind, Uid: uid})
+	}
+	return res, nil
+}
+
+// offsets 用双重哈希模拟 k 个哈希函数：h1 + i * h2
+func (r *RedisBloomCache) offsets(biz string, bizId int64) []uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(biz))
+	_, _ = h.Write([]byte(strconv.FormatInt(bizId, 10)))
+	sum := h.Sum64()
+	h1, h2 := sum&0xffffffff, sum>>32|1
+	res := make([]uint64, r.cfg.Hashes)
+	for i := 0; i < r.cfg.Hashes; i++ {
+		res[i] = (h1 + uint64(i)*h2) % r.cfg.Bits
+	}
+	return res
+}
+
+func (r *RedisBloomCache) offsetArgs(biz string, bizId int64) []any {
+	offsets := r.offsets(biz, bizId)
+	res := make([]any, len(offsets))
+	for i, offset := range offsets {
+		res[i] = offset
+	}
+	return res
+}
+
+func (r *RedisBloomCache) key(kind string, uid int64) string {
+	return fmt.Sprintf("interactive:bloom:%s:%d", kind, uid)
+}
+
+func (r *RedisBloomCache) rebuildingKey(key string) string {
+	return key + ":rebuilding"
+}
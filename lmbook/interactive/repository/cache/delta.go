@@ -0,0 +1,203 @@
+package cache
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	//go:embed lua/delta_add.lua
+	luaDeltaAdd string
+	//go:embed lua/delta_drain.lua
+	luaDeltaDrain string
+)
+
+// 按照 biz 分成 deltaShards 个分片，每个分片的 key 带上自己的 hash tag，
+// 在 Redis Cluster 下面同一个分片落到同一个 slot 上，lua 脚本才能正常执行，
+// 不同的分片分散到不同的节点上，不会所有的写都压在一个节点上。
+// 修改分片数量之前要先把积压的增量和日志都处理完，不然旧分片上的数据就没人管了
+const (
+	deltaShards     = 16
+	deltaKeyPrefix  = "{interactive_delta:%d}:cnt:"
+	deltaDirtyKey   = "{interactive_delta:%d}:dirty"
+	deltaBatchesKey = "{interactive_delta:%d}:batches"
+	deltaLogPrefix  = "{interactive_delta:%d}:log:"
+)
+
+// CntDelta 某个 biz 还没有落库的计数增量
+type CntDelta struct {
+	Biz     string
+	BizId   int64
+	ReadCnt int64
+	LikeCnt int64
+}
+
+//go:generate mockgen -source=./delta.go -package=cachemocks -destination=mocks/delta.mock.go DeltaCache
+type DeltaCache interface {
+	// Add 累加增量，返回 d 所在的分片还有多少个 biz 的增量没有落库
+	Add(ctx context.Context, d CntDelta) (int64, error)
+	// Peek 查询某个 biz 还没有落库的增量
+	Peek(ctx context.Context, biz string, bizId int64) (CntDelta, error)
+	// Shards 分片的数量，下面的方法都是针对某一个分片的
+	Shards() int
+	// Drain 从 shard 最多取出 limit 个 biz 的增量，挪到 batchId 对应的日志里面。
+	// 返回的增量已经按照 biz 合并好了
+	Drain(ctx context.Context, shard int, batchId string, limit int64) ([]CntDelta, error)
+	// Load 读取某个批次的日志，用于崩溃之后重放
+	Load(ctx context.Context, shard int, batchId string) ([]CntDelta, error)
+	// Ack 这一批已经落库了，删除日志
+	Ack(ctx context.Context, shard int, batchId string) error
+	// Pending 找出 shard 上 before 之前取出来，但是一直没有 Ack 的批次
+	Pending(ctx context.Context, shard int, before time.Time) ([]string, error)
+}
+
+type RedisDeltaCache struct {
+	client redis.Cmdable
+}
+
+func NewRedisDeltaCache(client redis.Cmdable) DeltaCache {
+	return &RedisDeltaCache{
+		client: client,
+	}
+}
+
+func (r *RedisDeltaCache) Add(ctx context.Context, d CntDelta) (int64, error) {
+	member := r.member(d.Biz, d.BizId)
+	shard := r.shardOf(member)
+	args := []any{member}
+	if d.ReadCnt != 0 {
+		args = append(args, fieldReadCnt, d.ReadCnt)
+	}
+	if d.LikeCnt != 0 {
+		args = append(args, fieldLikeCnt, d.LikeCnt)
+	}
+	return r.client.Eval(ctx, luaDeltaAdd,
+		[]string{r.key(deltaKeyPrefix, shard) + member, r.key(deltaDirtyKey, shard)},
+		args...).Int64()
+}
+
+func (r *RedisDeltaCache) Peek(ctx context.Context, biz string, bizId int64) (CntDelta, error) {
+	member := r.member(biz, bizId)
+	data, err := r.client.HGetAll(ctx, r.key(deltaKeyPrefix, r.shardOf(member))+member).Result()
+	if err != nil {
+		return CntDelta{}, err
+	}
+	readCnt, _ := strconv.ParseInt(data[fieldReadCnt], 10, 64)
+	likeCnt, _ := strconv.ParseInt(data[fieldLikeCnt], 10, 64)
+	return CntDelta{
+		Biz:     biz,
+		BizId:   bizId,
+		ReadCnt: readCnt,
+		LikeCnt: likeCnt,
+	}, nil
+}
+
+func (r *RedisDeltaCache) Shards() int {
+	return deltaShards
+}
+
+func (r *RedisDeltaCache) Drain(ctx context.Context, shard int, batchId string, limit int64) ([]CntDelta, error) {
+	cnt, err := r.client.Eval(ctx, luaDeltaDrain,
+		[]string{r.key(deltaDirtyKey, shard), r.key(deltaLogPrefix, shard) + batchId, r.key(deltaBatchesKey, shard)},
+		limit, r.key(deltaKeyPrefix, shard), batchId, time.Now().UnixMilli()).Int64()
+	if err != nil || cnt == 0 {
+		return nil, err
+	}
+	return r.Load(ctx, shard, batchId)
+}
+
+func (r *RedisDeltaCache) Load(ctx context.Context, shard int, batchId string) ([]CntDelta, error) {
+	data, err := r.client.HGetAll(ctx, r.key(deltaLogPrefix, shard)+batchId).Result()
+	if err != nil {
+		return nil, err
+	}
+	deltas := make(map[string]*CntDelta, len(data))
+	res := make([]CntDelta, 0, len(data))
+	for field, val := range data {
+		// read_cnt@article:1
+		cntField, member, ok := strings.Cut(field, "@")
+		if !ok {
+			continue
+		}
+		d, ok := deltas[member]
+		if !ok {
+			biz, bizId, err := r.parseMember(member)
+			if err != nil {
+				return nil, err
+			}
+			d = &CntDelta{Biz: biz, BizId: bizId}
+			deltas[member] = d
+		}
+		cnt, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		switch cntField {
+		case fieldReadCnt:
+			d.ReadCnt += cnt
+		case fieldLikeCnt:
+			d.LikeCnt += cnt
+		}
+	}
+	for _, d := range deltas {
+		res = append(res, *d)
+	}
+	// 多个实例同时落库的时候，按照固定的顺序加行锁，避免死锁
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Biz != res[j].Biz {
+			return res[i].Biz < res[j].Biz
+		}
+		return res[i].BizId < res[j].BizId
+	})
+	return res, nil
+}
+
+func (r *RedisDeltaCache) Ack(ctx context.Context, shard int, batchId string) error {
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, r.key(deltaLogPrefix, shard)+batchId)
+	pipe.ZRem(ctx, r.key(deltaBatchesKey, shard), batchId)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *RedisDeltaCache) Pending(ctx context.Context, shard int, before time.Time) ([]string, error) {
+	return r.client.ZRangeByScore(ctx, r.key(deltaBatchesKey, shard), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(before.UnixMilli(), 10),
+	}).Result()
+}
+
+// key 带上分片的 hash tag
+func (r *RedisDeltaCache) key(pattern string, shard int) string {
+	return fmt.Sprintf(pattern, shard)
+}
+
+func (r *RedisDeltaCache) shardOf(member string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(member))
+	return int(h.Sum32() % deltaShards)
+}
+
+func (r *RedisDeltaCache) member(biz string, bizId int64) string {
+	return fmt.Sprintf("%s:%d", biz, bizId)
+}
+
+func (r *RedisDeltaCache) parseMember(member string) (string, int64, error) {
+	// biz 里面理论上不会有冒号，不过保险起见还是从后往前找
+	idx := strings.LastIndexByte(member, ':')
+	if idx < 0 {
+		return "", 0, fmt.Errorf("非法的增量日志 %s", member)
+	}
+	bizId, err := strconv.ParseInt(member[idx+1:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("非法的增量日志 %s: %w", member, err)
+	}
+	return member[:idx], bizId, nil
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/redis/go-redis/v9"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -20,6 +21,8 @@ const (
 	fieldReadCnt    = "read_cnt"
 	fieldCollectCnt = "collect_cnt"
 	fieldLikeCnt    = "like_cnt"
+	// 点赞以外的表态，后面跟着表态的类型
+	fieldReactionCntPrefix = "reaction_cnt_"
 )
 
 //go:generate mockgen -source=./interactive.go -package=cachemocks -destination=mocks/interactive.mock.go InteractiveCache
@@ -34,6 +37,9 @@ type InteractiveCache interface {
 		biz string, bizId int64) error
 	IncrCollectCntIfPresent(ctx context.Context, biz string, bizId int64) error
 	DecrCollectCntIfPresent(ctx context.Context, biz string, bizId int64) error
+	// IncrReactionCntIfPresent 表态数量，点赞就是 like_cnt
+	IncrReactionCntIfPresent(ctx context.Context, biz string, bizId int64,
+		reaction domain.ReactionType, delta int64) error
 	// Get 查询缓存中数据
 	Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error)
 	Set(ctx context.Context, biz string, bizId int64, intr domain.Interactive) error
@@ -79,6 +85,20 @@ func (r *RedisInteractiveCache) DecrCollectCntIfPresent(ctx context.Context,
 		fieldCollectCnt, -1).Err()
 }
 
+func (r *RedisInteractiveCache) IncrReactionCntIfPresent(ctx context.Context,
+	biz string, bizId int64, reaction domain.ReactionType, delta int64) error {
+	return r.client.Eval(ctx, luaIncrCnt,
+		[]string{r.key(biz, bizId)},
+		r.reactionField(reaction), delta).Err()
+}
+
+func (r *RedisInteractiveCache) reactionField(reaction domain.ReactionType) string {
+	if reaction == domain.ReactionLike {
+		return fieldLikeCnt
+	}
+	return fmt.Sprintf("%s%d", fieldReactionCntPrefix, reaction)
+}
+
 func (r *RedisInteractiveCache) Get(ctx context.Context,
 	biz string, bizId int64) (domain.Interactive, error) {
 	// 直接使用 HMGet，即便缓存中没有对应的 key，也不会返回 error
@@ -96,22 +116,39 @@ func (r *RedisInteractiveCache) Get(ctx context.Context,
 	collectCnt, _ := strconv.ParseInt(data[fieldCollectCnt], 10, 64)
 	likeCnt, _ := strconv.ParseInt(data[fieldLikeCnt], 10, 64)
 	readCnt, _ := strconv.ParseInt(data[fieldReadCnt], 10, 64)
+	reactionCnts := make(map[domain.ReactionType]int64, 4)
+	for field, val := range data {
+		reaction, ok := strings.CutPrefix(field, fieldReactionCntPrefix)
+		if !ok {
+			continue
+		}
+		typ, _ := strconv.ParseUint(reaction, 10, 8)
+		cnt, _ := strconv.ParseInt(val, 10, 64)
+		reactionCnts[domain.ReactionType(typ)] = cnt
+	}
 
 	return domain.Interactive{
 		// 懒惰的写法
-		BizId:      bizId,
-		CollectCnt: collectCnt,
-		LikeCnt:    likeCnt,
-		ReadCnt:    readCnt,
+		BizId:        bizId,
+		CollectCnt:   collectCnt,
+		LikeCnt:      likeCnt,
+		ReadCnt:      readCnt,
+		ReactionCnts: reactionCnts,
 	}, err
 }
 
 func (r *RedisInteractiveCache) Set(ctx context.Context, biz string, bizId int64, intr domain.Interactive) error {
 	key := r.key(biz, bizId)
-	err := r.client.HMSet(ctx, key,
-		fieldLikeCnt, intr.LikeCnt,
+	vals := []any{fieldLikeCnt, intr.LikeCnt,
 		fieldCollectCnt, intr.CollectCnt,
-		fieldReadCnt, intr.ReadCnt).Err()
+		fieldReadCnt, intr.ReadCnt}
+	for reaction, cnt := range intr.ReactionCnts {
+		if reaction == domain.ReactionLike {
+			continue
+		}
+		vals = append(vals, r.reactionField(reaction), cnt)
+	}
+	err := r.client.HMSet(ctx, key, vals...).Err()
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,10 @@
+-- KEYS[1] 布隆过滤器，KEYS[2] 重建期间用来接收新数据的过滤器
+-- 不存在的过滤器不能写，不然会变成一个缺数据的过滤器，出现误判"一定没有"
+for k = 1, #KEYS do
+    if redis.call("EXISTS", KEYS[k]) == 1 then
+        for i = 1, #ARGV do
+            redis.call("SETBIT", KEYS[k], ARGV[i], 1)
+        end
+    end
+end
+return 0
@@ -0,0 +1,13 @@
+-- KEYS[1] 布隆过滤器，ARGV 是要检查的比特位
+if redis.call("EXISTS", KEYS[1]) == 0 then
+    -- 过滤器还没有构建，或者已经过期了
+    return -1
+end
+for i = 1, #ARGV do
+    if redis.call("GETBIT", KEYS[1], ARGV[i]) == 0 then
+        -- 一定没有
+        return 0
+    end
+end
+-- 可能有
+return 1
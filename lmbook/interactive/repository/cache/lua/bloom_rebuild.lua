@@ -0,0 +1,10 @@
+-- KEYS[1] 布隆过滤器，KEYS[2] 重建期间用来接收新数据的过滤器
+-- ARGV[1] 用全量数据算出来的位图，ARGV[2] 过期时间，毫秒
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+if redis.call("EXISTS", KEYS[2]) == 1 then
+    -- 把重建期间新增的数据合并进来
+    redis.call("BITOP", "OR", KEYS[1], KEYS[1], KEYS[2])
+    redis.call("DEL", KEYS[2])
+    redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
@@ -0,0 +1,12 @@
+-- 增量所在的 hash
+local key = KEYS[1]
+-- 还没有落库的 biz 集合
+local dirty = KEYS[2]
+local member = ARGV[1]
+-- 后面是 field, delta 交替出现
+for i = 2, #ARGV, 2 do
+    redis.call("HINCRBY", key, ARGV[i], tonumber(ARGV[i + 1]))
+end
+redis.call("SADD", dirty, member)
+-- 返回待落库的 biz 数量，调用者用来判断要不要提前落库
+return redis.call("SCARD", dirty)
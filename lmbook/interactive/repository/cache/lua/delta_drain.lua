@@ -0,0 +1,26 @@
+local dirty = KEYS[1]
+-- 这一批的日志
+local logKey = KEYS[2]
+-- 所有还没有 Ack 的批次
+local batches = KEYS[3]
+local limit = tonumber(ARGV[1])
+local prefix = ARGV[2]
+local batchId = ARGV[3]
+local now = tonumber(ARGV[4])
+
+local members = redis.call("SPOP", dirty, limit)
+if #members == 0 then
+    return 0
+end
+for _, member in ipairs(members) do
+    local key = prefix .. member
+    local kv = redis.call("HGETALL", key)
+    for i = 1, #kv, 2 do
+        -- 日志里面的 field 是 read_cnt@article:1 这种格式
+        redis.call("HINCRBY", logKey, kv[i] .. "@" .. member, kv[i + 1])
+    end
+    redis.call("DEL", key)
+end
+-- 增量挪到日志之后，即便落库的时候崩溃了，重启之后也可以从日志里面恢复
+redis.call("ZADD", batches, now, batchId)
+return #members
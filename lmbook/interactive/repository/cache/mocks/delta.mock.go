@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./delta.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./delta.go -package=cachemocks -destination=mocks/delta.mock.go
+//
+// Package cachemocks is a generated GoMock package.
+package cachemocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	cache "basic-go/lmbook/interactive/repository/cache"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeltaCache is a mock of DeltaCache interface.
+type MockDeltaCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeltaCacheMockRecorder
+}
+
+// MockDeltaCacheMockRecorder is the mock recorder for MockDeltaCache.
+type MockDeltaCacheMockRecorder struct {
+	mock *MockDeltaCache
+}
+
+// NewMockDeltaCache creates a new mock instance.
+func NewMockDeltaCache(ctrl *gomock.Controller) *MockDeltaCache {
+	mock := &MockDeltaCache{ctrl: ctrl}
+	mock.recorder = &MockDeltaCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeltaCache) EXPECT() *MockDeltaCacheMockRecorder {
+	return m.recorder
+}
+
+// Ack mocks base method.
+func (m *MockDeltaCache) Ack(ctx context.Context, shard int, batchId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ack", ctx, shard, batchId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ack indicates an expected call of Ack.
+func (mr *MockDeltaCacheMockRecorder) Ack(ctx, shard, batchId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ack", reflect.TypeOf((*MockDeltaCache)(nil).Ack), ctx, shard, batchId)
+}
+
+// Add mocks base method.
+func (m *MockDeltaCache) Add(ctx context.Context, d cache.CntDelta) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, d)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockDeltaCacheMockRecorder) Add(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockDeltaCache)(nil).Add), ctx, d)
+}
+
+// Drain mocks base method.
+func (m *MockDeltaCache) Drain(ctx context.Context, shard int, batchId string, limit int64) ([]cache.CntDelta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drain", ctx, shard, batchId, limit)
+	ret0, _ := ret[0].([]cache.CntDelta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drain indicates an expected call of Drain.
+func (mr *MockDeltaCacheMockRecorder) Drain(ctx, shard, batchId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drain", reflect.TypeOf((*MockDeltaCache)(nil).Drain), ctx, shard, batchId, limit)
+}
+
+// Load mocks base method.
+func (m *MockDeltaCache) Load(ctx context.Context, shard int, batchId string) ([]cache.CntDelta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", ctx, shard, batchId)
+	ret0, _ := ret[0].([]cache.CntDelta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockDeltaCacheMockRecorder) Load(ctx, shard, batchId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockDeltaCache)(nil).Load), ctx, shard, batchId)
+}
+
+// Peek mocks base method.
+func (m *MockDeltaCache) Peek(ctx context.Context, biz string, bizId int64) (cache.CntDelta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Peek", ctx, biz, bizId)
+	ret0, _ := ret[0].(cache.CntDelta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Peek indicates an expected call of Peek.
+func (mr *MockDeltaCacheMockRecorder) Peek(ctx, biz, bizId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Peek", reflect.TypeOf((*MockDeltaCache)(nil).Peek), ctx, biz, bizId)
+}
+
+// Pending mocks base method.
+func (m *MockDeltaCache) Pending(ctx context.Context, shard int, before time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pending", ctx, shard, before)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pending indicates an expected call of Pending.
+func (mr *MockDeltaCacheMockRecorder) Pending(ctx, shard, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pending", reflect.TypeOf((*MockDeltaCache)(nil).Pending), ctx, shard, before)
+}
+
+// Shards mocks base method.
+func (m *MockDeltaCache) Shards() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shards")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Shards indicates an expected call of Shards.
+func (mr *MockDeltaCacheMockRecorder) Shards() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shards", reflect.TypeOf((*MockDeltaCache)(nil).Shards))
+}
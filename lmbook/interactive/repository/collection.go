@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"basic-go/lmbook/interactive/domain"
+	"basic-go/lmbook/interactive/repository/dao"
+	"basic-go/lmbook/pkg/cursorx"
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"time"
+)
+
+// ErrCollectionNotFound 收藏夹不存在，或者不是自己的
+var ErrCollectionNotFound = dao.ErrRecordNotFound
+
+//go:generate mockgen -source=./collection.go -package=repomocks -destination=mocks/collection.mock.go CollectionRepository
+type CollectionRepository interface {
+	Create(ctx context.Context, c domain.Collection) (int64, error)
+	Update(ctx context.Context, c domain.Collection) error
+	Delete(ctx context.Context, uid, cid int64) error
+	// FindById 不带 ItemCnt
+	FindById(ctx context.Context, cid int64) (domain.Collection, error)
+	FindByUid(ctx context.Context, uid int64, onlyPublic bool) ([]domain.Collection, error)
+	FindItems(ctx context.Context, cid int64, cur cursorx.Cursor, limit int) ([]domain.CollectionItem, error)
+	RemoveItem(ctx context.Context, uid, cid int64, biz string, bizId int64) error
+	MoveItem(ctx context.Context, uid int64, biz string, bizId, from, to int64) error
+}
+
+type collectionRepository struct {
+	dao dao.CollectionDAO
+}
+
+func NewCollectionRepository(dao dao.CollectionDAO) CollectionRepository {
+	return &collectionRepository{dao: dao}
+}
+
+func (c *collectionRepository) Create(ctx context.Context, col domain.Collection) (int64, error) {
+	return c.dao.Insert(ctx, c.toEntity(col))
+}
+
+func (c *collectionRepository) Update(ctx context.Context, col domain.Collection) error {
+	return c.dao.Update(ctx, c.toEntity(col))
+}
+
+func (c *collectionRepository) Delete(ctx context.Context, uid, cid int64) error {
+	return c.dao.Delete(ctx, uid, cid)
+}
+
+func (c *collectionRepository) FindById(ctx context.Context, cid int64) (domain.Collection, error) {
+	col, err := c.dao.GetById(ctx, cid)
+	if err != nil {
+		return domain.Collection{}, err
+	}
+	return c.toDomain(col), nil
+}
+
+func (c *collectionRepository) FindByUid(ctx context.Context, uid int64, onlyPublic bool) ([]domain.Collection, error) {
+	cols, err := c.dao.FindByUid(ctx, uid, onlyPublic)
+	if err != nil {
+		return nil, err
+	}
+	cnts, err := c.dao.CountItems(ctx, slice.Map(cols, func(idx int, src dao.Collection) int64 {
+		return src.Id
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(cols, func(idx int, src dao.Collection) domain.Collection {
+		res := c.toDomain(src)
+		res.ItemCnt = cnts[src.Id]
+		return res
+	}), nil
+}
+
+func (c *collectionRepository) FindItems(ctx context.Context, cid int64, cur cursorx.Cursor, limit int) ([]domain.CollectionItem, error) {
+	items, err := c.dao.FindItems(ctx, cid, cur, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(items, func(idx int, src dao.CollectionItem) domain.CollectionItem {
+		return domain.CollectionItem{
+			Id:    src.Id,
+			Biz:   src.Biz,
+			BizId: src.BizId,
+			Ctime: time.UnixMilli(src.Ctime),
+		}
+	}), nil
+}
+
+func (c *collectionRepository) RemoveItem(ctx context.Context, uid, cid int64, biz string, bizId int64) error {
+	return c.dao.DeleteItem(ctx, uid, cid, biz, bizId)
+}
+
+func (c *collectionRepository) MoveItem(ctx context.Context, uid int64, biz string, bizId, from, to int64) error {
+	return c.dao.MoveItem(ctx, uid, biz, bizId, from, to)
+}
+
+func (c *collectionRepository) toDomain(col dao.Collection) domain.Collection {
+	return domain.Collection{
+		Id:     col.Id,
+		Uid:    col.Uid,
+		Name:   col.Name,
+		Public: col.Public,
+		Ctime:  time.UnixMilli(col.Ctime),
+		Utime:  time.UnixMilli(col.Utime),
+	}
+}
+
+func (c *collectionRepository) toEntity(col domain.Collection) dao.Collection {
+	return dao.Collection{
+		Id:     col.Id,
+		Uid:    col.Uid,
+		Name:   col.Name,
+		Public: col.Public,
+	}
+}
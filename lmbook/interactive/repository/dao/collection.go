@@ -0,0 +1,180 @@
+package dao
+
+import (
+	"basic-go/lmbook/pkg/cursorx"
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+//go:generate mockgen -source=./collection.go -package=daomocks -destination=mocks/collection.mock.go CollectionDAO
+type CollectionDAO interface {
+	Insert(ctx context.Context, c Collection) (int64, error)
+	// Update 只能更新自己的收藏夹
+	Update(ctx context.Context, c Collection) error
+	// Delete 删除收藏夹，以及收藏夹和内容的关联关系。
+	// 内容本身还是处于收藏状态，在"全部收藏"里面依旧能看到
+	Delete(ctx context.Context, uid, cid int64) error
+	GetById(ctx context.Context, cid int64) (Collection, error)
+	// FindByUid 查询某个人的收藏夹，onlyPublic 为 true 的时候只返回公开的
+	FindByUid(ctx context.Context, uid int64, onlyPublic bool) ([]Collection, error)
+	// CountItems 统计每个收藏夹里面有多少内容
+	CountItems(ctx context.Context, cids []int64) (map[int64]int64, error)
+	// FindItems 按照放入收藏夹的时间倒序翻页
+	FindItems(ctx context.Context, cid int64, cur cursorx.Cursor, limit int) ([]CollectionItem, error)
+	DeleteItem(ctx context.Context, uid, cid int64, biz string, bizId int64) error
+	// MoveItem 把内容从 from 移动到 to，如果 to 里面已经有了，那就只是从 from 里面删掉
+	MoveItem(ctx context.Context, uid int64, biz string, bizId, from, to int64) error
+}
+
+type GORMCollectionDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMCollectionDAO(db *gorm.DB) CollectionDAO {
+	return &GORMCollectionDAO{db: db}
+}
+
+func (dao *GORMCollectionDAO) Insert(ctx context.Context, c Collection) (int64, error) {
+	now := time.Now().UnixMilli()
+	c.Ctime = now
+	c.Utime = now
+	err := dao.db.WithContext(ctx).Create(&c).Error
+	return c.Id, err
+}
+
+func (dao *GORMCollectionDAO) Update(ctx context.Context, c Collection) error {
+	res := dao.db.WithContext(ctx).Model(&Collection{}).
+		Where("id = ? AND uid = ?", c.Id, c.Uid).
+		Updates(map[string]any{
+			"name":   c.Name,
+			"public": c.Public,
+			"utime":  time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		// 要么收藏夹不存在，要么不是自己的
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+func (dao *GORMCollectionDAO) Delete(ctx context.Context, uid, cid int64) error {
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("id = ? AND uid = ?", cid, uid).Delete(&Collection{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrRecordNotFound
+		}
+		return tx.Where("cid = ?", cid).Delete(&CollectionItem{}).Error
+	})
+}
+
+func (dao *GORMCollectionDAO) GetById(ctx context.Context, cid int64) (Collection, error) {
+	var res Collection
+	err := dao.db.WithContext(ctx).Where("id = ?", cid).First(&res).Error
+	return res, err
+}
+
+func (dao *GORMCollectionDAO) FindByUid(ctx context.Context, uid int64, onlyPublic bool) ([]Collection, error) {
+	var res []Collection
+	db := dao.db.WithContext(ctx).Where("uid = ?", uid)
+	if onlyPublic {
+		db = db.Where("public = ?", true)
+	}
+	// 一个人的收藏夹数量不会太多，所以不分页
+	err := db.Order("id ASC").Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMCollectionDAO) CountItems(ctx context.Context, cids []int64) (map[int64]int64, error) {
+	res := make(map[int64]int64, len(cids))
+	if len(cids) == 0 {
+		return res, nil
+	}
+	var cnts []struct {
+		Cid int64
+		Cnt int64
+	}
+	err := dao.db.WithContext(ctx).Model(&CollectionItem{}).
+		Select("cid, COUNT(*) AS cnt").
+		Where("cid IN ?", cids).
+		Group("cid").
+		Scan(&cnts).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range cnts {
+		res[c.Cid] = c.Cnt
+	}
+	return res, nil
+}
+
+func (dao *GORMCollectionDAO) FindItems(ctx context.Context, cid int64, cur cursorx.Cursor, limit int) ([]CollectionItem, error) {
+	var res []CollectionItem
+	err := dao.db.WithContext(ctx).
+		Where("cid = ?", cid).
+		Scopes(cursorx.Scope(cur, "ctime", "id", limit)).
+		Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMCollectionDAO) DeleteItem(ctx context.Context, uid, cid int64, biz string, bizId int64) error {
+	return dao.db.WithContext(ctx).
+		Where("cid = ? AND uid = ? AND biz = ? AND biz_id = ?", cid, uid, biz, bizId).
+		Delete(&CollectionItem{}).Error
+}
+
+func (dao *GORMCollectionDAO) MoveItem(ctx context.Context, uid int64, biz string, bizId, from, to int64) error {
+	now := time.Now().UnixMilli()
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("cid = ? AND uid = ? AND biz = ? AND biz_id = ?", from, uid, biz, bizId).
+			Delete(&CollectionItem{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			// from 里面本来就没有
+			return ErrRecordNotFound
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&CollectionItem{
+			Cid:   to,
+			Uid:   uid,
+			Biz:   biz,
+			BizId: bizId,
+			Ctime: now,
+			Utime: now,
+		}).Error
+	})
+}
+
+// Collection 收藏夹
+type Collection struct {
+	Id   int64  `gorm:"primaryKey,autoIncrement"`
+	Name string `gorm:"type:varchar(256)"`
+	Uid  int64  `gorm:"index"`
+	// Public 公开的收藏夹别人也能看到
+	Public bool
+
+	Ctime int64
+	Utime int64
+}
+
+// CollectionItem 收藏夹里面放了什么。
+// 一个东西可以放进多个收藏夹，但是在 UserCollectionBiz 里面只有一条记录，
+// 所以收藏数不会因为放进了多个收藏夹而重复计算
+type CollectionItem struct {
+	Id    int64  `gorm:"primaryKey,autoIncrement"`
+	Cid   int64  `gorm:"uniqueIndex:cid_biz_type_id;index:cid_ctime,priority:1"`
+	BizId int64  `gorm:"uniqueIndex:cid_biz_type_id"`
+	Biz   string `gorm:"type:varchar(128);uniqueIndex:cid_biz_type_id"`
+	// 冗余，方便校验和取消收藏的时候一起删掉
+	Uid   int64 `gorm:"index"`
+	Ctime int64 `gorm:"index:cid_ctime,priority:2"`
+	Utime int64
+}
@@ -0,0 +1,92 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// CntDelta 合并之后的计数增量
+type CntDelta struct {
+	Biz     string
+	BizId   int64
+	ReadCnt int64
+	LikeCnt int64
+}
+
+//go:generate mockgen -source=./delta.go -package=daomocks -destination=mocks/delta.mock.go DeltaDAO
+type DeltaDAO interface {
+	// ApplyDeltas 在一个事务里面把一批增量写进数据库。
+	// 同一个 batchId 只会生效一次，所以崩溃之后重放是安全的
+	ApplyDeltas(ctx context.Context, batchId string, deltas []CntDelta) error
+	// DeleteFlushLogs 删除 before 之前的落库记录，避免表无限增长
+	DeleteFlushLogs(ctx context.Context, before int64) error
+}
+
+type GORMDeltaDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMDeltaDAO(db *gorm.DB) DeltaDAO {
+	return &GORMDeltaDAO{db: db}
+}
+
+func (dao *GORMDeltaDAO) ApplyDeltas(ctx context.Context, batchId string, deltas []CntDelta) error {
+	now := time.Now().UnixMilli()
+	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&DeltaFlushLog{
+			BatchId: batchId,
+			Cnt:     len(deltas),
+			Ctime:   now,
+		})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			// 这一批之前已经落库了，只是没来得及 Ack
+			return nil
+		}
+		intrs := make([]Interactive, 0, len(deltas))
+		for _, d := range deltas {
+			if d.ReadCnt == 0 && d.LikeCnt == 0 {
+				// 点赞之后又取消了，互相抵消
+				continue
+			}
+			intrs = append(intrs, Interactive{
+				Biz:     d.Biz,
+				BizId:   d.BizId,
+				ReadCnt: d.ReadCnt,
+				LikeCnt: d.LikeCnt,
+				Ctime:   now,
+				Utime:   now,
+			})
+		}
+		if len(intrs) == 0 {
+			return nil
+		}
+		// 一条 INSERT ... ON DUPLICATE KEY UPDATE 写完一整批，
+		// 同一篇文章在这段时间内被读了一万次，也只会更新一次
+		return tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.Assignments(map[string]any{
+				"read_cnt": gorm.Expr("`read_cnt` + VALUES(`read_cnt`)"),
+				"like_cnt": gorm.Expr("`like_cnt` + VALUES(`like_cnt`)"),
+				"utime":    now,
+			}),
+		}).Create(&intrs).Error
+	})
+}
+
+func (dao *GORMDeltaDAO) DeleteFlushLogs(ctx context.Context, before int64) error {
+	return dao.db.WithContext(ctx).Where("ctime < ?", before).
+		Delete(&DeltaFlushLog{}).Error
+}
+
+// DeltaFlushLog 已经落库的批次，用来保证同一批增量只会加一次
+type DeltaFlushLog struct {
+	Id      int64  `gorm:"primaryKey,autoIncrement"`
+	BatchId string `gorm:"type:varchar(64);uniqueIndex"`
+	// 这一批有多少个 biz，方便排查问题
+	Cnt   int
+	Ctime int64 `gorm:"index"`
+}
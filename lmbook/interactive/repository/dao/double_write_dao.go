@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"basic-go/lmbook/pkg/cursorx"
 	"context"
 	"errors"
 
@@ -10,6 +11,8 @@ import (
 
 var errUnknownPattern = errors.New("未知的双写 pattern")
 
+var _ InteractiveDAO = (*DoubleWriteDAO)(nil)
+
 type DoubleWriteDAO struct {
 	src InteractiveDAO
 	dst InteractiveDAO
@@ -67,18 +70,63 @@ func (d *DoubleWriteDAO) IncrReadCnt(ctx context.Context, biz string, bizId int6
 }
 
 func (d *DoubleWriteDAO) InsertLikeInfo(ctx context.Context, biz string, bizId, uid int64) error {
-	//TODO implement me
-	panic("implement me")
+	return d.write(func(dao InteractiveDAO) error {
+		return dao.InsertLikeInfo(ctx, biz, bizId, uid)
+	})
 }
 
 func (d *DoubleWriteDAO) GetLikeInfo(ctx context.Context, biz string, bizId, uid int64) (UserLikeBiz, error) {
-	//TODO implement me
-	panic("implement me")
+	return doubleRead(d, func(dao InteractiveDAO) (UserLikeBiz, error) {
+		return dao.GetLikeInfo(ctx, biz, bizId, uid)
+	})
 }
 
 func (d *DoubleWriteDAO) DeleteLikeInfo(ctx context.Context, biz string, bizId, uid int64) error {
-	//TODO implement me
-	panic("implement me")
+	return d.write(func(dao InteractiveDAO) error {
+		return dao.DeleteLikeInfo(ctx, biz, bizId, uid)
+	})
+}
+
+func (d *DoubleWriteDAO) UpsertReaction(ctx context.Context, biz string, bizId, uid int64, reaction uint8, withLikeCnt bool) (uint8, error) {
+	return doubleWrite(d, func(dao InteractiveDAO) (uint8, error) {
+		return dao.UpsertReaction(ctx, biz, bizId, uid, reaction, withLikeCnt)
+	})
+}
+
+func (d *DoubleWriteDAO) DeleteReaction(ctx context.Context, biz string, bizId, uid int64, reaction uint8, withLikeCnt bool) (uint8, error) {
+	return doubleWrite(d, func(dao InteractiveDAO) (uint8, error) {
+		return dao.DeleteReaction(ctx, biz, bizId, uid, reaction, withLikeCnt)
+	})
+}
+
+func (d *DoubleWriteDAO) GetReactionCnts(ctx context.Context, biz string, bizIds []int64) ([]ReactionCnt, error) {
+	return doubleRead(d, func(dao InteractiveDAO) ([]ReactionCnt, error) {
+		return dao.GetReactionCnts(ctx, biz, bizIds)
+	})
+}
+
+func (d *DoubleWriteDAO) ListReactionsByUser(ctx context.Context, uid int64, minId int64, limit int) ([]UserLikeBiz, error) {
+	return doubleRead(d, func(dao InteractiveDAO) ([]UserLikeBiz, error) {
+		return dao.ListReactionsByUser(ctx, uid, minId, limit)
+	})
+}
+
+func (d *DoubleWriteDAO) ListByUtime(ctx context.Context, utime, minId, maxUtime int64, limit int) ([]Interactive, error) {
+	return doubleRead(d, func(dao InteractiveDAO) ([]Interactive, error) {
+		return dao.ListByUtime(ctx, utime, minId, maxUtime, limit)
+	})
+}
+
+func (d *DoubleWriteDAO) ListCollectionBizByUser(ctx context.Context, uid int64, minId int64, limit int) ([]UserCollectionBiz, error) {
+	return doubleRead(d, func(dao InteractiveDAO) ([]UserCollectionBiz, error) {
+		return dao.ListCollectionBizByUser(ctx, uid, minId, limit)
+	})
+}
+
+func (d *DoubleWriteDAO) GetReactions(ctx context.Context, biz string, bizIds []int64, uid int64) ([]UserLikeBiz, error) {
+	return doubleRead(d, func(dao InteractiveDAO) ([]UserLikeBiz, error) {
+		return dao.GetReactions(ctx, biz, bizIds, uid)
+	})
 }
 
 func (d *DoubleWriteDAO) Get(ctx context.Context, biz string, bizId int64) (Interactive, error) {
@@ -92,56 +140,89 @@ func (d *DoubleWriteDAO) Get(ctx context.Context, biz string, bizId int64) (Inte
 	}
 }
 
-func (d *DoubleWriteDAO) InsertCollectionBiz(ctx context.Context, cb UserCollectionBiz) error {
-	//TODO implement me
-	panic("implement me")
+func (d *DoubleWriteDAO) InsertCollectionBiz(ctx context.Context, cb UserCollectionBiz) (bool, error) {
+	return doubleWrite(d, func(dao InteractiveDAO) (bool, error) {
+		return dao.InsertCollectionBiz(ctx, cb)
+	})
 }
 
 func (d *DoubleWriteDAO) GetCollectionInfo(ctx context.Context, biz string, bizId, uid int64) (UserCollectionBiz, error) {
-	//TODO implement me
-	panic("implement me")
+	return doubleRead(d, func(dao InteractiveDAO) (UserCollectionBiz, error) {
+		return dao.GetCollectionInfo(ctx, biz, bizId, uid)
+	})
 }
 
 func (d *DoubleWriteDAO) BatchIncrReadCnt(ctx context.Context, bizs []string, ids []int64) error {
-	//TODO implement me
-	panic("implement me")
+	return d.write(func(dao InteractiveDAO) error {
+		return dao.BatchIncrReadCnt(ctx, bizs, ids)
+	})
 }
 
 func (d *DoubleWriteDAO) GetByIds(ctx context.Context, biz string, ids []int64) ([]Interactive, error) {
-	//TODO implement me
-	panic("implement me")
+	return doubleRead(d, func(dao InteractiveDAO) ([]Interactive, error) {
+		return dao.GetByIds(ctx, biz, ids)
+	})
 }
 
-func (d *DoubleWriteDAO) GetCollectionsByUser(ctx context.Context, uid int64, biz string, offset, limit int) ([]UserCollectionBiz, error) {
+func (d *DoubleWriteDAO) GetCollectionsByUser(ctx context.Context, uid int64, biz string, cur cursorx.Cursor, limit int) ([]UserCollectionBiz, error) {
 	switch d.pattern.Load() {
 	case patternSrcOnly, patternSrcFirst:
-		return d.src.GetCollectionsByUser(ctx, uid, biz, offset, limit)
+		return d.src.GetCollectionsByUser(ctx, uid, biz, cur, limit)
 	case patternDstFirst, patternDstOnly:
-		return d.dst.GetCollectionsByUser(ctx, uid, biz, offset, limit)
+		return d.dst.GetCollectionsByUser(ctx, uid, biz, cur, limit)
 	default:
 		return nil, errUnknownPattern
 	}
 }
 
-func (d *DoubleWriteDAO) DeleteCollectionBiz(ctx context.Context, biz string, bizId, uid int64) error {
+func (d *DoubleWriteDAO) DeleteCollectionBiz(ctx context.Context, biz string, bizId, uid int64) (bool, error) {
+	return doubleWrite(d, func(dao InteractiveDAO) (bool, error) {
+		return dao.DeleteCollectionBiz(ctx, biz, bizId, uid)
+	})
+}
+
+// write 按照双写的模式写 src 和 dst，以先写的那个的结果为准
+func (d *DoubleWriteDAO) write(fn func(dao InteractiveDAO) error) error {
+	_, err := doubleWrite(d, func(dao InteractiveDAO) (struct{}, error) {
+		return struct{}{}, fn(dao)
+	})
+	return err
+}
+
+// doubleWrite 先写的成功了才写后面那个，后面那个失败了只能记录日志、做好监控，靠校验修复
+func doubleWrite[T any](d *DoubleWriteDAO, fn func(dao InteractiveDAO) (T, error)) (T, error) {
 	switch d.pattern.Load() {
 	case patternSrcOnly:
-		return d.src.DeleteCollectionBiz(ctx, biz, bizId, uid)
+		return fn(d.src)
 	case patternSrcFirst:
-		err := d.src.DeleteCollectionBiz(ctx, biz, bizId, uid)
+		res, err := fn(d.src)
 		if err == nil {
-			_ = d.dst.DeleteCollectionBiz(ctx, biz, bizId, uid)
+			_, _ = fn(d.dst)
 		}
-		return err
+		return res, err
 	case patternDstFirst:
-		err := d.dst.DeleteCollectionBiz(ctx, biz, bizId, uid)
+		res, err := fn(d.dst)
 		if err == nil {
-			_ = d.src.DeleteCollectionBiz(ctx, biz, bizId, uid)
+			_, _ = fn(d.src)
 		}
-		return err
+		return res, err
 	case patternDstOnly:
-		return d.dst.DeleteCollectionBiz(ctx, biz, bizId, uid)
+		return fn(d.dst)
 	default:
-		return errUnknownPattern
+		var t T
+		return t, errUnknownPattern
+	}
+}
+
+// doubleRead 和 Get 一样，读先写的那个
+func doubleRead[T any](d *DoubleWriteDAO, fn func(dao InteractiveDAO) (T, error)) (T, error) {
+	switch d.pattern.Load() {
+	case patternSrcOnly, patternSrcFirst:
+		return fn(d.src)
+	case patternDstFirst, patternDstOnly:
+		return fn(d.dst)
+	default:
+		var t T
+		return t, errUnknownPattern
 	}
 }
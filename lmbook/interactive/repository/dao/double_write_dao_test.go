@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoubleWriteDAO_UpsertReaction(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pattern string
+
+		wantSrc int64
+		wantDst int64
+	}{
+		{name: "只写 src", pattern: patternSrcOnly, wantSrc: 1},
+		{name: "src 优先", pattern: patternSrcFirst, wantSrc: 1, wantDst: 1},
+		{name: "dst 优先", pattern: patternDstFirst, wantSrc: 1, wantDst: 1},
+		{name: "只写 dst", pattern: patternDstOnly, wantDst: 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src, dst := initTestDB(t), initTestDB(t)
+			d := NewDoubleWriteDAO(src, dst)
+			d.pattern.Store(tc.pattern)
+			_, err := d.UpsertReaction(context.Background(), "test", 1, 100, reactionLike, true)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantSrc, likeCnt(t, src, "test", 1))
+			assert.Equal(t, tc.wantDst, likeCnt(t, dst, "test", 1))
+		})
+	}
+}
+
+func TestDoubleWriteDAO_GetReactions(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pattern string
+
+		wantLen int
+		wantErr error
+	}{
+		// 数据只在 src 里面，所以读 dst 的模式什么也读不到
+		{name: "只写 src", pattern: patternSrcOnly, wantLen: 1},
+		{name: "src 优先", pattern: patternSrcFirst, wantLen: 1},
+		{name: "dst 优先", pattern: patternDstFirst},
+		{name: "只写 dst", pattern: patternDstOnly},
+		{name: "未知模式", pattern: "unknown", wantErr: errUnknownPattern},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src, dst := initTestDB(t), initTestDB(t)
+			_, err := NewGORMInteractiveDAO(src).
+				UpsertReaction(context.Background(), "test", 1, 100, reactionLike, true)
+			require.NoError(t, err)
+			d := NewDoubleWriteDAO(src, dst)
+			d.pattern.Store(tc.pattern)
+			res, err := d.GetReactions(context.Background(), "test", []int64{1}, 100)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Len(t, res, tc.wantLen)
+		})
+	}
+}
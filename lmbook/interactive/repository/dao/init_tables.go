@@ -5,5 +5,9 @@ import "gorm.io/gorm"
 func InitTables(db *gorm.DB) error {
 	return db.AutoMigrate(&Interactive{},
 		&UserLikeBiz{},
-		&UserCollectionBiz{})
+		&UserCollectionBiz{},
+		&Collection{},
+		&CollectionItem{},
+		&DeltaFlushLog{},
+		&ReactionCnt{})
 }
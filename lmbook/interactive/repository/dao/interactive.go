@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"basic-go/lmbook/pkg/cursorx"
 	"basic-go/lmbook/pkg/migrator"
 	"context"
 	"gorm.io/gorm"
@@ -14,13 +15,36 @@ type InteractiveDAO interface {
 	InsertLikeInfo(ctx context.Context, biz string, bizId, uid int64) error
 	GetLikeInfo(ctx context.Context, biz string, bizId, uid int64) (UserLikeBiz, error)
 	DeleteLikeInfo(ctx context.Context, biz string, bizId, uid int64) error
+	// UpsertReaction 设置表态，一个人对一个东西只能有一种表态，再次表态就是换一种。
+	// 点赞以外的表态数量在同一个事务里面维护。
+	// withLikeCnt 为 true 的时候点赞数也在同一个事务里面维护，点赞数走写缓冲的时候传 false，交给调用者处理。
+	// 返回之前的表态，0 代表之前没有表态
+	UpsertReaction(ctx context.Context, biz string, bizId, uid int64, reaction uint8, withLikeCnt bool) (uint8, error)
+	// DeleteReaction 取消表态，reaction 不为 0 的时候，只有当前表态是 reaction 才会取消。
+	// withLikeCnt 和 UpsertReaction 一样。返回被取消的表态，0 代表什么都没做
+	DeleteReaction(ctx context.Context, biz string, bizId, uid int64, reaction uint8, withLikeCnt bool) (uint8, error)
+	// GetReactionCnts 点赞以外的表态数量
+	GetReactionCnts(ctx context.Context, biz string, bizIds []int64) ([]ReactionCnt, error)
+	// GetReactions 某个用户对一批东西的表态，只返回有效的
+	GetReactions(ctx context.Context, biz string, bizIds []int64, uid int64) ([]UserLikeBiz, error)
+	// ListReactionsByUser 按照 id 翻页，列出用户所有有效的表态，重建布隆过滤器用
+	ListReactionsByUser(ctx context.Context, uid int64, minId int64, limit int) ([]UserLikeBiz, error)
 	Get(ctx context.Context, biz string, bizId int64) (Interactive, error)
-	InsertCollectionBiz(ctx context.Context, cb UserCollectionBiz) error
+	// InsertCollectionBiz 返回的 bool 代表这一次是不是新收藏的，
+	// 已经收藏过的东西再放进别的收藏夹，返回 false
+	InsertCollectionBiz(ctx context.Context, cb UserCollectionBiz) (bool, error)
 	GetCollectionInfo(ctx context.Context, biz string, bizId, uid int64) (UserCollectionBiz, error)
-	GetCollectionsByUser(ctx context.Context, uid int64, biz string, offset, limit int) ([]UserCollectionBiz, error)
-	DeleteCollectionBiz(ctx context.Context, biz string, bizId, uid int64) error
+	// GetCollectionsByUser 按照 (ctime, id) 倒序翻页，cur 为零值代表第一页
+	GetCollectionsByUser(ctx context.Context, uid int64, biz string, cur cursorx.Cursor, limit int) ([]UserCollectionBiz, error)
+	// DeleteCollectionBiz 返回的 bool 代表是不是真的取消了收藏，
+	// 本来就没有收藏的话返回 false，收藏数也不变
+	DeleteCollectionBiz(ctx context.Context, biz string, bizId, uid int64) (bool, error)
+	// ListCollectionBizByUser 按照 id 翻页，列出用户收藏的所有东西，重建布隆过滤器用
+	ListCollectionBizByUser(ctx context.Context, uid int64, minId int64, limit int) ([]UserCollectionBiz, error)
 	BatchIncrReadCnt(ctx context.Context, bizs []string, ids []int64) error
 	GetByIds(ctx context.Context, biz string, ids []int64) ([]Interactive, error)
+	// ListByUtime 按照 (utime, id) 正序翻页，列出 (utime, minId) 之后、maxUtime 之前更新过的计数，同步热度用
+	ListByUtime(ctx context.Context, utime, minId, maxUtime int64, limit int) ([]Interactive, error)
 }
 
 type GORMInteractiveDAO struct {
@@ -33,6 +57,16 @@ func (dao *GORMInteractiveDAO) GetByIds(ctx context.Context, biz string, ids []i
 	return res, err
 }
 
+func (dao *GORMInteractiveDAO) ListByUtime(ctx context.Context,
+	utime, minId, maxUtime int64, limit int) ([]Interactive, error) {
+	var res []Interactive
+	err := dao.db.WithContext(ctx).
+		Where("(utime > ? OR (utime = ? AND id > ?)) AND utime < ?", utime, utime, minId, maxUtime).
+		Order("utime").Order("id").Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
 func (dao *GORMInteractiveDAO) GetLikeInfo(ctx context.Context, biz string, bizId, uid int64) (UserLikeBiz, error) {
 	var res UserLikeBiz
 	err := dao.db.WithContext(ctx).
@@ -48,16 +82,36 @@ func (dao *GORMInteractiveDAO) GetCollectionInfo(ctx context.Context, biz string
 	return res, err
 }
 
-// InsertCollectionBiz 插入收藏记录，并且更新计数
-func (dao *GORMInteractiveDAO) InsertCollectionBiz(ctx context.Context, cb UserCollectionBiz) error {
+// InsertCollectionBiz 插入收藏记录，并且更新计数。
+// 同一个东西可以放进多个收藏夹，但是收藏数只在第一次收藏的时候 +1
+func (dao *GORMInteractiveDAO) InsertCollectionBiz(ctx context.Context, cb UserCollectionBiz) (bool, error) {
 	now := time.Now().UnixMilli()
 	cb.Utime = now
 	cb.Ctime = now
-	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err := dao.db.WithContext(ctx).Create(&cb).Error
-		if err != nil {
-			return err
+	created := false
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&cb)
+		if res.Error != nil {
+			return res.Error
+		}
+		if cb.Cid > 0 {
+			err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&CollectionItem{
+				Cid:   cb.Cid,
+				Uid:   cb.Uid,
+				Biz:   cb.Biz,
+				BizId: cb.BizId,
+				Ctime: now,
+				Utime: now,
+			}).Error
+			if err != nil {
+				return err
+			}
 		}
+		if res.RowsAffected == 0 {
+			// 之前已经收藏过了
+			return nil
+		}
+		created = true
 		return tx.Clauses(clause.OnConflict{
 			DoUpdates: clause.Assignments(map[string]any{
 				"collect_cnt": gorm.Expr("`collect_cnt`+1"),
@@ -71,24 +125,13 @@ func (dao *GORMInteractiveDAO) InsertCollectionBiz(ctx context.Context, cb UserC
 			BizId:      cb.BizId,
 		}).Error
 	})
+	return created, err
 }
 
 func (dao *GORMInteractiveDAO) InsertLikeInfo(ctx context.Context, biz string, bizId, uid int64) error {
 	now := time.Now().UnixMilli()
 	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err := tx.Clauses(clause.OnConflict{
-			DoUpdates: clause.Assignments(map[string]any{
-				"status": 1,
-				"utime":  now,
-			}),
-		}).Create(&UserLikeBiz{
-			Uid:    uid,
-			Ctime:  now,
-			Utime:  now,
-			Biz:    biz,
-			BizId:  bizId,
-			Status: 1,
-		}).Error
+		err := dao.insertLikeRecord(tx, biz, bizId, uid, now)
 		if err != nil {
 			return err
 		}
@@ -111,12 +154,7 @@ func (dao *GORMInteractiveDAO) InsertLikeInfo(ctx context.Context, biz string, b
 func (dao *GORMInteractiveDAO) DeleteLikeInfo(ctx context.Context, biz string, bizId, uid int64) error {
 	now := time.Now().UnixMilli()
 	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err := tx.Model(&UserLikeBiz{}).
-			Where("biz =? AND biz_id = ? AND uid = ?", biz, bizId, uid).
-			Updates(map[string]any{
-				"status": 0,
-				"utime":  now,
-			}).Error
+		err := dao.deleteLikeRecord(tx, biz, bizId, uid, now)
 		if err != nil {
 			return err
 		}
@@ -136,6 +174,32 @@ func (dao *GORMInteractiveDAO) DeleteLikeInfo(ctx context.Context, biz string, b
 	return err
 }
 
+func (dao *GORMInteractiveDAO) insertLikeRecord(tx *gorm.DB, biz string, bizId, uid int64, now int64) error {
+	return tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"status":   1,
+			"reaction": reactionLike,
+			"utime":    now,
+		}),
+	}).Create(&UserLikeBiz{
+		Uid:    uid,
+		Ctime:  now,
+		Utime:  now,
+		Biz:    biz,
+		BizId:  bizId,
+		Status: 1,
+	}).Error
+}
+
+func (dao *GORMInteractiveDAO) deleteLikeRecord(tx *gorm.DB, biz string, bizId, uid int64, now int64) error {
+	return tx.Model(&UserLikeBiz{}).
+		Where("biz =? AND biz_id = ? AND uid = ?", biz, bizId, uid).
+		Updates(map[string]any{
+			"status": 0,
+			"utime":  now,
+		}).Error
+}
+
 func NewGORMInteractiveDAO(db *gorm.DB) InteractiveDAO {
 	return &GORMInteractiveDAO{
 		db: db,
@@ -184,24 +248,46 @@ func (dao *GORMInteractiveDAO) Get(ctx context.Context, biz string, bizId int64)
 	return res, err
 }
 
-func (dao *GORMInteractiveDAO) GetCollectionsByUser(ctx context.Context, uid int64, biz string, offset, limit int) ([]UserCollectionBiz, error) {
+func (dao *GORMInteractiveDAO) GetCollectionsByUser(ctx context.Context, uid int64, biz string, cur cursorx.Cursor, limit int) ([]UserCollectionBiz, error) {
 	var res []UserCollectionBiz
 	db := dao.db.WithContext(ctx).Where("uid = ?", uid)
 	if biz != "" {
 		db = db.Where("biz = ?", biz)
 	}
-	err := db.Order("ctime DESC").Offset(offset).Limit(limit).Find(&res).Error
+	// 用 (ctime, id) 做 keyset 分页，翻得再深也不需要扫描前面的数据
+	err := db.Scopes(cursorx.Scope(cur, "ctime", "id", limit)).Find(&res).Error
 	return res, err
 }
 
-func (dao *GORMInteractiveDAO) DeleteCollectionBiz(ctx context.Context, biz string, bizId, uid int64) error {
+func (dao *GORMInteractiveDAO) ListCollectionBizByUser(ctx context.Context,
+	uid int64, minId int64, limit int) ([]UserCollectionBiz, error) {
+	var res []UserCollectionBiz
+	err := dao.db.WithContext(ctx).
+		Where("uid = ? AND id > ?", uid, minId).
+		Order("id").Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMInteractiveDAO) DeleteCollectionBiz(ctx context.Context, biz string, bizId, uid int64) (bool, error) {
 	now := time.Now().UnixMilli()
-	return dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err := tx.Where("biz = ? AND biz_id = ? AND uid = ?", biz, bizId, uid).
-			Delete(&UserCollectionBiz{}).Error
+	deleted := false
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("biz = ? AND biz_id = ? AND uid = ?", biz, bizId, uid).
+			Delete(&UserCollectionBiz{})
+		if res.Error != nil {
+			return res.Error
+		}
+		// 取消收藏，所有收藏夹里面都要删掉
+		err := tx.Where("uid = ? AND biz = ? AND biz_id = ?", uid, biz, bizId).
+			Delete(&CollectionItem{}).Error
 		if err != nil {
 			return err
 		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		deleted = true
 		return tx.Model(&Interactive{}).
 			Where("biz = ? AND biz_id = ?", biz, bizId).
 			Updates(map[string]any{
@@ -209,6 +295,7 @@ func (dao *GORMInteractiveDAO) DeleteCollectionBiz(ctx context.Context, biz stri
 				"utime":       now,
 			}).Error
 	})
+	return deleted, err
 }
 
 // 正常来说，一张主表和与它有关联关系的表会共用一个DAO，
@@ -222,7 +309,8 @@ type Interactive struct {
 	CollectCnt int64
 	LikeCnt    int64
 	Ctime      int64
-	Utime      int64
+	// 同步热度的时候按照 utime 扫描
+	Utime int64 `gorm:"index"`
 }
 
 func (i Interactive) ID() int64 {
@@ -242,35 +330,30 @@ type UserLikeBiz struct {
 	// 三个构成唯一索引
 	BizId int64  `gorm:"uniqueIndex:biz_type_id_uid"`
 	Biz   string `gorm:"type:varchar(128);uniqueIndex:biz_type_id_uid"`
-	Uid   int64  `gorm:"uniqueIndex:biz_type_id_uid"`
+	// 单独的 uid 索引是给重建布隆过滤器用的，二级索引里面本身就带了主键
+	Uid int64 `gorm:"uniqueIndex:biz_type_id_uid;index"`
 	// 依旧是只在 DB 层面生效的状态
 	// 1- 有效，0-无效。软删除的用法
 	Status uint8
-	Ctime  int64
-	Utime  int64
-}
-
-// Collection 收藏夹
-type Collection struct {
-	Id   int64  `gorm:"primaryKey,autoIncrement"`
-	Name string `gorm:"type=varchar(1024)"`
-	Uid  int64  `gorm:""`
-
-	Ctime int64
-	Utime int64
+	// Reaction 表态的类型，1 是点赞。老数据都是点赞
+	Reaction uint8 `gorm:"default:1"`
+	Ctime    int64
+	Utime    int64
 }
 
 // UserCollectionBiz 收藏的东西
 type UserCollectionBiz struct {
 	Id int64 `gorm:"primaryKey,autoIncrement"`
-	// 收藏夹 ID
+	// 第一次收藏的时候放进去的收藏夹 ID，0 代表没有放进任何收藏夹
+	// 收藏夹和内容的关联关系在 CollectionItem 里面
 	// 作为关联关系中的外键，我们这里需要索引
 	Cid   int64  `gorm:"index"`
 	BizId int64  `gorm:"uniqueIndex:biz_type_id_uid"`
 	Biz   string `gorm:"type:varchar(128);uniqueIndex:biz_type_id_uid"`
 	// 这算是一个冗余，因为正常来说，
 	// 只需要在 Collection 中维持住 Uid 就可以
-	Uid   int64 `gorm:"uniqueIndex:biz_type_id_uid"`
-	Ctime int64
+	// uid_ctime 是给收藏列表翻页用的，InnoDB 的二级索引里面本身就带了主键
+	Uid   int64 `gorm:"uniqueIndex:biz_type_id_uid;index:uid_ctime,priority:1"`
+	Ctime int64 `gorm:"index:uid_ctime,priority:2"`
 	Utime int64
 }
@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGORMInteractiveDAO_ListByUtime(t *testing.T) {
+	db := initTestDB(t)
+	dao := NewGORMInteractiveDAO(db)
+	ctx := context.Background()
+	intrs := []Interactive{
+		{Id: 1, Biz: "test", BizId: 1, Utime: 200},
+		{Id: 2, Biz: "test", BizId: 2, Utime: 100},
+		{Id: 3, Biz: "test", BizId: 3, Utime: 200},
+		{Id: 4, Biz: "test", BizId: 4, Utime: 300},
+		// 太新了，还不能扫描
+		{Id: 5, Biz: "test", BizId: 5, Utime: 400},
+	}
+	require.NoError(t, db.Create(&intrs).Error)
+
+	ids := func(vals []Interactive) []int64 {
+		return slice.Map(vals, func(idx int, src Interactive) int64 {
+			return src.Id
+		})
+	}
+	res, err := dao.ListByUtime(ctx, 100, 2, 400, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, ids(res))
+	// utime 一样的时候按照 id 接着翻
+	res, err = dao.ListByUtime(ctx, 200, 1, 400, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4}, ids(res))
+	res, err = dao.ListByUtime(ctx, 300, 4, 400, 2)
+	require.NoError(t, err)
+	assert.Empty(t, res)
+}
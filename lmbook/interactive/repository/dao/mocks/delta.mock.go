@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./delta.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./delta.go -package=daomocks -destination=mocks/delta.mock.go
+//
+// Package daomocks is a generated GoMock package.
+package daomocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dao "basic-go/lmbook/interactive/repository/dao"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeltaDAO is a mock of DeltaDAO interface.
+type MockDeltaDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeltaDAOMockRecorder
+}
+
+// MockDeltaDAOMockRecorder is the mock recorder for MockDeltaDAO.
+type MockDeltaDAOMockRecorder struct {
+	mock *MockDeltaDAO
+}
+
+// NewMockDeltaDAO creates a new mock instance.
+func NewMockDeltaDAO(ctrl *gomock.Controller) *MockDeltaDAO {
+	mock := &MockDeltaDAO{ctrl: ctrl}
+	mock.recorder = &MockDeltaDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeltaDAO) EXPECT() *MockDeltaDAOMockRecorder {
+	return m.recorder
+}
+
+// ApplyDeltas mocks base method.
+func (m *MockDeltaDAO) ApplyDeltas(ctx context.Context, batchId string, deltas []dao.CntDelta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyDeltas", ctx, batchId, deltas)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyDeltas indicates an expected call of ApplyDeltas.
+func (mr *MockDeltaDAOMockRecorder) ApplyDeltas(ctx, batchId, deltas any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyDeltas", reflect.TypeOf((*MockDeltaDAO)(nil).ApplyDeltas), ctx, batchId, deltas)
+}
+
+// DeleteFlushLogs mocks base method.
+func (m *MockDeltaDAO) DeleteFlushLogs(ctx context.Context, before int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFlushLogs", ctx, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFlushLogs indicates an expected call of DeleteFlushLogs.
+func (mr *MockDeltaDAOMockRecorder) DeleteFlushLogs(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlushLogs", reflect.TypeOf((*MockDeltaDAO)(nil).DeleteFlushLogs), ctx, before)
+}
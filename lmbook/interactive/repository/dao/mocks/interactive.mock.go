@@ -0,0 +1,307 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./interactive.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./interactive.go -package=daomocks -destination=./mocks/interactive.mock.go
+//
+// Package daomocks is a generated GoMock package.
+package daomocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dao "basic-go/lmbook/interactive/repository/dao"
+	cursorx "basic-go/lmbook/pkg/cursorx"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInteractiveDAO is a mock of InteractiveDAO interface.
+type MockInteractiveDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockInteractiveDAOMockRecorder
+}
+
+// MockInteractiveDAOMockRecorder is the mock recorder for MockInteractiveDAO.
+type MockInteractiveDAOMockRecorder struct {
+	mock *MockInteractiveDAO
+}
+
+// NewMockInteractiveDAO creates a new mock instance.
+func NewMockInteractiveDAO(ctrl *gomock.Controller) *MockInteractiveDAO {
+	mock := &MockInteractiveDAO{ctrl: ctrl}
+	mock.recorder = &MockInteractiveDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInteractiveDAO) EXPECT() *MockInteractiveDAOMockRecorder {
+	return m.recorder
+}
+
+// BatchIncrReadCnt mocks base method.
+func (m *MockInteractiveDAO) BatchIncrReadCnt(ctx context.Context, bizs []string, ids []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchIncrReadCnt", ctx, bizs, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchIncrReadCnt indicates an expected call of BatchIncrReadCnt.
+func (mr *MockInteractiveDAOMockRecorder) BatchIncrReadCnt(ctx, bizs, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchIncrReadCnt", reflect.TypeOf((*MockInteractiveDAO)(nil).BatchIncrReadCnt), ctx, bizs, ids)
+}
+
+// DeleteCollectionBiz mocks base method.
+func (m *MockInteractiveDAO) DeleteCollectionBiz(ctx context.Context, biz string, bizId, uid int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCollectionBiz", ctx, biz, bizId, uid)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCollectionBiz indicates an expected call of DeleteCollectionBiz.
+func (mr *MockInteractiveDAOMockRecorder) DeleteCollectionBiz(ctx, biz, bizId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollectionBiz", reflect.TypeOf((*MockInteractiveDAO)(nil).DeleteCollectionBiz), ctx, biz, bizId, uid)
+}
+
+// DeleteLikeInfo mocks base method.
+func (m *MockInteractiveDAO) DeleteLikeInfo(ctx context.Context, biz string, bizId, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLikeInfo", ctx, biz, bizId, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLikeInfo indicates an expected call of DeleteLikeInfo.
+func (mr *MockInteractiveDAOMockRecorder) DeleteLikeInfo(ctx, biz, bizId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLikeInfo", reflect.TypeOf((*MockInteractiveDAO)(nil).DeleteLikeInfo), ctx, biz, bizId, uid)
+}
+
+// DeleteReaction mocks base method.
+func (m *MockInteractiveDAO) DeleteReaction(ctx context.Context, biz string, bizId, uid int64, reaction uint8, withLikeCnt bool) (uint8, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReaction", ctx, biz, bizId, uid, reaction, withLikeCnt)
+	ret0, _ := ret[0].(uint8)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReaction indicates an expected call of DeleteReaction.
+func (mr *MockInteractiveDAOMockRecorder) DeleteReaction(ctx, biz, bizId, uid, reaction, withLikeCnt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReaction", reflect.TypeOf((*MockInteractiveDAO)(nil).DeleteReaction), ctx, biz, bizId, uid, reaction, withLikeCnt)
+}
+
+// Get mocks base method.
+func (m *MockInteractiveDAO) Get(ctx context.Context, biz string, bizId int64) (dao.Interactive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, biz, bizId)
+	ret0, _ := ret[0].(dao.Interactive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInteractiveDAOMockRecorder) Get(ctx, biz, bizId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInteractiveDAO)(nil).Get), ctx, biz, bizId)
+}
+
+// GetByIds mocks base method.
+func (m *MockInteractiveDAO) GetByIds(ctx context.Context, biz string, ids []int64) ([]dao.Interactive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIds", ctx, biz, ids)
+	ret0, _ := ret[0].([]dao.Interactive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockInteractiveDAOMockRecorder) GetByIds(ctx, biz, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockInteractiveDAO)(nil).GetByIds), ctx, biz, ids)
+}
+
+// GetCollectionInfo mocks base method.
+func (m *MockInteractiveDAO) GetCollectionInfo(ctx context.Context, biz string, bizId, uid int64) (dao.UserCollectionBiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionInfo", ctx, biz, bizId, uid)
+	ret0, _ := ret[0].(dao.UserCollectionBiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionInfo indicates an expected call of GetCollectionInfo.
+func (mr *MockInteractiveDAOMockRecorder) GetCollectionInfo(ctx, biz, bizId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionInfo", reflect.TypeOf((*MockInteractiveDAO)(nil).GetCollectionInfo), ctx, biz, bizId, uid)
+}
+
+// GetCollectionsByUser mocks base method.
+func (m *MockInteractiveDAO) GetCollectionsByUser(ctx context.Context, uid int64, biz string, cur cursorx.Cursor, limit int) ([]dao.UserCollectionBiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionsByUser", ctx, uid, biz, cur, limit)
+	ret0, _ := ret[0].([]dao.UserCollectionBiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionsByUser indicates an expected call of GetCollectionsByUser.
+func (mr *MockInteractiveDAOMockRecorder) GetCollectionsByUser(ctx, uid, biz, cur, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionsByUser", reflect.TypeOf((*MockInteractiveDAO)(nil).GetCollectionsByUser), ctx, uid, biz, cur, limit)
+}
+
+// GetLikeInfo mocks base method.
+func (m *MockInteractiveDAO) GetLikeInfo(ctx context.Context, biz string, bizId, uid int64) (dao.UserLikeBiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLikeInfo", ctx, biz, bizId, uid)
+	ret0, _ := ret[0].(dao.UserLikeBiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLikeInfo indicates an expected call of GetLikeInfo.
+func (mr *MockInteractiveDAOMockRecorder) GetLikeInfo(ctx, biz, bizId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikeInfo", reflect.TypeOf((*MockInteractiveDAO)(nil).GetLikeInfo), ctx, biz, bizId, uid)
+}
+
+// GetReactionCnts mocks base method.
+func (m *MockInteractiveDAO) GetReactionCnts(ctx context.Context, biz string, bizIds []int64) ([]dao.ReactionCnt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReactionCnts", ctx, biz, bizIds)
+	ret0, _ := ret[0].([]dao.ReactionCnt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReactionCnts indicates an expected call of GetReactionCnts.
+func (mr *MockInteractiveDAOMockRecorder) GetReactionCnts(ctx, biz, bizIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactionCnts", reflect.TypeOf((*MockInteractiveDAO)(nil).GetReactionCnts), ctx, biz, bizIds)
+}
+
+// GetReactions mocks base method.
+func (m *MockInteractiveDAO) GetReactions(ctx context.Context, biz string, bizIds []int64, uid int64) ([]dao.UserLikeBiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReactions", ctx, biz, bizIds, uid)
+	ret0, _ := ret[0].([]dao.UserLikeBiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReactions indicates an expected call of GetReactions.
+func (mr *MockInteractiveDAOMockRecorder) GetReactions(ctx, biz, bizIds, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactions", reflect.TypeOf((*MockInteractiveDAO)(nil).GetReactions), ctx, biz, bizIds, uid)
+}
+
+// IncrReadCnt mocks base method.
+func (m *MockInteractiveDAO) IncrReadCnt(ctx context.Context, biz string, bizId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrReadCnt", ctx, biz, bizId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrReadCnt indicates an expected call of IncrReadCnt.
+func (mr *MockInteractiveDAOMockRecorder) IncrReadCnt(ctx, biz, bizId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrReadCnt", reflect.TypeOf((*MockInteractiveDAO)(nil).IncrReadCnt), ctx, biz, bizId)
+}
+
+// InsertCollectionBiz mocks base method.
+func (m *MockInteractiveDAO) InsertCollectionBiz(ctx context.Context, cb dao.UserCollectionBiz) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertCollectionBiz", ctx, cb)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertCollectionBiz indicates an expected call of InsertCollectionBiz.
+func (mr *MockInteractiveDAOMockRecorder) InsertCollectionBiz(ctx, cb any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertCollectionBiz", reflect.TypeOf((*MockInteractiveDAO)(nil).InsertCollectionBiz), ctx, cb)
+}
+
+// InsertLikeInfo mocks base method.
+func (m *MockInteractiveDAO) InsertLikeInfo(ctx context.Context, biz string, bizId, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertLikeInfo", ctx, biz, bizId, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertLikeInfo indicates an expected call of InsertLikeInfo.
+func (mr *MockInteractiveDAOMockRecorder) InsertLikeInfo(ctx, biz, bizId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertLikeInfo", reflect.TypeOf((*MockInteractiveDAO)(nil).InsertLikeInfo), ctx, biz, bizId, uid)
+}
+
+// ListByUtime mocks base method.
+func (m *MockInteractiveDAO) ListByUtime(ctx context.Context, utime, minId, maxUtime int64, limit int) ([]dao.Interactive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUtime", ctx, utime, minId, maxUtime, limit)
+	ret0, _ := ret[0].([]dao.Interactive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUtime indicates an expected call of ListByUtime.
+func (mr *MockInteractiveDAOMockRecorder) ListByUtime(ctx, utime, minId, maxUtime, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUtime", reflect.TypeOf((*MockInteractiveDAO)(nil).ListByUtime), ctx, utime, minId, maxUtime, limit)
+}
+
+// ListCollectionBizByUser mocks base method.
+func (m *MockInteractiveDAO) ListCollectionBizByUser(ctx context.Context, uid, minId int64, limit int) ([]dao.UserCollectionBiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCollectionBizByUser", ctx, uid, minId, limit)
+	ret0, _ := ret[0].([]dao.UserCollectionBiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCollectionBizByUser indicates an expected call of ListCollectionBizByUser.
+func (mr *MockInteractiveDAOMockRecorder) ListCollectionBizByUser(ctx, uid, minId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCollectionBizByUser", reflect.TypeOf((*MockInteractiveDAO)(nil).ListCollectionBizByUser), ctx, uid, minId, limit)
+}
+
+// ListReactionsByUser mocks base method.
+func (m *MockInteractiveDAO) ListReactionsByUser(ctx context.Context, uid, minId int64, limit int) ([]dao.UserLikeBiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReactionsByUser", ctx, uid, minId, limit)
+	ret0, _ := ret[0].([]dao.UserLikeBiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReactionsByUser indicates an expected call of ListReactionsByUser.
+func (mr *MockInteractiveDAOMockRecorder) ListReactionsByUser(ctx, uid, minId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReactionsByUser", reflect.TypeOf((*MockInteractiveDAO)(nil).ListReactionsByUser), ctx, uid, minId, limit)
+}
+
+// UpsertReaction mocks base method.
+func (m *MockInteractiveDAO) UpsertReaction(ctx context.Context, biz string, bizId, uid int64, reaction uint8, withLikeCnt bool) (uint8, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertReaction", ctx, biz, bizId, uid, reaction, withLikeCnt)
+	ret0, _ := ret[0].(uint8)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertReaction indicates an expected call of UpsertReaction.
+func (mr *MockInteractiveDAOMockRecorder) UpsertReaction(ctx, biz, bizId, uid, reaction, withLikeCnt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertReaction", reflect.TypeOf((*MockInteractiveDAO)(nil).UpsertReaction), ctx, biz, bizId, uid, reaction, withLikeCnt)
+}
@@ -0,0 +1,175 @@
+package dao
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// reactionLike 点赞。点赞数还是放在 Interactive.LikeCnt 里面，
+// 热榜之类的都依赖它，所以 ReactionCnt 里面没有点赞
+const reactionLike uint8 = 1
+
+func (dao *GORMInteractiveDAO) UpsertReaction(ctx context.Context,
+	biz string, bizId, uid int64, reaction uint8, withLikeCnt bool) (uint8, error) {
+	now := time.Now().UnixMilli()
+	var old uint8
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ub UserLikeBiz
+		// 锁住这一行，避免同一个人并发换表态导致计数错乱
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("biz = ? AND biz_id = ? AND uid = ?", biz, bizId, uid).
+			First(&ub).Error
+		switch err {
+		case nil:
+			if ub.Status == 1 {
+				old = ub.Reaction
+			}
+			if old == reaction {
+				return nil
+			}
+			err = tx.Model(&UserLikeBiz{}).Where("id = ?", ub.Id).
+				Updates(map[string]any{
+					"status":   1,
+					"reaction": reaction,
+					"utime":    now,
+				}).Error
+		case ErrRecordNotFound:
+			// 并发第一次表态，只有一个能插入成功，另外一个返回唯一索引冲突
+			err = tx.Create(&UserLikeBiz{
+				Biz:      biz,
+				BizId:    bizId,
+				Uid:      uid,
+				Status:   1,
+				Reaction: reaction,
+				Ctime:    now,
+				Utime:    now,
+			}).Error
+		}
+		if err != nil {
+			return err
+		}
+		if old != 0 {
+			err = dao.incrReactionCnt(tx, biz, bizId, old, -1, now, withLikeCnt)
+			if err != nil {
+				return err
+			}
+		}
+		return dao.incrReactionCnt(tx, biz, bizId, reaction, 1, now, withLikeCnt)
+	})
+	return old, err
+}
+
+func (dao *GORMInteractiveDAO) DeleteReaction(ctx context.Context,
+	biz string, bizId, uid int64, reaction uint8, withLikeCnt bool) (uint8, error) {
+	now := time.Now().UnixMilli()
+	var old uint8
+	err := dao.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ub UserLikeBiz
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("biz = ? AND biz_id = ? AND uid = ? AND status = ?", biz, bizId, uid, 1).
+			First(&ub).Error
+		if err == ErrRecordNotFound {
+			// 本来就没有表态
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if reaction != 0 && ub.Reaction != reaction {
+			// 比如说老的客户端取消点赞，但是现在的表态已经不是点赞了
+			return nil
+		}
+		err = tx.Model(&UserLikeBiz{}).Where("id = ?", ub.Id).
+			Updates(map[string]any{
+				"status": 0,
+				"utime":  now,
+			}).Error
+		if err != nil {
+			return err
+		}
+		old = ub.Reaction
+		return dao.incrReactionCnt(tx, biz, bizId, old, -1, now, withLikeCnt)
+	})
+	return old, err
+}
+
+// incrReactionCnt 更新表态计数，点赞更新的是 Interactive.LikeCnt，withLikeCnt 为 false 的时候跳过
+func (dao *GORMInteractiveDAO) incrReactionCnt(tx *gorm.DB,
+	biz string, bizId int64, reaction uint8, delta int64, now int64, withLikeCnt bool) error {
+	if reaction == reactionLike {
+		if !withLikeCnt {
+			return nil
+		}
+		return tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.Assignments(map[string]any{
+				"like_cnt": gorm.Expr("`like_cnt` + ?", delta),
+				"utime":    now,
+			}),
+		}).Create(&Interactive{
+			LikeCnt: delta,
+			Ctime:   now,
+			Utime:   now,
+			Biz:     biz,
+			BizId:   bizId,
+		}).Error
+	}
+	return tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"cnt":   gorm.Expr("`cnt` + ?", delta),
+			"utime": now,
+		}),
+	}).Create(&ReactionCnt{
+		Biz:      biz,
+		BizId:    bizId,
+		Reaction: reaction,
+		Cnt:      delta,
+		Ctime:    now,
+		Utime:    now,
+	}).Error
+}
+
+func (dao *GORMInteractiveDAO) GetReactionCnts(ctx context.Context, biz string, bizIds []int64) ([]ReactionCnt, error) {
+	var res []ReactionCnt
+	if len(bizIds) == 0 {
+		return res, nil
+	}
+	err := dao.db.WithContext(ctx).
+		Where("biz = ? AND biz_id IN ? AND cnt > ?", biz, bizIds, 0).
+		Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMInteractiveDAO) GetReactions(ctx context.Context, biz string, bizIds []int64, uid int64) ([]UserLikeBiz, error) {
+	var res []UserLikeBiz
+	if len(bizIds) == 0 {
+		return res, nil
+	}
+	err := dao.db.WithContext(ctx).
+		Where("biz = ? AND biz_id IN ? AND uid = ? AND status = ?", biz, bizIds, uid, 1).
+		Find(&res).Error
+	return res, err
+}
+
+func (dao *GORMInteractiveDAO) ListReactionsByUser(ctx context.Context,
+	uid int64, minId int64, limit int) ([]UserLikeBiz, error) {
+	var res []UserLikeBiz
+	err := dao.db.WithContext(ctx).
+		Where("uid = ? AND id > ? AND status = ?", uid, minId, 1).
+		Order("id").Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+// ReactionCnt 点赞以外每一种表态的数量。
+// 表态的种类以后还会加，所以不在 Interactive 上面加列
+type ReactionCnt struct {
+	Id       int64  `gorm:"primaryKey,autoIncrement"`
+	BizId    int64  `gorm:"uniqueIndex:biz_type_id_reaction"`
+	Biz      string `gorm:"type:varchar(128);uniqueIndex:biz_type_id_reaction"`
+	Reaction uint8  `gorm:"uniqueIndex:biz_type_id_reaction"`
+	Cnt      int64
+	Ctime    int64
+	Utime    int64
+}
@@ -0,0 +1,103 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// initTestDB 内存里面的 SQLite，只用来验证 SQL 的逻辑
+func initTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// 每个连接都是一个新的内存数据库
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&Interactive{}, &UserLikeBiz{}, &ReactionCnt{}))
+	return db
+}
+
+func likeCnt(t *testing.T, db *gorm.DB, biz string, bizId int64) int64 {
+	var intr Interactive
+	err := db.Where("biz = ? AND biz_id = ?", biz, bizId).First(&intr).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0
+	}
+	require.NoError(t, err)
+	return intr.LikeCnt
+}
+
+func reactionCnt(t *testing.T, db *gorm.DB, biz string, bizId int64, reaction uint8) int64 {
+	var rc ReactionCnt
+	err := db.Where("biz = ? AND biz_id = ? AND reaction = ?", biz, bizId, reaction).First(&rc).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0
+	}
+	require.NoError(t, err)
+	return rc.Cnt
+}
+
+func TestGORMInteractiveDAO_UpsertReaction(t *testing.T) {
+	db := initTestDB(t)
+	dao := NewGORMInteractiveDAO(db)
+	ctx := context.Background()
+
+	// 点赞，点赞数和表态在同一个事务里面更新
+	old, err := dao.UpsertReaction(ctx, "test", 1, 100, reactionLike, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0), old)
+	assert.Equal(t, int64(1), likeCnt(t, db, "test", 1))
+
+	// 重复点赞，什么都不变
+	old, err = dao.UpsertReaction(ctx, "test", 1, 100, reactionLike, true)
+	require.NoError(t, err)
+	assert.Equal(t, reactionLike, old)
+	assert.Equal(t, int64(1), likeCnt(t, db, "test", 1))
+
+	// 换成别的表态，点赞数减回去
+	old, err = dao.UpsertReaction(ctx, "test", 1, 100, 2, true)
+	require.NoError(t, err)
+	assert.Equal(t, reactionLike, old)
+	assert.Equal(t, int64(0), likeCnt(t, db, "test", 1))
+	assert.Equal(t, int64(1), reactionCnt(t, db, "test", 1, 2))
+
+	// 有缓冲的时候点赞数由缓冲去更新
+	_, err = dao.UpsertReaction(ctx, "test", 2, 100, reactionLike, false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), likeCnt(t, db, "test", 2))
+}
+
+func TestGORMInteractiveDAO_DeleteReaction(t *testing.T) {
+	db := initTestDB(t)
+	dao := NewGORMInteractiveDAO(db)
+	ctx := context.Background()
+
+	_, err := dao.UpsertReaction(ctx, "test", 1, 100, reactionLike, true)
+	require.NoError(t, err)
+	_, err = dao.UpsertReaction(ctx, "test", 1, 101, reactionLike, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), likeCnt(t, db, "test", 1))
+
+	// 要取消的不是现在的表态
+	old, err := dao.DeleteReaction(ctx, "test", 1, 100, 2, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0), old)
+	assert.Equal(t, int64(2), likeCnt(t, db, "test", 1))
+
+	old, err = dao.DeleteReaction(ctx, "test", 1, 100, reactionLike, true)
+	require.NoError(t, err)
+	assert.Equal(t, reactionLike, old)
+	assert.Equal(t, int64(1), likeCnt(t, db, "test", 1))
+
+	// 没有表态过
+	old, err = dao.DeleteReaction(ctx, "test", 1, 102, 0, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0), old)
+	assert.Equal(t, int64(1), likeCnt(t, db, "test", 1))
+}
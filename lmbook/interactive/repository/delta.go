@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"basic-go/lmbook/interactive/repository/cache"
+	"basic-go/lmbook/interactive/repository/dao"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/google/uuid"
+	"time"
+)
+
+type DeltaBufferConfig struct {
+	// BatchSize 一次最多落库多少个 biz。积压的 biz 超过这个数量，也会立刻触发落库
+	BatchSize int64
+	// Interval 最多隔多久落库一次
+	Interval time.Duration
+	// AckTimeout 取出来之后超过这个时间还没有 Ack，
+	// 就认为落库的时候崩溃了，需要从日志里面重放
+	AckTimeout time.Duration
+	// FlushLogRetention 数据库里面落库记录保留多久，要远大于 AckTimeout
+	FlushLogRetention time.Duration
+}
+
+// CntDeltaBuffer 阅读数和点赞数的写缓冲。
+// 每一次阅读、点赞不再直接更新数据库，而是先在 Redis 里面按照 biz 合并增量，
+// 按照数量或者时间触发，一批增量一条 SQL 写进数据库，大幅减少热点文章的行锁争抢。
+//
+// 崩溃安全依赖两点：
+//  1. 取增量的时候，在同一个 lua 脚本里面把增量挪到批次日志里面，落库成功之后才删除日志；
+//  2. 数据库里面用 batchId 做唯一索引，同一批重放多少次都只加一次。
+type CntDeltaBuffer struct {
+	cache  cache.DeltaCache
+	dao    dao.DeltaDAO
+	cfg    DeltaBufferConfig
+	l      logger.LoggerV1
+	notify chan struct{}
+}
+
+func NewCntDeltaBuffer(cache cache.DeltaCache, dao dao.DeltaDAO,
+	cfg DeltaBufferConfig, l logger.LoggerV1) *CntDeltaBuffer {
+	return &CntDeltaBuffer{
+		cache:  cache,
+		dao:    dao,
+		cfg:    cfg,
+		l:      l,
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// Add 记录增量，积压太多的时候通知后台提前落库
+func (b *CntDeltaBuffer) Add(ctx context.Context, d cache.CntDelta) error {
+	size, err := b.cache.Add(ctx, d)
+	if err != nil {
+		return err
+	}
+	if size >= b.cfg.BatchSize {
+		select {
+		case b.notify <- struct{}{}:
+		default:
+			// 已经通知过了
+		}
+	}
+	return nil
+}
+
+// Peek 还没有落库的增量。
+// 已经取出来、正在落库的那一批不在这里，所以读出来的数字可能会短暂偏小
+func (b *CntDeltaBuffer) Peek(ctx context.Context, biz string, bizId int64) (cache.CntDelta, error) {
+	return b.cache.Peek(ctx, biz, bizId)
+}
+
+// Start 启动后台落库，ctx 取消之后会再落库一次才退出，返回的 channel 在退出之后关闭
+func (b *CntDeltaBuffer) Start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// 启动的时候先把上一次崩溃遗留的批次处理掉
+		b.Recover(ctx)
+		ticker := time.NewTicker(b.cfg.Interval)
+		defer ticker.Stop()
+		recoverTicker := time.NewTicker(b.cfg.AckTimeout)
+		defer recoverTicker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				// 退出之前尽量把积压的增量写进去
+				b.flush(context.Background())
+				return
+			case <-ticker.C:
+				b.flush(ctx)
+			case <-b.notify:
+				b.flush(ctx)
+			case <-recoverTicker.C:
+				b.Recover(ctx)
+			}
+		}
+	}()
+	return done
+}
+
+// flush 每个分片都一直取到积压的增量不足一批为止
+func (b *CntDeltaBuffer) flush(ctx context.Context) {
+	for shard := 0; shard < b.cache.Shards(); shard++ {
+		for {
+			n, err := b.flushShard(ctx, shard)
+			if err != nil {
+				b.l.Error("增量落库失败", logger.Int64("shard", int64(shard)), logger.Error(err))
+				break
+			}
+			if int64(n) < b.cfg.BatchSize {
+				break
+			}
+		}
+	}
+}
+
+// Flush 每个分片取出一批增量写进数据库，返回一共有多少个 biz
+func (b *CntDeltaBuffer) Flush(ctx context.Context) (int, error) {
+	total := 0
+	for shard := 0; shard < b.cache.Shards(); shard++ {
+		n, err := b.flushShard(ctx, shard)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+func (b *CntDeltaBuffer) flushShard(ctx context.Context, shard int) (int, error) {
+	batchId := uuid.New().String()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+	deltas, err := b.cache.Drain(ctx, shard, batchId, b.cfg.BatchSize)
+	if err != nil || len(deltas) == 0 {
+		// Drain 失败的话，要么增量还在原地，要么已经进了日志，都不会丢
+		return 0, err
+	}
+	return len(deltas), b.apply(ctx, shard, batchId, deltas)
+}
+
+func (b *CntDeltaBuffer) apply(ctx context.Context, shard int, batchId string, deltas []cache.CntDelta) error {
+	err := b.dao.ApplyDeltas(ctx, batchId, slice.Map(deltas, func(idx int, src cache.CntDelta) dao.CntDelta {
+		return dao.CntDelta{
+			Biz:     src.Biz,
+			BizId:   src.BizId,
+			ReadCnt: src.ReadCnt,
+			LikeCnt: src.LikeCnt,
+		}
+	}))
+	if err != nil {
+		// 日志还在，过了 AckTimeout 之后会重放
+		return err
+	}
+	return b.cache.Ack(ctx, shard, batchId)
+}
+
+// Recover 重放超时没有 Ack 的批次，顺便清理过期的落库记录
+func (b *CntDeltaBuffer) Recover(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+	for shard := 0; shard < b.cache.Shards(); shard++ {
+		b.recoverShard(ctx, shard)
+	}
+	err := b.dao.DeleteFlushLogs(ctx, time.Now().Add(-b.cfg.FlushLogRetention).UnixMilli())
+	if err != nil {
+		b.l.Error("清理增量落库记录失败", logger.Error(err))
+	}
+}
+
+func (b *CntDeltaBuffer) recoverShard(ctx context.Context, shard int) {
+	batchIds, err := b.cache.Pending(ctx, shard, time.Now().Add(-b.cfg.AckTimeout))
+	if err != nil {
+		b.l.Error("查询未完成的增量批次失败",
+			logger.Int64("shard", int64(shard)),
+			logger.Error(err))
+		return
+	}
+	for _, batchId := range batchIds {
+		deltas, err := b.cache.Load(ctx, shard, batchId)
+		if err == nil {
+			err = b.apply(ctx, shard, batchId, deltas)
+		}
+		if err != nil {
+			b.l.Error("重放增量批次失败",
+				logger.String("batchId", batchId),
+				logger.Error(err))
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"basic-go/lmbook/interactive/repository/cache"
+	cachemocks "basic-go/lmbook/interactive/repository/cache/mocks"
+	"basic-go/lmbook/interactive/repository/dao"
+	daomocks "basic-go/lmbook/interactive/repository/dao/mocks"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCntDeltaBuffer_Flush(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (cache.DeltaCache, dao.DeltaDAO)
+
+		wantN   int
+		wantErr error
+	}{
+		{
+			name: "每个分片取一批",
+			mock: func(ctrl *gomock.Controller) (cache.DeltaCache, dao.DeltaDAO) {
+				c := cachemocks.NewMockDeltaCache(ctrl)
+				d := daomocks.NewMockDeltaDAO(ctrl)
+				c.EXPECT().Shards().Return(3).AnyTimes()
+				c.EXPECT().Drain(gomock.Any(), 0, gomock.Any(), int64(10)).
+					Return([]cache.CntDelta{{Biz: "test", BizId: 1, ReadCnt: 1}}, nil)
+				// 空的分片什么也不做
+				c.EXPECT().Drain(gomock.Any(), 1, gomock.Any(), int64(10)).Return(nil, nil)
+				c.EXPECT().Drain(gomock.Any(), 2, gomock.Any(), int64(10)).
+					Return([]cache.CntDelta{
+						{Biz: "test", BizId: 2, LikeCnt: 1},
+						{Biz: "test", BizId: 3, ReadCnt: 2},
+					}, nil)
+				d.EXPECT().ApplyDeltas(gomock.Any(), gomock.Any(), []dao.CntDelta{
+					{Biz: "test", BizId: 1, ReadCnt: 1},
+				}).Return(nil)
+				d.EXPECT().ApplyDeltas(gomock.Any(), gomock.Any(), []dao.CntDelta{
+					{Biz: "test", BizId: 2, LikeCnt: 1},
+					{Biz: "test", BizId: 3, ReadCnt: 2},
+				}).Return(nil)
+				// Ack 的是同一个分片
+				c.EXPECT().Ack(gomock.Any(), 0, gomock.Any()).Return(nil)
+				c.EXPECT().Ack(gomock.Any(), 2, gomock.Any()).Return(nil)
+				return c, d
+			},
+			wantN: 3,
+		},
+		{
+			name: "落库失败，不 Ack",
+			mock: func(ctrl *gomock.Controller) (cache.DeltaCache, dao.DeltaDAO) {
+				c := cachemocks.NewMockDeltaCache(ctrl)
+				d := daomocks.NewMockDeltaDAO(ctrl)
+				c.EXPECT().Shards().Return(2).AnyTimes()
+				c.EXPECT().Drain(gomock.Any(), 0, gomock.Any(), int64(10)).
+					Return([]cache.CntDelta{{Biz: "test", BizId: 1, ReadCnt: 1}}, nil)
+				d.EXPECT().ApplyDeltas(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("mock db error"))
+				return c, d
+			},
+			wantN:   1,
+			wantErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c, d := tc.mock(ctrl)
+			buffer := NewCntDeltaBuffer(c, d, DeltaBufferConfig{
+				BatchSize: 10,
+				Interval:  time.Second,
+			}, logger.NewNoOpLogger())
+			n, err := buffer.Flush(context.Background())
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantN, n)
+		})
+	}
+}
+
+func TestCntDeltaBuffer_Start(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := cachemocks.NewMockDeltaCache(ctrl)
+	d := daomocks.NewMockDeltaDAO(ctrl)
+	c.EXPECT().Shards().Return(1).AnyTimes()
+	c.EXPECT().Pending(gomock.Any(), 0, gomock.Any()).Return(nil, nil).AnyTimes()
+	d.EXPECT().DeleteFlushLogs(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	// 间隔很长，只有退出之前的这一次落库
+	c.EXPECT().Drain(gomock.Any(), 0, gomock.Any(), gomock.Any()).
+		Return([]cache.CntDelta{{Biz: "test", BizId: 1, ReadCnt: 1}}, nil)
+	d.EXPECT().ApplyDeltas(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	c.EXPECT().Ack(gomock.Any(), 0, gomock.Any()).Return(nil)
+
+	buffer := NewCntDeltaBuffer(c, d, DeltaBufferConfig{
+		BatchSize:  10,
+		Interval:   time.Hour,
+		AckTimeout: time.Hour,
+	}, logger.NewNoOpLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	done := buffer.Start(ctx)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second * 3):
+		t.Fatal("取消之后没有退出")
+	}
+}
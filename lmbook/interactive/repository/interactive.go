@@ -4,9 +4,11 @@ import (
 	"basic-go/lmbook/interactive/domain"
 	"basic-go/lmbook/interactive/repository/cache"
 	"basic-go/lmbook/interactive/repository/dao"
+	"basic-go/lmbook/pkg/cursorx"
 	"basic-go/lmbook/pkg/logger"
 	"context"
 	"github.com/ecodeclub/ekit/slice"
+	"time"
 )
 
 //go:generate mockgen -source=./interactive.go -package=repomocks -destination=mocks/interactive.mock.go InteractiveRepository
@@ -15,21 +17,37 @@ type InteractiveRepository interface {
 		biz string, bizId int64) error
 	// BatchIncrReadCnt 这里调用者要保证 bizs 和 bizIds 长度一样
 	BatchIncrReadCnt(ctx context.Context, bizs []string, bizIds []int64) error
-	IncrLike(ctx context.Context, biz string, bizId, uid int64) error
-	DecrLike(ctx context.Context, biz string, bizId, uid int64) error
+	// React 表态，返回之前的表态
+	React(ctx context.Context, biz string, bizId, uid int64, reaction domain.ReactionType) (domain.ReactionType, error)
+	// CancelReaction 取消表态，reaction 为 ReactionNone 的时候不管当前是哪一种都取消。
+	// 返回被取消的表态
+	CancelReaction(ctx context.Context, biz string, bizId, uid int64, reaction domain.ReactionType) (domain.ReactionType, error)
 	AddCollectionItem(ctx context.Context, biz string, bizId, cid int64, uid int64) error
 	RemoveCollectionItem(ctx context.Context, biz string, bizId, uid int64) error
-	GetCollectionsByUser(ctx context.Context, uid int64, biz string, offset, limit int) ([]int64, error)
+	GetCollectionsByUser(ctx context.Context, uid int64, biz string, cur cursorx.Cursor, limit int) ([]domain.CollectionItem, error)
 	Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error)
-	Liked(ctx context.Context, biz string, id int64, uid int64) (bool, error)
+	// Reaction 用户当前的表态
+	Reaction(ctx context.Context, biz string, id int64, uid int64) (domain.ReactionType, error)
+	Reactions(ctx context.Context, biz string, ids []int64, uid int64) (map[int64]domain.ReactionType, error)
 	Collected(ctx context.Context, biz string, id int64, uid int64) (bool, error)
 	GetByIds(ctx context.Context, biz string, ids []int64) ([]domain.Interactive, error)
+	// ListUpdated 按照更新时间正序，列出 cur 之后、maxUtime 之前计数变过的，同步热度用。
+	// 只有计数，没有表态的数量。返回的游标是最后一条的位置，没有数据的时候原样返回
+	ListUpdated(ctx context.Context, cur UtimeCursor, maxUtime int64, limit int) ([]domain.Interactive, UtimeCursor, error)
+}
+
+// UtimeCursor 按照 (utime, id) 正序扫描到的位置
+type UtimeCursor struct {
+	Utime int64
+	Id    int64
 }
 
 type CachedReadCntRepository struct {
 	cache cache.InteractiveCache
 	dao   dao.InteractiveDAO
-	l     logger.LoggerV1
+	// buffer 不为 nil 的时候，阅读数和点赞数先进写缓冲，再批量落库
+	buffer *CntDeltaBuffer
+	l      logger.LoggerV1
 }
 
 func (c *CachedReadCntRepository) GetByIds(ctx context.Context, biz string, ids []int64) ([]domain.Interactive, error) {
@@ -37,22 +55,55 @@ func (c *CachedReadCntRepository) GetByIds(ctx context.Context, biz string, ids
 	if err != nil {
 		return nil, err
 	}
+	cnts, err := c.reactionCnts(ctx, biz, slice.Map(vals, func(idx int, src dao.Interactive) int64 {
+		return src.BizId
+	}))
+	if err != nil {
+		return nil, err
+	}
 	return slice.Map[dao.Interactive, domain.Interactive](vals,
 		func(idx int, src dao.Interactive) domain.Interactive {
-			return c.toDomain(src)
+			res := c.toDomain(src)
+			res.ReactionCnts = cnts[src.BizId]
+			c.fillLikeReaction(&res)
+			return res
 		}), nil
 }
 
-func (c *CachedReadCntRepository) Liked(ctx context.Context, biz string, id int64, uid int64) (bool, error) {
-	_, err := c.dao.GetLikeInfo(ctx, biz, id, uid)
+func (c *CachedReadCntRepository) ListUpdated(ctx context.Context, cur UtimeCursor,
+	maxUtime int64, limit int) ([]domain.Interactive, UtimeCursor, error) {
+	vals, err := c.dao.ListByUtime(ctx, cur.Utime, cur.Id, maxUtime, limit)
+	if err != nil || len(vals) == 0 {
+		return nil, cur, err
+	}
+	last := vals[len(vals)-1]
+	return slice.Map(vals, func(idx int, src dao.Interactive) domain.Interactive {
+		return c.toDomain(src)
+	}), UtimeCursor{Utime: last.Utime, Id: last.Id}, nil
+}
+
+func (c *CachedReadCntRepository) Reaction(ctx context.Context, biz string, id int64, uid int64) (domain.ReactionType, error) {
+	ub, err := c.dao.GetLikeInfo(ctx, biz, id, uid)
 	switch err {
 	case nil:
-		return true, nil
+		return domain.ReactionType(ub.Reaction), nil
 	case dao.ErrRecordNotFound:
-		return false, nil
+		return domain.ReactionNone, nil
 	default:
-		return false, err
+		return domain.ReactionNone, err
+	}
+}
+
+func (c *CachedReadCntRepository) Reactions(ctx context.Context, biz string, ids []int64, uid int64) (map[int64]domain.ReactionType, error) {
+	ubs, err := c.dao.GetReactions(ctx, biz, ids, uid)
+	if err != nil {
+		return nil, err
 	}
+	res := make(map[int64]domain.ReactionType, len(ubs))
+	for _, ub := range ubs {
+		res[ub.BizId] = domain.ReactionType(ub.Reaction)
+	}
+	return res, nil
 }
 
 func (c *CachedReadCntRepository) Collected(ctx context.Context, biz string, id int64, uid int64) (bool, error) {
@@ -67,27 +118,66 @@ func (c *CachedReadCntRepository) Collected(ctx context.Context, biz string, id
 	}
 }
 
-func (c *CachedReadCntRepository) IncrLike(ctx context.Context,
-	biz string, bizId int64, uid int64) error {
-	err := c.dao.InsertLikeInfo(ctx, biz, bizId, uid)
-	if err != nil {
-		return err
+func (c *CachedReadCntRepository) React(ctx context.Context,
+	biz string, bizId, uid int64, reaction domain.ReactionType) (domain.ReactionType, error) {
+	o, err := c.dao.UpsertReaction(ctx, biz, bizId, uid, uint8(reaction), c.buffer == nil)
+	old := domain.ReactionType(o)
+	if err != nil || old == reaction {
+		return old, err
 	}
-	return c.cache.IncrLikeCntIfPresent(ctx, biz, bizId)
+	return old, c.onReactionChanged(ctx, biz, bizId, old, reaction)
 }
 
-func (c *CachedReadCntRepository) DecrLike(ctx context.Context,
-	biz string, bizId int64, uid int64) error {
-	err := c.dao.DeleteLikeInfo(ctx, biz, bizId, uid)
-	if err != nil {
-		return err
+func (c *CachedReadCntRepository) CancelReaction(ctx context.Context,
+	biz string, bizId, uid int64, reaction domain.ReactionType) (domain.ReactionType, error) {
+	o, err := c.dao.DeleteReaction(ctx, biz, bizId, uid, uint8(reaction), c.buffer == nil)
+	old := domain.ReactionType(o)
+	if err != nil || old == domain.ReactionNone {
+		return old, err
+	}
+	return old, c.onReactionChanged(ctx, biz, bizId, old, domain.ReactionNone)
+}
+
+// onReactionChanged 表态从 old 换成了 cur，维护写缓冲里面的点赞数和缓存。
+// 没有写缓冲的时候，点赞数和其它表态的数量 DAO 已经在同一个事务里面改好了
+func (c *CachedReadCntRepository) onReactionChanged(ctx context.Context,
+	biz string, bizId int64, old, cur domain.ReactionType) error {
+	if old == domain.ReactionLike {
+		if err := c.incrLikeCnt(ctx, biz, bizId, -1); err != nil {
+			return err
+		}
+	}
+	if cur == domain.ReactionLike {
+		if err := c.incrLikeCnt(ctx, biz, bizId, 1); err != nil {
+			return err
+		}
+	}
+	if old != domain.ReactionNone {
+		if err := c.cache.IncrReactionCntIfPresent(ctx, biz, bizId, old, -1); err != nil {
+			return err
+		}
+	}
+	if cur != domain.ReactionNone {
+		return c.cache.IncrReactionCntIfPresent(ctx, biz, bizId, cur, 1)
 	}
-	return c.cache.DecrLikeCntIfPresent(ctx, biz, bizId)
+	return nil
+}
+
+func (c *CachedReadCntRepository) incrLikeCnt(ctx context.Context, biz string, bizId int64, delta int64) error {
+	if c.buffer == nil {
+		return nil
+	}
+	return c.buffer.Add(ctx, cache.CntDelta{Biz: biz, BizId: bizId, LikeCnt: delta})
 }
 
 func (c *CachedReadCntRepository) IncrReadCnt(ctx context.Context,
 	biz string, bizId int64) error {
-	err := c.dao.IncrReadCnt(ctx, biz, bizId)
+	var err error
+	if c.buffer != nil {
+		err = c.buffer.Add(ctx, cache.CntDelta{Biz: biz, BizId: bizId, ReadCnt: 1})
+	} else {
+		err = c.dao.IncrReadCnt(ctx, biz, bizId)
+	}
 	if err != nil {
 		return err
 	}
@@ -98,18 +188,28 @@ func (c *CachedReadCntRepository) IncrReadCnt(ctx context.Context,
 
 func (c *CachedReadCntRepository) BatchIncrReadCnt(ctx context.Context,
 	bizs []string, bizIds []int64) error {
-	return c.dao.BatchIncrReadCnt(ctx, bizs, bizIds)
+	if c.buffer == nil {
+		return c.dao.BatchIncrReadCnt(ctx, bizs, bizIds)
+	}
+	for i := 0; i < len(bizs); i++ {
+		err := c.buffer.Add(ctx, cache.CntDelta{Biz: bizs[i], BizId: bizIds[i], ReadCnt: 1})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (c *CachedReadCntRepository) AddCollectionItem(ctx context.Context,
 	biz string, bizId, cid, uid int64) error {
-	err := c.dao.InsertCollectionBiz(ctx, dao.UserCollectionBiz{
+	created, err := c.dao.InsertCollectionBiz(ctx, dao.UserCollectionBiz{
 		Biz:   biz,
 		Cid:   cid,
 		BizId: bizId,
 		Uid:   uid,
 	})
-	if err != nil {
+	if err != nil || !created {
+		// 只是放进了另外一个收藏夹，收藏数不变
 		return err
 	}
 	return c.cache.IncrCollectCntIfPresent(ctx, biz, bizId)
@@ -117,22 +217,28 @@ func (c *CachedReadCntRepository) AddCollectionItem(ctx context.Context,
 
 func (c *CachedReadCntRepository) RemoveCollectionItem(ctx context.Context,
 	biz string, bizId, uid int64) error {
-	err := c.dao.DeleteCollectionBiz(ctx, biz, bizId, uid)
-	if err != nil {
+	deleted, err := c.dao.DeleteCollectionBiz(ctx, biz, bizId, uid)
+	if err != nil || !deleted {
+		// 本来就没有收藏，收藏数不变
 		return err
 	}
 	return c.cache.DecrCollectCntIfPresent(ctx, biz, bizId)
 }
 
 func (c *CachedReadCntRepository) GetCollectionsByUser(ctx context.Context,
-	uid int64, biz string, offset, limit int) ([]int64, error) {
-	collections, err := c.dao.GetCollectionsByUser(ctx, uid, biz, offset, limit)
+	uid int64, biz string, cur cursorx.Cursor, limit int) ([]domain.CollectionItem, error) {
+	collections, err := c.dao.GetCollectionsByUser(ctx, uid, biz, cur, limit)
 	if err != nil {
 		return nil, err
 	}
-	return slice.Map[dao.UserCollectionBiz, int64](collections,
-		func(idx int, src dao.UserCollectionBiz) int64 {
-			return src.BizId
+	return slice.Map[dao.UserCollectionBiz, domain.CollectionItem](collections,
+		func(idx int, src dao.UserCollectionBiz) domain.CollectionItem {
+			return domain.CollectionItem{
+				Id:    src.Id,
+				Biz:   src.Biz,
+				BizId: src.BizId,
+				Ctime: time.UnixMilli(src.Ctime),
+			}
 		}), nil
 }
 
@@ -143,22 +249,67 @@ func (c *CachedReadCntRepository) Get(ctx context.Context,
 		// 缓存只缓存了具体的数字，但是没有缓存自身有没有点赞的信息
 		// 因为一个人反复刷，重复刷一篇文章是小概率的事情
 		// 也就是说，你缓存了某个用户是否点赞的数据，命中率会很低
+		c.fillLikeReaction(&intr)
 		return intr, nil
 	}
 	ie, err := c.dao.Get(ctx, biz, bizId)
 	if err == dao.ErrRecordNotFound || err == nil {
 		res := c.toDomain(ie)
+		cnts, er := c.reactionCnts(ctx, biz, []int64{bizId})
+		if er != nil {
+			return domain.Interactive{}, er
+		}
+		res.ReactionCnts = cnts[bizId]
+		if c.buffer != nil {
+			// 数据库里面还缺着没有落库的增量，补上之后再回写缓存
+			d, er := c.buffer.Peek(ctx, biz, bizId)
+			if er == nil {
+				res.ReadCnt += d.ReadCnt
+				res.LikeCnt += d.LikeCnt
+			}
+		}
 		if er := c.cache.Set(ctx, biz, bizId, res); er != nil {
 			c.l.Error("回写缓存失败",
 				logger.Int64("bizId", bizId),
 				logger.String("biz", biz),
 				logger.Error(er))
 		}
+		c.fillLikeReaction(&res)
 		return res, nil
 	}
 	return domain.Interactive{}, err
 }
 
+// reactionCnts 点赞以外的表态数量
+func (c *CachedReadCntRepository) reactionCnts(ctx context.Context,
+	biz string, bizIds []int64) (map[int64]map[domain.ReactionType]int64, error) {
+	cnts, err := c.dao.GetReactionCnts(ctx, biz, bizIds)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]map[domain.ReactionType]int64, len(bizIds))
+	for _, cnt := range cnts {
+		m, ok := res[cnt.BizId]
+		if !ok {
+			m = make(map[domain.ReactionType]int64, 4)
+			res[cnt.BizId] = m
+		}
+		m[domain.ReactionType(cnt.Reaction)] = cnt.Cnt
+	}
+	return res, nil
+}
+
+// fillLikeReaction 点赞数单独存在 LikeCnt 里面，这里补到 ReactionCnts 里
+func (c *CachedReadCntRepository) fillLikeReaction(intr *domain.Interactive) {
+	if intr.LikeCnt <= 0 {
+		return
+	}
+	if intr.ReactionCnts == nil {
+		intr.ReactionCnts = make(map[domain.ReactionType]int64, 1)
+	}
+	intr.ReactionCnts[domain.ReactionLike] = intr.LikeCnt
+}
+
 func (c *CachedReadCntRepository) toDomain(intr dao.Interactive) domain.Interactive {
 	return domain.Interactive{
 		Biz:        intr.Biz,
@@ -177,3 +328,14 @@ func NewCachedInteractiveRepository(dao dao.InteractiveDAO,
 		l:     l,
 	}
 }
+
+// NewBufferedInteractiveRepository 阅读数和点赞数走写缓冲的版本
+func NewBufferedInteractiveRepository(dao dao.InteractiveDAO,
+	cache cache.InteractiveCache, buffer *CntDeltaBuffer, l logger.LoggerV1) InteractiveRepository {
+	return &CachedReadCntRepository{
+		dao:    dao,
+		cache:  cache,
+		buffer: buffer,
+		l:      l,
+	}
+}
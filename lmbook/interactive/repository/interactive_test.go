@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"basic-go/lmbook/interactive/repository/cache"
+	cachemocks "basic-go/lmbook/interactive/repository/cache/mocks"
+	"basic-go/lmbook/interactive/repository/dao"
+	daomocks "basic-go/lmbook/interactive/repository/dao/mocks"
+	"basic-go/lmbook/pkg/logger"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCachedReadCntRepository_RemoveCollectionItem(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (dao.InteractiveDAO, cache.InteractiveCache)
+
+		wantErr error
+	}{
+		{
+			name: "取消收藏，缓存的收藏数减一",
+			mock: func(ctrl *gomock.Controller) (dao.InteractiveDAO, cache.InteractiveCache) {
+				d := daomocks.NewMockInteractiveDAO(ctrl)
+				c := cachemocks.NewMockInteractiveCache(ctrl)
+				d.EXPECT().DeleteCollectionBiz(gomock.Any(), "article", int64(1), int64(2)).Return(true, nil)
+				c.EXPECT().DecrCollectCntIfPresent(gomock.Any(), "article", int64(1)).Return(nil)
+				return d, c
+			},
+		},
+		{
+			name: "本来就没有收藏，缓存不动",
+			mock: func(ctrl *gomock.Controller) (dao.InteractiveDAO, cache.InteractiveCache) {
+				d := daomocks.NewMockInteractiveDAO(ctrl)
+				c := cachemocks.NewMockInteractiveCache(ctrl)
+				d.EXPECT().DeleteCollectionBiz(gomock.Any(), "article", int64(1), int64(2)).Return(false, nil)
+				return d, c
+			},
+		},
+		{
+			name: "数据库出错",
+			mock: func(ctrl *gomock.Controller) (dao.InteractiveDAO, cache.InteractiveCache) {
+				d := daomocks.NewMockInteractiveDAO(ctrl)
+				c := cachemocks.NewMockInteractiveCache(ctrl)
+				d.EXPECT().DeleteCollectionBiz(gomock.Any(), "article", int64(1), int64(2)).
+					Return(false, errors.New("mock db error"))
+				return d, c
+			},
+			wantErr: errors.New("mock db error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			d, c := tc.mock(ctrl)
+			repo := NewCachedInteractiveRepository(d, c, logger.NewNoOpLogger())
+			err := repo.RemoveCollectionItem(context.Background(), "article", 1, 2)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./interactive.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./interactive.go -package=repomocks -destination=./mocks/interactive.mock.go
+//
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "basic-go/lmbook/interactive/domain"
+	repository "basic-go/lmbook/interactive/repository"
+	cursorx "basic-go/lmbook/pkg/cursorx"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInteractiveRepository is a mock of InteractiveRepository interface.
+type MockInteractiveRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInteractiveRepositoryMockRecorder
+}
+
+// MockInteractiveRepositoryMockRecorder is the mock recorder for MockInteractiveRepository.
+type MockInteractiveRepositoryMockRecorder struct {
+	mock *MockInteractiveRepository
+}
+
+// NewMockInteractiveRepository creates a new mock instance.
+func NewMockInteractiveRepository(ctrl *gomock.Controller) *MockInteractiveRepository {
+	mock := &MockInteractiveRepository{ctrl: ctrl}
+	mock.recorder = &MockInteractiveRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInteractiveRepository) EXPECT() *MockInteractiveRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddCollectionItem mocks base method.
+func (m *MockInteractiveRepository) AddCollectionItem(ctx context.Context, biz string, bizId, cid, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCollectionItem", ctx, biz, bizId, cid, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCollectionItem indicates an expected call of AddCollectionItem.
+func (mr *MockInteractiveRepositoryMockRecorder) AddCollectionItem(ctx, biz, bizId, cid, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollectionItem", reflect.TypeOf((*MockInteractiveRepository)(nil).AddCollectionItem), ctx, biz, bizId, cid, uid)
+}
+
+// BatchIncrReadCnt mocks base method.
+func (m *MockInteractiveRepository) BatchIncrReadCnt(ctx context.Context, bizs []string, bizIds []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchIncrReadCnt", ctx, bizs, bizIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchIncrReadCnt indicates an expected call of BatchIncrReadCnt.
+func (mr *MockInteractiveRepositoryMockRecorder) BatchIncrReadCnt(ctx, bizs, bizIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchIncrReadCnt", reflect.TypeOf((*MockInteractiveRepository)(nil).BatchIncrReadCnt), ctx, bizs, bizIds)
+}
+
+// CancelReaction mocks base method.
+func (m *MockInteractiveRepository) CancelReaction(ctx context.Context, biz string, bizId, uid int64, reaction domain.ReactionType) (domain.ReactionType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReaction", ctx, biz, bizId, uid, reaction)
+	ret0, _ := ret[0].(domain.ReactionType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReaction indicates an expected call of CancelReaction.
+func (mr *MockInteractiveRepositoryMockRecorder) CancelReaction(ctx, biz, bizId, uid, reaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReaction", reflect.TypeOf((*MockInteractiveRepository)(nil).CancelReaction), ctx, biz, bizId, uid, reaction)
+}
+
+// Collected mocks base method.
+func (m *MockInteractiveRepository) Collected(ctx context.Context, biz string, id, uid int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Collected", ctx, biz, id, uid)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Collected indicates an expected call of Collected.
+func (mr *MockInteractiveRepositoryMockRecorder) Collected(ctx, biz, id, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collected", reflect.TypeOf((*MockInteractiveRepository)(nil).Collected), ctx, biz, id, uid)
+}
+
+// Get mocks base method.
+func (m *MockInteractiveRepository) Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, biz, bizId)
+	ret0, _ := ret[0].(domain.Interactive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInteractiveRepositoryMockRecorder) Get(ctx, biz, bizId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInteractiveRepository)(nil).Get), ctx, biz, bizId)
+}
+
+// GetByIds mocks base method.
+func (m *MockInteractiveRepository) GetByIds(ctx context.Context, biz string, ids []int64) ([]domain.Interactive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIds", ctx, biz, ids)
+	ret0, _ := ret[0].([]domain.Interactive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockInteractiveRepositoryMockRecorder) GetByIds(ctx, biz, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockInteractiveRepository)(nil).GetByIds), ctx, biz, ids)
+}
+
+// GetCollectionsByUser mocks base method.
+func (m *MockInteractiveRepository) GetCollectionsByUser(ctx context.Context, uid int64, biz string, cur cursorx.Cursor, limit int) ([]domain.CollectionItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollectionsByUser", ctx, uid, biz, cur, limit)
+	ret0, _ := ret[0].([]domain.CollectionItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollectionsByUser indicates an expected call of GetCollectionsByUser.
+func (mr *MockInteractiveRepositoryMockRecorder) GetCollectionsByUser(ctx, uid, biz, cur, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionsByUser", reflect.TypeOf((*MockInteractiveRepository)(nil).GetCollectionsByUser), ctx, uid, biz, cur, limit)
+}
+
+// IncrReadCnt mocks base method.
+func (m *MockInteractiveRepository) IncrReadCnt(ctx context.Context, biz string, bizId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrReadCnt", ctx, biz, bizId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrReadCnt indicates an expected call of IncrReadCnt.
+func (mr *MockInteractiveRepositoryMockRecorder) IncrReadCnt(ctx, biz, bizId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrReadCnt", reflect.TypeOf((*MockInteractiveRepository)(nil).IncrReadCnt), ctx, biz, bizId)
+}
+
+// ListUpdated mocks base method.
+func (m *MockInteractiveRepository) ListUpdated(ctx context.Context, cur repository.UtimeCursor, maxUtime int64, limit int) ([]domain.Interactive, repository.UtimeCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUpdated", ctx, cur, maxUtime, limit)
+	ret0, _ := ret[0].([]domain.Interactive)
+	ret1, _ := ret[1].(repository.UtimeCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUpdated indicates an expected call of ListUpdated.
+func (mr *MockInteractiveRepositoryMockRecorder) ListUpdated(ctx, cur, maxUtime, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUpdated", reflect.TypeOf((*MockInteractiveRepository)(nil).ListUpdated), ctx, cur, maxUtime, limit)
+}
+
+// React mocks base method.
+func (m *MockInteractiveRepository) React(ctx context.Context, biz string, bizId, uid int64, reaction domain.ReactionType) (domain.ReactionType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "React", ctx, biz, bizId, uid, reaction)
+	ret0, _ := ret[0].(domain.ReactionType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// React indicates an expected call of React.
+func (mr *MockInteractiveRepositoryMockRecorder) React(ctx, biz, bizId, uid, reaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "React", reflect.TypeOf((*MockInteractiveRepository)(nil).React), ctx, biz, bizId, uid, reaction)
+}
+
+// Reaction mocks base method.
+func (m *MockInteractiveRepository) Reaction(ctx context.Context, biz string, id, uid int64) (domain.ReactionType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reaction", ctx, biz, id, uid)
+	ret0, _ := ret[0].(domain.ReactionType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reaction indicates an expected call of Reaction.
+func (mr *MockInteractiveRepositoryMockRecorder) Reaction(ctx, biz, id, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reaction", reflect.TypeOf((*MockInteractiveRepository)(nil).Reaction), ctx, biz, id, uid)
+}
+
+// Reactions mocks base method.
+func (m *MockInteractiveRepository) Reactions(ctx context.Context, biz string, ids []int64, uid int64) (map[int64]domain.ReactionType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reactions", ctx, biz, ids, uid)
+	ret0, _ := ret[0].(map[int64]domain.ReactionType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reactions indicates an expected call of Reactions.
+func (mr *MockInteractiveRepositoryMockRecorder) Reactions(ctx, biz, ids, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reactions", reflect.TypeOf((*MockInteractiveRepository)(nil).Reactions), ctx, biz, ids, uid)
+}
+
+// RemoveCollectionItem mocks base method.
+func (m *MockInteractiveRepository) RemoveCollectionItem(ctx context.Context, biz string, bizId, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollectionItem", ctx, biz, bizId, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCollectionItem indicates an expected call of RemoveCollectionItem.
+func (mr *MockInteractiveRepositoryMockRecorder) RemoveCollectionItem(ctx, biz, bizId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollectionItem", reflect.TypeOf((*MockInteractiveRepository)(nil).RemoveCollectionItem), ctx, biz, bizId, uid)
+}
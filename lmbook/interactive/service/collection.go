@@ -0,0 +1,97 @@
+package service
+
+import (
+	"basic-go/lmbook/interactive/domain"
+	"basic-go/lmbook/interactive/repository"
+	"basic-go/lmbook/pkg/cursorx"
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxCollectionNameLen = 64
+
+var (
+	// ErrCollectionNotFound 收藏夹不存在。
+	// 别人的私密收藏夹、操作别人的收藏夹，都当作不存在来处理
+	ErrCollectionNotFound    = errors.New("收藏夹不存在")
+	ErrInvalidCollectionName = errors.New("收藏夹名字不能为空，并且不能超过 64 个字")
+)
+
+func (i *interactiveService) CreateCollection(ctx context.Context, c domain.Collection) (int64, error) {
+	c.Name = strings.TrimSpace(c.Name)
+	if !validCollectionName(c.Name) {
+		return 0, ErrInvalidCollectionName
+	}
+	return i.colRepo.Create(ctx, c)
+}
+
+func (i *interactiveService) UpdateCollection(ctx context.Context, c domain.Collection) error {
+	c.Name = strings.TrimSpace(c.Name)
+	if !validCollectionName(c.Name) {
+		return ErrInvalidCollectionName
+	}
+	return toCollectionErr(i.colRepo.Update(ctx, c))
+}
+
+func (i *interactiveService) DeleteCollection(ctx context.Context, uid, cid int64) error {
+	return toCollectionErr(i.colRepo.Delete(ctx, uid, cid))
+}
+
+func (i *interactiveService) ListCollections(ctx context.Context, uid, viewer int64) ([]domain.Collection, error) {
+	return i.colRepo.FindByUid(ctx, uid, uid != viewer)
+}
+
+func (i *interactiveService) GetCollectionItems(ctx context.Context,
+	cid, viewer int64, cur cursorx.Cursor, limit int) ([]domain.CollectionItem, error) {
+	c, err := i.colRepo.FindById(ctx, cid)
+	if err != nil {
+		return nil, toCollectionErr(err)
+	}
+	if c.Uid != viewer && !c.Public {
+		return nil, ErrCollectionNotFound
+	}
+	return i.colRepo.FindItems(ctx, cid, cur, limit)
+}
+
+func (i *interactiveService) RemoveCollectionItem(ctx context.Context,
+	uid, cid int64, biz string, bizId int64) error {
+	// 条件里面带了 uid，所以不需要额外检查收藏夹是不是自己的
+	return i.colRepo.RemoveItem(ctx, uid, cid, biz, bizId)
+}
+
+func (i *interactiveService) MoveCollectionItem(ctx context.Context,
+	uid int64, biz string, bizId, from, to int64) error {
+	if from == to {
+		return nil
+	}
+	// from 在删除的时候会带上 uid，只需要检查 to
+	err := i.checkCollectionOwner(ctx, uid, to)
+	if err != nil {
+		return err
+	}
+	return toCollectionErr(i.colRepo.MoveItem(ctx, uid, biz, bizId, from, to))
+}
+
+func (i *interactiveService) checkCollectionOwner(ctx context.Context, uid, cid int64) error {
+	c, err := i.colRepo.FindById(ctx, cid)
+	if err != nil {
+		return toCollectionErr(err)
+	}
+	if c.Uid != uid {
+		return ErrCollectionNotFound
+	}
+	return nil
+}
+
+func validCollectionName(name string) bool {
+	return name != "" && utf8.RuneCountInString(name) <= maxCollectionNameLen
+}
+
+func toCollectionErr(err error) error {
+	if errors.Is(err, repository.ErrCollectionNotFound) {
+		return ErrCollectionNotFound
+	}
+	return err
+}
@@ -2,9 +2,12 @@ package service
 
 import (
 	"basic-go/lmbook/interactive/domain"
+	"basic-go/lmbook/interactive/events"
 	"basic-go/lmbook/interactive/repository"
+	"basic-go/lmbook/pkg/cursorx"
 	"basic-go/lmbook/pkg/logger"
 	"context"
+	"github.com/ecodeclub/ekit/slice"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -13,31 +16,69 @@ type InteractiveService interface {
 	IncrReadCnt(ctx context.Context, biz string, bizId int64) error
 	// Like 点赞
 	Like(ctx context.Context, biz string, bizId int64, uid int64) error
-	// CancelLike 取消点赞
+	// CancelLike 取消点赞，当前的表态不是点赞的话什么也不做
 	CancelLike(ctx context.Context, biz string, bizId int64, uid int64) error
+	// React 表态，一个人对一个东西只能有一种表态，再次调用就是换一种。
+	// Like 就是 React 点赞
+	React(ctx context.Context, biz string, bizId, uid int64, reaction domain.ReactionType) error
+	// CancelReaction 取消表态，不管当前是哪一种
+	CancelReaction(ctx context.Context, biz string, bizId, uid int64) error
 	// Collect 收藏
 	Collect(ctx context.Context, biz string, bizId, cid, uid int64) error
 	// CancelCollect 取消收藏
 	CancelCollect(ctx context.Context, biz string, bizId, uid int64) error
-	// GetCollections 获取用户收藏的 bizId 列表
-	GetCollections(ctx context.Context, uid int64, biz string, offset, limit int) ([]int64, error)
+	// GetCollections 获取用户收藏列表，按照收藏时间倒序，cur 为零值代表第一页
+	GetCollections(ctx context.Context, uid int64, biz string, cur cursorx.Cursor, limit int) ([]domain.CollectionItem, error)
 	Get(ctx context.Context, biz string, bizId, uid int64) (domain.Interactive, error)
-	GetByIds(ctx context.Context, biz string, bizIds []int64) (map[int64]domain.Interactive, error)
+	// GetByIds uid 不为 0 的时候顺便查询这个用户的表态
+	GetByIds(ctx context.Context, biz string, bizIds []int64, uid int64) (map[int64]domain.Interactive, error)
+
+	// CreateCollection 创建收藏夹
+	CreateCollection(ctx context.Context, c domain.Collection) (int64, error)
+	// UpdateCollection 修改收藏夹的名字和是否公开
+	UpdateCollection(ctx context.Context, c domain.Collection) error
+	// DeleteCollection 删除收藏夹，里面的内容依旧是收藏状态
+	DeleteCollection(ctx context.Context, uid, cid int64) error
+	// ListCollections viewer 查看 uid 的收藏夹，不是本人只能看到公开的
+	ListCollections(ctx context.Context, uid, viewer int64) ([]domain.Collection, error)
+	// GetCollectionItems viewer 查看某个收藏夹里面的内容，不是本人只能看公开的收藏夹
+	GetCollectionItems(ctx context.Context, cid, viewer int64, cur cursorx.Cursor, limit int) ([]domain.CollectionItem, error)
+	// RemoveCollectionItem 从收藏夹里面拿出来，但是依旧是收藏状态
+	RemoveCollectionItem(ctx context.Context, uid, cid int64, biz string, bizId int64) error
+	// MoveCollectionItem 从一个收藏夹移动到另外一个收藏夹
+	MoveCollectionItem(ctx context.Context, uid int64, biz string, bizId, from, to int64) error
 }
 
 type interactiveService struct {
-	repo repository.InteractiveRepository
-	l    logger.LoggerV1
+	repo     repository.InteractiveRepository
+	colRepo  repository.CollectionRepository
+	producer events.Producer
+	l        logger.LoggerV1
 }
 
 func (i *interactiveService) GetByIds(ctx context.Context, biz string,
-	bizIds []int64) (map[int64]domain.Interactive, error) {
+	bizIds []int64, uid int64) (map[int64]domain.Interactive, error) {
 	intrs, err := i.repo.GetByIds(ctx, biz, bizIds)
 	if err != nil {
 		return nil, err
 	}
+	var reactions map[int64]domain.ReactionType
+	if uid > 0 {
+		reactions, err = i.repo.Reactions(ctx, biz, slice.Map(intrs, func(idx int, src domain.Interactive) int64 {
+			return src.BizId
+		}), uid)
+		if err != nil {
+			// 和 Get 一样，查不到用户的表态不影响返回计数
+			i.l.Error("查询用户的表态失败",
+				logger.String("biz", biz),
+				logger.Int64("uid", uid),
+				logger.Error(err))
+		}
+	}
 	res := make(map[int64]domain.Interactive, len(intrs))
 	for _, intr := range intrs {
+		intr.Reaction = reactions[intr.BizId]
+		intr.Liked = intr.Reaction == domain.ReactionLike
 		res[intr.BizId] = intr
 	}
 	return res, nil
@@ -57,12 +98,15 @@ func (i *interactiveService) Get(
 	}
 	var eg errgroup.Group
 	eg.Go(func() error {
-		intr.Liked, err = i.repo.Liked(ctx, biz, bizId, uid)
-		return err
+		var er error
+		intr.Reaction, er = i.repo.Reaction(ctx, biz, bizId, uid)
+		intr.Liked = intr.Reaction == domain.ReactionLike
+		return er
 	})
 	eg.Go(func() error {
-		intr.Collected, err = i.repo.Collected(ctx, biz, bizId, uid)
-		return err
+		var er error
+		intr.Collected, er = i.repo.Collected(ctx, biz, bizId, uid)
+		return er
 	})
 	// 说明是登录过的，补充用户是否点赞或者
 	// 新的打印日志的形态 zap 本身就有这种用法
@@ -79,16 +123,36 @@ func (i *interactiveService) Get(
 }
 
 func (i *interactiveService) Like(ctx context.Context, biz string, bizId int64, uid int64) error {
-	return i.repo.IncrLike(ctx, biz, bizId, uid)
+	return i.React(ctx, biz, bizId, uid, domain.ReactionLike)
 }
 
 func (i *interactiveService) CancelLike(ctx context.Context, biz string, bizId int64, uid int64) error {
-	return i.repo.DecrLike(ctx, biz, bizId, uid)
+	return i.cancelReaction(ctx, biz, bizId, uid, domain.ReactionLike)
+}
+
+// produceLikeEvent 点赞已经成功了，消息发送失败只记录日志，
+// 下游（比如热榜）会有定时的全量计算来兜底
+func (i *interactiveService) produceLikeEvent(ctx context.Context, evt events.LikeEvent) {
+	err := i.producer.ProduceLikeEvent(ctx, evt)
+	if err != nil {
+		i.l.Error("发送点赞消息失败",
+			logger.String("biz", evt.Biz),
+			logger.Int64("bizId", evt.BizId),
+			logger.Int64("uid", evt.Uid),
+			logger.Error(err))
+	}
 }
 
-// Collect 收藏
+// Collect 收藏，cid 不为 0 的时候顺便放进收藏夹。
+// 已经收藏过的东西再调用一次，就是放进另外一个收藏夹
 func (i *interactiveService) Collect(ctx context.Context,
 	biz string, bizId, cid, uid int64) error {
+	if cid > 0 {
+		err := i.checkCollectionOwner(ctx, uid, cid)
+		if err != nil {
+			return err
+		}
+	}
 	return i.repo.AddCollectionItem(ctx, biz, bizId, cid, uid)
 }
 
@@ -100,14 +164,18 @@ func (i *interactiveService) CancelCollect(ctx context.Context,
 
 // GetCollections 获取用户收藏列表
 func (i *interactiveService) GetCollections(ctx context.Context,
-	uid int64, biz string, offset, limit int) ([]int64, error) {
-	return i.repo.GetCollectionsByUser(ctx, uid, biz, offset, limit)
+	uid int64, biz string, cur cursorx.Cursor, limit int) ([]domain.CollectionItem, error) {
+	return i.repo.GetCollectionsByUser(ctx, uid, biz, cur, limit)
 }
 
 func NewInteractiveService(repo repository.InteractiveRepository,
+	colRepo repository.CollectionRepository,
+	producer events.Producer,
 	l logger.LoggerV1) InteractiveService {
 	return &interactiveService{
-		repo: repo,
-		l:    l,
+		repo:     repo,
+		colRepo:  colRepo,
+		producer: producer,
+		l:        l,
 	}
 }
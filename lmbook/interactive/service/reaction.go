@@ -0,0 +1,51 @@
+package service
+
+import (
+	"basic-go/lmbook/interactive/domain"
+	"basic-go/lmbook/interactive/events"
+	"context"
+	"errors"
+)
+
+var ErrInvalidReaction = errors.New("非法的表态")
+
+func (i *interactiveService) React(ctx context.Context,
+	biz string, bizId, uid int64, reaction domain.ReactionType) error {
+	if !reaction.Valid() {
+		return ErrInvalidReaction
+	}
+	old, err := i.repo.React(ctx, biz, bizId, uid, reaction)
+	if err != nil {
+		return err
+	}
+	i.onReactionChanged(ctx, biz, bizId, uid, old, reaction)
+	return nil
+}
+
+func (i *interactiveService) CancelReaction(ctx context.Context, biz string, bizId, uid int64) error {
+	return i.cancelReaction(ctx, biz, bizId, uid, domain.ReactionNone)
+}
+
+func (i *interactiveService) cancelReaction(ctx context.Context,
+	biz string, bizId, uid int64, reaction domain.ReactionType) error {
+	old, err := i.repo.CancelReaction(ctx, biz, bizId, uid, reaction)
+	if err != nil {
+		return err
+	}
+	i.onReactionChanged(ctx, biz, bizId, uid, old, domain.ReactionNone)
+	return nil
+}
+
+// onReactionChanged 下游目前只关心点赞，所以只有涉及点赞的变化才发消息
+func (i *interactiveService) onReactionChanged(ctx context.Context,
+	biz string, bizId, uid int64, old, cur domain.ReactionType) {
+	if old == cur {
+		return
+	}
+	if old == domain.ReactionLike {
+		i.produceLikeEvent(ctx, events.LikeEvent{Biz: biz, BizId: bizId, Uid: uid, Liked: false})
+	}
+	if cur == domain.ReactionLike {
+		i.produceLikeEvent(ctx, events.LikeEvent{Biz: biz, BizId: bizId, Uid: uid, Liked: true})
+	}
+}
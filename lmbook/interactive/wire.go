@@ -3,8 +3,10 @@
 package main
 
 import (
+	"basic-go/lmbook/interactive/events"
 	"basic-go/lmbook/interactive/grpc"
 	"basic-go/lmbook/interactive/ioc"
+	"basic-go/lmbook/interactive/job"
 	repository2 "basic-go/lmbook/interactive/repository"
 	cache2 "basic-go/lmbook/interactive/repository/cache"
 	dao2 "basic-go/lmbook/interactive/repository/dao"
@@ -22,9 +24,17 @@ var thirdPartySet = wire.NewSet(ioc.InitSrcDB,
 	ioc.InitRedis)
 
 var interactiveSvcSet = wire.NewSet(dao2.NewGORMInteractiveDAO,
+	dao2.NewGORMCollectionDAO,
+	dao2.NewGORMDeltaDAO,
 	cache2.NewRedisInteractiveCache,
-	repository2.NewCachedInteractiveRepository,
+	cache2.NewRedisDeltaCache,
+	ioc.InitCntDeltaBuffer,
+	ioc.InitBloomCache,
+	repository2.NewBloomInteractiveRepository,
+	wire.Bind(new(repository2.InteractiveRepository), new(*repository2.BloomInteractiveRepository)),
+	repository2.NewCollectionRepository,
 	service2.NewInteractiveService,
+	events.NewSaramaSyncProducer,
 )
 
 func InitApp() *App {
@@ -37,6 +47,9 @@ func InitApp() *App {
 		ioc.InitConsumers,
 		ioc.NewGrpcxServer,
 		ioc.InitGinxServer,
+		job.NewBloomRebuildJob,
+		ioc.InitPopularitySyncJob,
+		ioc.InitJobs,
 		wire.Struct(new(App), "*"),
 	)
 	return new(App)
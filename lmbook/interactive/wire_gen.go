@@ -7,8 +7,10 @@
 package main
 
 import (
+	"basic-go/lmbook/interactive/events"
 	"basic-go/lmbook/interactive/grpc"
 	"basic-go/lmbook/interactive/ioc"
+	"basic-go/lmbook/interactive/job"
 	"basic-go/lmbook/interactive/repository"
 	"basic-go/lmbook/interactive/repository/cache"
 	"basic-go/lmbook/interactive/repository/dao"
@@ -37,15 +39,27 @@ func InitApp() *App {
 	interactiveDAO := dao.NewGORMInteractiveDAO(db)
 	cmdable := ioc.InitRedis()
 	interactiveCache := cache.NewRedisInteractiveCache(cmdable)
-	interactiveRepository := repository.NewCachedInteractiveRepository(interactiveDAO, interactiveCache, loggerV1)
-	interactiveService := service.NewInteractiveService(interactiveRepository, loggerV1)
+	deltaCache := cache.NewRedisDeltaCache(cmdable)
+	deltaDAO := dao.NewGORMDeltaDAO(db)
+	cntDeltaBuffer := ioc.InitCntDeltaBuffer(deltaCache, deltaDAO, loggerV1)
+	bloomCache := ioc.InitBloomCache(cmdable)
+	bloomInteractiveRepository := repository.NewBloomInteractiveRepository(interactiveDAO, interactiveCache, cntDeltaBuffer, bloomCache, loggerV1)
+	collectionDAO := dao.NewGORMCollectionDAO(db)
+	collectionRepository := repository.NewCollectionRepository(collectionDAO)
+	eventsProducer := events.NewSaramaSyncProducer(syncProducer)
+	interactiveService := service.NewInteractiveService(bloomInteractiveRepository, collectionRepository, eventsProducer, loggerV1)
 	interactiveServiceServer := grpc.NewInteractiveServiceServer(interactiveService)
 	server := ioc.NewGrpcxServer(interactiveServiceServer, loggerV1)
 	ginxServer := ioc.InitGinxServer(loggerV1, srcDB, dstDB, doubleWritePool, producer)
+	bloomRebuildJob := job.NewBloomRebuildJob(bloomInteractiveRepository, loggerV1)
+	popularitySyncJob := ioc.InitPopularitySyncJob(bloomInteractiveRepository, eventsProducer, loggerV1)
+	cron := ioc.InitJobs(loggerV1, bloomRebuildJob, popularitySyncJob)
 	app := &App{
 		consumers:   v,
 		server:      server,
 		adminServer: ginxServer,
+		deltaBuffer: cntDeltaBuffer,
+		cron:        cron,
 	}
 	return app
 }
@@ -54,4 +68,4 @@ func InitApp() *App {
 
 var thirdPartySet = wire.NewSet(ioc.InitSrcDB, ioc.InitDstDB, ioc.InitDoubleWritePool, ioc.InitBizDB, ioc.InitLogger, ioc.InitSaramaClient, ioc.InitSaramaSyncProducer, ioc.InitRedis)
 
-var interactiveSvcSet = wire.NewSet(dao.NewGORMInteractiveDAO, cache.NewRedisInteractiveCache, repository.NewCachedInteractiveRepository, service.NewInteractiveService)
+var interactiveSvcSet = wire.NewSet(dao.NewGORMInteractiveDAO, dao.NewGORMCollectionDAO, dao.NewGORMDeltaDAO, cache.NewRedisInteractiveCache, cache.NewRedisDeltaCache, ioc.InitCntDeltaBuffer, ioc.InitBloomCache, repository.NewBloomInteractiveRepository, wire.Bind(new(repository.InteractiveRepository), new(*repository.BloomInteractiveRepository)), repository.NewCollectionRepository, service.NewInteractiveService, events.NewSaramaSyncProducer)
@@ -0,0 +1,81 @@
+package cursorx
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+)
+
+var ErrInvalidCursor = errors.New("非法的游标")
+
+// Cursor keyset 分页的游标，按照 (Time, Id) 倒序翻页。
+// 相比 OFFSET，翻得再深也只需要扫描 limit 行，
+// 并且翻页的过程中有新数据插入，也不会出现重复或者遗漏。
+// Time 一般是 ctime，如果列表按照更新时间排序，那就是 utime
+type Cursor struct {
+	Time int64 `json:"t"`
+	Id   int64 `json:"i"`
+}
+
+// IsZero 零值游标代表从第一页开始
+func (c Cursor) IsZero() bool {
+	return c.Time == 0 && c.Id == 0
+}
+
+// Encode 编码成不透明的字符串，前端原样带回来就可以
+// 零值游标编码成空字符串，代表没有下一页了
+func (c Cursor) Encode() string {
+	if c.IsZero() {
+		return ""
+	}
+	// 两个 int64 序列化不可能出错
+	val, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(val)
+}
+
+// Decode 解析前端传过来的游标，空字符串代表第一页
+func Decode(token string) (Cursor, error) {
+	var c Cursor
+	if token == "" {
+		return c, nil
+	}
+	val, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+	err = json.Unmarshal(val, &c)
+	if err != nil {
+		return c, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+	if c.Time < 0 || c.Id < 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// Scope 在查询上加上游标条件、排序和 limit，limit 小于等于 0 代表不限制。
+// timeCol 和 idCol 上最好有联合索引，比如说 (uid, ctime, id)
+func Scope(c Cursor, timeCol, idCol string, limit int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if !c.IsZero() {
+			db = db.Where(fmt.Sprintf("(%s < ? OR (%s = ? AND %s < ?))",
+				timeCol, timeCol, idCol), c.Time, c.Time, c.Id)
+		}
+		db = db.Order(timeCol + " DESC").Order(idCol + " DESC")
+		if limit > 0 {
+			db = db.Limit(limit)
+		}
+		return db
+	}
+}
+
+// Next 根据这一页的数据计算下一页的游标。
+// 不满一页说明已经到底了，返回空字符串
+func Next[T any](list []T, limit int, cursor func(t T) Cursor) string {
+	if limit <= 0 || len(list) < limit {
+		return ""
+	}
+	return cursor(list[len(list)-1]).Encode()
+}
@@ -0,0 +1,60 @@
+package cursorx
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	testCases := []struct {
+		name  string
+		token string
+
+		wantCursor Cursor
+		wantErr    error
+	}{
+		{
+			name:  "空字符串是第一页",
+			token: "",
+		},
+		{
+			name:       "正常编码解码",
+			token:      Cursor{Time: 1700000000000, Id: 123}.Encode(),
+			wantCursor: Cursor{Time: 1700000000000, Id: 123},
+		},
+		{
+			name:    "不是 base64",
+			token:   "abc$%^",
+			wantErr: ErrInvalidCursor,
+		},
+		{
+			name:    "不是 JSON",
+			token:   "YWJj",
+			wantErr: ErrInvalidCursor,
+		},
+		{
+			name:    "负数",
+			token:   Cursor{Time: -1, Id: 1}.Encode(),
+			wantErr: ErrInvalidCursor,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := Decode(tc.token)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantCursor, c)
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	toCursor := func(id int64) Cursor {
+		return Cursor{Time: id * 10, Id: id}
+	}
+	assert.Equal(t, "", Next([]int64{3, 2}, 3, toCursor))
+	token := Next([]int64{3, 2, 1}, 3, toCursor)
+	c, err := Decode(token)
+	require.NoError(t, err)
+	assert.Equal(t, Cursor{Time: 10, Id: 1}, c)
+}
@@ -0,0 +1,149 @@
+package sensitive
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Matcher Aho-Corasick 自动机，一次扫描就能找出所有敏感词
+// 构造好之后就是只读的，可以并发使用。忽略大小写，按照 rune 匹配
+type Matcher struct {
+	nodes []acNode
+}
+
+type acNode struct {
+	next map[rune]int32
+	fail int32
+	// out 沿着 fail 链最近的一个词尾节点，-1 表示没有
+	out int32
+	// wordLen 以这个节点结尾的词的长度，0 表示不是词尾
+	wordLen int32
+}
+
+// Hit 一次命中，Start 和 End 都是 rune 下标，左闭右开
+type Hit struct {
+	Word  string
+	Start int
+	End   int
+}
+
+func NewMatcher(words []string) *Matcher {
+	m := &Matcher{
+		nodes: []acNode{{out: -1}},
+	}
+	for _, w := range words {
+		m.insert([]rune(strings.ToLower(strings.TrimSpace(w))))
+	}
+	m.build()
+	return m
+}
+
+func (m *Matcher) insert(word []rune) {
+	if len(word) == 0 {
+		return
+	}
+	cur := int32(0)
+	for _, r := range word {
+		nxt, ok := m.nodes[cur].next[r]
+		if !ok {
+			if m.nodes[cur].next == nil {
+				m.nodes[cur].next = make(map[rune]int32, 1)
+			}
+			nxt = int32(len(m.nodes))
+			m.nodes = append(m.nodes, acNode{out: -1})
+			m.nodes[cur].next[r] = nxt
+		}
+		cur = nxt
+	}
+	m.nodes[cur].wordLen = int32(len(word))
+}
+
+// build 按照层次遍历计算 fail 指针和 out 指针
+func (m *Matcher) build() {
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for f > 0 {
+				if _, ok := m.nodes[f].next[r]; ok {
+					break
+				}
+				f = m.nodes[f].fail
+			}
+			if nxt, ok := m.nodes[f].next[r]; ok && nxt != child {
+				m.nodes[child].fail = nxt
+			}
+			fn := m.nodes[child].fail
+			if m.nodes[fn].wordLen > 0 {
+				m.nodes[child].out = fn
+			} else {
+				m.nodes[child].out = m.nodes[fn].out
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// FindAll 返回所有命中，包括互相重叠的
+func (m *Matcher) FindAll(text string) []Hit {
+	runes := []rune(text)
+	var hits []Hit
+	cur := int32(0)
+	for i, r := range runes {
+		r = unicode.ToLower(r)
+		for {
+			if nxt, ok := m.nodes[cur].next[r]; ok {
+				cur = nxt
+				break
+			}
+			if cur == 0 {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		for n := cur; n > 0; n = m.nodes[n].out {
+			if l := int(m.nodes[n].wordLen); l > 0 {
+				hits = append(hits, Hit{
+					Word:  string(runes[i+1-l : i+1]),
+					Start: i + 1 - l,
+					End:   i + 1,
+				})
+			}
+		}
+	}
+	return hits
+}
+
+func (m *Matcher) Find(text string) []string {
+	hits := m.FindAll(text)
+	res := make([]string, 0, len(hits))
+	seen := make(map[string]struct{}, len(hits))
+	for _, h := range hits {
+		w := strings.ToLower(h.Word)
+		if _, ok := seen[w]; ok {
+			continue
+		}
+		seen[w] = struct{}{}
+		res = append(res, w)
+	}
+	return res
+}
+
+func (m *Matcher) Replace(text string, mask rune) string {
+	hits := m.FindAll(text)
+	if len(hits) == 0 {
+		return text
+	}
+	runes := []rune(text)
+	for _, h := range hits {
+		for i := h.Start; i < h.End; i++ {
+			runes[i] = mask
+		}
+	}
+	return string(runes)
+}
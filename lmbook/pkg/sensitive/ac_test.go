@@ -0,0 +1,73 @@
+package sensitive
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMatcher(t *testing.T) {
+	testCases := []struct {
+		name  string
+		words []string
+		text  string
+
+		wantWords   []string
+		wantReplace string
+	}{
+		{
+			name:        "没有命中",
+			words:       []string{"赌博", "代开发票"},
+			text:        "今天天气不错",
+			wantWords:   []string{},
+			wantReplace: "今天天气不错",
+		},
+		{
+			name:        "命中多个，去重",
+			words:       []string{"赌博", "代开发票"},
+			text:        "赌博和代开发票，还是赌博",
+			wantWords:   []string{"赌博", "代开发票"},
+			wantReplace: "**和****，还是**",
+		},
+		{
+			name:        "互相重叠",
+			words:       []string{"he", "she", "his", "hers"},
+			text:        "ushers",
+			wantWords:   []string{"she", "he", "hers"},
+			wantReplace: "u*****",
+		},
+		{
+			name:        "一个词是另外一个词的后缀",
+			words:       []string{"abcd", "bc"},
+			text:        "abce",
+			wantWords:   []string{"bc"},
+			wantReplace: "a**e",
+		},
+		{
+			name:        "忽略大小写",
+			words:       []string{"Spam"},
+			text:        "buy SPAM now",
+			wantWords:   []string{"spam"},
+			wantReplace: "buy **** now",
+		},
+		{
+			name:        "空词库",
+			text:        "随便写点什么",
+			wantWords:   []string{},
+			wantReplace: "随便写点什么",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := NewMatcher(tc.words)
+			assert.Equal(t, tc.wantWords, m.Find(tc.text))
+			assert.Equal(t, tc.wantReplace, m.Replace(tc.text, '*'))
+		})
+	}
+}
+
+func TestACFilter_Reload(t *testing.T) {
+	f := NewACFilter([]string{"旧词"})
+	assert.Equal(t, []string{"旧词"}, f.Find("旧词新词"))
+	f.Reload([]string{"新词"})
+	assert.Equal(t, []string{"新词"}, f.Find("旧词新词"))
+}
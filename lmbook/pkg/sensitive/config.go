@@ -0,0 +1,28 @@
+package sensitive
+
+import (
+	"basic-go/lmbook/pkg/logger"
+	"github.com/spf13/viper"
+)
+
+// InitFilter 按照 sensitive 配置创建 Filter，article 和 comment 共用
+//
+//	sensitive:
+//	  # 词库文件的路径，修改之后自动重新加载
+//	  dict: "config/sensitive.yaml"
+func InitFilter(l logger.LoggerV1) Filter {
+	type Config struct {
+		Dict string `yaml:"dict"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("sensitive", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	f := NewACFilter(nil)
+	err = WatchDict(cfg.Dict, f, l)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
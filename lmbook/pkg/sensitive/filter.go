@@ -0,0 +1,74 @@
+package sensitive
+
+import (
+	"basic-go/lmbook/pkg/logger"
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+	"sync/atomic"
+)
+
+// ACFilter 可以热更新词库的 Filter
+// 更新词库的时候整个换掉 Matcher，正在匹配的请求还是用旧的，不需要加锁
+type ACFilter struct {
+	m atomic.Pointer[Matcher]
+}
+
+func NewACFilter(words []string) *ACFilter {
+	f := &ACFilter{}
+	f.Reload(words)
+	return f
+}
+
+// Reload 用新的词库替换旧的
+func (f *ACFilter) Reload(words []string) {
+	f.m.Store(NewMatcher(words))
+}
+
+func (f *ACFilter) Find(text string) []string {
+	return f.m.Load().Find(text)
+}
+
+func (f *ACFilter) Replace(text string, mask rune) string {
+	return f.m.Load().Replace(text, mask)
+}
+
+// WatchDict 从词库文件加载敏感词，文件变更之后自动重新加载
+// 词库文件的格式是：
+//
+//	words:
+//	  - 敏感词1
+//	  - 敏感词2
+//
+// 重新加载失败的时候保留旧的词库
+func WatchDict(path string, f *ACFilter, l logger.LoggerV1) error {
+	v := viper.New()
+	v.SetConfigFile(path)
+	load := func() error {
+		var dict struct {
+			Words []string `yaml:"words"`
+		}
+		if err := v.Unmarshal(&dict); err != nil {
+			return err
+		}
+		f.Reload(dict.Words)
+		l.Info("加载敏感词库",
+			logger.String("path", path),
+			logger.Int64("cnt", int64(len(dict.Words))))
+		return nil
+	}
+	if err := v.ReadInConfig(); err != nil {
+		return err
+	}
+	if err := load(); err != nil {
+		return err
+	}
+	v.OnConfigChange(func(in fsnotify.Event) {
+		if err := load(); err != nil {
+			l.Error("重新加载敏感词库失败",
+				logger.String("path", path),
+				logger.Error(err))
+		}
+	})
+	v.WatchConfig()
+	return nil
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./types.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./types.go -package=sensitivemocks -destination=./mocks/filter.mock.go Filter
+//
+// Package sensitivemocks is a generated GoMock package.
+package sensitivemocks
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFilter is a mock of Filter interface.
+type MockFilter struct {
+	ctrl     *gomock.Controller
+	recorder *MockFilterMockRecorder
+}
+
+// MockFilterMockRecorder is the mock recorder for MockFilter.
+type MockFilterMockRecorder struct {
+	mock *MockFilter
+}
+
+// NewMockFilter creates a new mock instance.
+func NewMockFilter(ctrl *gomock.Controller) *MockFilter {
+	mock := &MockFilter{ctrl: ctrl}
+	mock.recorder = &MockFilterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFilter) EXPECT() *MockFilterMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockFilter) Find(text string) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", text)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockFilterMockRecorder) Find(text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockFilter)(nil).Find), text)
+}
+
+// Replace mocks base method.
+func (m *MockFilter) Replace(text string, mask rune) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", text, mask)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockFilterMockRecorder) Replace(text, mask any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockFilter)(nil).Replace), text, mask)
+}
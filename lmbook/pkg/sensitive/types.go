@@ -0,0 +1,10 @@
+package sensitive
+
+//go:generate mockgen -source=./types.go -package=sensitivemocks -destination=./mocks/filter.mock.go Filter
+type Filter interface {
+	// Find 返回 text 里面出现过的敏感词，去重，按照第一次出现的顺序
+	// 没有敏感词就返回空切片
+	Find(text string) []string
+	// Replace 把敏感词的每一个字符都替换成 mask
+	Replace(text string, mask rune) string
+}
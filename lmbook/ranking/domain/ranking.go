@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Interactive 计算热度需要用到的交互数据
+type Interactive struct {
+	BizId      int64
+	ReadCnt    int64
+	LikeCnt    int64
+	CollectCnt int64
+}
+
+// RankingList 某一个打分策略计算出来的榜单
+// 缓存的时候策略名字和榜单放在一起，方便对比不同策略的效果
+type RankingList struct {
+	Board    string
+	Scorer   string
+	Articles []Article
+}
+
+const (
+	tagBoardPrefix    = "tag:"
+	authorBoardPrefix = "author:"
+)
+
+// Board 一个榜单，比如日榜、周榜、月榜，或者某个标签、某个作者的榜单
+type Board struct {
+	Key string
+	// N 榜单的长度
+	N int
+	// Window 只有在这个时间窗口内更新过的文章才能上榜。
+	// 为 0 的是总榜，所有的文章都能上榜，每次计算都要扫描全部的文章
+	Window time.Duration
+}
+
+// TagBoardKey 标签榜单的 key，比如 tag:Go
+func TagBoardKey(tag string) string {
+	return tagBoardPrefix + tag
+}
+
+// TagOfBoard 如果是标签榜单，返回对应的标签
+func TagOfBoard(key string) (string, bool) {
+	if !strings.HasPrefix(key, tagBoardPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(key, tagBoardPrefix), true
+}
+
+// AuthorBoardKey 作者榜单的 key，比如 author:123
+func AuthorBoardKey(author int64) string {
+	return authorBoardPrefix + strconv.FormatInt(author, 10)
+}
+
+// AuthorOfBoard 如果是作者榜单，返回对应的作者
+func AuthorOfBoard(key string) (int64, bool) {
+	if !strings.HasPrefix(key, authorBoardPrefix) {
+		return 0, false
+	}
+	author, err := strconv.ParseInt(strings.TrimPrefix(key, authorBoardPrefix), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return author, true
+}
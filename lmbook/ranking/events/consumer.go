@@ -0,0 +1,103 @@
+package events
+
+import (
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/pkg/saramax"
+	"basic-go/lmbook/ranking/service"
+	"context"
+	"github.com/IBM/sarama"
+	"time"
+)
+
+const (
+	topicReadEvent = "article_read_event"
+	topicLikeEvent = "interactive_like_event"
+)
+
+// ReadEvent 文章服务发出来的阅读事件
+type ReadEvent struct {
+	Aid int64
+	Uid int64
+}
+
+// LikeEvent 互动服务发出来的点赞事件
+type LikeEvent struct {
+	Biz   string `json:"biz"`
+	BizId int64  `json:"biz_id"`
+	Uid   int64  `json:"uid"`
+	Liked bool   `json:"liked"`
+}
+
+// InteractiveEventConsumer 消费阅读和点赞事件，增量更新热度
+type InteractiveEventConsumer struct {
+	client sarama.Client
+	svc    *service.IncrementalRankingService
+	l      logger.LoggerV1
+}
+
+func NewInteractiveEventConsumer(client sarama.Client,
+	svc *service.IncrementalRankingService,
+	l logger.LoggerV1) *InteractiveEventConsumer {
+	return &InteractiveEventConsumer{
+		client: client,
+		svc:    svc,
+		l:      l,
+	}
+}
+
+func (c *InteractiveEventConsumer) Start() error {
+	readCg, err := sarama.NewConsumerGroupFromClient("ranking_read", c.client)
+	if err != nil {
+		return err
+	}
+	likeCg, err := sarama.NewConsumerGroupFromClient("ranking_like", c.client)
+	if err != nil {
+		return err
+	}
+	go func() {
+		// 阅读事件量大，批量消费，同一篇文章的阅读合并成一次更新
+		er := readCg.Consume(context.Background(),
+			[]string{topicReadEvent},
+			saramax.NewBatchHandler[ReadEvent](c.l, c.ConsumeRead))
+		if er != nil {
+			c.l.Error("退出了消费循环异常", logger.Error(er))
+		}
+	}()
+	go func() {
+		er := likeCg.Consume(context.Background(),
+			[]string{topicLikeEvent},
+			saramax.NewHandler[LikeEvent](c.l, c.ConsumeLike))
+		if er != nil {
+			c.l.Error("退出了消费循环异常", logger.Error(er))
+		}
+	}()
+	return nil
+}
+
+func (c *InteractiveEventConsumer) ConsumeRead(msgs []*sarama.ConsumerMessage,
+	evts []ReadEvent) error {
+	cnts := make(map[int64]int64, len(evts))
+	for _, evt := range evts {
+		cnts[evt.Aid]++
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for aid, cnt := range cnts {
+		err := c.svc.OnRead(ctx, aid, cnt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *InteractiveEventConsumer) ConsumeLike(msg *sarama.ConsumerMessage,
+	evt LikeEvent) error {
+	// 热榜只关心文章
+	if evt.Biz != "article" {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	return c.svc.OnLike(ctx, evt.BizId, evt.Liked)
+}
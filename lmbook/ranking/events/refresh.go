@@ -0,0 +1,50 @@
+package events
+
+import (
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/ranking/repository"
+	"basic-go/lmbook/ranking/repository/cache"
+	"context"
+	"time"
+)
+
+// RefreshConsumer 收到热榜刷新的通知之后，从 Redis 重新加载本地缓存，
+// 这样所有的实例几乎在同一时刻切换到新的榜单
+type RefreshConsumer struct {
+	refresher *cache.RedisRankingRefresher
+	repo      repository.RankingRepository
+	l         logger.LoggerV1
+}
+
+func NewRefreshConsumer(refresher *cache.RedisRankingRefresher,
+	repo repository.RankingRepository,
+	l logger.LoggerV1) *RefreshConsumer {
+	return &RefreshConsumer{
+		refresher: refresher,
+		repo:      repo,
+		l:         l,
+	}
+}
+
+func (c *RefreshConsumer) Start() error {
+	ch, err := c.refresher.Subscribe(context.Background())
+	if err != nil {
+		return err
+	}
+	go func() {
+		for msg := range ch {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			er := c.repo.Reload(ctx, msg.Board, msg.Scorer)
+			cancel()
+			if er != nil {
+				// 本地缓存过期之后还会再去 Redis 加载，这里只记录日志
+				c.l.Error("刷新热榜本地缓存失败",
+					logger.String("board", msg.Board),
+					logger.String("scorer", msg.Scorer),
+					logger.Error(er))
+			}
+		}
+		c.l.Warn("热榜刷新通知的订阅退出了")
+	}()
+	return nil
+}
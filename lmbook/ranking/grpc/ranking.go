@@ -5,7 +5,10 @@ import (
 	"basic-go/lmbook/ranking/domain"
 	"basic-go/lmbook/ranking/service"
 	"context"
+	"errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -25,24 +28,34 @@ func (r *RankingServiceServer) Register(server grpc.ServiceRegistrar) {
 }
 
 func (r *RankingServiceServer) RankTopN(ctx context.Context, request *rankingv1.RankTopNRequest) (*rankingv1.RankTopNResponse, error) {
-	err := r.svc.RankTopN(ctx)
-	return &rankingv1.RankTopNResponse{}, err
+	err := r.svc.RankTopN(ctx, request.GetBoard())
+	return &rankingv1.RankTopNResponse{}, toStatusErr(err)
 }
 
 func (r *RankingServiceServer) TopN(ctx context.Context, request *rankingv1.TopNRequest) (*rankingv1.TopNResponse, error) {
-	domainArticles, err := r.svc.TopN(ctx)
+	list, err := r.svc.TopN(ctx, request.GetBoard(), request.GetScorer())
 	if err != nil {
-		return &rankingv1.TopNResponse{}, err
+		return &rankingv1.TopNResponse{}, toStatusErr(err)
 	}
-	res := make([]*rankingv1.Article, 0, len(domainArticles))
-	for _, art := range domainArticles {
+	res := make([]*rankingv1.Article, 0, len(list.Articles))
+	for _, art := range list.Articles {
 		res = append(res, convertToV(art))
 	}
 	return &rankingv1.TopNResponse{
 		Articles: res,
+		Scorer:   list.Scorer,
+		Board:    list.Board,
 	}, nil
 }
 
+// toStatusErr 榜单或者打分策略不存在是调用方的问题
+func toStatusErr(err error) error {
+	if errors.Is(err, service.ErrUnknownBoard) || errors.Is(err, service.ErrUnknownScorer) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
 func convertToV(domainArticle domain.Article) *rankingv1.Article {
 	return &rankingv1.Article{
 		Id:      domainArticle.Id,
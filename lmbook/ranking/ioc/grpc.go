@@ -2,14 +2,19 @@ package ioc
 
 import (
 	"basic-go/lmbook/pkg/grpcx"
+	"basic-go/lmbook/pkg/logger"
 	grpc2 "basic-go/lmbook/ranking/grpc"
 	"github.com/spf13/viper"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc"
 )
 
-func InitGRPCxServer(rankingServer *grpc2.RankingServiceServer) *grpcx.Server {
+func InitGRPCxServer(rankingServer *grpc2.RankingServiceServer,
+	ecli *clientv3.Client,
+	l logger.LoggerV1) *grpcx.Server {
 	type Config struct {
-		Addr string `yaml:"addr"`
+		Port    int   `yaml:"port"`
+		EtcdTTL int64 `yaml:"etcdTTL"`
 	}
 	var cfg Config
 	err := viper.UnmarshalKey("grpc.server", &cfg)
@@ -19,7 +24,11 @@ func InitGRPCxServer(rankingServer *grpc2.RankingServiceServer) *grpcx.Server {
 	server := grpc.NewServer()
 	rankingServer.Register(server)
 	return &grpcx.Server{
-		Server: server,
-		Addr:   cfg.Addr,
+		Server:     server,
+		Port:       cfg.Port,
+		Name:       "ranking",
+		L:          l,
+		EtcdTTL:    cfg.EtcdTTL,
+		EtcdClient: ecli,
 	}
 }
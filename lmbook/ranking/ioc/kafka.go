@@ -0,0 +1,35 @@
+package ioc
+
+import (
+	"basic-go/lmbook/pkg/saramax"
+	"basic-go/lmbook/ranking/events"
+	"github.com/IBM/sarama"
+	"github.com/spf13/viper"
+)
+
+func InitSaramaClient() sarama.Client {
+	type Config struct {
+		Addr []string `yaml:"addr"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("kafka", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	scfg := sarama.NewConfig()
+	client, err := sarama.NewClient(cfg.Addr, scfg)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// InitConsumers 只有增量模式才需要消费阅读和点赞事件，
+// 刷新本地缓存的通知则是所有实例都要订阅
+func InitConsumers(c *events.InteractiveEventConsumer,
+	refresh *events.RefreshConsumer) []saramax.Consumer {
+	if viper.GetString("ranking.mode") != modeIncremental {
+		return []saramax.Consumer{refresh}
+	}
+	return []saramax.Consumer{c, refresh}
+}
@@ -0,0 +1,120 @@
+package ioc
+
+import (
+	articlev1 "basic-go/lmbook/api/proto/gen/article/v1"
+	intrv1 "basic-go/lmbook/api/proto/gen/intr/v1"
+	tagv1 "basic-go/lmbook/api/proto/gen/tag/v1"
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/ranking/domain"
+	"basic-go/lmbook/ranking/repository"
+	"basic-go/lmbook/ranking/service"
+	"errors"
+	"fmt"
+	"github.com/spf13/viper"
+	"time"
+)
+
+const modeIncremental = "incremental"
+
+func InitIncrementalRankingService(
+	intrSvc intrv1.InteractiveServiceClient,
+	artSvc articlev1.ArticleServiceClient,
+	tagSvc tagv1.TagServiceClient,
+	repo repository.RankingRepository,
+	scoreRepo repository.RankingScoreRepository,
+	scorers []service.Scorer,
+	boards service.Boards,
+	l logger.LoggerV1) *service.IncrementalRankingService {
+	type Config struct {
+		ReadWeight        float64       `yaml:"readWeight"`
+		LikeWeight        float64       `yaml:"likeWeight"`
+		HalfLife          time.Duration `yaml:"halfLife"`
+		MinScore          float64       `yaml:"minScore"`
+		Capacity          int64         `yaml:"capacity"`
+		ReconcileInterval time.Duration `yaml:"reconcileInterval"`
+	}
+	cfg := Config{
+		ReadWeight:        1,
+		LikeWeight:        5,
+		HalfLife:          time.Hour * 24,
+		MinScore:          0.1,
+		Capacity:          10000,
+		ReconcileInterval: time.Hour,
+	}
+	err := viper.UnmarshalKey("ranking.incremental", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return service.NewIncrementalRankingService(intrSvc, artSvc, tagSvc, repo, scoreRepo,
+		scorers, boards, service.IncrementalConfig{
+			ReadWeight:        cfg.ReadWeight,
+			LikeWeight:        cfg.LikeWeight,
+			HalfLife:          cfg.HalfLife,
+			MinScore:          cfg.MinScore,
+			Capacity:          cfg.Capacity,
+			ReconcileInterval: cfg.ReconcileInterval,
+		}, l)
+}
+
+// InitRankingService ranking.mode 为 incremental 的时候用增量计算，否则还是全量计算
+func InitRankingService(
+	intrSvc intrv1.InteractiveServiceClient,
+	artSvc articlev1.ArticleServiceClient,
+	tagSvc tagv1.TagServiceClient,
+	repo repository.RankingRepository,
+	scorers []service.Scorer,
+	boards service.Boards,
+	incr *service.IncrementalRankingService,
+	l logger.LoggerV1) service.RankingService {
+	if viper.GetString("ranking.mode") == modeIncremental {
+		return incr
+	}
+	return service.NewBatchRankingService(intrSvc, artSvc, tagSvc, repo, scorers, boards, l)
+}
+
+// InitBoards 第一个固定榜单是默认榜单，tag.n、author.n 大于 0 的时候每个标签、每个作者都有一个榜单。
+// 固定榜单不配置 window 就是总榜，代价是全量计算和校准的时候要扫描全部的文章。
+// 默认榜单在增量模式下是靠会衰减的 ZSET 维护的，标签、作者榜单数量太多，所以这几个都必须配置 window
+func InitBoards() service.Boards {
+	type BoardConfig struct {
+		Key    string        `yaml:"key"`
+		N      int           `yaml:"n"`
+		Window time.Duration `yaml:"window"`
+	}
+	type Config struct {
+		Fixed  []BoardConfig `yaml:"fixed"`
+		Tag    BoardConfig   `yaml:"tag"`
+		Author BoardConfig   `yaml:"author"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("ranking.boards", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	toDomain := func(b BoardConfig) domain.Board {
+		return domain.Board{Key: b.Key, N: b.N, Window: b.Window}
+	}
+	res := service.Boards{
+		Fixed:  make([]domain.Board, 0, len(cfg.Fixed)),
+		Tag:    toDomain(cfg.Tag),
+		Author: toDomain(cfg.Author),
+	}
+	for i, b := range cfg.Fixed {
+		_, isTag := domain.TagOfBoard(b.Key)
+		_, isAuthor := domain.AuthorOfBoard(b.Key)
+		if isTag || isAuthor || b.Key == "" || b.N <= 0 || b.Window < 0 {
+			panic(fmt.Errorf("榜单配置错误 %s", b.Key))
+		}
+		if i == 0 && b.Window == 0 {
+			panic(fmt.Errorf("默认榜单 %s 没有配置 window", b.Key))
+		}
+		res.Fixed = append(res.Fixed, toDomain(b))
+	}
+	if cfg.Tag.N > 0 && cfg.Tag.Window <= 0 {
+		panic(errors.New("标签榜单没有配置 window"))
+	}
+	if cfg.Author.N > 0 && cfg.Author.Window <= 0 {
+		panic(errors.New("作者榜单没有配置 window"))
+	}
+	return res
+}
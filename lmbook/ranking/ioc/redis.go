@@ -1,14 +1,47 @@
 package ioc
 
 import (
+	"basic-go/lmbook/ranking/repository/cache"
 	"github.com/redis/go-redis/v9"
 	"github.com/spf13/viper"
+	"time"
 )
 
-func InitRedis() redis.Cmdable {
+func InitRedisClient() redis.UniversalClient {
 	// 这里演示读取特定的某个字段
 	cmd := redis.NewClient(&redis.Options{
 		Addr: viper.GetString("redis.addr"),
 	})
 	return cmd
 }
+
+func InitRedis(client redis.UniversalClient) redis.Cmdable {
+	return client
+}
+
+type rankingCacheConfig struct {
+	// Expiration Redis 缓存的过期时间
+	Expiration time.Duration `yaml:"expiration"`
+	// LocalExpiration 本地缓存的过期时间
+	LocalExpiration time.Duration `yaml:"localExpiration"`
+}
+
+func rankingCacheCfg() rankingCacheConfig {
+	cfg := rankingCacheConfig{
+		Expiration:      time.Minute * 5,
+		LocalExpiration: time.Minute*3 + time.Second*30,
+	}
+	err := viper.UnmarshalKey("ranking.cache", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+func InitRedisRankingCache(client redis.Cmdable) *cache.RedisRankingCache {
+	return cache.NewRedisRankingCache(client, rankingCacheCfg().Expiration)
+}
+
+func InitRankingLocalCache() *cache.RankingLocalCache {
+	return cache.NewRankingLocalCache(rankingCacheCfg().LocalExpiration)
+}
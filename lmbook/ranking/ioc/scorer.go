@@ -0,0 +1,64 @@
+package ioc
+
+import (
+	"basic-go/lmbook/ranking/service"
+	"fmt"
+	"github.com/spf13/viper"
+	"time"
+)
+
+// InitScorers 第一个是默认策略，candidates 里面的策略会同时计算，用于 A/B 对比
+func InitScorers() []service.Scorer {
+	type GravityConfig struct {
+		Factor float64 `yaml:"factor"`
+	}
+	type LogConfig struct {
+		Period time.Duration `yaml:"period"`
+	}
+	type WeightedConfig struct {
+		Read    float64 `yaml:"read"`
+		Like    float64 `yaml:"like"`
+		Collect float64 `yaml:"collect"`
+		Factor  float64 `yaml:"factor"`
+	}
+	type Config struct {
+		Scorer     string         `yaml:"scorer"`
+		Candidates []string       `yaml:"candidates"`
+		Gravity    GravityConfig  `yaml:"gravity"`
+		Log        LogConfig      `yaml:"log"`
+		Weighted   WeightedConfig `yaml:"weighted"`
+	}
+	cfg := Config{
+		Scorer:  service.ScorerGravity,
+		Gravity: GravityConfig{Factor: 1.5},
+		Log:     LogConfig{Period: time.Second * 45000},
+		Weighted: WeightedConfig{
+			Read: 1, Like: 5, Collect: 10, Factor: 1.5,
+		},
+	}
+	err := viper.UnmarshalKey("ranking", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	names := append([]string{cfg.Scorer}, cfg.Candidates...)
+	res := make([]service.Scorer, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		switch name {
+		case service.ScorerGravity:
+			res = append(res, service.NewGravityScorer(cfg.Gravity.Factor))
+		case service.ScorerLog:
+			res = append(res, service.NewLogScorer(cfg.Log.Period))
+		case service.ScorerWeighted:
+			res = append(res, service.NewWeightedScorer(cfg.Weighted.Read,
+				cfg.Weighted.Like, cfg.Weighted.Collect, cfg.Weighted.Factor))
+		default:
+			panic(fmt.Errorf("未知的打分策略 %s", name))
+		}
+	}
+	return res
+}
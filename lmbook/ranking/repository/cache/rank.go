@@ -9,8 +9,8 @@ import (
 )
 
 type RankingCache interface {
-	Set(ctx context.Context, arts []domain.Article) error
-	Get(ctx context.Context) ([]domain.Article, error)
+	Set(ctx context.Context, list domain.RankingList) error
+	Get(ctx context.Context, board, scorer string) (domain.RankingList, error)
 }
 
 type RedisRankingCache struct {
@@ -19,34 +19,42 @@ type RedisRankingCache struct {
 	expiration time.Duration
 }
 
-func (r *RedisRankingCache) Set(ctx context.Context, arts []domain.Article) error {
+func (r *RedisRankingCache) Set(ctx context.Context, list domain.RankingList) error {
 	// 这里我们不会缓存内容
-	for i := 0; i < len(arts); i++ {
-		arts[i].Content = arts[i].Abstract()
+	for i := 0; i < len(list.Articles); i++ {
+		list.Articles[i].Content = list.Articles[i].Abstract()
 	}
-	val, err := json.Marshal(arts)
+	// 榜单、策略名字和文章一起存，读出来的时候就知道是哪个策略算的
+	val, err := json.Marshal(list)
 	if err != nil {
 		return err
 	}
 	// 过期时间要设置得比定时计算的间隔长
-	return r.client.Set(ctx, r.key, val,
+	return r.client.Set(ctx, r.listKey(list.Board, list.Scorer), val,
 		r.expiration).Err()
 }
 
-func (r *RedisRankingCache) Get(ctx context.Context) ([]domain.Article, error) {
-	val, err := r.client.Get(ctx, r.key).Bytes()
+func (r *RedisRankingCache) Get(ctx context.Context, board, scorer string) (domain.RankingList, error) {
+	val, err := r.client.Get(ctx, r.listKey(board, scorer)).Bytes()
 	if err != nil {
-		return nil, err
+		return domain.RankingList{}, err
 	}
-	var res []domain.Article
+	var res domain.RankingList
 	err = json.Unmarshal(val, &res)
-	return nil, err
+	return res, err
 }
 
-func NewRedisRankingCache(client redis.Cmdable) *RedisRankingCache {
+// listKey 每一个榜单的每一个打分策略一个 key，这样可以同时保留多个策略的结果做对比
+func (r *RedisRankingCache) listKey(board, scorer string) string {
+	return r.key + ":" + board + ":" + scorer
+}
+
+// NewRedisRankingCache expiration 要比定时计算的间隔长，
+// 保证下一次计算完成之前，缓存都不会过期
+func NewRedisRankingCache(client redis.Cmdable, expiration time.Duration) *RedisRankingCache {
 	return &RedisRankingCache{
 		key:        "ranking:article",
 		client:     client,
-		expiration: time.Minute * 3,
+		expiration: expiration,
 	}
 }
@@ -4,39 +4,59 @@ import (
 	"basic-go/lmbook/ranking/domain"
 	"context"
 	"errors"
-	"github.com/ecodeclub/ekit/syncx/atomicx"
+	"sync"
 	"time"
 )
 
-// RankingLocalCache 因为本身数据只有一份，所以不需要借助真正的本地缓存
+// RankingLocalCache 每个榜单每个打分策略的数据只有一份，所以不需要借助真正的本地缓存
 type RankingLocalCache struct {
-	topN       *atomicx.Value[[]domain.Article]
-	ddl        *atomicx.Value[time.Time]
+	// board:scorer => *localTopN
+	lists      sync.Map
 	expiration time.Duration
 }
 
-func NewRankingLocalCache() *RankingLocalCache {
+type localTopN struct {
+	list domain.RankingList
+	ddl  time.Time
+}
+
+// NewRankingLocalCache expiration 比定时计算的间隔稍微长一点，
+// 正常情况下，在过期之前就会收到刷新的通知
+func NewRankingLocalCache(expiration time.Duration) *RankingLocalCache {
 	return &RankingLocalCache{
-		topN:       atomicx.NewValue[[]domain.Article](),
-		ddl:        atomicx.NewValueOf[time.Time](time.Now()),
-		expiration: time.Minute * 3,
+		expiration: expiration,
 	}
 }
 
-func (r *RankingLocalCache) Set(_ context.Context, arts []domain.Article) error {
-	r.ddl.Store(time.Now().Add(time.Minute * 3))
-	r.topN.Store(arts)
+func (r *RankingLocalCache) Set(_ context.Context, list domain.RankingList) error {
+	r.lists.Store(localKey(list.Board, list.Scorer), &localTopN{
+		list: list,
+		ddl:  time.Now().Add(r.expiration),
+	})
 	return nil
 }
 
-func (r *RankingLocalCache) Get(_ context.Context) ([]domain.Article, error) {
-	arts := r.topN.Load()
-	if len(arts) == 0 || r.ddl.Load().Before(time.Now()) {
-		return nil, errors.New("本地缓存失效了")
+func (r *RankingLocalCache) Get(_ context.Context, board, scorer string) (domain.RankingList, error) {
+	val, ok := r.lists.Load(localKey(board, scorer))
+	if !ok {
+		return domain.RankingList{}, errors.New("本地缓存失效了")
+	}
+	topN := val.(*localTopN)
+	if len(topN.list.Articles) == 0 || topN.ddl.Before(time.Now()) {
+		return domain.RankingList{}, errors.New("本地缓存失效了")
+	}
+	return topN.list, nil
+}
+
+// ForceGet 不管有没有过期，都返回最后一次拿到的榜单，Redis 出问题的时候兜底用
+func (r *RankingLocalCache) ForceGet(_ context.Context, board, scorer string) (domain.RankingList, error) {
+	val, ok := r.lists.Load(localKey(board, scorer))
+	if !ok {
+		return domain.RankingList{}, errors.New("本地缓存没有数据")
 	}
-	return arts, nil
+	return val.(*localTopN).list, nil
 }
 
-func (r *RankingLocalCache) ForceGet(_ context.Context) ([]domain.Article, error) {
-	return r.topN.Load(), nil
+func localKey(board, scorer string) string {
+	return board + ":" + scorer
 }
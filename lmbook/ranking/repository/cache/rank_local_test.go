@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"basic-go/lmbook/ranking/domain"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankingLocalCache(t *testing.T) {
+	ctx := context.Background()
+	c := NewRankingLocalCache(time.Millisecond * 100)
+	list := domain.RankingList{
+		Board:    "weekly",
+		Scorer:   "gravity",
+		Articles: []domain.Article{{Id: 1}, {Id: 2}},
+	}
+	_, err := c.ForceGet(ctx, "weekly", "gravity")
+	assert.Error(t, err, "没有加载过的时候没法兜底")
+
+	require.NoError(t, c.Set(ctx, list))
+	res, err := c.Get(ctx, "weekly", "gravity")
+	require.NoError(t, err)
+	assert.Equal(t, list, res)
+	// 不同的策略互不影响
+	_, err = c.Get(ctx, "weekly", "weighted")
+	assert.Error(t, err)
+
+	time.Sleep(time.Millisecond * 150)
+	_, err = c.Get(ctx, "weekly", "gravity")
+	assert.Error(t, err, "过期了")
+	res, err = c.ForceGet(ctx, "weekly", "gravity")
+	require.NoError(t, err)
+	assert.Equal(t, list, res, "过期之后还能拿到最后一次的榜单")
+}
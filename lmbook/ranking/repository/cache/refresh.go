@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/redis/go-redis/v9"
+)
+
+// RefreshMessage 某个榜单某个策略的结果更新了
+type RefreshMessage struct {
+	Board  string `json:"board"`
+	Scorer string `json:"scorer"`
+}
+
+// RedisRankingRefresher 借助 Redis 的发布订阅，
+// 在热榜重新计算之后通知所有的实例同时刷新本地缓存
+type RedisRankingRefresher struct {
+	client  redis.UniversalClient
+	channel string
+}
+
+func NewRedisRankingRefresher(client redis.UniversalClient) *RedisRankingRefresher {
+	return &RedisRankingRefresher{
+		client:  client,
+		channel: "ranking:article:refresh",
+	}
+}
+
+func (r *RedisRankingRefresher) Publish(ctx context.Context, msg RefreshMessage) error {
+	val, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return r.client.Publish(ctx, r.channel, val).Err()
+}
+
+// Subscribe 返回的 channel 会在 ctx 结束的时候关闭。
+// 格式不对的消息直接丢弃
+func (r *RedisRankingRefresher) Subscribe(ctx context.Context) (<-chan RefreshMessage, error) {
+	sub := r.client.Subscribe(ctx, r.channel)
+	// 确认订阅成功
+	_, err := sub.Receive(ctx)
+	if err != nil {
+		_ = sub.Close()
+		return nil, err
+	}
+	ch := make(chan RefreshMessage)
+	go func() {
+		defer close(ch)
+		defer sub.Close()
+		msgs := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case m, ok := <-msgs:
+				if !ok {
+					return
+				}
+				var msg RefreshMessage
+				if json.Unmarshal([]byte(m.Payload), &msg) != nil {
+					continue
+				}
+				select {
+				case ch <- msg:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
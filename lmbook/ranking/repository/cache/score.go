@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"basic-go/lmbook/ranking/domain"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/redis/go-redis/v9"
+	"math"
+	"strconv"
+	"time"
+)
+
+// RedisRankingScoreCache 增量计算热榜的时候，用一个 ZSET 维护每篇文章的热度
+// member 是文章 ID，score 是经过时间衰减之后的热度。
+// 合并分数的时候要在一个事务里面同时操作好几个 key，所以所有的 key 都用同一个 hash tag，保证在 Redis Cluster 的同一个槽
+type RedisRankingScoreCache struct {
+	client redis.Cmdable
+	key    string
+	// deltaKey 上一次开始全量校准之后的增量
+	deltaKey string
+	decayKey string
+	metaKey  string
+	// 文章元数据的过期时间，超过七天的文章不可能上热榜
+	metaExpiration time.Duration
+}
+
+func NewRedisRankingScoreCache(client redis.Cmdable) *RedisRankingScoreCache {
+	return &RedisRankingScoreCache{
+		client:         client,
+		key:            "ranking:{article_scores}",
+		deltaKey:       "ranking:{article_scores}:delta",
+		decayKey:       "ranking:{article_scores}:decay_at",
+		metaKey:        "ranking:{article_scores}:meta",
+		metaExpiration: time.Hour * 24 * 7,
+	}
+}
+
+func (r *RedisRankingScoreCache) IncrScore(ctx context.Context, id int64, delta float64) error {
+	member := strconv.FormatInt(id, 10)
+	pipe := r.client.TxPipeline()
+	pipe.ZIncrBy(ctx, r.key, delta, member)
+	pipe.ZIncrBy(ctx, r.deltaKey, delta, member)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Decay 按照距离上一次衰减过去的时间，所有文章的热度统一乘以 0.5^(elapsed/halfLife)，
+// 低于 minScore 的直接删掉，最后只保留分数最高的 capacity 个。
+// 上一次衰减的时间放在 Redis 里面，这样不管是哪个实例来衰减，结果都是一样的
+func (r *RedisRankingScoreCache) Decay(ctx context.Context, now time.Time,
+	halfLife time.Duration, minScore float64, capacity int64) error {
+	prev, err := r.client.GetSet(ctx, r.decayKey, now.UnixMilli()).Int64()
+	if errors.Is(err, redis.Nil) {
+		// 第一次，只需要记录时间
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	elapsed := now.Sub(time.UnixMilli(prev))
+	if elapsed <= 0 {
+		return nil
+	}
+	factor := math.Pow(0.5, float64(elapsed)/float64(halfLife))
+	pipe := r.client.TxPipeline()
+	// 用自己和自己做并集，借助 WEIGHTS 一次性完成所有分数的衰减
+	pipe.ZUnionStore(ctx, r.key, &redis.ZStore{
+		Keys:    []string{r.key},
+		Weights: []float64{factor},
+	})
+	pipe.ZRemRangeByScore(ctx, r.key, "-inf", "("+strconv.FormatFloat(minScore, 'f', -1, 64))
+	pipe.ZRemRangeByRank(ctx, r.key, 0, -capacity-1)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// TopIds 按照热度从高到低返回前 n 个文章 ID
+func (r *RedisRankingScoreCache) TopIds(ctx context.Context, n int64) ([]int64, error) {
+	vals, err := r.client.ZRevRange(ctx, r.key, 0, n-1).Result()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]int64, 0, len(vals))
+	for _, val := range vals {
+		id, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			// 正常不可能出现
+			continue
+		}
+		res = append(res, id)
+	}
+	return res, nil
+}
+
+// BeginReconcile 清空增量，从现在开始重新累计
+func (r *RedisRankingScoreCache) BeginReconcile(ctx context.Context) error {
+	return r.client.Del(ctx, r.deltaKey).Err()
+}
+
+// MergeScores 全量校准的结果先写到临时 key，再和校准期间的增量做并集覆盖原本的分数，
+// 整个过程在一个事务里面，读的人看到的要么是校准前的，要么是校准后的。
+// 增量里面可能有一部分已经被全量计算统计进去了，重复算一点比丢掉要好
+func (r *RedisRankingScoreCache) MergeScores(ctx context.Context,
+	now time.Time, scores map[int64]float64, capacity int64) error {
+	tmpKey := r.key + ":tmp"
+	keys := []string{r.deltaKey}
+	pipe := r.client.TxPipeline()
+	if len(scores) > 0 {
+		members := make([]redis.Z, 0, len(scores))
+		for id, score := range scores {
+			members = append(members, redis.Z{
+				Score:  score,
+				Member: strconv.FormatInt(id, 10),
+			})
+		}
+		pipe.Del(ctx, tmpKey)
+		pipe.ZAdd(ctx, tmpKey, members...)
+		keys = append(keys, tmpKey)
+	}
+	pipe.ZUnionStore(ctx, r.key, &redis.ZStore{Keys: keys})
+	pipe.Del(ctx, tmpKey)
+	pipe.ZRemRangeByRank(ctx, r.key, 0, -capacity-1)
+	// 校准之后的分数就是 now 这个时刻的，下一次衰减从这里开始算
+	pipe.Set(ctx, r.decayKey, now.UnixMilli(), 0)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// SetArticles 缓存文章的元数据，增量模式下组装榜单的时候用
+func (r *RedisRankingScoreCache) SetArticles(ctx context.Context, arts []domain.Article) error {
+	if len(arts) == 0 {
+		return nil
+	}
+	vals := make([]any, 0, len(arts)*2)
+	for _, art := range arts {
+		// 这里我们不会缓存内容
+		art.Content = art.Abstract()
+		val, err := json.Marshal(art)
+		if err != nil {
+			return err
+		}
+		vals = append(vals, strconv.FormatInt(art.Id, 10), val)
+	}
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, r.metaKey, vals...)
+	pipe.Expire(ctx, r.metaKey, r.metaExpiration)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetArticles 返回缓存里面有的那部分文章
+func (r *RedisRankingScoreCache) GetArticles(ctx context.Context,
+	ids []int64) (map[int64]domain.Article, error) {
+	if len(ids) == 0 {
+		return map[int64]domain.Article{}, nil
+	}
+	fields := make([]string, 0, len(ids))
+	for _, id := range ids {
+		fields = append(fields, strconv.FormatInt(id, 10))
+	}
+	vals, err := r.client.HMGet(ctx, r.metaKey, fields...).Result()
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]domain.Article, len(vals))
+	for _, val := range vals {
+		str, ok := val.(string)
+		if !ok {
+			// 没有缓存
+			continue
+		}
+		var art domain.Article
+		if json.Unmarshal([]byte(str), &art) == nil {
+			res[art.Id] = art
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./score.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./score.go -package=repomocks -destination=mocks/score.mock.go RankingScoreRepository
+//
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "basic-go/lmbook/ranking/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRankingScoreRepository is a mock of RankingScoreRepository interface.
+type MockRankingScoreRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRankingScoreRepositoryMockRecorder
+}
+
+// MockRankingScoreRepositoryMockRecorder is the mock recorder for MockRankingScoreRepository.
+type MockRankingScoreRepositoryMockRecorder struct {
+	mock *MockRankingScoreRepository
+}
+
+// NewMockRankingScoreRepository creates a new mock instance.
+func NewMockRankingScoreRepository(ctrl *gomock.Controller) *MockRankingScoreRepository {
+	mock := &MockRankingScoreRepository{ctrl: ctrl}
+	mock.recorder = &MockRankingScoreRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRankingScoreRepository) EXPECT() *MockRankingScoreRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BeginReconcile mocks base method.
+func (m *MockRankingScoreRepository) BeginReconcile(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginReconcile", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BeginReconcile indicates an expected call of BeginReconcile.
+func (mr *MockRankingScoreRepositoryMockRecorder) BeginReconcile(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginReconcile", reflect.TypeOf((*MockRankingScoreRepository)(nil).BeginReconcile), ctx)
+}
+
+// Decay mocks base method.
+func (m *MockRankingScoreRepository) Decay(ctx context.Context, now time.Time, halfLife time.Duration, minScore float64, capacity int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decay", ctx, now, halfLife, minScore, capacity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decay indicates an expected call of Decay.
+func (mr *MockRankingScoreRepositoryMockRecorder) Decay(ctx, now, halfLife, minScore, capacity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decay", reflect.TypeOf((*MockRankingScoreRepository)(nil).Decay), ctx, now, halfLife, minScore, capacity)
+}
+
+// GetArticles mocks base method.
+func (m *MockRankingScoreRepository) GetArticles(ctx context.Context, ids []int64) (map[int64]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticles", ctx, ids)
+	ret0, _ := ret[0].(map[int64]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticles indicates an expected call of GetArticles.
+func (mr *MockRankingScoreRepositoryMockRecorder) GetArticles(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticles", reflect.TypeOf((*MockRankingScoreRepository)(nil).GetArticles), ctx, ids)
+}
+
+// IncrScore mocks base method.
+func (m *MockRankingScoreRepository) IncrScore(ctx context.Context, id int64, delta float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrScore", ctx, id, delta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrScore indicates an expected call of IncrScore.
+func (mr *MockRankingScoreRepositoryMockRecorder) IncrScore(ctx, id, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrScore", reflect.TypeOf((*MockRankingScoreRepository)(nil).IncrScore), ctx, id, delta)
+}
+
+// MergeScores mocks base method.
+func (m *MockRankingScoreRepository) MergeScores(ctx context.Context, now time.Time, scores map[int64]float64, capacity int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeScores", ctx, now, scores, capacity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeScores indicates an expected call of MergeScores.
+func (mr *MockRankingScoreRepositoryMockRecorder) MergeScores(ctx, now, scores, capacity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeScores", reflect.TypeOf((*MockRankingScoreRepository)(nil).MergeScores), ctx, now, scores, capacity)
+}
+
+// SetArticles mocks base method.
+func (m *MockRankingScoreRepository) SetArticles(ctx context.Context, arts []domain.Article) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArticles", ctx, arts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetArticles indicates an expected call of SetArticles.
+func (mr *MockRankingScoreRepositoryMockRecorder) SetArticles(ctx, arts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArticles", reflect.TypeOf((*MockRankingScoreRepository)(nil).SetArticles), ctx, arts)
+}
+
+// TopIds mocks base method.
+func (m *MockRankingScoreRepository) TopIds(ctx context.Context, n int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopIds", ctx, n)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopIds indicates an expected call of TopIds.
+func (mr *MockRankingScoreRepositoryMockRecorder) TopIds(ctx, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopIds", reflect.TypeOf((*MockRankingScoreRepository)(nil).TopIds), ctx, n)
+}
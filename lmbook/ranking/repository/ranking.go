@@ -1,52 +1,89 @@
 package repository
 
 import (
+	"basic-go/lmbook/pkg/logger"
 	"basic-go/lmbook/ranking/domain"
 	"basic-go/lmbook/ranking/repository/cache"
 	"context"
-	"github.com/ecodeclub/ekit/syncx/atomicx"
 )
 
+//go:generate mockgen -source=./ranking.go -package=repomocks -destination=mocks/ranking.mock.go RankingRepository
 type RankingRepository interface {
-	ReplaceTopN(ctx context.Context, arts []domain.Article) error
-	GetTopN(ctx context.Context) ([]domain.Article, error)
+	// ReplaceTopN 更新榜单，并且通知所有的实例刷新本地缓存
+	ReplaceTopN(ctx context.Context, list domain.RankingList) error
+	GetTopN(ctx context.Context, board, scorer string) (domain.RankingList, error)
+	// Reload 收到刷新通知之后，从 Redis 重新加载到本地缓存
+	Reload(ctx context.Context, board, scorer string) error
 }
 
 type CachedRankingRepository struct {
 	redisCache *cache.RedisRankingCache
-	localCache *cache.RankingLocalCache
 	// 你也可以考虑将这个本地缓存塞进去 RankingCache 里面，作为一个实现
-	topN atomicx.Value[[]domain.Article]
+	localCache *cache.RankingLocalCache
+	refresher  *cache.RedisRankingRefresher
+	l          logger.LoggerV1
 }
 
 func NewCachedRankingRepository(
 	redisCache *cache.RedisRankingCache,
-	localCache *cache.RankingLocalCache) RankingRepository {
+	localCache *cache.RankingLocalCache,
+	refresher *cache.RedisRankingRefresher,
+	l logger.LoggerV1) RankingRepository {
 	return &CachedRankingRepository{
 		redisCache: redisCache,
 		localCache: localCache,
+		refresher:  refresher,
+		l:          l,
 	}
 }
 
 func (c *CachedRankingRepository) ReplaceTopN(ctx context.Context,
-	arts []domain.Article) error {
+	list domain.RankingList) error {
 	// 这一步必然不会出错
-	_ = c.localCache.Set(ctx, arts)
-	return c.redisCache.Set(ctx, arts)
+	_ = c.localCache.Set(ctx, list)
+	err := c.redisCache.Set(ctx, list)
+	if err != nil {
+		return err
+	}
+	// 通知失败也没关系，其它实例的本地缓存过期之后也会去 Redis 加载
+	err = c.refresher.Publish(ctx, cache.RefreshMessage{
+		Board:  list.Board,
+		Scorer: list.Scorer,
+	})
+	if err != nil {
+		c.l.Error("发送热榜刷新通知失败",
+			logger.String("board", list.Board),
+			logger.String("scorer", list.Scorer),
+			logger.Error(err))
+	}
+	return nil
 }
 
-func (c *CachedRankingRepository) GetTopN(ctx context.Context) ([]domain.Article, error) {
-	arts, err := c.localCache.Get(ctx)
+func (c *CachedRankingRepository) Reload(ctx context.Context, board, scorer string) error {
+	list, err := c.redisCache.Get(ctx, board, scorer)
+	if err != nil {
+		return err
+	}
+	return c.localCache.Set(ctx, list)
+}
+
+func (c *CachedRankingRepository) GetTopN(ctx context.Context,
+	board, scorer string) (domain.RankingList, error) {
+	list, err := c.localCache.Get(ctx, board, scorer)
 	if err == nil {
-		return arts, nil
+		return list, nil
 	}
 	// 回写本地缓存
-	arts, err = c.redisCache.Get(ctx)
+	list, err = c.redisCache.Get(ctx, board, scorer)
 	if err == nil {
-		_ = c.localCache.Set(ctx, arts)
-	} else {
-		// 这里，我们没有进一步区分是什么原因导致的 Redis 错误
-		return c.localCache.ForceGet(ctx)
+		_ = c.localCache.Set(ctx, list)
+		return list, nil
+	}
+	// 这里，我们没有进一步区分是什么原因导致的 Redis 错误，
+	// 本地还有上一次拿到的榜单就用它，宁可旧一点也不要没有
+	res, er := c.localCache.ForceGet(ctx, board, scorer)
+	if er != nil {
+		return domain.RankingList{}, err
 	}
-	return arts, err
+	return res, nil
 }
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"basic-go/lmbook/ranking/domain"
+	"basic-go/lmbook/ranking/repository/cache"
+	"context"
+	"time"
+)
+
+// RankingScoreRepository 增量模式下的热度数据
+//
+//go:generate mockgen -source=./score.go -package=repomocks -destination=mocks/score.mock.go RankingScoreRepository
+type RankingScoreRepository interface {
+	IncrScore(ctx context.Context, id int64, delta float64) error
+	Decay(ctx context.Context, now time.Time, halfLife time.Duration,
+		minScore float64, capacity int64) error
+	TopIds(ctx context.Context, n int64) ([]int64, error)
+	// BeginReconcile 开始全量校准，从这个时候开始的增量会在 MergeScores 的时候保留下来
+	BeginReconcile(ctx context.Context) error
+	// MergeScores 用全量校准的结果加上校准期间的增量替换原本的分数
+	MergeScores(ctx context.Context, now time.Time, scores map[int64]float64, capacity int64) error
+	SetArticles(ctx context.Context, arts []domain.Article) error
+	GetArticles(ctx context.Context, ids []int64) (map[int64]domain.Article, error)
+}
+
+type CachedRankingScoreRepository struct {
+	cache *cache.RedisRankingScoreCache
+}
+
+func NewCachedRankingScoreRepository(cache *cache.RedisRankingScoreCache) RankingScoreRepository {
+	return &CachedRankingScoreRepository{
+		cache: cache,
+	}
+}
+
+func (c *CachedRankingScoreRepository) IncrScore(ctx context.Context, id int64, delta float64) error {
+	return c.cache.IncrScore(ctx, id, delta)
+}
+
+func (c *CachedRankingScoreRepository) Decay(ctx context.Context, now time.Time,
+	halfLife time.Duration, minScore float64, capacity int64) error {
+	return c.cache.Decay(ctx, now, halfLife, minScore, capacity)
+}
+
+func (c *CachedRankingScoreRepository) TopIds(ctx context.Context, n int64) ([]int64, error) {
+	return c.cache.TopIds(ctx, n)
+}
+
+func (c *CachedRankingScoreRepository) BeginReconcile(ctx context.Context) error {
+	return c.cache.BeginReconcile(ctx)
+}
+
+func (c *CachedRankingScoreRepository) MergeScores(ctx context.Context,
+	now time.Time, scores map[int64]float64, capacity int64) error {
+	return c.cache.MergeScores(ctx, now, scores, capacity)
+}
+
+func (c *CachedRankingScoreRepository) SetArticles(ctx context.Context, arts []domain.Article) error {
+	return c.cache.SetArticles(ctx, arts)
+}
+
+func (c *CachedRankingScoreRepository) GetArticles(ctx context.Context,
+	ids []int64) (map[int64]domain.Article, error) {
+	return c.cache.GetArticles(ctx, ids)
+}
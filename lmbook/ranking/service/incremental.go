@@ -0,0 +1,216 @@
+package service
+
+import (
+	articlev1 "basic-go/lmbook/api/proto/gen/article/v1"
+	intrv1 "basic-go/lmbook/api/proto/gen/intr/v1"
+	tagv1 "basic-go/lmbook/api/proto/gen/tag/v1"
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/ranking/domain"
+	"basic-go/lmbook/ranking/repository"
+	"context"
+	"github.com/ecodeclub/ekit/syncx/atomicx"
+	"time"
+)
+
+const ScorerIncremental = "incremental"
+
+type IncrementalConfig struct {
+	ReadWeight float64
+	LikeWeight float64
+	// HalfLife 热度衰减一半需要的时间
+	HalfLife time.Duration
+	// MinScore 衰减到这个分数以下的文章直接丢弃
+	MinScore float64
+	// Capacity ZSET 里面最多保留多少篇文章
+	Capacity int64
+	// ReconcileInterval 多久做一次全量校准
+	ReconcileInterval time.Duration
+}
+
+// IncrementalRankingService 增量计算热榜
+// 阅读、点赞事件实时累加到 ZSET 里面，RankTopN 只需要做衰减和组装榜单，
+// 不再需要每次都把七天内的文章全部翻一遍。
+// 原本的全量计算保留下来，每隔 ReconcileInterval 做一次，用来纠正
+// 消息丢失、重复消费之类的原因导致的偏差，同时也会算出其它策略、其它榜单的结果。
+// ZSET 只对应默认榜单，其它榜单要么等校准，要么单独调用 RankTopN 来计算
+type IncrementalRankingService struct {
+	batch     *BatchRankingService
+	artSvc    articlev1.ArticleServiceClient
+	repo      repository.RankingRepository
+	scoreRepo repository.RankingScoreRepository
+	scorer    *DecayScorer
+	cfg       IncrementalConfig
+	l         logger.LoggerV1
+	// 上一次全量校准的时间
+	reconciledAt *atomicx.Value[time.Time]
+}
+
+func NewIncrementalRankingService(
+	intrSvc intrv1.InteractiveServiceClient,
+	artSvc articlev1.ArticleServiceClient,
+	tagSvc tagv1.TagServiceClient,
+	repo repository.RankingRepository,
+	scoreRepo repository.RankingScoreRepository,
+	scorers []Scorer,
+	boards Boards,
+	cfg IncrementalConfig,
+	l logger.LoggerV1) *IncrementalRankingService {
+	batch := NewBatchRankingService(intrSvc, artSvc, tagSvc, repo, scorers, boards, l).(*BatchRankingService)
+	return &IncrementalRankingService{
+		batch:        batch,
+		artSvc:       artSvc,
+		repo:         repo,
+		scoreRepo:    scoreRepo,
+		scorer:       NewDecayScorer(cfg.ReadWeight, cfg.LikeWeight, cfg.HalfLife),
+		cfg:          cfg,
+		l:            l,
+		reconciledAt: atomicx.NewValue[time.Time](),
+	}
+}
+
+// OnRead 累加阅读数带来的热度
+func (i *IncrementalRankingService) OnRead(ctx context.Context, aid int64, cnt int64) error {
+	return i.scoreRepo.IncrScore(ctx, aid, float64(cnt)*i.cfg.ReadWeight)
+}
+
+// OnLike 点赞加分，取消点赞减分
+func (i *IncrementalRankingService) OnLike(ctx context.Context, aid int64, liked bool) error {
+	delta := i.cfg.LikeWeight
+	if !liked {
+		delta = -delta
+	}
+	return i.scoreRepo.IncrScore(ctx, aid, delta)
+}
+
+func (i *IncrementalRankingService) RankTopN(ctx context.Context, board string) error {
+	if board != "" && board != i.defaultBoard().Key {
+		return i.batch.RankTopN(ctx, board)
+	}
+	now := time.Now()
+	var err error
+	if now.Sub(i.reconciledAt.Load()) >= i.cfg.ReconcileInterval {
+		err = i.reconcile(ctx, now)
+		if err == nil {
+			i.reconciledAt.Store(now)
+		}
+	} else {
+		err = i.scoreRepo.Decay(ctx, now, i.cfg.HalfLife, i.cfg.MinScore, i.cfg.Capacity)
+	}
+	if err != nil {
+		return err
+	}
+	return i.materialize(ctx)
+}
+
+// reconcile 全量计算一遍，把 DecayScorer 的结果合并到 ZSET 里面。
+// 全量计算的过程中还会有事件进来，这部分增量要保留下来，所以是合并而不是直接覆盖
+func (i *IncrementalRankingService) reconcile(ctx context.Context, now time.Time) error {
+	err := i.scoreRepo.BeginReconcile(ctx)
+	if err != nil {
+		return err
+	}
+	scores := make(map[int64]float64, i.cfg.Capacity)
+	arts := make([]domain.Article, 0, i.cfg.Capacity)
+	lists, err := i.batch.rankTopN(ctx, "", func(art domain.Article, intr domain.Interactive) {
+		score := i.scorer.Score(intr, art.Utime)
+		if score < i.cfg.MinScore {
+			return
+		}
+		scores[art.Id] = score
+		arts = append(arts, art)
+	})
+	if err != nil {
+		return err
+	}
+	for _, list := range lists {
+		err = i.repo.ReplaceTopN(ctx, list)
+		if err != nil {
+			return err
+		}
+	}
+	err = i.scoreRepo.SetArticles(ctx, arts)
+	if err != nil {
+		return err
+	}
+	return i.scoreRepo.MergeScores(ctx, now, scores, i.cfg.Capacity)
+}
+
+// materialize 按照 ZSET 里面的顺序组装出榜单
+func (i *IncrementalRankingService) materialize(ctx context.Context) error {
+	board := i.defaultBoard()
+	ids, err := i.scoreRepo.TopIds(ctx, int64(board.N))
+	if err != nil {
+		return err
+	}
+	metas, err := i.scoreRepo.GetArticles(ctx, ids)
+	if err != nil {
+		return err
+	}
+	// 上一次校准之后才有热度的文章，缓存里面还没有元数据，需要去文章服务查。
+	// 这里用的是不会产生阅读事件的批量接口，不然查询本身就会影响热度
+	missingIds := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := metas[id]; !ok {
+			missingIds = append(missingIds, id)
+		}
+	}
+	if len(missingIds) > 0 {
+		i.loadMissing(ctx, missingIds, metas)
+	}
+	arts := make([]domain.Article, 0, len(ids))
+	for _, id := range ids {
+		if art, ok := metas[id]; ok && art.Published() {
+			arts = append(arts, art)
+		}
+	}
+	return i.repo.ReplaceTopN(ctx, domain.RankingList{
+		Board:    board.Key,
+		Scorer:   ScorerIncremental,
+		Articles: arts,
+	})
+}
+
+// loadMissing 查询之后放进 metas 并且缓存起来。
+// 查不到或者没有发表的也缓存，避免每次组装榜单都去查，下一次全量校准的时候会被覆盖
+func (i *IncrementalRankingService) loadMissing(ctx context.Context,
+	ids []int64, metas map[int64]domain.Article) {
+	resp, err := i.artSvc.GetPublishedByIds(ctx, &articlev1.GetPublishedByIdsRequest{
+		Ids: ids,
+	})
+	if err != nil {
+		// 这一次先不上榜，下一次再查
+		i.l.Warn("查询热榜文章失败", logger.Error(err))
+		return
+	}
+	found := make([]domain.Article, 0, len(ids))
+	for _, art := range resp.GetArticles() {
+		found = append(found, articleToDomain(art))
+	}
+	for _, art := range found {
+		metas[art.Id] = art
+	}
+	for _, id := range ids {
+		if _, ok := metas[id]; !ok {
+			// 被删除了，Status 是零值，不会上榜
+			found = append(found, domain.Article{Id: id})
+		}
+	}
+	err = i.scoreRepo.SetArticles(ctx, found)
+	if err != nil {
+		i.l.Error("缓存热榜文章失败", logger.Error(err))
+	}
+}
+
+func (i *IncrementalRankingService) TopN(ctx context.Context,
+	board, scorer string) (domain.RankingList, error) {
+	def := i.defaultBoard().Key
+	if (board == "" || board == def) &&
+		(scorer == "" || scorer == ScorerIncremental) {
+		return i.repo.GetTopN(ctx, def, ScorerIncremental)
+	}
+	return i.batch.TopN(ctx, board, scorer)
+}
+
+func (i *IncrementalRankingService) defaultBoard() domain.Board {
+	return i.batch.boards.Fixed[0]
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	articlev1 "basic-go/lmbook/api/proto/gen/article/v1"
+	artmocks "basic-go/lmbook/api/proto/gen/article/v1/mocks"
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/ranking/domain"
+	"basic-go/lmbook/ranking/repository"
+	repomocks "basic-go/lmbook/ranking/repository/mocks"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIncrementalRankingService_materialize(t *testing.T) {
+	published := func(id int64) domain.Article {
+		return domain.Article{Id: id, Status: domain.ArticleStatusPublished}
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (articlev1.ArticleServiceClient,
+			repository.RankingScoreRepository)
+		wantIds []int64
+		wantErr error
+	}{
+		{
+			name: "缓存里面都有",
+			mock: func(ctrl *gomock.Controller) (articlev1.ArticleServiceClient,
+				repository.RankingScoreRepository) {
+				artSvc := artmocks.NewMockArticleServiceClient(ctrl)
+				scoreRepo := repomocks.NewMockRankingScoreRepository(ctrl)
+				scoreRepo.EXPECT().TopIds(gomock.Any(), int64(3)).Return([]int64{2, 1}, nil)
+				scoreRepo.EXPECT().GetArticles(gomock.Any(), []int64{2, 1}).
+					Return(map[int64]domain.Article{1: published(1), 2: published(2)}, nil)
+				return artSvc, scoreRepo
+			},
+			wantIds: []int64{2, 1},
+		},
+		{
+			name: "缓存没有的批量查询，查不到的也缓存起来",
+			mock: func(ctrl *gomock.Controller) (articlev1.ArticleServiceClient,
+				repository.RankingScoreRepository) {
+				artSvc := artmocks.NewMockArticleServiceClient(ctrl)
+				scoreRepo := repomocks.NewMockRankingScoreRepository(ctrl)
+				scoreRepo.EXPECT().TopIds(gomock.Any(), int64(3)).Return([]int64{3, 2, 1}, nil)
+				scoreRepo.EXPECT().GetArticles(gomock.Any(), []int64{3, 2, 1}).
+					Return(map[int64]domain.Article{1: published(1)}, nil)
+				art := &articlev1.Article{Id: 2, Status: int32(domain.ArticleStatusPublished)}
+				artSvc.EXPECT().GetPublishedByIds(gomock.Any(), &articlev1.GetPublishedByIdsRequest{
+					Ids: []int64{3, 2},
+				}).Return(&articlev1.GetPublishedByIdsResponse{
+					Articles: []*articlev1.Article{art},
+				}, nil)
+				scoreRepo.EXPECT().SetArticles(gomock.Any(), []domain.Article{
+					articleToDomain(art), {Id: 3},
+				}).Return(nil)
+				return artSvc, scoreRepo
+			},
+			wantIds: []int64{2, 1},
+		},
+		{
+			name: "缓存里面没有发表的不上榜",
+			mock: func(ctrl *gomock.Controller) (articlev1.ArticleServiceClient,
+				repository.RankingScoreRepository) {
+				artSvc := artmocks.NewMockArticleServiceClient(ctrl)
+				scoreRepo := repomocks.NewMockRankingScoreRepository(ctrl)
+				scoreRepo.EXPECT().TopIds(gomock.Any(), int64(3)).Return([]int64{2, 1}, nil)
+				scoreRepo.EXPECT().GetArticles(gomock.Any(), []int64{2, 1}).
+					Return(map[int64]domain.Article{1: published(1), 2: {Id: 2}}, nil)
+				return artSvc, scoreRepo
+			},
+			wantIds: []int64{1},
+		},
+		{
+			name: "查询文章失败，这一次先不上榜",
+			mock: func(ctrl *gomock.Controller) (articlev1.ArticleServiceClient,
+				repository.RankingScoreRepository) {
+				artSvc := artmocks.NewMockArticleServiceClient(ctrl)
+				scoreRepo := repomocks.NewMockRankingScoreRepository(ctrl)
+				scoreRepo.EXPECT().TopIds(gomock.Any(), int64(3)).Return([]int64{2, 1}, nil)
+				scoreRepo.EXPECT().GetArticles(gomock.Any(), []int64{2, 1}).
+					Return(map[int64]domain.Article{1: published(1)}, nil)
+				artSvc.EXPECT().GetPublishedByIds(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock rpc error"))
+				return artSvc, scoreRepo
+			},
+			wantIds: []int64{1},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			artSvc, scoreRepo := tc.mock(ctrl)
+			repo := repomocks.NewMockRankingRepository(ctrl)
+			var list domain.RankingList
+			repo.EXPECT().ReplaceTopN(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, l domain.RankingList) error {
+					list = l
+					return nil
+				})
+			boards := Boards{Fixed: []domain.Board{{Key: "weekly", N: 3, Window: time.Hour}}}
+			svc := NewIncrementalRankingService(nil, artSvc, nil, repo, scoreRepo,
+				[]Scorer{likeScorer{}}, boards, IncrementalConfig{}, logger.NewNoOpLogger())
+			err := svc.materialize(context.Background())
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, "weekly", list.Board)
+			assert.Equal(t, ScorerIncremental, list.Scorer)
+			ids := make([]int64, 0, len(list.Articles))
+			for _, art := range list.Articles {
+				ids = append(ids, art.Id)
+			}
+			assert.Equal(t, tc.wantIds, ids)
+		})
+	}
+}
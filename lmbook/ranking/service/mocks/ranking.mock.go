@@ -40,30 +40,30 @@ func (m *MockRankingService) EXPECT() *MockRankingServiceMockRecorder {
 }
 
 // RankTopN mocks base method.
-func (m *MockRankingService) RankTopN(ctx context.Context) error {
+func (m *MockRankingService) RankTopN(ctx context.Context, board string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RankTopN", ctx)
+	ret := m.ctrl.Call(m, "RankTopN", ctx, board)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RankTopN indicates an expected call of RankTopN.
-func (mr *MockRankingServiceMockRecorder) RankTopN(ctx any) *gomock.Call {
+func (mr *MockRankingServiceMockRecorder) RankTopN(ctx, board any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RankTopN", reflect.TypeOf((*MockRankingService)(nil).RankTopN), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RankTopN", reflect.TypeOf((*MockRankingService)(nil).RankTopN), ctx, board)
 }
 
 // TopN mocks base method.
-func (m *MockRankingService) TopN(ctx context.Context) ([]domain.Article, error) {
+func (m *MockRankingService) TopN(ctx context.Context, board, scorer string) (domain.RankingList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TopN", ctx)
-	ret0, _ := ret[0].([]domain.Article)
+	ret := m.ctrl.Call(m, "TopN", ctx, board, scorer)
+	ret0, _ := ret[0].(domain.RankingList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // TopN indicates an expected call of TopN.
-func (mr *MockRankingServiceMockRecorder) TopN(ctx any) *gomock.Call {
+func (mr *MockRankingServiceMockRecorder) TopN(ctx, board, scorer any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopN", reflect.TypeOf((*MockRankingService)(nil).TopN), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopN", reflect.TypeOf((*MockRankingService)(nil).TopN), ctx, board, scorer)
 }
@@ -3,81 +3,157 @@ package service
 import (
 	articlev1 "basic-go/lmbook/api/proto/gen/article/v1"
 	intrv1 "basic-go/lmbook/api/proto/gen/intr/v1"
+	tagv1 "basic-go/lmbook/api/proto/gen/tag/v1"
+	"basic-go/lmbook/pkg/logger"
 	"basic-go/lmbook/ranking/domain"
 	"basic-go/lmbook/ranking/repository"
 	"context"
+	"errors"
 	"github.com/ecodeclub/ekit/queue"
 	"github.com/ecodeclub/ekit/slice"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"math"
 	"time"
 )
 
 //go:generate mockgen -source=./ranking.go -package=svcmocks -destination=./mocks/ranking.mock.go RankingService
 type RankingService interface {
-	// RankTopN 计算 TopN
-	RankTopN(ctx context.Context) error
-	// TopN 返回业务的 ID
-	TopN(ctx context.Context) ([]domain.Article, error)
+	// RankTopN 计算 board 这个榜单，board 为空的时候计算所有的榜单。
+	// 每一个打分策略都会算一份
+	RankTopN(ctx context.Context, board string) error
+	// TopN 返回 board 榜单里 scorer 计算出来的结果，
+	// board 和 scorer 为空的时候使用默认榜单和默认策略
+	TopN(ctx context.Context, board, scorer string) (domain.RankingList, error)
+}
+
+var (
+	ErrUnknownScorer = errors.New("未知的打分策略")
+	ErrUnknownBoard  = errors.New("未知的榜单")
+)
+
+// Boards 榜单配置
+type Boards struct {
+	// Fixed 固定的榜单，第一个是默认榜单
+	Fixed []domain.Board
+	// Tag N 大于 0 的时候，每一个标签都有一个自己的榜单，
+	// 长度和时间窗口都用这里的，Key 不需要填
+	Tag domain.Board
+	// Author 和 Tag 一样，N 大于 0 的时候每一个作者都有一个自己的榜单
+	Author domain.Board
 }
 
 // BatchRankingService 分批计算
 type BatchRankingService struct {
 	intrSvc intrv1.InteractiveServiceClient
 	artSvc  articlev1.ArticleServiceClient
+	tagSvc  tagv1.TagServiceClient
 	repo    repository.RankingRepository
 	// 为了测试，不得已暴露出去
 	BatchSize int
-	N         int
-	// 将来扩展，以及支持测试
-	scoreFunc func(likeCnt int64, utime time.Time) float64
+	// 第一个是默认策略，剩下的是用来做 A/B 对比的策略
+	scorers []Scorer
+	boards  Boards
+	l       logger.LoggerV1
 }
 
 func NewBatchRankingService(
 	intrSvc intrv1.InteractiveServiceClient,
 	artSvc articlev1.ArticleServiceClient,
-	repo repository.RankingRepository) RankingService {
-	res := &BatchRankingService{
+	tagSvc tagv1.TagServiceClient,
+	repo repository.RankingRepository,
+	scorers []Scorer,
+	boards Boards,
+	l logger.LoggerV1) RankingService {
+	if len(scorers) == 0 {
+		scorers = []Scorer{NewGravityScorer(1.5)}
+	}
+	if len(boards.Fixed) == 0 {
+		boards.Fixed = []domain.Board{{Key: "weekly", N: 100, Window: time.Hour * 24 * 7}}
+	}
+	return &BatchRankingService{
 		intrSvc:   intrSvc,
 		artSvc:    artSvc,
+		tagSvc:    tagSvc,
 		repo:      repo,
 		BatchSize: 100,
-		N:         100,
+		scorers:   scorers,
+		boards:    boards,
+		l:         l,
 	}
-	res.scoreFunc = res.score
-	return res
 }
 
-func (a *BatchRankingService) RankTopN(ctx context.Context) error {
-	arts, err := a.rankTopN(ctx)
+func (a *BatchRankingService) RankTopN(ctx context.Context, board string) error {
+	lists, err := a.rankTopN(ctx, board, nil)
 	if err != nil {
 		return err
 	}
 	// 准备放到缓存里面
-	return a.repo.ReplaceTopN(ctx, arts)
+	for _, list := range lists {
+		err = a.repo.ReplaceTopN(ctx, list)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type scoredArticle struct {
+	art   domain.Article
+	score float64
+}
+
+// boardQueues 一个榜单的计算过程，每一个策略一个优先级队列，维持住了各自 topN 的 id。
+type boardQueues struct {
+	board domain.Board
+	ques  []*queue.PriorityQueue[scoredArticle]
+}
+
+func (a *BatchRankingService) newBoardQueues(board domain.Board) *boardQueues {
+	ques := make([]*queue.PriorityQueue[scoredArticle], len(a.scorers))
+	for i := range ques {
+		ques[i] = queue.NewPriorityQueue[scoredArticle](board.N,
+			func(src scoredArticle, dst scoredArticle) int {
+				if src.score > dst.score {
+					return 1
+				} else if src.score == dst.score {
+					return 0
+				} else {
+					return -1
+				}
+			})
+	}
+	return &boardQueues{board: board, ques: ques}
 }
 
-func (a *BatchRankingService) rankTopN(ctx context.Context) ([]domain.Article, error) {
+// rankTopN visit 不为 nil 的时候，每一篇参与计算的文章都会回调一次
+func (a *BatchRankingService) rankTopN(ctx context.Context, board string,
+	visit func(art domain.Article, intr domain.Interactive)) ([]domain.RankingList, error) {
+	plan, err := a.plan(board)
+	if err != nil {
+		return nil, err
+	}
 	now := time.Now()
-	// 只计算七天内的，因为超过七天的我们可以认为绝对不可能成为热榜了
-	// 如果一个批次里面 utime 最小已经是七天之前的，我们就中断当前计算
-	ddl := now.Add(-time.Hour * 24 * 7)
+	// 只计算时间窗口内的，如果一个批次里面 utime 最小已经在所有窗口之外，我们就中断当前计算
+	// 有一个榜单不限制时间窗口的话，就只能全部算一遍
+	ddl := plan.ddl(now)
 	offset := 0
-	type Score struct {
-		art   domain.Article
-		score float64
-	}
-	// 这是一个优先级队列，维持住了 topN 的 id。
-	que := queue.NewPriorityQueue[Score](a.N,
-		func(src Score, dst Score) int {
-			if src.score > dst.score {
-				return 1
-			} else if src.score == dst.score {
-				return 0
-			} else {
-				return -1
-			}
-		})
+	// 固定榜单在前，标签、作者榜单按照出现的顺序排在后面
+	keys := make([]string, 0, len(plan.fixed))
+	bqs := make(map[string]*boardQueues, len(plan.fixed))
+	dynamicQueues := func(tmpl *domain.Board, key string) *boardQueues {
+		bq, ok := bqs[key]
+		if !ok {
+			b := *tmpl
+			b.Key = key
+			bq = a.newBoardQueues(b)
+			bqs[key] = bq
+			keys = append(keys, key)
+		}
+		return bq
+	}
+	for _, b := range plan.fixed {
+		keys = append(keys, b.Key)
+		bqs[b.Key] = a.newBoardQueues(b)
+	}
 
 	for {
 		arts, err := a.artSvc.ListPub(ctx, &articlev1.ListPubRequest{
@@ -103,22 +179,36 @@ func (a *BatchRankingService) rankTopN(ctx context.Context) ([]domain.Article, e
 		if err != nil {
 			return nil, err
 		}
-		minScore := float64(0)
+		var tags map[int64][]string
+		if plan.tag != nil {
+			tags = a.tagsOf(ctx, domainArts)
+		}
 		for _, art := range domainArts {
 			intr, ok := intrResp.GetIntrs()[art.Id]
 			if !ok {
 				continue
 			}
-			score := a.scoreFunc(intr.LikeCnt, art.Utime)
-			if score > minScore {
-				ele := Score{art: art, score: score}
-				err = que.Enqueue(ele)
-				if err == queue.ErrOutOfCapacity {
-					_, _ = que.Dequeue()
-					err = que.Enqueue(ele)
+			di := intrToDomain(intr)
+			if visit != nil {
+				visit(art, di)
+			}
+			// 同一篇文章在不同榜单里面的分数是一样的，只算一次
+			scores := make([]float64, len(a.scorers))
+			for i, scorer := range a.scorers {
+				scores[i] = scorer.Score(di, art.Utime)
+			}
+			for _, b := range plan.fixed {
+				a.enqueueBoard(bqs[b.Key], now, art, scores)
+			}
+			for _, tag := range tags[art.Id] {
+				if plan.onlyTag != "" && tag != plan.onlyTag {
+					continue
 				}
-			} else {
-				minScore = score
+				a.enqueueBoard(dynamicQueues(plan.tag, domain.TagBoardKey(tag)), now, art, scores)
+			}
+			if plan.author != nil &&
+				(plan.onlyAuthor == 0 || art.Author.Id == plan.onlyAuthor) {
+				a.enqueueBoard(dynamicQueues(plan.author, domain.AuthorBoardKey(art.Author.Id)), now, art, scores)
 			}
 		}
 		if len(domainArts) == 0 || len(domainArts) < a.BatchSize ||
@@ -127,25 +217,159 @@ func (a *BatchRankingService) rankTopN(ctx context.Context) ([]domain.Article, e
 		}
 		offset = offset + len(domainArts)
 	}
-	ql := que.Len()
-	res := make([]domain.Article, ql)
-	for i := ql - 1; i >= 0; i-- {
-		val, _ := que.Dequeue()
-		res[i] = val.art
+	res := make([]domain.RankingList, 0, len(keys)*len(a.scorers))
+	for _, key := range keys {
+		bq := bqs[key]
+		for i, scorer := range a.scorers {
+			que := bq.ques[i]
+			ql := que.Len()
+			arts := make([]domain.Article, ql)
+			for j := ql - 1; j >= 0; j-- {
+				val, _ := que.Dequeue()
+				arts[j] = val.art
+			}
+			res = append(res, domain.RankingList{
+				Board:    key,
+				Scorer:   scorer.Name(),
+				Articles: arts,
+			})
+		}
 	}
 	return res, nil
 }
 
-// 这里不需要提前抽象算法，因为正常一家公司的算法都是固定的，不会今天切换到这里，明天切换到那里
-func (a *BatchRankingService) score(likeCnt int64, utime time.Time) float64 {
-	// 这个 factor 也可以做成一个参数
-	const factor = 1.5
-	return float64(likeCnt-1) /
-		math.Pow(time.Since(utime).Hours()+2, factor)
+// rankPlan 这一次要计算的榜单
+type rankPlan struct {
+	fixed []domain.Board
+	// 不为 nil 的时候要算标签榜单，onlyTag 不为空的时候只算这一个标签
+	tag     *domain.Board
+	onlyTag string
+	// 不为 nil 的时候要算作者榜单，onlyAuthor 不为 0 的时候只算这一个作者
+	author     *domain.Board
+	onlyAuthor int64
 }
 
-func (a *BatchRankingService) TopN(ctx context.Context) ([]domain.Article, error) {
-	return a.repo.GetTopN(ctx)
+func (p rankPlan) ddl(now time.Time) time.Time {
+	boards := make([]domain.Board, 0, len(p.fixed)+2)
+	boards = append(boards, p.fixed...)
+	if p.tag != nil {
+		boards = append(boards, *p.tag)
+	}
+	if p.author != nil {
+		boards = append(boards, *p.author)
+	}
+	var maxWindow time.Duration
+	for _, b := range boards {
+		if b.Window <= 0 {
+			return time.Time{}
+		}
+		maxWindow = max(maxWindow, b.Window)
+	}
+	return now.Add(-maxWindow)
+}
+
+// plan board 为空的时候计算所有的榜单
+func (a *BatchRankingService) plan(board string) (rankPlan, error) {
+	if board == "" {
+		res := rankPlan{fixed: a.boards.Fixed}
+		if a.boards.Tag.N > 0 {
+			res.tag = &a.boards.Tag
+		}
+		if a.boards.Author.N > 0 {
+			res.author = &a.boards.Author
+		}
+		return res, nil
+	}
+	if tag, ok := domain.TagOfBoard(board); ok && a.boards.Tag.N > 0 {
+		return rankPlan{tag: &a.boards.Tag, onlyTag: tag}, nil
+	}
+	if author, ok := domain.AuthorOfBoard(board); ok && a.boards.Author.N > 0 {
+		return rankPlan{author: &a.boards.Author, onlyAuthor: author}, nil
+	}
+	for _, b := range a.boards.Fixed {
+		if b.Key == board {
+			return rankPlan{fixed: []domain.Board{b}}, nil
+		}
+	}
+	return rankPlan{}, ErrUnknownBoard
+}
+
+func (a *BatchRankingService) enqueueBoard(bq *boardQueues, now time.Time,
+	art domain.Article, scores []float64) {
+	if bq.board.Window > 0 && art.Utime.Before(now.Add(-bq.board.Window)) {
+		return
+	}
+	for i, score := range scores {
+		enqueueTopN(bq.ques[i], scoredArticle{art: art, score: score})
+	}
+}
+
+// tagsOf 批量查询文章作者给文章打的标签，查询失败的话这一批文章就不参与标签榜单了
+func (a *BatchRankingService) tagsOf(ctx context.Context,
+	arts []domain.Article) map[int64][]string {
+	resp, err := a.tagSvc.BatchGetBizTags(ctx, &tagv1.BatchGetBizTagsRequest{
+		Biz: "article",
+		Targets: slice.Map(arts, func(idx int, src domain.Article) *tagv1.BizTarget {
+			return &tagv1.BizTarget{BizId: src.Id, Uid: src.Author.Id}
+		}),
+	})
+	if err != nil {
+		a.l.Warn("批量查询文章标签失败",
+			logger.Int64("cnt", int64(len(arts))),
+			logger.Error(err))
+		return nil
+	}
+	res := make(map[int64][]string, len(resp.GetTags()))
+	for aid, tags := range resp.GetTags() {
+		if len(tags.GetTags()) > 0 {
+			res[aid] = slice.Map(tags.GetTags(), func(idx int, src *tagv1.Tag) string {
+				return src.GetName()
+			})
+		}
+	}
+	return res
+}
+
+// enqueueTopN 队列满了之后，只有比当前最小值大的才能挤进去
+func enqueueTopN(que *queue.PriorityQueue[scoredArticle], ele scoredArticle) {
+	err := que.Enqueue(ele)
+	if err != queue.ErrOutOfCapacity {
+		return
+	}
+	minEle, _ := que.Peek()
+	if ele.score <= minEle.score {
+		return
+	}
+	_, _ = que.Dequeue()
+	_ = que.Enqueue(ele)
+}
+
+func (a *BatchRankingService) TopN(ctx context.Context, board, scorer string) (domain.RankingList, error) {
+	if board == "" {
+		board = a.boards.Fixed[0].Key
+	}
+	_, err := a.plan(board)
+	if err != nil {
+		return domain.RankingList{}, err
+	}
+	if scorer == "" {
+		scorer = a.scorers[0].Name()
+	}
+	for _, s := range a.scorers {
+		if s.Name() == scorer {
+			return a.repo.GetTopN(ctx, board, scorer)
+		}
+	}
+	return domain.RankingList{}, ErrUnknownScorer
+}
+
+func intrToDomain(intr *intrv1.Interactive) domain.Interactive {
+	return domain.Interactive{
+		BizId:      intr.GetBizId(),
+		ReadCnt:    intr.GetReadCnt(),
+		LikeCnt:    intr.GetLikeCnt(),
+		CollectCnt: intr.GetCollectCnt(),
+	}
 }
 
 func articleToDomain(article *articlev1.Article) domain.Article {
@@ -0,0 +1,256 @@
+package service
+
+import (
+	articlev1 "basic-go/lmbook/api/proto/gen/article/v1"
+	artmocks "basic-go/lmbook/api/proto/gen/article/v1/mocks"
+	intrv1 "basic-go/lmbook/api/proto/gen/intr/v1"
+	intrmocks "basic-go/lmbook/api/proto/gen/intr/v1/mocks"
+	tagv1 "basic-go/lmbook/api/proto/gen/tag/v1"
+	tagmocks "basic-go/lmbook/api/proto/gen/tag/v1/mocks"
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/ranking/domain"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// likeScorer 直接用点赞数作为分数，方便验证结果
+type likeScorer struct{}
+
+func (likeScorer) Name() string {
+	return "like"
+}
+
+func (likeScorer) Score(intr domain.Interactive, utime time.Time) float64 {
+	return float64(intr.LikeCnt)
+}
+
+func TestBatchRankingService_rankTopN(t *testing.T) {
+	now := time.Now()
+	// 1 和 3 是一天内的，2 是三天前的
+	utimes := map[int64]time.Time{
+		1: now.Add(-time.Hour),
+		2: now.Add(-time.Hour * 72),
+		3: now.Add(-time.Hour * 2),
+	}
+	likes := map[int64]int64{1: 10, 2: 100, 3: 5}
+	boards := Boards{
+		Fixed: []domain.Board{
+			{Key: "weekly", N: 2, Window: time.Hour * 24 * 7},
+			{Key: "daily", N: 2, Window: time.Hour * 24},
+		},
+	}
+	testCases := []struct {
+		name  string
+		board string
+
+		wantBoards []string
+		wantIds    [][]int64
+		wantErr    error
+	}{
+		{
+			name:       "计算所有榜单",
+			wantBoards: []string{"weekly", "daily"},
+			wantIds:    [][]int64{{2, 1}, {1, 3}},
+		},
+		{
+			name:       "只计算日榜",
+			board:      "daily",
+			wantBoards: []string{"daily"},
+			wantIds:    [][]int64{{1, 3}},
+		},
+		{
+			name:    "未知榜单",
+			board:   "monthly",
+			wantErr: ErrUnknownBoard,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			artSvc := artmocks.NewMockArticleServiceClient(ctrl)
+			intrSvc := intrmocks.NewMockInteractiveServiceClient(ctrl)
+			if tc.wantErr == nil {
+				arts := make([]*articlev1.Article, 0, 3)
+				intrs := make(map[int64]*intrv1.Interactive, 3)
+				for _, id := range []int64{1, 3, 2} {
+					arts = append(arts, &articlev1.Article{
+						Id:    id,
+						Utime: timestamppb.New(utimes[id]),
+					})
+					intrs[id] = &intrv1.Interactive{BizId: id, LikeCnt: likes[id]}
+				}
+				artSvc.EXPECT().ListPub(gomock.Any(), gomock.Any()).
+					Return(&articlev1.ListPubResponse{Articles: arts}, nil)
+				intrSvc.EXPECT().GetByIds(gomock.Any(), gomock.Any()).
+					Return(&intrv1.GetByIdsResponse{Intrs: intrs}, nil)
+			}
+			svc := NewBatchRankingService(intrSvc, artSvc, nil, nil,
+				[]Scorer{likeScorer{}}, boards, logger.NewNoOpLogger()).(*BatchRankingService)
+			svc.BatchSize = 10
+			lists, err := svc.rankTopN(context.Background(), tc.board, nil)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			require.Len(t, lists, len(tc.wantBoards))
+			for i, list := range lists {
+				assert.Equal(t, tc.wantBoards[i], list.Board)
+				assert.Equal(t, "like", list.Scorer)
+				ids := make([]int64, 0, len(list.Articles))
+				for _, art := range list.Articles {
+					ids = append(ids, art.Id)
+				}
+				assert.Equal(t, tc.wantIds[i], ids)
+			}
+		})
+	}
+}
+
+func TestBatchRankingService_rankTopN_AllTime(t *testing.T) {
+	now := time.Now()
+	// 第一批最后一篇已经在周榜的窗口之外了，有总榜的时候还要继续往后扫描
+	batches := [][]int64{{1, 2}, {4}}
+	utimes := map[int64]time.Time{
+		1: now.Add(-time.Hour),
+		2: now.Add(-time.Hour * 24 * 10),
+		4: now.Add(-time.Hour * 24 * 30),
+	}
+	likes := map[int64]int64{1: 10, 2: 100, 4: 50}
+	testCases := []struct {
+		name   string
+		boards Boards
+
+		wantBoards []string
+		wantIds    [][]int64
+	}{
+		{
+			name: "只有周榜，扫描到窗口之外就停下来",
+			boards: Boards{Fixed: []domain.Board{
+				{Key: "weekly", N: 2, Window: time.Hour * 24 * 7},
+			}},
+			wantBoards: []string{"weekly"},
+			wantIds:    [][]int64{{1}},
+		},
+		{
+			name: "有总榜，扫描全部的文章",
+			boards: Boards{Fixed: []domain.Board{
+				{Key: "weekly", N: 2, Window: time.Hour * 24 * 7},
+				{Key: "all", N: 2},
+			}},
+			wantBoards: []string{"weekly", "all"},
+			wantIds:    [][]int64{{1}, {2, 4}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			artSvc := artmocks.NewMockArticleServiceClient(ctrl)
+			intrSvc := intrmocks.NewMockInteractiveServiceClient(ctrl)
+			artSvc.EXPECT().ListPub(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, req *articlev1.ListPubRequest,
+					opts ...grpc.CallOption) (*articlev1.ListPubResponse, error) {
+					batch := batches[0]
+					if req.GetOffset() > 0 {
+						batch = batches[1]
+					}
+					arts := make([]*articlev1.Article, 0, len(batch))
+					for _, id := range batch {
+						arts = append(arts, &articlev1.Article{
+							Id:    id,
+							Utime: timestamppb.New(utimes[id]),
+						})
+					}
+					return &articlev1.ListPubResponse{Articles: arts}, nil
+				}).AnyTimes()
+			intrSvc.EXPECT().GetByIds(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, req *intrv1.GetByIdsRequest,
+					opts ...grpc.CallOption) (*intrv1.GetByIdsResponse, error) {
+					intrs := make(map[int64]*intrv1.Interactive, len(req.GetIds()))
+					for _, id := range req.GetIds() {
+						intrs[id] = &intrv1.Interactive{BizId: id, LikeCnt: likes[id]}
+					}
+					return &intrv1.GetByIdsResponse{Intrs: intrs}, nil
+				}).AnyTimes()
+			svc := NewBatchRankingService(intrSvc, artSvc, nil, nil,
+				[]Scorer{likeScorer{}}, tc.boards, logger.NewNoOpLogger()).(*BatchRankingService)
+			svc.BatchSize = 2
+			lists, err := svc.rankTopN(context.Background(), "", nil)
+			require.NoError(t, err)
+			require.Len(t, lists, len(tc.wantBoards))
+			for i, list := range lists {
+				assert.Equal(t, tc.wantBoards[i], list.Board)
+				ids := make([]int64, 0, len(list.Articles))
+				for _, art := range list.Articles {
+					ids = append(ids, art.Id)
+				}
+				assert.Equal(t, tc.wantIds[i], ids)
+			}
+		})
+	}
+}
+
+func TestBatchRankingService_tagsOf(t *testing.T) {
+	arts := []domain.Article{
+		{Id: 1, Author: domain.Author{Id: 100}},
+		{Id: 2, Author: domain.Author{Id: 200}},
+	}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) tagv1.TagServiceClient
+
+		wantTags map[int64][]string
+	}{
+		{
+			name: "一次查询一批文章",
+			mock: func(ctrl *gomock.Controller) tagv1.TagServiceClient {
+				tagSvc := tagmocks.NewMockTagServiceClient(ctrl)
+				tagSvc.EXPECT().BatchGetBizTags(gomock.Any(), &tagv1.BatchGetBizTagsRequest{
+					Biz: "article",
+					Targets: []*tagv1.BizTarget{
+						{BizId: 1, Uid: 100},
+						{BizId: 2, Uid: 200},
+					},
+				}).Return(&tagv1.BatchGetBizTagsResponse{
+					Tags: map[int64]*tagv1.BizTags{
+						1: {Tags: []*tagv1.Tag{{Name: "Go"}, {Name: "并发"}}},
+						2: {},
+					},
+				}, nil)
+				return tagSvc
+			},
+			wantTags: map[int64][]string{1: {"Go", "并发"}},
+		},
+		{
+			name: "查询失败，这一批都不参与标签榜单",
+			mock: func(ctrl *gomock.Controller) tagv1.TagServiceClient {
+				tagSvc := tagmocks.NewMockTagServiceClient(ctrl)
+				tagSvc.EXPECT().BatchGetBizTags(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("mock error"))
+				return tagSvc
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewBatchRankingService(nil, nil, tc.mock(ctrl), nil,
+				[]Scorer{likeScorer{}}, Boards{}, logger.NewNoOpLogger()).(*BatchRankingService)
+			tags := svc.tagsOf(context.Background(), arts)
+			assert.Equal(t, len(tc.wantTags), len(tags))
+			for aid, want := range tc.wantTags {
+				assert.Equal(t, want, tags[aid])
+			}
+		})
+	}
+}
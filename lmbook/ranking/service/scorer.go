@@ -0,0 +1,124 @@
+package service
+
+import (
+	"basic-go/lmbook/ranking/domain"
+	"math"
+	"time"
+)
+
+const (
+	ScorerGravity  = "gravity"
+	ScorerLog      = "log"
+	ScorerWeighted = "weighted"
+	ScorerDecay    = "decay"
+)
+
+// Scorer 热榜的打分策略
+type Scorer interface {
+	// Name 策略的名字，缓存 TopN 和查询 TopN 的时候都用它来区分
+	Name() string
+	Score(intr domain.Interactive, utime time.Time) float64
+}
+
+// GravityScorer Hacker News 的重力衰减算法
+// score = (P-1) / (T+2)^G，P 是点赞数，T 是距离更新时间的小时数
+type GravityScorer struct {
+	// Factor 就是 G，越大衰减越快
+	Factor float64
+}
+
+func NewGravityScorer(factor float64) *GravityScorer {
+	return &GravityScorer{Factor: factor}
+}
+
+func (g *GravityScorer) Name() string {
+	return ScorerGravity
+}
+
+func (g *GravityScorer) Score(intr domain.Interactive, utime time.Time) float64 {
+	return float64(intr.LikeCnt-1) /
+		math.Pow(time.Since(utime).Hours()+2, g.Factor)
+}
+
+// redditEpoch Reddit 算法里面的起始时间，2005-12-08 07:46:43 UTC
+const redditEpoch = 1134028003
+
+// LogScorer Reddit 的对数算法
+// score = log10(max(P, 1)) + (t - epoch) / period
+// 点赞数每增长十倍才加一分，而时间越新基础分越高，
+// 所以老文章需要多得多的点赞才能压过新文章
+type LogScorer struct {
+	// Period 多久的新旧差距相当于点赞数差十倍，Reddit 用的是 12.5 小时
+	Period time.Duration
+}
+
+func NewLogScorer(period time.Duration) *LogScorer {
+	return &LogScorer{Period: period}
+}
+
+func (l *LogScorer) Name() string {
+	return ScorerLog
+}
+
+func (l *LogScorer) Score(intr domain.Interactive, utime time.Time) float64 {
+	order := math.Log10(math.Max(float64(intr.LikeCnt), 1))
+	seconds := float64(utime.Unix() - redditEpoch)
+	return order + seconds/l.Period.Seconds()
+}
+
+// WeightedScorer 阅读、点赞、收藏加权求和之后再按照时间衰减
+// score = (R*Wr + L*Wl + C*Wc) / (T+2)^G
+type WeightedScorer struct {
+	ReadWeight    float64
+	LikeWeight    float64
+	CollectWeight float64
+	Factor        float64
+}
+
+func NewWeightedScorer(readWeight, likeWeight, collectWeight, factor float64) *WeightedScorer {
+	return &WeightedScorer{
+		ReadWeight:    readWeight,
+		LikeWeight:    likeWeight,
+		CollectWeight: collectWeight,
+		Factor:        factor,
+	}
+}
+
+func (w *WeightedScorer) Name() string {
+	return ScorerWeighted
+}
+
+func (w *WeightedScorer) Score(intr domain.Interactive, utime time.Time) float64 {
+	sum := float64(intr.ReadCnt)*w.ReadWeight +
+		float64(intr.LikeCnt)*w.LikeWeight +
+		float64(intr.CollectCnt)*w.CollectWeight
+	return sum / math.Pow(time.Since(utime).Hours()+2, w.Factor)
+}
+
+// DecayScorer 阅读、点赞加权求和之后按照半衰期衰减
+// score = (R*Wr + L*Wl) * 0.5^(T/H)
+// 增量模式下 ZSET 里面的分数就是按照这个公式逐步累积出来的，
+// 所以全量校准的时候也用它
+type DecayScorer struct {
+	ReadWeight float64
+	LikeWeight float64
+	// HalfLife 就是 H，热度衰减一半需要的时间
+	HalfLife time.Duration
+}
+
+func NewDecayScorer(readWeight, likeWeight float64, halfLife time.Duration) *DecayScorer {
+	return &DecayScorer{
+		ReadWeight: readWeight,
+		LikeWeight: likeWeight,
+		HalfLife:   halfLife,
+	}
+}
+
+func (d *DecayScorer) Name() string {
+	return ScorerDecay
+}
+
+func (d *DecayScorer) Score(intr domain.Interactive, utime time.Time) float64 {
+	sum := float64(intr.ReadCnt)*d.ReadWeight + float64(intr.LikeCnt)*d.LikeWeight
+	return sum * math.Pow(0.5, float64(time.Since(utime))/float64(d.HalfLife))
+}
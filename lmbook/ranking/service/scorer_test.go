@@ -0,0 +1,69 @@
+package service
+
+import (
+	"basic-go/lmbook/ranking/domain"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestScorer(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name   string
+		scorer Scorer
+		// higher 的得分应该比 lower 高
+		higher      domain.Interactive
+		higherUtime time.Time
+		lower       domain.Interactive
+		lowerUtime  time.Time
+	}{
+		{
+			name:        "gravity 同一时间点赞多的高",
+			scorer:      NewGravityScorer(1.5),
+			higher:      domain.Interactive{LikeCnt: 100},
+			higherUtime: now,
+			lower:       domain.Interactive{LikeCnt: 10},
+			lowerUtime:  now,
+		},
+		{
+			name:        "gravity 点赞一样新的高",
+			scorer:      NewGravityScorer(1.5),
+			higher:      domain.Interactive{LikeCnt: 100},
+			higherUtime: now,
+			lower:       domain.Interactive{LikeCnt: 100},
+			lowerUtime:  now.Add(-time.Hour * 24),
+		},
+		{
+			name:        "log 晚一个周期抵得上十倍点赞",
+			scorer:      NewLogScorer(time.Hour),
+			higher:      domain.Interactive{LikeCnt: 20},
+			higherUtime: now,
+			lower:       domain.Interactive{LikeCnt: 100},
+			lowerUtime:  now.Add(-time.Hour),
+		},
+		{
+			name:        "weighted 收藏比阅读权重高",
+			scorer:      NewWeightedScorer(1, 5, 10, 1.5),
+			higher:      domain.Interactive{CollectCnt: 10},
+			higherUtime: now,
+			lower:       domain.Interactive{ReadCnt: 50},
+			lowerUtime:  now,
+		},
+		{
+			name:        "decay 过了一个半衰期分数减半",
+			scorer:      NewDecayScorer(1, 5, time.Hour),
+			higher:      domain.Interactive{LikeCnt: 11},
+			higherUtime: now.Add(-time.Hour),
+			lower:       domain.Interactive{LikeCnt: 10},
+			lowerUtime:  now.Add(-time.Hour * 2),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			higher := tc.scorer.Score(tc.higher, tc.higherUtime)
+			lower := tc.scorer.Score(tc.lower, tc.lowerUtime)
+			assert.Greater(t, higher, lower)
+		})
+	}
+}
@@ -3,31 +3,45 @@
 package main
 
 import (
+	"basic-go/lmbook/ranking/events"
 	"basic-go/lmbook/ranking/grpc"
 	"basic-go/lmbook/ranking/ioc"
 	"basic-go/lmbook/ranking/repository"
 	"basic-go/lmbook/ranking/repository/cache"
-	"basic-go/lmbook/ranking/service"
 	"github.com/google/wire"
 )
 
 var serviceProviderSet = wire.NewSet(
-	cache.NewRankingLocalCache,
-	cache.NewRedisRankingCache,
+	ioc.InitRankingLocalCache,
+	ioc.InitRedisRankingCache,
+	cache.NewRedisRankingRefresher,
 	repository.NewCachedRankingRepository,
-	service.NewBatchRankingService,
+	cache.NewRedisRankingScoreCache,
+	repository.NewCachedRankingScoreRepository,
+	ioc.InitIncrementalRankingService,
+	ioc.InitRankingService,
 )
 
 var thirdProvider = wire.NewSet(
+	ioc.InitRedisClient,
 	ioc.InitRedis,
 	ioc.InitInterActiveRpcClient,
 	ioc.InitArticleRpcClient,
+	ioc.InitEtcdClient,
+	ioc.InitLogger,
+	ioc.InitScorers,
+	ioc.InitSaramaClient,
+	ioc.InitTagRpcClient,
+	ioc.InitBoards,
 )
 
 func Init() *App {
 	wire.Build(
 		thirdProvider,
 		serviceProviderSet,
+		events.NewInteractiveEventConsumer,
+		events.NewRefreshConsumer,
+		ioc.InitConsumers,
 		grpc.NewRankingServiceServer,
 		ioc.InitGRPCxServer,
 		wire.Struct(new(App), "*"),
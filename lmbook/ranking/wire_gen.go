@@ -7,11 +7,11 @@
 package main
 
 import (
+	"basic-go/lmbook/ranking/events"
 	"basic-go/lmbook/ranking/grpc"
 	"basic-go/lmbook/ranking/ioc"
 	"basic-go/lmbook/ranking/repository"
 	"basic-go/lmbook/ranking/repository/cache"
-	"basic-go/lmbook/ranking/service"
 	"github.com/google/wire"
 )
 
@@ -20,21 +20,36 @@ import (
 func Init() *App {
 	interactiveServiceClient := ioc.InitInterActiveRpcClient()
 	articleServiceClient := ioc.InitArticleRpcClient()
-	cmdable := ioc.InitRedis()
-	redisRankingCache := cache.NewRedisRankingCache(cmdable)
-	rankingLocalCache := cache.NewRankingLocalCache()
-	rankingRepository := repository.NewCachedRankingRepository(redisRankingCache, rankingLocalCache)
-	rankingService := service.NewBatchRankingService(interactiveServiceClient, articleServiceClient, rankingRepository)
+	tagServiceClient := ioc.InitTagRpcClient()
+	universalClient := ioc.InitRedisClient()
+	cmdable := ioc.InitRedis(universalClient)
+	redisRankingCache := ioc.InitRedisRankingCache(cmdable)
+	rankingLocalCache := ioc.InitRankingLocalCache()
+	redisRankingRefresher := cache.NewRedisRankingRefresher(universalClient)
+	loggerV1 := ioc.InitLogger()
+	rankingRepository := repository.NewCachedRankingRepository(redisRankingCache, rankingLocalCache, redisRankingRefresher, loggerV1)
+	v := ioc.InitScorers()
+	redisRankingScoreCache := cache.NewRedisRankingScoreCache(cmdable)
+	rankingScoreRepository := repository.NewCachedRankingScoreRepository(redisRankingScoreCache)
+	boards := ioc.InitBoards()
+	incrementalRankingService := ioc.InitIncrementalRankingService(interactiveServiceClient, articleServiceClient, tagServiceClient, rankingRepository, rankingScoreRepository, v, boards, loggerV1)
+	rankingService := ioc.InitRankingService(interactiveServiceClient, articleServiceClient, tagServiceClient, rankingRepository, v, boards, incrementalRankingService, loggerV1)
 	rankingServiceServer := grpc.NewRankingServiceServer(rankingService)
-	server := ioc.InitGRPCxServer(rankingServiceServer)
+	client := ioc.InitEtcdClient()
+	server := ioc.InitGRPCxServer(rankingServiceServer, client, loggerV1)
+	saramaClient := ioc.InitSaramaClient()
+	interactiveEventConsumer := events.NewInteractiveEventConsumer(saramaClient, incrementalRankingService, loggerV1)
+	refreshConsumer := events.NewRefreshConsumer(redisRankingRefresher, rankingRepository, loggerV1)
+	v2 := ioc.InitConsumers(interactiveEventConsumer, refreshConsumer)
 	app := &App{
-		server: server,
+		server:    server,
+		consumers: v2,
 	}
 	return app
 }
 
 // wire.go:
 
-var serviceProviderSet = wire.NewSet(cache.NewRankingLocalCache, cache.NewRedisRankingCache, repository.NewCachedRankingRepository, service.NewBatchRankingService)
+var serviceProviderSet = wire.NewSet(ioc.InitRankingLocalCache, ioc.InitRedisRankingCache, cache.NewRedisRankingRefresher, repository.NewCachedRankingRepository, cache.NewRedisRankingScoreCache, repository.NewCachedRankingScoreRepository, ioc.InitIncrementalRankingService, ioc.InitRankingService)
 
-var thirdProvider = wire.NewSet(ioc.InitRedis, ioc.InitInterActiveRpcClient, ioc.InitArticleRpcClient)
+var thirdProvider = wire.NewSet(ioc.InitRedisClient, ioc.InitRedis, ioc.InitInterActiveRpcClient, ioc.InitArticleRpcClient, ioc.InitEtcdClient, ioc.InitLogger, ioc.InitScorers, ioc.InitSaramaClient, ioc.InitTagRpcClient, ioc.InitBoards)
@@ -5,7 +5,10 @@ import (
 	"basic-go/lmbook/reward/domain"
 	"basic-go/lmbook/reward/service"
 	"context"
+	"errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type RewardServiceServer struct {
@@ -28,10 +31,13 @@ func (r *RewardServiceServer) PreReward(ctx context.Context, request *rewardv1.P
 			Biz:     request.Biz,
 			BizId:   request.BizId,
 			BizName: request.BizName,
-			Uid:     request.Uid,
+			Uid:     request.TargetUid,
 		},
 		Amt: request.Amt,
 	})
+	if errors.Is(err, service.ErrBlocked) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
 	return &rewardv1.PreRewardResponse{
 		CodeUrl: codeURL.URL,
 		Rid:     codeURL.Rid,
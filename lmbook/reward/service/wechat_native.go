@@ -2,6 +2,7 @@ package service
 
 import (
 	accountv1 "basic-go/lmbook/api/proto/gen/account/v1"
+	followv1 "basic-go/lmbook/api/proto/gen/follow/v1"
 	pmtv1 "basic-go/lmbook/api/proto/gen/payment/v1"
 	"basic-go/lmbook/pkg/logger"
 	"basic-go/lmbook/reward/domain"
@@ -13,11 +14,15 @@ import (
 	"strings"
 )
 
+// ErrBlocked 被打赏的人拉黑了
+var ErrBlocked = errors.New("对方已将你拉黑")
+
 type WechatNativeRewardService struct {
 	client pmtv1.WechatPaymentServiceClient
 	repo   repository.RewardRepository
 	l      logger.LoggerV1
 	acli   accountv1.AccountServiceClient
+	fcli   followv1.FollowServiceClient
 }
 
 func (s *WechatNativeRewardService) UpdateReward(ctx context.Context,
@@ -111,6 +116,10 @@ func (s *WechatNativeRewardService) GetReward(ctx context.Context, rid, uid int6
 }
 
 func (s *WechatNativeRewardService) PreReward(ctx context.Context, r domain.Reward) (domain.CodeURL, error) {
+	err := s.checkBlocked(ctx, r)
+	if err != nil {
+		return domain.CodeURL{}, err
+	}
 	// 缓存，可选的步骤
 	res, err := s.repo.GetCachedCodeURL(ctx, r)
 	if err == nil {
@@ -145,6 +154,28 @@ func (s *WechatNativeRewardService) PreReward(ctx context.Context, r domain.Rewa
 	return cu, nil
 }
 
+// checkBlocked 关注服务出问题的时候放行，不能因为它让打赏整个不可用
+func (s *WechatNativeRewardService) checkBlocked(ctx context.Context, r domain.Reward) error {
+	if r.Target.Uid == 0 || r.Target.Uid == r.Uid {
+		return nil
+	}
+	resp, err := s.fcli.IsBlocked(ctx, &followv1.IsBlockedRequest{
+		Uid:    r.Target.Uid,
+		Target: r.Uid,
+	})
+	if err != nil {
+		s.l.Error("查询拉黑关系失败",
+			logger.Error(err),
+			logger.Int64("uid", r.Uid),
+			logger.Int64("target", r.Target.Uid))
+		return nil
+	}
+	if resp.GetBlocked() {
+		return ErrBlocked
+	}
+	return nil
+}
+
 func (s *WechatNativeRewardService) bizTradeNO(rid int64) string {
 	return fmt.Sprintf("reward-%d", rid)
 }
@@ -160,6 +191,7 @@ func NewWechatNativeRewardService(
 	repo repository.RewardRepository,
 	l logger.LoggerV1,
 	acli accountv1.AccountServiceClient,
+	fcli followv1.FollowServiceClient,
 ) RewardService {
-	return &WechatNativeRewardService{client: client, repo: repo, l: l, acli: acli}
+	return &WechatNativeRewardService{client: client, repo: repo, l: l, acli: acli, fcli: fcli}
 }
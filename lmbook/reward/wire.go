@@ -23,6 +23,7 @@ func Init() *wego.App {
 	wire.Build(thirdPartySet,
 		service.NewWechatNativeRewardService,
 		ioc.InitAccountClient,
+		ioc.InitFollowClient,
 		ioc.InitGRPCxServer,
 		ioc.InitPaymentClient,
 		repository.NewRewardRepository,
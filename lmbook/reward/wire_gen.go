@@ -29,7 +29,8 @@ func Init() *wego.App {
 	rewardRepository := repository.NewRewardRepository(rewardDAO, rewardCache)
 	loggerV1 := ioc.InitLogger()
 	accountServiceClient := ioc.InitAccountClient(client)
-	rewardService := service.NewWechatNativeRewardService(wechatPaymentServiceClient, rewardRepository, loggerV1, accountServiceClient)
+	followServiceClient := ioc.InitFollowClient(client)
+	rewardService := service.NewWechatNativeRewardService(wechatPaymentServiceClient, rewardRepository, loggerV1, accountServiceClient, followServiceClient)
 	rewardServiceServer := grpc.NewRewardServiceServer(rewardService)
 	server := ioc.InitGRPCxServer(rewardServiceServer, client, loggerV1)
 	app := &wego.App{
@@ -6,4 +6,16 @@ type Article struct {
 	Status  int32
 	Content string
 	Tags    []string
+	// 作者
+	AuthorId int64
+	// 更新时间，毫秒
+	Utime int64
+	// 只有搜索结果里面才有
+	Highlight ArticleHighlight
+}
+
+// ArticleHighlight 命中的片段，关键字已经用 <em></em> 包起来了
+type ArticleHighlight struct {
+	Title   []string
+	Content []string
 }
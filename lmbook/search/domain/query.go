@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+// Query 解析之后的搜索表达式
+type Query struct {
+	// 普通的关键字
+	Keywords []string
+	// 引号括起来的短语，要求完整出现
+	Phrases []string
+	// -xxx，命中了就排除掉
+	Excludes []string
+	// author:xxx，可以是用户 id，也可以是昵称
+	Authors []string
+	// tag:xxx
+	Tags []string
+	// before: 和 after:，零值代表不限制
+	Before time.Time
+	After  time.Time
+}
+
+// HasText 是否有需要全文匹配的内容，只有过滤条件的时候用户是搜不出来的
+func (q Query) HasText() bool {
+	return len(q.Keywords) > 0 || len(q.Phrases) > 0
+}
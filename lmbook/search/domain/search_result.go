@@ -3,4 +3,53 @@ package domain
 type SearchResult struct {
 	Users    []User
 	Articles []Article
+	// 用户和文章分开翻页
+	UserPage    Page
+	ArticlePage Page
+}
+
+// Page 一次搜索的分页信息
+type Page struct {
+	// 命中的总数，不受分页影响
+	Total int64
+	// 下一页的 search_after 游标，为空说明没有下一页了
+	NextCursor string
+}
+
+type SortBy uint8
+
+const (
+	// SortByRelevance 按照相关度，也是默认的排序
+	SortByRelevance SortBy = iota
+	// SortByRecency 最近更新的排前面
+	SortByRecency
+	// SortByHotness 热度高的排前面
+	SortByHotness
+)
+
+// SearchOption 分页和排序选项
+type SearchOption struct {
+	Offset int
+	Limit  int
+	Sort   SortBy
+	// 上一页返回的游标，不为空的时候忽略 Offset
+	UserCursor    string
+	ArticleCursor string
+}
+
+// PageOption 单个业务的分页选项
+type PageOption struct {
+	Offset int
+	Limit  int
+	Sort   SortBy
+	Cursor string
+}
+
+func (o SearchOption) UserPage() PageOption {
+	// 用户没有时间、热度这些维度，只能按照相关度来
+	return PageOption{Offset: o.Offset, Limit: o.Limit, Cursor: o.UserCursor}
+}
+
+func (o SearchOption) ArticlePage() PageOption {
+	return PageOption{Offset: o.Offset, Limit: o.Limit, Sort: o.Sort, Cursor: o.ArticleCursor}
 }
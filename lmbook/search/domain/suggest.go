@@ -0,0 +1,22 @@
+package domain
+
+// Suggestions 输入提示，只需要 id 和展示用的文字
+type Suggestions struct {
+	Articles []Article
+	Users    []User
+	Tags     []string
+}
+
+// Popularity 某个资源的热度，来自 interactive 的计数
+type Popularity struct {
+	Biz        string
+	BizId      int64
+	ReadCnt    int64
+	LikeCnt    int64
+	CollectCnt int64
+}
+
+// Score 热度分。收藏比点赞难，点赞比阅读难，所以权重依次增加
+func (p Popularity) Score() float64 {
+	return float64(p.ReadCnt) + float64(p.LikeCnt)*5 + float64(p.CollectCnt)*10
+}
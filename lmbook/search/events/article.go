@@ -10,7 +10,7 @@ import (
 	"time"
 )
 
-const topicSyncArticle = "sync_article_event"
+const TopicSyncArticle = "sync_article_event"
 
 type ArticleConsumer struct {
 	syncSvc service.SyncService
@@ -29,10 +29,12 @@ func NewArticleConsumer(client sarama.Client,
 }
 
 type ArticleEvent struct {
-	Id      int64  `json:"id"`
-	Title   string `json:"title"`
-	Status  int32  `json:"status"`
-	Content string `json:"content"`
+	Id       int64  `json:"id"`
+	Title    string `json:"title"`
+	Status   int32  `json:"status"`
+	Content  string `json:"content"`
+	AuthorId int64  `json:"author_id"`
+	Utime    int64  `json:"utime"`
 }
 
 func (a *ArticleConsumer) Start() error {
@@ -43,7 +45,7 @@ func (a *ArticleConsumer) Start() error {
 	}
 	go func() {
 		err := cg.Consume(context.Background(),
-			[]string{topicSyncArticle},
+			[]string{TopicSyncArticle},
 			saramax.NewHandler[ArticleEvent](a.l, a.Consume))
 		if err != nil {
 			a.l.Error("退出了消费循环异常", logger.Error(err))
@@ -61,9 +63,11 @@ func (a *ArticleConsumer) Consume(sg *sarama.ConsumerMessage,
 
 func (a *ArticleConsumer) toDomain(article ArticleEvent) domain.Article {
 	return domain.Article{
-		Id:      article.Id,
-		Title:   article.Title,
-		Status:  article.Status,
-		Content: article.Content,
+		Id:       article.Id,
+		Title:    article.Title,
+		Status:   article.Status,
+		Content:  article.Content,
+		AuthorId: article.AuthorId,
+		Utime:    article.Utime,
 	}
 }
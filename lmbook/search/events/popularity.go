@@ -0,0 +1,68 @@
+package events
+
+import (
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/pkg/saramax"
+	"basic-go/lmbook/search/domain"
+	"basic-go/lmbook/search/service"
+	"context"
+	"github.com/IBM/sarama"
+	"time"
+)
+
+const topicSyncPopularity = "sync_popularity_event"
+
+// PopularityConsumer 同步热度，上游是 interactive 的计数
+type PopularityConsumer struct {
+	syncSvc service.SyncService
+	client  sarama.Client
+	l       logger.LoggerV1
+}
+
+func NewPopularityConsumer(client sarama.Client,
+	l logger.LoggerV1,
+	svc service.SyncService) *PopularityConsumer {
+	return &PopularityConsumer{
+		syncSvc: svc,
+		client:  client,
+		l:       l,
+	}
+}
+
+type PopularityEvent struct {
+	Biz        string `json:"biz"`
+	BizId      int64  `json:"biz_id"`
+	ReadCnt    int64  `json:"read_cnt"`
+	LikeCnt    int64  `json:"like_cnt"`
+	CollectCnt int64  `json:"collect_cnt"`
+}
+
+func (p *PopularityConsumer) Start() error {
+	cg, err := sarama.NewConsumerGroupFromClient("sync_popularity",
+		p.client)
+	if err != nil {
+		return err
+	}
+	go func() {
+		err := cg.Consume(context.Background(),
+			[]string{topicSyncPopularity},
+			saramax.NewHandler[PopularityEvent](p.l, p.Consume))
+		if err != nil {
+			p.l.Error("退出了消费循环异常", logger.Error(err))
+		}
+	}()
+	return err
+}
+
+func (p *PopularityConsumer) Consume(sg *sarama.ConsumerMessage,
+	evt PopularityEvent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	return p.syncSvc.InputPopularity(ctx, domain.Popularity{
+		Biz:        evt.Biz,
+		BizId:      evt.BizId,
+		ReadCnt:    evt.ReadCnt,
+		LikeCnt:    evt.LikeCnt,
+		CollectCnt: evt.CollectCnt,
+	})
+}
@@ -10,7 +10,7 @@ import (
 	"time"
 )
 
-const topicSyncUser = "sync_user_event"
+const TopicSyncUser = "sync_user_event"
 
 type UserConsumer struct {
 	syncSvc service.SyncService
@@ -43,7 +43,7 @@ func (u *UserConsumer) Start() error {
 	}
 	go func() {
 		err := cg.Consume(context.Background(),
-			[]string{topicSyncUser},
+			[]string{TopicSyncUser},
 			saramax.NewHandler[UserEvent](u.l, u.Consume))
 		if err != nil {
 			u.l.Error("退出了消费循环异常", logger.Error(err))
@@ -3,10 +3,14 @@ package grpc
 import (
 	searchv1 "basic-go/lmbook/api/proto/gen/search/v1"
 	"basic-go/lmbook/search/domain"
+	"basic-go/lmbook/search/repository"
 	"basic-go/lmbook/search/service"
 	"context"
+	"errors"
 	"github.com/ecodeclub/ekit/slice"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type SearchServiceServer struct {
@@ -23,9 +27,15 @@ func (s *SearchServiceServer) Register(server grpc.ServiceRegistrar) {
 }
 
 func (s *SearchServiceServer) Search(ctx context.Context, request *searchv1.SearchRequest) (*searchv1.SearchResponse, error) {
-	resp, err := s.svc.Search(ctx, request.Uid, request.Expression)
+	resp, err := s.svc.Search(ctx, request.Uid, request.Expression, domain.SearchOption{
+		Offset:        int(request.Offset),
+		Limit:         int(request.Limit),
+		Sort:          domain.SortBy(request.Sort),
+		UserCursor:    request.UserCursor,
+		ArticleCursor: request.ArticleCursor,
+	})
 	if err != nil {
-		return nil, err
+		return nil, toStatusErr(err)
 	}
 	return &searchv1.SearchResponse{
 		User: &searchv1.UserResult{
@@ -37,16 +47,56 @@ func (s *SearchServiceServer) Search(ctx context.Context, request *searchv1.Sear
 					Phone:    src.Phone,
 				}
 			}),
+			Total:      resp.UserPage.Total,
+			NextCursor: resp.UserPage.NextCursor,
 		},
 		Article: &searchv1.ArticleResult{
 			Articles: slice.Map(resp.Articles, func(idx int, src domain.Article) *searchv1.Article {
 				return &searchv1.Article{
+					Id:       src.Id,
+					Title:    src.Title,
+					Status:   src.Status,
+					Content:  src.Content,
+					Tags:     src.Tags,
+					AuthorId: src.AuthorId,
+					Utime:    src.Utime,
+				}
+			}),
+			Total:      resp.ArticlePage.Total,
+			NextCursor: resp.ArticlePage.NextCursor,
+			Highlights: slice.Map(resp.Articles, func(idx int, src domain.Article) *searchv1.ArticleHighlight {
+				return &searchv1.ArticleHighlight{
 					Id:      src.Id,
-					Title:   src.Title,
-					Status:  src.Status,
-					Content: src.Content,
+					Title:   src.Highlight.Title,
+					Content: src.Highlight.Content,
 				}
 			}),
 		},
 	}, nil
 }
+
+func (s *SearchServiceServer) Suggest(ctx context.Context, request *searchv1.SuggestRequest) (*searchv1.SuggestResponse, error) {
+	res, err := s.svc.Suggest(ctx, request.Uid, request.Prefix, int(request.Limit))
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &searchv1.SuggestResponse{
+		Articles: slice.Map(res.Articles, func(idx int, src domain.Article) *searchv1.ArticleSuggestion {
+			return &searchv1.ArticleSuggestion{Id: src.Id, Title: src.Title}
+		}),
+		Users: slice.Map(res.Users, func(idx int, src domain.User) *searchv1.UserSuggestion {
+			return &searchv1.UserSuggestion{Id: src.Id, Nickname: src.Nickname}
+		}),
+		Tags: res.Tags,
+	}, nil
+}
+
+func toStatusErr(err error) error {
+	switch {
+	case errors.Is(err, service.ErrDeepPaging),
+		errors.Is(err, repository.ErrInvalidCursor):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
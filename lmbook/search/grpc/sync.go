@@ -5,7 +5,10 @@ import (
 	"basic-go/lmbook/search/domain"
 	"basic-go/lmbook/search/service"
 	"context"
+	"errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type SyncServiceServer struct {
@@ -38,6 +41,20 @@ func (s *SyncServiceServer) InputAny(ctx context.Context, req *searchv1.InputAny
 	return &searchv1.InputAnyResponse{}, err
 }
 
+func (s *SyncServiceServer) InputPopularity(ctx context.Context, req *searchv1.InputPopularityRequest) (*searchv1.InputPopularityResponse, error) {
+	err := s.syncSvc.InputPopularity(ctx, domain.Popularity{
+		Biz:        req.Biz,
+		BizId:      req.BizId,
+		ReadCnt:    req.ReadCnt,
+		LikeCnt:    req.LikeCnt,
+		CollectCnt: req.CollectCnt,
+	})
+	if errors.Is(err, service.ErrUnsupportedBiz) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &searchv1.InputPopularityResponse{}, err
+}
+
 func (s *SyncServiceServer) toDomainUser(vuser *searchv1.User) domain.User {
 	return domain.User{
 		Id:       vuser.Id,
@@ -48,10 +65,12 @@ func (s *SyncServiceServer) toDomainUser(vuser *searchv1.User) domain.User {
 
 func (s *SyncServiceServer) toDomainArticle(art *searchv1.Article) domain.Article {
 	return domain.Article{
-		Id:      art.Id,
-		Title:   art.Title,
-		Status:  art.Status,
-		Content: art.Content,
-		Tags:    art.Tags,
+		Id:       art.Id,
+		Title:    art.Title,
+		Status:   art.Status,
+		Content:  art.Content,
+		Tags:     art.Tags,
+		AuthorId: art.AuthorId,
+		Utime:    art.Utime,
 	}
 }
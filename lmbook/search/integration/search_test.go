@@ -72,6 +72,39 @@ func (s *SearchTestSuite) TestSearch() {
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), 1, len(resp.User.Users))
 	assert.Equal(s.T(), 2, len(resp.Article.Articles))
+	assert.Equal(s.T(), int64(2), resp.Article.Total)
+	assert.Equal(s.T(), 2, len(resp.Article.Highlights))
+
+	// 一页一条，用游标翻到第二页
+	resp, err = s.searchSvc.Search(ctx, &searchv1.SearchRequest{
+		Expression: "Tom 内容 Jerry",
+		Uid:        1001,
+		Limit:      1,
+		Sort:       searchv1.SortBy_SORT_BY_RECENCY,
+	})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, len(resp.Article.Articles))
+	require.NotEmpty(s.T(), resp.Article.NextCursor)
+	first := resp.Article.Articles[0].Id
+	resp, err = s.searchSvc.Search(ctx, &searchv1.SearchRequest{
+		Expression:    "Tom 内容 Jerry",
+		Uid:           1001,
+		Limit:         1,
+		Sort:          searchv1.SortBy_SORT_BY_RECENCY,
+		ArticleCursor: resp.Article.NextCursor,
+	})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, len(resp.Article.Articles))
+	assert.NotEqual(s.T(), first, resp.Article.Articles[0].Id)
+
+	// 排除掉内容里面有 "这是内容" 的
+	resp, err = s.searchSvc.Search(ctx, &searchv1.SearchRequest{
+		Expression: `Tom -"这是内容"`,
+		Uid:        1001,
+	})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, len(resp.Article.Articles))
+	assert.Equal(s.T(), int64(123), resp.Article.Articles[0].Id)
 }
 
 type BizTags struct {
@@ -0,0 +1,27 @@
+package startup
+
+import (
+	"basic-go/lmbook/search/repository/dao"
+	"basic-go/lmbook/search/repository/dao/embedded"
+	"os"
+	"sync"
+)
+
+var (
+	backend     dao.Backend
+	backendOnce sync.Once
+)
+
+// InitBackend 默认用内嵌的全文索引，不需要启动 ES。
+// 设置环境变量 SEARCH_TEST_BACKEND=es 就连本地的 ES 跑。
+// 同步和搜索两个 server 要共用同一个，不然内嵌的实现里面搜不到同步进去的数据
+func InitBackend() dao.Backend {
+	backendOnce.Do(func() {
+		if os.Getenv("SEARCH_TEST_BACKEND") == "es" {
+			backend = dao.NewESBackend(InitESClient())
+			return
+		}
+		backend = embedded.NewBackend()
+	})
+	return backend
+}
@@ -12,10 +12,10 @@ import (
 )
 
 var serviceProviderSet = wire.NewSet(
-	dao.NewUserElasticDAO,
-	dao.NewArticleElasticDAO,
-	dao.NewTagESDAO,
-	dao.NewAnyESDAO,
+	dao.NewUserDAO,
+	dao.NewArticleDAO,
+	dao.NewTagDAO,
+	dao.NewAnyDAO,
 	repository.NewUserRepository,
 	repository.NewAnyRepository,
 	repository.NewArticleRepository,
@@ -24,7 +24,7 @@ var serviceProviderSet = wire.NewSet(
 )
 
 var thirdProvider = wire.NewSet(
-	InitESClient,
+	InitBackend,
 	ioc.InitLogger)
 
 func InitSearchServer() *grpc.SearchServiceServer {
@@ -18,26 +18,26 @@ import (
 // Injectors from wire.go:
 
 func InitSearchServer() *grpc.SearchServiceServer {
-	client := InitESClient()
-	userDAO := dao.NewUserElasticDAO(client)
+	backend := InitBackend()
+	userDAO := dao.NewUserDAO(backend)
 	userRepository := repository.NewUserRepository(userDAO)
-	articleDAO := dao.NewArticleElasticDAO(client)
-	tagDAO := dao.NewTagESDAO(client)
-	articleRepository := repository.NewArticleRepository(articleDAO, tagDAO)
+	articleDAO := dao.NewArticleDAO(backend)
+	tagDAO := dao.NewTagDAO(backend)
+	articleRepository := repository.NewArticleRepository(articleDAO, tagDAO, userDAO)
 	searchService := service.NewSearchService(userRepository, articleRepository)
 	searchServiceServer := grpc.NewSearchService(searchService)
 	return searchServiceServer
 }
 
 func InitSyncServer() *grpc.SyncServiceServer {
-	client := InitESClient()
-	anyDAO := dao.NewAnyESDAO(client)
+	backend := InitBackend()
+	anyDAO := dao.NewAnyDAO(backend)
 	anyRepository := repository.NewAnyRepository(anyDAO)
-	userDAO := dao.NewUserElasticDAO(client)
+	userDAO := dao.NewUserDAO(backend)
 	userRepository := repository.NewUserRepository(userDAO)
-	articleDAO := dao.NewArticleElasticDAO(client)
-	tagDAO := dao.NewTagESDAO(client)
-	articleRepository := repository.NewArticleRepository(articleDAO, tagDAO)
+	articleDAO := dao.NewArticleDAO(backend)
+	tagDAO := dao.NewTagDAO(backend)
+	articleRepository := repository.NewArticleRepository(articleDAO, tagDAO, userDAO)
 	syncService := service.NewSyncService(anyRepository, userRepository, articleRepository)
 	syncServiceServer := grpc.NewSyncServiceServer(syncService)
 	return syncServiceServer
@@ -45,8 +45,8 @@ func InitSyncServer() *grpc.SyncServiceServer {
 
 // wire.go:
 
-var serviceProviderSet = wire.NewSet(dao.NewUserElasticDAO, dao.NewArticleElasticDAO, dao.NewTagESDAO, dao.NewAnyESDAO, repository.NewUserRepository, repository.NewAnyRepository, repository.NewArticleRepository, service.NewSyncService, service.NewSearchService)
+var serviceProviderSet = wire.NewSet(dao.NewUserDAO, dao.NewArticleDAO, dao.NewTagDAO, dao.NewAnyDAO, repository.NewUserRepository, repository.NewAnyRepository, repository.NewArticleRepository, service.NewSyncService, service.NewSearchService)
 
 var thirdProvider = wire.NewSet(
-	InitESClient, ioc.InitLogger,
+	InitBackend, ioc.InitLogger,
 )
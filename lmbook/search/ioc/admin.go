@@ -0,0 +1,97 @@
+package ioc
+
+import (
+	articlev1 "basic-go/lmbook/api/proto/gen/article/v1"
+	"basic-go/lmbook/pkg/ginx"
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/search/reindex"
+	"basic-go/lmbook/search/repository/dao"
+	"crypto/subtle"
+	"fmt"
+	"github.com/IBM/sarama"
+	"github.com/gin-gonic/gin"
+	prometheus2 "github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/viper"
+	"net"
+	"net/http"
+)
+
+// InitAdminServer 管理后台的 server，目前只有重建索引
+func InitAdminServer(l logger.LoggerV1,
+	backend dao.Backend,
+	client sarama.Client,
+	artClient articlev1.ArticleServiceClient) *ginx.Server {
+	engine := gin.Default()
+	ginx.InitCounter(prometheus2.CounterOpts{
+		Namespace: "geektime_daming",
+		Subsystem: "lmbook_search_admin",
+		Name:      "biz_code",
+		Help:      "统计业务错误码",
+	})
+	type Config struct {
+		Addr string `yaml:"addr"`
+		// Token 配置了的话，请求要带上 Authorization: Bearer <token>
+		Token string `yaml:"token"`
+	}
+	cfg := Config{
+		Addr: "127.0.0.1:8083",
+	}
+	err := viper.UnmarshalKey("reindex.http", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	// 重建索引会写 ES，不能谁都可以调用
+	if cfg.Token == "" && !isLoopback(cfg.Addr) {
+		panic(fmt.Errorf("重建索引的管理后台监听 %s 的时候必须配置 reindex.http.token", cfg.Addr))
+	}
+	server := &ginx.Server{
+		Engine: engine,
+		Addr:   cfg.Addr,
+	}
+	esBackend, ok := backend.(*dao.ESBackend)
+	if !ok {
+		// 内嵌的全文索引没有 mapping，不需要重建
+		l.Info("搜索引擎不是 ES，不启用重建索引")
+		return server
+	}
+	m := esBackend.Indices()
+	sch := reindex.NewScheduler(m, l).
+		Register(dao.ArticleIndexName, "kafka", reindex.NewArticleKafkaSource(client)).
+		Register(dao.ArticleIndexName, "service", reindex.NewArticleServiceSource(artClient)).
+		Register(dao.ArticleIndexName, "index", reindex.NewIndexSource(m, dao.ArticleIndexName)).
+		Register(dao.UserIndexName, "kafka", reindex.NewUserKafkaSource(client)).
+		Register(dao.UserIndexName, "index", reindex.NewIndexSource(m, dao.UserIndexName)).
+		// 标签是通用的同步接口写进来的，只能从老版本拷贝
+		Register(dao.TagIndexName, "index", reindex.NewIndexSource(m, dao.TagIndexName)).
+		// 热度和按照热度算的补全权重是单独同步的
+		Carry(dao.ArticleIndexName, "hot_score", "title_suggest.weight")
+	sch.RegisterRoutes(engine.Group("/reindex", adminAuth(cfg.Token)))
+	return server
+}
+
+// adminAuth token 为空的时候只监听本机，不用校验
+func adminAuth(token string) gin.HandlerFunc {
+	want := []byte("Bearer " + token)
+	return func(ctx *gin.Context) {
+		if token == "" {
+			return
+		}
+		got := []byte(ctx.GetHeader("Authorization"))
+		if subtle.ConstantTimeCompare(got, want) != 1 {
+			ctx.AbortWithStatus(http.StatusUnauthorized)
+		}
+	}
+}
+
+// isLoopback addr 是不是只监听本机，:8083 这种监听的是所有网卡
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,77 @@
+package ioc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminAuth(t *testing.T) {
+	testCases := []struct {
+		name   string
+		token  string
+		header string
+
+		wantCode int
+	}{
+		{
+			name:     "token 正确",
+			token:    "abc",
+			header:   "Bearer abc",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "token 错误",
+			token:    "abc",
+			header:   "Bearer abd",
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "没有带 token",
+			token:    "abc",
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "没有配置 token",
+			wantCode: http.StatusOK,
+		},
+	}
+	gin.SetMode(gin.ReleaseMode)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			engine := gin.New()
+			engine.POST("/reindex/start", adminAuth(tc.token), func(ctx *gin.Context) {
+				ctx.Status(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodPost, "/reindex/start", nil)
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, req)
+			assert.Equal(t, tc.wantCode, recorder.Code)
+		})
+	}
+}
+
+func TestIsLoopback(t *testing.T) {
+	testCases := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "127.0.0.1:8083", want: true},
+		{addr: "localhost:8083", want: true},
+		{addr: "[::1]:8083", want: true},
+		{addr: ":8083"},
+		{addr: "0.0.0.0:8083"},
+		{addr: "10.0.0.1:8083"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.addr, func(t *testing.T) {
+			assert.Equal(t, tc.want, isLoopback(tc.addr))
+		})
+	}
+}
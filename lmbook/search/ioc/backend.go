@@ -0,0 +1,38 @@
+package ioc
+
+import (
+	"basic-go/lmbook/search/repository/dao"
+	"basic-go/lmbook/search/repository/dao/embedded"
+	"fmt"
+	"github.com/spf13/viper"
+)
+
+// InitBackend 搜索引擎，默认用 ES。
+// 本地开发或者数据量不大的部署可以配置成 embedded，用内嵌的全文索引，不需要 ES
+func InitBackend() dao.Backend {
+	type Config struct {
+		Backend string `yaml:"backend"`
+		// Dir 内嵌的全文索引存放数据的目录，不配置就只放在内存里面
+		Dir string `yaml:"dir"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("search", &cfg)
+	if err != nil {
+		panic(fmt.Errorf("读取搜索引擎配置失败 %w", err))
+	}
+	switch cfg.Backend {
+	case "embedded":
+		if cfg.Dir == "" {
+			return embedded.NewBackend()
+		}
+		b, err := embedded.Open(cfg.Dir)
+		if err != nil {
+			panic(err)
+		}
+		return b
+	case "", "es":
+		return dao.NewESBackend(InitESClient())
+	default:
+		panic(fmt.Errorf("未知的搜索引擎 %s", cfg.Backend))
+	}
+}
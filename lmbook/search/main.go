@@ -1,6 +1,7 @@
 package main
 
 import (
+	"basic-go/lmbook/pkg/ginx"
 	"basic-go/lmbook/pkg/grpcx"
 	"basic-go/lmbook/search/events"
 	"github.com/spf13/pflag"
@@ -16,6 +17,10 @@ func main() {
 			panic(err)
 		}
 	}
+	go func() {
+		err1 := app.adminServer.Start()
+		panic(err1)
+	}()
 	err := app.server.Serve()
 	panic(err)
 }
@@ -36,4 +41,6 @@ func initViperV2Watch() {
 type App struct {
 	server    *grpcx.Server
 	consumers []events.Consumer
+	// adminServer 重建索引之类的管理接口
+	adminServer *ginx.Server
 }
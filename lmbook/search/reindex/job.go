@@ -0,0 +1,190 @@
+package reindex
+
+import (
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/search/repository/dao"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrCountMismatch = errors.New("重建之后文档数量不对")
+
+const (
+	PhaseCreating   = "creating"
+	PhaseLoading    = "loading"
+	PhaseValidating = "validating"
+	PhaseSwapped    = "swapped"
+	PhaseFailed     = "failed"
+)
+
+// Progress 重建的进度
+type Progress struct {
+	Alias string `json:"alias"`
+	Index string `json:"index"`
+	Phase string `json:"phase"`
+	// Loaded 从数据源读到的文档数量，去重之后的
+	Loaded int64 `json:"loaded"`
+	// OldCount 和 NewCount 是校验的时候两个版本的文档数量
+	OldCount int64    `json:"oldCount"`
+	NewCount int64    `json:"newCount"`
+	Olds     []string `json:"olds"`
+	Err      string   `json:"err,omitempty"`
+}
+
+// Job 重建一个别名对应的索引：
+//  1. 创建新版本，挂上重建的别名，开始双写
+//  2. 从数据源全量导入，已经双写进去的文档不会被覆盖
+//  3. 校验文档数量
+//  4. 原子地切换别名，老版本保留，需要的话可以手动切回去
+//
+// 任何一步失败都会删掉新版本，别名一直指向老版本，线上不受影响
+type Job struct {
+	m     *dao.IndexManager
+	alias string
+	src   Source
+	// minRatio 新版本的文档数量至少要是老版本的多少
+	minRatio float64
+	// carry 不是从数据源来的字段，要从老版本拷贝过来
+	carry     []string
+	batchSize int
+	l         logger.LoggerV1
+}
+
+func NewJob(m *dao.IndexManager, alias string, src Source, l logger.LoggerV1) *Job {
+	return &Job{
+		m:         m,
+		alias:     alias,
+		src:       src,
+		minRatio:  0.9,
+		batchSize: 500,
+		l:         l,
+	}
+}
+
+func (j *Job) MinRatio(ratio float64) *Job {
+	j.minRatio = ratio
+	return j
+}
+
+func (j *Job) Carry(fields ...string) *Job {
+	j.carry = fields
+	return j
+}
+
+// Run 执行重建，report 用来汇报进度
+func (j *Job) Run(ctx context.Context, report func(p Progress)) error {
+	p := Progress{Alias: j.alias, Phase: PhaseCreating}
+	err := j.run(ctx, &p, report)
+	if err == nil {
+		return nil
+	}
+	p.Phase, p.Err = PhaseFailed, err.Error()
+	report(p)
+	if p.Index != "" {
+		// 有可能是 ctx 被取消了，所以要用新的
+		abortCtx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		er := j.m.AbortRebuild(abortCtx, j.alias, p.Index)
+		if er != nil {
+			j.l.Error("删除重建失败的索引失败",
+				logger.String("index", p.Index), logger.Error(er))
+		}
+	}
+	return err
+}
+
+func (j *Job) run(ctx context.Context, p *Progress, report func(p Progress)) error {
+	index, err := j.m.NextVersion(j.alias)
+	if err != nil {
+		return err
+	}
+	err = j.m.Create(ctx, j.alias, index)
+	if err != nil {
+		return err
+	}
+	p.Index = index
+	report(*p)
+	err = j.m.StartRebuild(ctx, j.alias, index)
+	if err != nil {
+		return err
+	}
+	// 等所有实例都开始双写再导数据，不然中间的更新就丢了
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(j.m.RebuildPropagation()):
+	}
+
+	p.Phase = PhaseLoading
+	report(*p)
+	ids := make(map[string]struct{})
+	err = j.src.Load(ctx, func(docs []dao.Doc) error {
+		for _, doc := range docs {
+			ids[doc.Id] = struct{}{}
+		}
+		er := j.m.BulkCreate(ctx, index, docs)
+		if er != nil {
+			return er
+		}
+		p.Loaded = int64(len(ids))
+		report(*p)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	olds, err := j.m.Current(ctx, j.alias)
+	if err != nil {
+		return err
+	}
+	if len(j.carry) > 0 {
+		for _, old := range olds {
+			err = j.m.CopyFields(ctx, old, index, j.batchSize, j.carry...)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	p.Phase = PhaseValidating
+	report(*p)
+	err = j.validate(ctx, p, olds)
+	if err != nil {
+		return err
+	}
+	p.Olds, err = j.m.Swap(ctx, j.alias, index)
+	if err != nil {
+		return err
+	}
+	p.Phase = PhaseSwapped
+	report(*p)
+	return nil
+}
+
+// validate 新版本至少要包含所有导入的文档，并且不能比老版本少太多
+func (j *Job) validate(ctx context.Context, p *Progress, olds []string) error {
+	var err error
+	p.NewCount, err = j.m.Count(ctx, p.Index)
+	if err != nil {
+		return err
+	}
+	p.OldCount = 0
+	for _, old := range olds {
+		cnt, er := j.m.Count(ctx, old)
+		if er != nil {
+			return er
+		}
+		p.OldCount += cnt
+	}
+	if p.NewCount < p.Loaded {
+		return fmt.Errorf("%w 导入了 %d 条，新版本只有 %d 条",
+			ErrCountMismatch, p.Loaded, p.NewCount)
+	}
+	if float64(p.NewCount) < float64(p.OldCount)*j.minRatio {
+		return fmt.Errorf("%w 老版本 %d 条，新版本 %d 条，要求至少 %.2f",
+			ErrCountMismatch, p.OldCount, p.NewCount, j.minRatio)
+	}
+	return nil
+}
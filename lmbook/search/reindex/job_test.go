@@ -0,0 +1,113 @@
+package reindex
+
+import (
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/search/repository/dao"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCountServer 假的 ES，只支持刷新和数文档
+func newCountServer(t *testing.T, counts map[string]int64) *dao.IndexManager {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		index, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		switch action {
+		case "_refresh":
+			_, _ = w.Write([]byte(`{}`))
+		case "_count":
+			_, _ = fmt.Fprintf(w, `{"count":%d}`, counts[index])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	client, err := elastic.NewClient(elastic.SetURL(srv.URL),
+		elastic.SetSniff(false), elastic.SetHealthcheck(false))
+	require.NoError(t, err)
+	return dao.NewIndexManager(client)
+}
+
+func TestJob_validate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		counts map[string]int64
+		olds   []string
+		loaded int64
+
+		wantOld int64
+		wantNew int64
+		wantErr error
+	}{
+		{
+			name:    "数量正常",
+			counts:  map[string]int64{"article_index_v2": 95, "article_index_v1": 100},
+			olds:    []string{"article_index_v1"},
+			loaded:  95,
+			wantOld: 100,
+			wantNew: 95,
+		},
+		{
+			name:    "双写进来的比导入的多",
+			counts:  map[string]int64{"article_index_v2": 101, "article_index_v1": 100},
+			olds:    []string{"article_index_v1"},
+			loaded:  100,
+			wantOld: 100,
+			wantNew: 101,
+		},
+		{
+			name:    "导入的文档没有都写进去",
+			counts:  map[string]int64{"article_index_v2": 99, "article_index_v1": 100},
+			olds:    []string{"article_index_v1"},
+			loaded:  100,
+			wantOld: 100,
+			wantNew: 99,
+			wantErr: ErrCountMismatch,
+		},
+		{
+			name:    "比老版本少太多",
+			counts:  map[string]int64{"article_index_v2": 89, "article_index_v1": 100},
+			olds:    []string{"article_index_v1"},
+			loaded:  89,
+			wantOld: 100,
+			wantNew: 89,
+			wantErr: ErrCountMismatch,
+		},
+		{
+			name: "多个老版本加起来算",
+			counts: map[string]int64{"article_index_v3": 90,
+				"article_index_v1": 50, "article_index_v2": 60},
+			olds:    []string{"article_index_v1", "article_index_v2"},
+			loaded:  90,
+			wantOld: 110,
+			wantNew: 90,
+			wantErr: ErrCountMismatch,
+		},
+		{
+			name:    "第一次建索引，没有老版本",
+			counts:  map[string]int64{"article_index_v1": 10},
+			loaded:  10,
+			wantNew: 10,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := newCountServer(t, tc.counts)
+			j := NewJob(m, dao.ArticleIndexName, nil, logger.NewNoOpLogger())
+			index := fmt.Sprintf("article_index_v%d", len(tc.olds)+1)
+			p := &Progress{Index: index, Loaded: tc.loaded}
+			err := j.validate(context.Background(), p, tc.olds)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantOld, p.OldCount)
+			assert.Equal(t, tc.wantNew, p.NewCount)
+		})
+	}
+}
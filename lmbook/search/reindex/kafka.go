@@ -0,0 +1,147 @@
+package reindex
+
+import (
+	"basic-go/lmbook/search/events"
+	"basic-go/lmbook/search/repository/dao"
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// KafkaSource 从头重放同步数据的 topic，同一个文档只保留最新的一条。
+// 要求 topic 的保留策略是 compact 或者保留得足够久，不然就只能用别的数据源。
+// 重放的终点是开始的时候每个分区的最新位置，之后的消息双写会处理
+type KafkaSource struct {
+	client    sarama.Client
+	topic     string
+	decode    func(val []byte) (dao.Doc, error)
+	batchSize int
+}
+
+func NewArticleKafkaSource(client sarama.Client) *KafkaSource {
+	return &KafkaSource{
+		client:    client,
+		topic:     events.TopicSyncArticle,
+		batchSize: 500,
+		decode: func(val []byte) (dao.Doc, error) {
+			var evt events.ArticleEvent
+			err := json.Unmarshal(val, &evt)
+			return dao.Doc{
+				Id: strconv.FormatInt(evt.Id, 10),
+				Body: dao.Article{
+					Id:       evt.Id,
+					Title:    evt.Title,
+					Status:   evt.Status,
+					Content:  evt.Content,
+					AuthorId: evt.AuthorId,
+					Utime:    evt.Utime,
+					// 权重是从老版本拷贝过来的热度
+					TitleSuggest: dao.NewTitleSuggest(evt.Title, evt.Status),
+				},
+			}, err
+		},
+	}
+}
+
+func NewUserKafkaSource(client sarama.Client) *KafkaSource {
+	return &KafkaSource{
+		client:    client,
+		topic:     events.TopicSyncUser,
+		batchSize: 500,
+		decode: func(val []byte) (dao.Doc, error) {
+			var evt events.UserEvent
+			err := json.Unmarshal(val, &evt)
+			return dao.Doc{
+				Id: strconv.FormatInt(evt.Id, 10),
+				Body: dao.User{
+					Id:       evt.Id,
+					Email:    evt.Email,
+					Nickname: evt.Nickname,
+					Phone:    evt.Phone,
+				},
+			}, err
+		},
+	}
+}
+
+type record struct {
+	doc dao.Doc
+	// 不知道生产者是不是按照 id 分区的，所以跨分区只能靠消息时间判断新旧
+	timestamp time.Time
+}
+
+func (s *KafkaSource) Load(ctx context.Context, fn func(docs []dao.Doc) error) error {
+	partitions, err := s.client.Partitions(s.topic)
+	if err != nil {
+		return err
+	}
+	consumer, err := sarama.NewConsumerFromClient(s.client)
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+	latest := make(map[string]record)
+	for _, p := range partitions {
+		err = s.replay(ctx, consumer, p, latest)
+		if err != nil {
+			return err
+		}
+	}
+	docs := make([]dao.Doc, 0, s.batchSize)
+	for _, r := range latest {
+		docs = append(docs, r.doc)
+		if len(docs) == s.batchSize {
+			err = fn(docs)
+			if err != nil {
+				return err
+			}
+			docs = make([]dao.Doc, 0, s.batchSize)
+		}
+	}
+	return fn(docs)
+}
+
+// replay 重放一个分区，从最老的消息到开始的时候的最新位置
+func (s *KafkaSource) replay(ctx context.Context, consumer sarama.Consumer,
+	partition int32, latest map[string]record) error {
+	begin, err := s.client.GetOffset(s.topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return err
+	}
+	// OffsetNewest 拿到的是下一条消息的位置
+	end, err := s.client.GetOffset(s.topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return err
+	}
+	if begin >= end {
+		return nil
+	}
+	pc, err := consumer.ConsumePartition(s.topic, partition, begin)
+	if err != nil {
+		return err
+	}
+	defer pc.Close()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err = <-pc.Errors():
+			return err
+		case msg := <-pc.Messages():
+			doc, err := s.decode(msg.Value)
+			// 和消费者一样，格式不对的消息直接跳过
+			if err == nil {
+				old, ok := latest[doc.Id]
+				if !ok || !msg.Timestamp.Before(old.timestamp) {
+					latest[doc.Id] = record{doc: doc, timestamp: msg.Timestamp}
+				}
+			}
+			if msg.Offset+1 >= end {
+				return nil
+			}
+		}
+	}
+}
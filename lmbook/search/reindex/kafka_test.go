@@ -0,0 +1,100 @@
+package reindex
+
+import (
+	"basic-go/lmbook/search/repository/dao"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// offsetClient 只实现了 GetOffset，其余的方法不会被调用
+type offsetClient struct {
+	sarama.Client
+	oldest int64
+	newest int64
+}
+
+func (c *offsetClient) GetOffset(topic string, partition int32, offset int64) (int64, error) {
+	if offset == sarama.OffsetOldest {
+		return c.oldest, nil
+	}
+	return c.newest, nil
+}
+
+func TestKafkaSource_replay(t *testing.T) {
+	now := time.Now()
+	msg := func(val string, ts time.Time) *sarama.ConsumerMessage {
+		return &sarama.ConsumerMessage{Value: []byte(val), Timestamp: ts}
+	}
+	testCases := []struct {
+		name   string
+		oldest int64
+		newest int64
+		// 依次从 oldest 开始的消息，nil 代表这个分区不会被消费
+		msgs []*sarama.ConsumerMessage
+
+		wantTitles map[string]string
+	}{
+		{
+			name:   "重放到开始的时候的最新位置就停下",
+			oldest: 5,
+			newest: 9,
+			msgs: []*sarama.ConsumerMessage{
+				msg(`{"id":1,"title":"v1"}`, now),
+				msg(`{"id":2,"title":"v1"}`, now),
+				// 格式不对的跳过
+				msg(`not json`, now),
+				msg(`{"id":1,"title":"v2"}`, now.Add(time.Second)),
+				// 开始之后才写进来的，不会读到
+				msg(`{"id":3,"title":"v1"}`, now),
+			},
+			wantTitles: map[string]string{"1": "v2", "2": "v1"},
+		},
+		{
+			name:   "跨分区之后时间更老的不能覆盖",
+			oldest: 0,
+			newest: 2,
+			msgs: []*sarama.ConsumerMessage{
+				msg(`{"id":1,"title":"v2"}`, now),
+				msg(`{"id":1,"title":"v1"}`, now.Add(-time.Second)),
+			},
+			wantTitles: map[string]string{"1": "v2"},
+		},
+		{
+			name:       "分区是空的",
+			oldest:     3,
+			newest:     3,
+			wantTitles: map[string]string{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			consumer := mocks.NewConsumer(t, nil)
+			defer func() {
+				require.NoError(t, consumer.Close())
+			}()
+			s := NewArticleKafkaSource(&offsetClient{oldest: tc.oldest, newest: tc.newest})
+			if tc.msgs != nil {
+				pc := consumer.ExpectConsumePartition(s.topic, 0, tc.oldest)
+				for _, m := range tc.msgs {
+					pc.YieldMessage(m)
+				}
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			latest := make(map[string]record)
+			err := s.replay(ctx, consumer, 0, latest)
+			require.NoError(t, err)
+			titles := make(map[string]string, len(latest))
+			for id, r := range latest {
+				titles[id] = r.doc.Body.(dao.Article).Title
+			}
+			assert.Equal(t, tc.wantTitles, titles)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package reindex
+
+import (
+	"basic-go/lmbook/pkg/ginx"
+	"basic-go/lmbook/pkg/logger"
+	"basic-go/lmbook/search/repository/dao"
+	"context"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Scheduler 管理后台触发重建索引的入口，同一时刻只允许一个重建任务
+type Scheduler struct {
+	lock sync.Mutex
+	m    *dao.IndexManager
+	// sources 别名 => 数据源名字 => 数据源
+	sources map[string]map[string]Source
+	// carry 别名 => 要从老版本拷贝过来的字段
+	carry    map[string][]string
+	l        logger.LoggerV1
+	cancel   func()
+	running  bool
+	progress Progress
+}
+
+func NewScheduler(m *dao.IndexManager, l logger.LoggerV1) *Scheduler {
+	return &Scheduler{
+		m:       m,
+		sources: make(map[string]map[string]Source),
+		carry:   make(map[string][]string),
+		l:       l,
+		cancel: func() {
+			// 初始的时候，啥也不用做
+		},
+	}
+}
+
+// Register 注册别名可以使用的数据源
+func (s *Scheduler) Register(alias, name string, src Source) *Scheduler {
+	srcs, ok := s.sources[alias]
+	if !ok {
+		srcs = make(map[string]Source)
+		s.sources[alias] = srcs
+	}
+	srcs[name] = src
+	return s
+}
+
+// Carry 别名重建的时候要从老版本拷贝过来的字段
+func (s *Scheduler) Carry(alias string, fields ...string) *Scheduler {
+	s.carry[alias] = fields
+	return s
+}
+
+func (s *Scheduler) RegisterRoutes(server *gin.RouterGroup) {
+	server.POST("/start", ginx.WrapReq[StartReq](s.Start))
+	server.POST("/cancel", ginx.Wrap(s.Cancel))
+	server.POST("/status", ginx.Wrap(s.Status))
+}
+
+type StartReq struct {
+	// Index 别名，比如说 article_index
+	Index string `json:"index"`
+	// Source 数据源的名字，比如说 kafka
+	Source string `json:"source"`
+	// MinRatio 新版本的文档数量至少要是老版本的多少，不传就是 0.9
+	MinRatio float64 `json:"minRatio"`
+}
+
+func (s *Scheduler) Start(ctx *gin.Context, req StartReq) (ginx.Result, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.running {
+		return ginx.Result{Code: 4, Msg: "已经有重建任务在运行了"}, nil
+	}
+	src, ok := s.sources[req.Index][req.Source]
+	if !ok {
+		return ginx.Result{Code: 4, Msg: "不支持的索引或者数据源"}, nil
+	}
+	job := NewJob(s.m, req.Index, src, s.l).Carry(s.carry[req.Index]...)
+	if req.MinRatio > 0 {
+		job.MinRatio(req.MinRatio)
+	}
+	var jobCtx context.Context
+	jobCtx, s.cancel = context.WithCancel(context.Background())
+	s.running = true
+	s.progress = Progress{Alias: req.Index, Phase: PhaseCreating}
+	go func() {
+		err := job.Run(jobCtx, s.report)
+		if err != nil {
+			s.l.Error("重建索引失败", logger.String("index", req.Index),
+				logger.String("source", req.Source), logger.Error(err))
+		}
+		s.lock.Lock()
+		s.running = false
+		s.cancel()
+		s.lock.Unlock()
+	}()
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+// Cancel 取消正在运行的重建，新版本会被删掉
+func (s *Scheduler) Cancel(ctx *gin.Context) (ginx.Result, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cancel()
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+// Status 最近一次重建的进度
+func (s *Scheduler) Status(ctx *gin.Context) (ginx.Result, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return ginx.Result{
+		Data: map[string]any{
+			"running":  s.running,
+			"progress": s.progress,
+		},
+	}, nil
+}
+
+func (s *Scheduler) report(p Progress) {
+	s.lock.Lock()
+	s.progress = p
+	s.lock.Unlock()
+}
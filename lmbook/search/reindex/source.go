@@ -0,0 +1,101 @@
+package reindex
+
+import (
+	articlev1 "basic-go/lmbook/api/proto/gen/article/v1"
+	"basic-go/lmbook/search/repository/dao"
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Source 重建索引的全量数据来源
+type Source interface {
+	// Load 分批把所有的文档交给 fn
+	Load(ctx context.Context, fn func(docs []dao.Doc) error) error
+}
+
+// ArticleServiceSource 直接从文章服务分页查询已发表的文章。
+// 没有发表的文章本来就搜不到，所以校验数量的时候 minRatio 要设置得宽松一点
+type ArticleServiceSource struct {
+	client    articlev1.ArticleServiceClient
+	batchSize int
+}
+
+func NewArticleServiceSource(client articlev1.ArticleServiceClient) *ArticleServiceSource {
+	return &ArticleServiceSource{
+		client:    client,
+		batchSize: 100,
+	}
+}
+
+func (s *ArticleServiceSource) Load(ctx context.Context, fn func(docs []dao.Doc) error) error {
+	// 开始之后更新的文章，双写会处理。
+	// 这里用更新时间做游标而不是一直翻 offset，因为导入期间有文章更新的话，
+	// 后面的文章会整体往前挪，用 offset 就会漏掉
+	start := time.Now()
+	offset := 0
+	for {
+		resp, err := s.client.ListPub(ctx, &articlev1.ListPubRequest{
+			StartTime: timestamppb.New(start),
+			Offset:    int32(offset),
+			Limit:     int32(s.batchSize),
+		})
+		if err != nil {
+			return err
+		}
+		docs := make([]dao.Doc, 0, len(resp.Articles))
+		for _, art := range resp.Articles {
+			docs = append(docs, dao.Doc{
+				Id: strconv.FormatInt(art.Id, 10),
+				Body: dao.Article{
+					Id:       art.Id,
+					Title:    art.Title,
+					Status:   art.Status,
+					Content:  art.Content,
+					AuthorId: art.GetAuthor().GetId(),
+					Utime:    art.GetUtime().AsTime().UnixMilli(),
+					// 权重是从老版本拷贝过来的热度
+					TitleSuggest: dao.NewTitleSuggest(art.Title, art.Status),
+				},
+			})
+		}
+		err = fn(docs)
+		if err != nil {
+			return err
+		}
+		if len(resp.Articles) < s.batchSize {
+			return nil
+		}
+		// 查询条件包含了等于，所以同一毫秒更新的文章会重复，但是不会漏
+		next := resp.Articles[len(resp.Articles)-1].GetUtime().AsTime()
+		if next.Equal(start) {
+			// 一整批的更新时间都一样，只能往后翻
+			offset += s.batchSize
+			continue
+		}
+		start, offset = next, 0
+	}
+}
+
+// IndexSource 从别名当前指向的版本拷贝，
+// 适用于只改了 mapping，数据本身没有别的来源的索引，比如说标签
+type IndexSource struct {
+	m         *dao.IndexManager
+	alias     string
+	batchSize int
+}
+
+func NewIndexSource(m *dao.IndexManager, alias string) *IndexSource {
+	return &IndexSource{
+		m:         m,
+		alias:     alias,
+		batchSize: 500,
+	}
+}
+
+func (s *IndexSource) Load(ctx context.Context, fn func(docs []dao.Doc) error) error {
+	// 别名上的写入都会双写，所以拷贝期间的变更也不会丢
+	return s.m.Scan(ctx, s.alias, s.batchSize, fn)
+}
@@ -5,47 +5,148 @@ import (
 	"basic-go/lmbook/search/repository/dao"
 	"context"
 	"github.com/ecodeclub/ekit/slice"
+	"golang.org/x/sync/errgroup"
+	"strconv"
+)
+
+const (
+	// maxAuthors 昵称重名的时候，最多取多少个作者
+	maxAuthors = 100
 )
 
 type articleRepository struct {
-	dao  dao.ArticleDAO
-	tags dao.TagDAO
+	dao   dao.ArticleDAO
+	tags  dao.TagDAO
+	users dao.UserDAO
 }
 
 func (a *articleRepository) SearchArticle(ctx context.Context,
 	uid int64,
-	keywords []string) ([]domain.Article, error) {
-	artIDs, err := a.tags.Search(ctx, uid, "article", keywords)
+	q domain.Query,
+	opt domain.PageOption) ([]domain.Article, domain.Page, error) {
+	opts, err := toSearchOptions(opt)
 	if err != nil {
-		return nil, err
+		return nil, domain.Page{}, err
+	}
+	aq := dao.ArticleQuery{
+		Query: toDAOQuery(q),
+		Tags:  q.Tags,
+	}
+	if !q.After.IsZero() {
+		aq.After = q.After.UnixMilli()
+	}
+	if !q.Before.IsZero() {
+		aq.Before = q.Before.UnixMilli()
+	}
+	var eg errgroup.Group
+	if len(q.Keywords) > 0 {
+		eg.Go(func() error {
+			var er error
+			aq.TagArtIds, er = a.tags.Search(ctx, uid, "article", q.Keywords)
+			return er
+		})
+	}
+	if len(q.Tags) > 0 {
+		eg.Go(func() error {
+			var er error
+			aq.TaggedIds, er = a.tags.Search(ctx, uid, "article", q.Tags)
+			return er
+		})
 	}
-	arts, err := a.dao.Search(ctx, artIDs, keywords)
+	if len(q.Authors) > 0 {
+		eg.Go(func() error {
+			var er error
+			aq.AuthorIds, er = a.authorIds(ctx, q.Authors)
+			return er
+		})
+	}
+	err = eg.Wait()
+	if err != nil {
+		return nil, domain.Page{}, err
+	}
+	if len(q.Authors) > 0 && len(aq.AuthorIds) == 0 {
+		// 指定的作者一个都不存在
+		return []domain.Article{}, domain.Page{}, nil
+	}
+	hits, err := a.dao.Search(ctx, aq, opts)
+	if err != nil {
+		return nil, domain.Page{}, err
+	}
+	return slice.Map(hits.Items, func(idx int, src dao.Article) domain.Article {
+		return domain.Article{
+			Id:       src.Id,
+			Title:    src.Title,
+			Status:   src.Status,
+			Content:  src.Content,
+			Tags:     src.Tags,
+			AuthorId: src.AuthorId,
+			Utime:    src.Utime,
+			Highlight: domain.ArticleHighlight{
+				Title:   src.Highlight["title"],
+				Content: src.Highlight["content"],
+			},
+		}
+	}), toPage(hits, opt.Limit), nil
+}
+
+func (a *articleRepository) SuggestArticle(ctx context.Context, prefix string, limit int) ([]domain.Article, error) {
+	arts, err := a.dao.Suggest(ctx, prefix, limit)
 	if err != nil {
 		return nil, err
 	}
 	return slice.Map(arts, func(idx int, src dao.Article) domain.Article {
 		return domain.Article{
-			Id:      src.Id,
-			Title:   src.Title,
-			Status:  src.Status,
-			Content: src.Content,
-			Tags:    src.Tags,
+			Id:    src.Id,
+			Title: src.Title,
 		}
 	}), nil
 }
 
+func (a *articleRepository) SuggestTag(ctx context.Context, uid int64, prefix string, limit int) ([]string, error) {
+	return a.tags.Suggest(ctx, uid, "article", prefix, limit)
+}
+
+func (a *articleRepository) InputPopularity(ctx context.Context, p domain.Popularity) error {
+	return a.dao.UpdateHotScore(ctx, p.BizId, p.Score())
+}
+
 func (a *articleRepository) InputArticle(ctx context.Context, msg domain.Article) error {
 	return a.dao.InputArticle(ctx, dao.Article{
-		Id:      msg.Id,
-		Title:   msg.Title,
-		Status:  msg.Status,
-		Content: msg.Content,
+		Id:       msg.Id,
+		Title:    msg.Title,
+		Status:   msg.Status,
+		Content:  msg.Content,
+		AuthorId: msg.AuthorId,
+		Utime:    msg.Utime,
 	})
 }
 
-func NewArticleRepository(d dao.ArticleDAO, td dao.TagDAO) ArticleRepository {
+// authorIds author: 后面是数字的当成 id，其余的当成昵称去用户索引里面找
+func (a *articleRepository) authorIds(ctx context.Context, authors []string) ([]int64, error) {
+	res := make([]int64, 0, len(authors))
+	nicknames := make([]string, 0, len(authors))
+	for _, author := range authors {
+		id, err := strconv.ParseInt(author, 10, 64)
+		if err == nil && id > 0 {
+			res = append(res, id)
+			continue
+		}
+		nicknames = append(nicknames, author)
+	}
+	if len(nicknames) == 0 {
+		return res, nil
+	}
+	ids, err := a.users.FindIdsByNickname(ctx, nicknames, maxAuthors)
+	if err != nil {
+		return nil, err
+	}
+	return append(res, ids...), nil
+}
+
+func NewArticleRepository(d dao.ArticleDAO, td dao.TagDAO, ud dao.UserDAO) ArticleRepository {
 	return &articleRepository{
-		dao:  d,
-		tags: td,
+		dao:   d,
+		tags:  td,
+		users: ud,
 	}
 }
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"basic-go/lmbook/search/domain"
+	"basic-go/lmbook/search/repository/dao"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidCursor = errors.New("非法的搜索游标")
+
+// encodeCursor 把 ES 返回的排序值编码成不透明的字符串
+func encodeCursor(sort []any) string {
+	if len(sort) == 0 {
+		return ""
+	}
+	val, err := json.Marshal(sort)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(val)
+}
+
+func decodeCursor(cursor string) ([]any, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	val, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+	// id、utime 这种 long 转成 float64 会丢精度
+	dec := json.NewDecoder(bytes.NewReader(val))
+	dec.UseNumber()
+	var res []any
+	err = dec.Decode(&res)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+	return res, nil
+}
+
+func toSearchOptions(opt domain.PageOption) (dao.SearchOptions, error) {
+	after, err := decodeCursor(opt.Cursor)
+	return dao.SearchOptions{
+		Offset:      opt.Offset,
+		Limit:       opt.Limit,
+		Sort:        dao.SortBy(opt.Sort),
+		SearchAfter: after,
+	}, err
+}
+
+// toPage 不满一页说明已经到底了
+func toPage[T any](hits dao.Hits[T], limit int) domain.Page {
+	page := domain.Page{Total: hits.Total}
+	if len(hits.Items) >= limit {
+		page.NextCursor = encodeCursor(hits.LastSort)
+	}
+	return page
+}
@@ -0,0 +1,18 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type AnySearchDAO struct {
+	backend Backend
+}
+
+func NewAnyDAO(backend Backend) AnyDAO {
+	return &AnySearchDAO{backend: backend}
+}
+
+func (a *AnySearchDAO) Input(ctx context.Context, index, docId, data string) error {
+	return a.backend.Put(ctx, index, docId, json.RawMessage(data))
+}
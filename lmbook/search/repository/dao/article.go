@@ -0,0 +1,131 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+const ArticleIndexName = "article_index"
+const TagIndexName = "tags_index"
+
+// articleStatusPublished 只补全已发表的文章，和 article 服务里面的已发表状态保持一致
+const articleStatusPublished = "2"
+
+// NewTitleSuggest 文章的标题补全，带上状态的上下文，
+// 不带权重，这样同步文章的时候不会把热度覆盖掉
+func NewTitleSuggest(title string, status int32) *Completion {
+	return &Completion{
+		Input:    []string{title},
+		Contexts: map[string][]string{"status": {strconv.FormatInt(int64(status), 10)}},
+	}
+}
+
+// suggestWeight 热度换算成补全的权重，权重只能是正的 int32
+func suggestWeight(score float64) int {
+	if score >= math.MaxInt32-1 {
+		return math.MaxInt32
+	}
+	return max(int(score), 0) + 1
+}
+
+type Article struct {
+	Id      int64    `json:"id"`
+	Title   string   `json:"title"`
+	Status  int32    `json:"status"`
+	Content string   `json:"content"`
+	Tags    []string `json:"tags"`
+	// 作者
+	AuthorId int64 `json:"author_id"`
+	// 更新时间，毫秒
+	Utime int64 `json:"utime"`
+	// 热度，不是文章本身的数据，omitempty 保证同步文章的时候不会把它覆盖掉
+	HotScore float64 `json:"hot_score,omitempty"`
+	// 标题补全，权重是热度，同步热度的时候单独更新
+	TitleSuggest *Completion `json:"title_suggest,omitempty"`
+	// 命中的片段，只有搜索的时候才有
+	Highlight map[string][]string `json:"-"`
+}
+
+type ArticleSearchDAO struct {
+	backend Backend
+}
+
+func NewArticleDAO(backend Backend) ArticleDAO {
+	return &ArticleSearchDAO{backend: backend}
+}
+
+func (h *ArticleSearchDAO) Search(ctx context.Context, q ArticleQuery, opts SearchOptions) (Hits[Article], error) {
+	req := searchRequest(q.Build(), opts)
+	req.Highlights = []HighlightField{
+		// 标题不长，整个返回
+		{Field: "title"},
+		{Field: "content", FragmentSize: 100, NumOfFragments: 3},
+	}
+	resp, err := h.backend.Search(ctx, ArticleIndexName, req)
+	if err != nil {
+		return Hits[Article]{}, err
+	}
+	res := Hits[Article]{
+		Items: make([]Article, 0, len(resp.Hits)),
+		Total: resp.Total,
+	}
+	for _, hit := range resp.Hits {
+		var ele Article
+		err = json.Unmarshal(hit.Source, &ele)
+		if err != nil {
+			return Hits[Article]{}, err
+		}
+		ele.Highlight = hit.Highlight
+		res.Items = append(res.Items, ele)
+		res.LastSort = hit.Sort
+	}
+	return res, nil
+}
+
+func (h *ArticleSearchDAO) Suggest(ctx context.Context, prefix string, limit int) ([]Article, error) {
+	// 按照热度排序，未发表的通过上下文过滤掉
+	sources, err := h.backend.Suggest(ctx, ArticleIndexName, SuggestRequest{
+		Field:    "title_suggest",
+		Prefix:   prefix,
+		Size:     limit,
+		Contexts: map[string][]string{"status": {articleStatusPublished}},
+		Includes: []string{"id", "title", "status", "hot_score"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Article, 0, len(sources))
+	for _, src := range sources {
+		var ele Article
+		err = json.Unmarshal(src, &ele)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, ele)
+	}
+	return res, nil
+}
+
+func (h *ArticleSearchDAO) UpdateHotScore(ctx context.Context, id int64, score float64) error {
+	return h.backend.Update(ctx, ArticleIndexName, strconv.FormatInt(id, 10),
+		map[string]any{
+			"hot_score": score,
+			// 只改权重，input 和 contexts 保持不变
+			"title_suggest": map[string]any{"weight": suggestWeight(score)},
+		}, false)
+}
+
+func NewArticleRepository(backend Backend) ArticleDAO {
+	return &ArticleSearchDAO{
+		backend: backend,
+	}
+}
+func (h *ArticleSearchDAO) InputArticle(ctx context.Context, art Article) error {
+	// 用 upsert 而不是整个覆盖，因为 hot_score 是另外同步过来的
+	if art.TitleSuggest == nil {
+		art.TitleSuggest = NewTitleSuggest(art.Title, art.Status)
+	}
+	return h.backend.Update(ctx, ArticleIndexName, strconv.FormatInt(art.Id, 10), art, true)
+}
@@ -0,0 +1,135 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Backend 搜索引擎。DAO 只负责拼查询条件和转换结果，
+// 怎么存、怎么查交给 Backend：线上用 ES，本地开发、测试和小规模部署可以用内嵌的实现
+type Backend interface {
+	// Put 写入整个文档，已经存在就覆盖
+	Put(ctx context.Context, index, id string, doc any) error
+	// Update 部分更新文档里面的字段。
+	// 文档不存在的时候，upsert 为 true 就直接写入，否则什么也不做
+	Update(ctx context.Context, index, id string, doc any, upsert bool) error
+	Search(ctx context.Context, index string, req SearchRequest) (SearchResult, error)
+	// Suggest 前缀补全，同样的补全结果只会返回一次
+	Suggest(ctx context.Context, index string, req SuggestRequest) ([]json.RawMessage, error)
+}
+
+type SearchRequest struct {
+	Query Clause
+	// Sort 排序字段，都是降序，没有这个字段的排在最后，ScoreField 代表相关度。
+	// 为空的时候只按照相关度排序
+	Sort   []string
+	Offset int
+	// Limit 为 0 的时候和 ES 一样，返回 10 条
+	Limit int
+	// SearchAfter 上一页最后一条的排序值，不为空的时候忽略 Offset
+	SearchAfter []any
+	// Includes 只返回这些字段，为空返回全部
+	Includes   []string
+	Highlights []HighlightField
+}
+
+const ScoreField = "_score"
+
+// HighlightField 命中的词用 <em></em> 包起来
+type HighlightField struct {
+	Field string
+	// FragmentSize 每个片段的长度，字符数
+	FragmentSize int
+	// NumOfFragments 最多返回几个片段，0 代表返回整个字段
+	NumOfFragments int
+}
+
+type SearchResult struct {
+	Hits []Hit
+	// 命中的总数
+	Total int64
+}
+
+type Hit struct {
+	Id        string
+	Source    json.RawMessage
+	Highlight map[string][]string
+	// Sort 排序值，下一页的 SearchAfter 就用最后一条的
+	Sort []any
+}
+
+type SuggestRequest struct {
+	// Field completion 类型的字段，比如说 nickname.suggest、title_suggest
+	Field  string
+	Prefix string
+	Size   int
+	// Contexts 只补全这些上下文里面的，对应 mapping 里面的 category context
+	Contexts map[string][]string
+	// Includes 只返回这些字段，为空返回全部
+	Includes []string
+}
+
+// Completion 单独的 completion 字段的值。
+// multi-field 的补全只能按照长度排序，要带权重就得写成这样
+type Completion struct {
+	Input []string `json:"input,omitempty"`
+	// Weight 越大越靠前，部分更新的时候可以只改它
+	Weight   int                 `json:"weight,omitempty"`
+	Contexts map[string][]string `json:"contexts,omitempty"`
+}
+
+// Clause 查询条件，和 ES 的 query DSL 一一对应，由 Backend 翻译或者直接执行。
+// match 和 phrase 按照分词之后的结果匹配，terms 和 prefix 按照原始值匹配，
+// 所以前两个只能用在 text 字段上，后两个只能用在 keyword 和数字字段上
+type Clause interface {
+	clause()
+}
+
+// BoolClause 和 ES 的 bool 一样，Filter 和 MustNot 不参与算分。
+// MinimumShouldMatch 为 0 的时候，如果没有 Must 和 Filter，至少要命中一个 Should
+type BoolClause struct {
+	Must               []Clause
+	Should             []Clause
+	Filter             []Clause
+	MustNot            []Clause
+	MinimumShouldMatch int
+}
+
+// MatchClause 分词之后命中任意一个词就可以
+type MatchClause struct {
+	Field string
+	Text  string
+}
+
+// PhraseClause 在任意一个字段上完整出现
+type PhraseClause struct {
+	Fields []string
+	Text   string
+}
+
+// TermsClause 等于任意一个值，Boost 为 0 就是默认的 1
+type TermsClause struct {
+	Field  string
+	Values []any
+	Boost  float64
+}
+
+// RangeClause 数值范围，左闭右开，nil 代表不限
+type RangeClause struct {
+	Field string
+	Gte   any
+	Lt    any
+}
+
+// PrefixClause 原始值的前缀匹配
+type PrefixClause struct {
+	Field  string
+	Prefix string
+}
+
+func (BoolClause) clause()   {}
+func (MatchClause) clause()  {}
+func (PhraseClause) clause() {}
+func (TermsClause) clause()  {}
+func (RangeClause) clause()  {}
+func (PrefixClause) clause() {}
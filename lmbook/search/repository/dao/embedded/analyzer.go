@@ -0,0 +1,60 @@
+package embedded
+
+import (
+	"strings"
+	"unicode"
+)
+
+// token 分词的结果，start 和 end 是在原文里面的字符下标，高亮的时候要用
+type token struct {
+	term  string
+	start int
+	end   int
+}
+
+// analyze 模仿 ES 默认的 standard 分词器：
+// 连续的字母和数字算一个词，并且转成小写；汉字和日文假名每个字单独算一个词；其余的都是分隔符
+func analyze(text []rune) []token {
+	var res []token
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		res = append(res, token{
+			term:  strings.ToLower(string(text[start:end])),
+			start: start,
+			end:   end,
+		})
+		start = -1
+	}
+	for i, r := range text {
+		switch {
+		case isIdeograph(r):
+			flush(i)
+			res = append(res, token{term: string(r), start: i, end: i + 1})
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if start < 0 {
+				start = i
+			}
+		default:
+			flush(i)
+		}
+	}
+	flush(len(text))
+	return res
+}
+
+// terms 分词之后的词，保留顺序和重复的
+func terms(text string) []string {
+	tokens := analyze([]rune(text))
+	res := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		res = append(res, t.term)
+	}
+	return res
+}
+
+func isIdeograph(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana)
+}
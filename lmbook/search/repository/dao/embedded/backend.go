@@ -0,0 +1,492 @@
+package embedded
+
+import (
+	"basic-go/lmbook/search/repository/dao"
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// defaultSize 和 ES 一样，不指定的时候搜索返回 10 条，补全返回 5 条
+	defaultSize        = 10
+	defaultSuggestSize = 5
+	fileExt            = ".jsonl"
+)
+
+var _ dao.Backend = (*Backend)(nil)
+
+// Backend 内嵌在进程里面的全文索引，本地开发、测试和小规模部署的时候用来代替 ES。
+// 查询的语义和 ES 保持一致（standard 分词、BM25 算分、bool 的规则），但是没有做任何的优化，
+// 过滤条件都是直接扫描所有的文档，所以只适合数据量不大的场景。
+//
+// dir 不为空的时候，每次写入都会追加到 dir 下面每个索引一个的文件里面，启动的时候重放，
+// 重放完了会把文件压缩成每个文档一行
+type Backend struct {
+	lock    sync.RWMutex
+	indices map[string]*index
+	dir     string
+	files   map[string]*os.File
+}
+
+// NewBackend 只放在内存里面的，测试用
+func NewBackend() *Backend {
+	return &Backend{
+		indices: make(map[string]*index),
+		files:   make(map[string]*os.File),
+	}
+}
+
+// Open 从 dir 里面恢复数据
+func Open(dir string) (*Backend, error) {
+	b := NewBackend()
+	b.dir = dir
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), fileExt)
+		if e.IsDir() || !ok {
+			continue
+		}
+		err = b.load(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// Close 关闭打开的文件
+func (b *Backend) Close() error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	var err error
+	for _, f := range b.files {
+		err = errors.Join(err, f.Close())
+	}
+	return err
+}
+
+func (b *Backend) Put(ctx context.Context, index, id string, doc any) error {
+	source, err := decode(doc)
+	if err != nil {
+		return err
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.put(index, id, source)
+}
+
+func (b *Backend) Update(ctx context.Context, index, id string, doc any, upsert bool) error {
+	source, err := decode(doc)
+	if err != nil {
+		return err
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	old, ok := b.indices[index].get(id)
+	if !ok {
+		if !upsert {
+			return nil
+		}
+		return b.put(index, id, source)
+	}
+	return b.put(index, id, merge(old.source, source))
+}
+
+// merge 和 ES 的部分更新一样，对象递归合并，其余的值（包括数组）直接覆盖。
+// 不会修改 old，索引里面的文档一直是只读的
+func merge(old, doc map[string]any) map[string]any {
+	res := make(map[string]any, len(old)+len(doc))
+	for k, v := range old {
+		res[k] = v
+	}
+	for k, v := range doc {
+		oldObj, ok1 := res[k].(map[string]any)
+		obj, ok2 := v.(map[string]any)
+		if ok1 && ok2 {
+			v = merge(oldObj, obj)
+		}
+		res[k] = v
+	}
+	return res
+}
+
+func (b *Backend) Search(ctx context.Context, index string, req dao.SearchRequest) (dao.SearchResult, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	idx, ok := b.indices[index]
+	if !ok {
+		return dao.SearchResult{}, nil
+	}
+	matched, err := idx.eval(req.Query)
+	if err != nil {
+		return dao.SearchResult{}, err
+	}
+	hits := make([]hit, 0, len(matched))
+	for num, score := range matched {
+		hits = append(hits, newHit(idx.docs[num], num, score, req.Sort))
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		return hits[i].before(hits[j])
+	})
+	res := dao.SearchResult{Total: int64(len(hits))}
+	if len(req.SearchAfter) > 0 {
+		i := sort.Search(len(hits), func(i int) bool {
+			return hits[i].after(req.SearchAfter)
+		})
+		hits = hits[i:]
+	} else {
+		hits = hits[min(req.Offset, len(hits)):]
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSize
+	}
+	hits = hits[:min(limit, len(hits))]
+
+	hlTerms := make(map[string]map[string]struct{})
+	if len(req.Highlights) > 0 {
+		highlightTerms(req.Query, hlTerms)
+	}
+	res.Hits = make([]dao.Hit, 0, len(hits))
+	for _, h := range hits {
+		doc := idx.docs[h.num]
+		src, err := marshal(doc.source, req.Includes)
+		if err != nil {
+			return dao.SearchResult{}, err
+		}
+		ele := dao.Hit{Id: doc.id, Source: src, Sort: h.sort}
+		for _, hf := range req.Highlights {
+			frags := highlight(doc.source[hf.Field], hlTerms[hf.Field], hf)
+			if len(frags) == 0 {
+				continue
+			}
+			if ele.Highlight == nil {
+				ele.Highlight = make(map[string][]string)
+			}
+			ele.Highlight[hf.Field] = frags
+		}
+		res.Hits = append(res.Hits, ele)
+	}
+	return res, nil
+}
+
+func (b *Backend) Suggest(ctx context.Context, index string, req dao.SuggestRequest) ([]json.RawMessage, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	idx, ok := b.indices[index]
+	if !ok {
+		return nil, nil
+	}
+	type candidate struct {
+		text   string
+		weight int64
+		num    int
+	}
+	prefix := strings.ToLower(req.Prefix)
+	var candidates []candidate
+	for num, doc := range idx.docs {
+		if doc == nil {
+			continue
+		}
+		c, ok := completionOf(doc.source, req.Field)
+		if !ok || !c.matchContexts(req.Contexts) {
+			continue
+		}
+		for _, text := range c.inputs {
+			if strings.HasPrefix(strings.ToLower(text), prefix) {
+				candidates = append(candidates, candidate{text: text, weight: c.weight, num: num})
+			}
+		}
+	}
+	// 和 ES 一样，权重大的在前面，权重一样的按照补全的内容排序
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].weight != candidates[j].weight {
+			return candidates[i].weight > candidates[j].weight
+		}
+		if candidates[i].text != candidates[j].text {
+			return candidates[i].text < candidates[j].text
+		}
+		return candidates[i].num < candidates[j].num
+	})
+	size := req.Size
+	if size <= 0 {
+		size = defaultSuggestSize
+	}
+	// 同样的补全内容只留权重最大的那个
+	seen := make(map[string]struct{}, size)
+	res := make([]json.RawMessage, 0, min(size, len(candidates)))
+	for _, c := range candidates {
+		if len(res) >= size {
+			break
+		}
+		if _, ok := seen[c.text]; ok {
+			continue
+		}
+		seen[c.text] = struct{}{}
+		src, err := marshal(idx.docs[c.num].source, req.Includes)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, src)
+	}
+	return res, nil
+}
+
+type completion struct {
+	inputs   []string
+	weight   int64
+	contexts map[string][]string
+}
+
+// completionOf 取出 completion 字段的值。
+// title.suggest 这种 multi-field 补全的是 title 本身，没有权重和上下文；
+// 单独的 completion 字段可以是字符串、数组，或者是 dao.Completion 那样的对象
+func completionOf(source map[string]any, field string) (completion, bool) {
+	val, ok := source[field]
+	if !ok {
+		i := strings.LastIndexByte(field, '.')
+		if i <= 0 {
+			return completion{}, false
+		}
+		val, ok = source[field[:i]]
+		if !ok {
+			return completion{}, false
+		}
+	}
+	obj, ok := val.(map[string]any)
+	if !ok {
+		return completion{inputs: stringValues(val), weight: 1}, true
+	}
+	res := completion{inputs: stringValues(obj["input"]), weight: 1}
+	if w, ok := toInt(obj["weight"]); ok {
+		res.weight = w
+	}
+	if contexts, ok := obj["contexts"].(map[string]any); ok {
+		res.contexts = make(map[string][]string, len(contexts))
+		for name, vals := range contexts {
+			res.contexts[name] = stringValues(vals)
+		}
+	}
+	return res, true
+}
+
+// matchContexts 查询里面的每一种上下文都要命中一个值，查询没有上下文的时候都算命中
+func (c completion) matchContexts(contexts map[string][]string) bool {
+	for name, want := range contexts {
+		found := false
+		for _, val := range c.contexts[name] {
+			for _, w := range want {
+				if val == w {
+					found = true
+				}
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// put 调用者要持有写锁
+func (b *Backend) put(index, id string, source map[string]any) error {
+	err := b.persist(index, id, source)
+	if err != nil {
+		return err
+	}
+	idx, ok := b.indices[index]
+	if !ok {
+		idx = newIndex()
+		b.indices[index] = idx
+	}
+	idx.put(id, source)
+	return nil
+}
+
+type entry struct {
+	Id  string          `json:"id"`
+	Doc json.RawMessage `json:"doc"`
+}
+
+func (b *Backend) persist(index, id string, source map[string]any) error {
+	if b.dir == "" {
+		return nil
+	}
+	f, ok := b.files[index]
+	if !ok {
+		var err error
+		f, err = os.OpenFile(b.path(index), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return err
+		}
+		b.files[index] = f
+	}
+	doc, err := json.Marshal(source)
+	if err != nil {
+		return err
+	}
+	line, err := json.Marshal(entry{Id: id, Doc: doc})
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
+
+// load 重放一个索引的文件，然后压缩
+func (b *Backend) load(index string) error {
+	f, err := os.Open(b.path(index))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	idx := newIndex()
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			// 最后一行没有换行符，说明写到一半进程就退出了，直接丢掉
+			break
+		}
+		if err != nil {
+			return err
+		}
+		var e entry
+		err = json.Unmarshal(line, &e)
+		if err != nil {
+			return err
+		}
+		source, err := decodeBytes(e.Doc)
+		if err != nil {
+			return err
+		}
+		idx.put(e.Id, source)
+	}
+	b.indices[index] = idx
+	return b.compact(index, idx)
+}
+
+// compact 每个文档只保留最新的一行，写到临时文件再替换，中途失败也不会丢数据
+func (b *Backend) compact(index string, idx *index) error {
+	tmp := b.path(index) + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	for _, doc := range idx.docs {
+		if doc == nil {
+			continue
+		}
+		var data []byte
+		data, err = json.Marshal(doc.source)
+		if err != nil {
+			break
+		}
+		data, err = json.Marshal(entry{Id: doc.id, Doc: data})
+		if err != nil {
+			break
+		}
+		_, err = w.Write(append(data, '\n'))
+		if err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	err = errors.Join(err, f.Close())
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, b.path(index))
+}
+
+func (b *Backend) path(index string) string {
+	return filepath.Join(b.dir, index+fileExt)
+}
+
+func marshal(source map[string]any, includes []string) (json.RawMessage, error) {
+	if len(includes) > 0 {
+		filtered := make(map[string]any, len(includes))
+		for _, f := range includes {
+			if val, ok := source[f]; ok {
+				filtered[f] = val
+			}
+		}
+		source = filtered
+	}
+	return json.Marshal(source)
+}
+
+type hit struct {
+	num   int
+	score float64
+	// sort 排序值，没有指定排序的时候是 nil
+	sort []any
+	// keys 实际用来排序的值，没有指定排序的时候只有得分
+	keys []any
+}
+
+func newHit(doc *document, num int, score float64, fields []string) hit {
+	h := hit{num: num, score: score}
+	if len(fields) == 0 {
+		h.keys = []any{score}
+		return h
+	}
+	h.sort = make([]any, 0, len(fields))
+	for _, f := range fields {
+		if f == dao.ScoreField {
+			h.sort = append(h.sort, score)
+			continue
+		}
+		// 多个值的时候和 ES 一样，降序取最大的
+		var val any
+		for _, v := range values(doc.source[f]) {
+			if _, ok := toFloat(v); ok && (val == nil || compare(v, val) > 0) {
+				val = v
+			}
+		}
+		h.sort = append(h.sort, val)
+	}
+	h.keys = h.sort
+	return h
+}
+
+// before 都是降序，nil（没有这个字段）排在最后，全都一样的按照写入的顺序
+func (h hit) before(other hit) bool {
+	for i := range h.keys {
+		if c := compare(h.keys[i], other.keys[i]); c != 0 {
+			return c > 0
+		}
+	}
+	return h.num < other.num
+}
+
+// after 排在 searchAfter 后面，和 ES 一样，完全相等的不算
+func (h hit) after(searchAfter []any) bool {
+	for i := range h.keys {
+		if i >= len(searchAfter) {
+			break
+		}
+		if c := compare(h.keys[i], searchAfter[i]); c != 0 {
+			return c < 0
+		}
+	}
+	return false
+}
@@ -0,0 +1,243 @@
+package embedded
+
+import (
+	"basic-go/lmbook/search/repository/dao"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackend_SearchArticle(t *testing.T) {
+	arts := []dao.Article{
+		{Id: 1, Title: "Go 并发编程", Content: "goroutine 和 channel", Status: 2,
+			AuthorId: 100, Utime: 1000, Tags: []string{"Go"}},
+		{Id: 2, Title: "并发的陷阱", Content: "Go 里面并发写 map 会 panic", Status: 2,
+			AuthorId: 200, Utime: 3000},
+		{Id: 3, Title: "Go 入门", Content: "这是广告", Status: 2,
+			AuthorId: 100, Utime: 2000},
+		// 没有发表的搜不到
+		{Id: 4, Title: "Go 并发编程草稿", Status: 1, Utime: 4000},
+	}
+	testCases := []struct {
+		name    string
+		q       dao.ArticleQuery
+		opts    dao.SearchOptions
+		wantIds []int64
+	}{
+		{
+			name:    "关键字，标题和内容都命中的更靠前",
+			q:       dao.ArticleQuery{Query: dao.Query{Keywords: []string{"并发"}}},
+			wantIds: []int64{2, 1},
+		},
+		{
+			name:    "短语要求连续出现",
+			q:       dao.ArticleQuery{Query: dao.Query{Phrases: []string{"go 并发"}}},
+			wantIds: []int64{1},
+		},
+		{
+			name: "排除",
+			q: dao.ArticleQuery{Query: dao.Query{
+				Keywords: []string{"go"},
+				Excludes: []string{"广告"},
+			}},
+			opts:    dao.SearchOptions{Sort: dao.SortByRecency},
+			wantIds: []int64{2, 1},
+		},
+		{
+			name: "作者和时间过滤",
+			q: dao.ArticleQuery{
+				Query:     dao.Query{Keywords: []string{"go"}},
+				AuthorIds: []int64{100},
+				After:     1500,
+				Before:    3000,
+			},
+			wantIds: []int64{3},
+		},
+		{
+			name: "标签，文章本身的或者用户打的都可以",
+			q: dao.ArticleQuery{
+				Query:     dao.Query{Keywords: []string{"go"}},
+				Tags:      []string{"Go"},
+				TaggedIds: []int64{3},
+			},
+			opts:    dao.SearchOptions{Sort: dao.SortByRecency},
+			wantIds: []int64{3, 1},
+		},
+		{
+			name:    "按照更新时间排序",
+			q:       dao.ArticleQuery{Query: dao.Query{Keywords: []string{"go"}}},
+			opts:    dao.SearchOptions{Sort: dao.SortByRecency},
+			wantIds: []int64{2, 3, 1},
+		},
+		{
+			name:    "分页",
+			q:       dao.ArticleQuery{Query: dao.Query{Keywords: []string{"go"}}},
+			opts:    dao.SearchOptions{Sort: dao.SortByRecency, Offset: 1, Limit: 1},
+			wantIds: []int64{3},
+		},
+		{
+			name:    "用户打的标签命中关键字的加权",
+			q:       dao.ArticleQuery{Query: dao.Query{Keywords: []string{"入门"}}, TagArtIds: []int64{2}},
+			wantIds: []int64{2, 3},
+		},
+	}
+	ctx := context.Background()
+	d := dao.NewArticleDAO(NewBackend())
+	for _, art := range arts {
+		require.NoError(t, d.InputArticle(ctx, art))
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hits, err := d.Search(ctx, tc.q, tc.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantIds, slice.Map(hits.Items, func(idx int, src dao.Article) int64 {
+				return src.Id
+			}))
+		})
+	}
+}
+
+func TestBackend_SearchAfter(t *testing.T) {
+	ctx := context.Background()
+	d := dao.NewArticleDAO(NewBackend())
+	for i := int64(1); i <= 5; i++ {
+		require.NoError(t, d.InputArticle(ctx, dao.Article{
+			Id: i, Title: "Go", Status: 2,
+			// 3 没有热度，排在最后
+			HotScore: float64(i % 3),
+		}))
+	}
+	q := dao.ArticleQuery{Query: dao.Query{Keywords: []string{"go"}}}
+	var ids []int64
+	opts := dao.SearchOptions{Sort: dao.SortByHotness, Limit: 2}
+	for {
+		hits, err := d.Search(ctx, q, opts)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), hits.Total)
+		if len(hits.Items) == 0 {
+			break
+		}
+		for _, art := range hits.Items {
+			ids = append(ids, art.Id)
+		}
+		// 模拟游标经过一次 JSON 编解码
+		data, err := json.Marshal(hits.LastSort)
+		require.NoError(t, err)
+		opts.SearchAfter = nil
+		require.NoError(t, json.Unmarshal(data, &opts.SearchAfter))
+	}
+	assert.Equal(t, []int64{5, 2, 4, 1, 3}, ids)
+}
+
+func TestBackend_Highlight(t *testing.T) {
+	ctx := context.Background()
+	d := dao.NewArticleDAO(NewBackend())
+	require.NoError(t, d.InputArticle(ctx, dao.Article{
+		Id: 1, Title: "Tom 的小秘密", Content: "这是内容，Tom 的小秘密", Status: 2,
+	}))
+	hits, err := d.Search(ctx, dao.ArticleQuery{Query: dao.Query{Keywords: []string{"tom"}}},
+		dao.SearchOptions{})
+	require.NoError(t, err)
+	require.Len(t, hits.Items, 1)
+	assert.Equal(t, map[string][]string{
+		"title":   {"<em>Tom</em> 的小秘密"},
+		"content": {"这是内容，<em>Tom</em> 的小秘密"},
+	}, hits.Items[0].Highlight)
+}
+
+func TestBackend_Update(t *testing.T) {
+	ctx := context.Background()
+	d := dao.NewArticleDAO(NewBackend())
+	// 文章还没有同步过来，热度直接丢掉
+	require.NoError(t, d.UpdateHotScore(ctx, 1, 10))
+	require.NoError(t, d.InputArticle(ctx, dao.Article{Id: 1, Title: "Go", Status: 2}))
+	require.NoError(t, d.UpdateHotScore(ctx, 1, 20))
+	// 再同步一次文章，热度不能被覆盖
+	require.NoError(t, d.InputArticle(ctx, dao.Article{Id: 1, Title: "Rust", Status: 2}))
+
+	hits, err := d.Search(ctx, dao.ArticleQuery{Query: dao.Query{Keywords: []string{"go"}}},
+		dao.SearchOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, hits.Items)
+	hits, err = d.Search(ctx, dao.ArticleQuery{Query: dao.Query{Keywords: []string{"rust"}}},
+		dao.SearchOptions{})
+	require.NoError(t, err)
+	require.Len(t, hits.Items, 1)
+	assert.Equal(t, float64(20), hits.Items[0].HotScore)
+}
+
+func TestBackend_Suggest(t *testing.T) {
+	ctx := context.Background()
+	d := dao.NewUserDAO(NewBackend())
+	for i, name := range []string{"Tom White", "tommy", "Jerry", "Tom White"} {
+		require.NoError(t, d.InputUser(ctx, dao.User{Id: int64(i + 1), Nickname: name}))
+	}
+	users, err := d.Suggest(ctx, "tom", 5)
+	require.NoError(t, err)
+	assert.Equal(t, []dao.User{
+		{Id: 1, Nickname: "Tom White"},
+		{Id: 2, Nickname: "tommy"},
+	}, users)
+}
+
+func TestBackend_SuggestArticle(t *testing.T) {
+	ctx := context.Background()
+	d := dao.NewArticleDAO(NewBackend())
+	arts := []dao.Article{
+		{Id: 1, Title: "Go 入门", Status: 2},
+		{Id: 2, Title: "Go 进阶", Status: 2},
+		// 未发表的不补全
+		{Id: 3, Title: "Go 草稿", Status: 1},
+		{Id: 4, Title: "Go 实战", Status: 2},
+		{Id: 5, Title: "Rust 入门", Status: 2},
+	}
+	for _, art := range arts {
+		require.NoError(t, d.InputArticle(ctx, art))
+	}
+	require.NoError(t, d.UpdateHotScore(ctx, 2, 10))
+	require.NoError(t, d.UpdateHotScore(ctx, 3, 100))
+	require.NoError(t, d.UpdateHotScore(ctx, 4, 5))
+	// 再同步一次文章，权重不能被覆盖
+	require.NoError(t, d.InputArticle(ctx, dao.Article{Id: 4, Title: "Go 实战", Status: 2}))
+
+	res, err := d.Suggest(ctx, "go", 2)
+	require.NoError(t, err)
+	// 按照热度排序，只取 limit 个
+	assert.Equal(t, []int64{2, 4}, slice.Map(res, func(idx int, src dao.Article) int64 {
+		return src.Id
+	}))
+	res, err = d.Suggest(ctx, "go", 5)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 4, 1}, slice.Map(res, func(idx int, src dao.Article) int64 {
+		return src.Id
+	}))
+}
+
+func TestOpen(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	b, err := Open(dir)
+	require.NoError(t, err)
+	d := dao.NewUserDAO(b)
+	require.NoError(t, d.InputUser(ctx, dao.User{Id: 1, Nickname: "Tom"}))
+	require.NoError(t, d.InputUser(ctx, dao.User{Id: 1, Nickname: "Jerry"}))
+	require.NoError(t, b.Close())
+
+	// 重启之后数据还在，并且是最新的
+	b, err = Open(dir)
+	require.NoError(t, err)
+	defer b.Close()
+	d = dao.NewUserDAO(b)
+	hits, err := d.Search(ctx, dao.UserQuery{Query: dao.Query{Keywords: []string{"jerry"}}},
+		dao.SearchOptions{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []dao.User{{Id: 1, Nickname: "Jerry"}}, hits.Items)
+	hits, err = d.Search(ctx, dao.UserQuery{Query: dao.Query{Keywords: []string{"tom"}}},
+		dao.SearchOptions{Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, hits.Items)
+}
@@ -0,0 +1,83 @@
+package embedded
+
+import (
+	"basic-go/lmbook/search/repository/dao"
+	"strings"
+)
+
+const (
+	preTag  = "<em>"
+	postTag = "</em>"
+	// defaultFragmentSize 和 ES 的默认值一样
+	defaultFragmentSize = 100
+)
+
+// highlight 把 val 里面命中的词用 <em></em> 包起来，没有命中返回 nil
+func highlight(val any, set map[string]struct{}, h dao.HighlightField) []string {
+	if len(set) == 0 {
+		return nil
+	}
+	var res []string
+	for _, text := range stringValues(val) {
+		runes := []rune(text)
+		var matched []token
+		for _, t := range analyze(runes) {
+			if _, ok := set[t.term]; ok {
+				matched = append(matched, t)
+			}
+		}
+		if len(matched) == 0 {
+			continue
+		}
+		if h.NumOfFragments == 0 {
+			res = append(res, wrap(runes, 0, len(runes), matched))
+			continue
+		}
+		res = append(res, fragments(runes, matched, h)...)
+		if len(res) >= h.NumOfFragments {
+			return res[:h.NumOfFragments]
+		}
+	}
+	return res
+}
+
+// fragments 按照命中的位置切片段，每个片段从命中的词前面一点开始
+func fragments(runes []rune, matched []token, h dao.HighlightField) []string {
+	size := h.FragmentSize
+	if size <= 0 {
+		size = defaultFragmentSize
+	}
+	var res []string
+	next := 0
+	for _, t := range matched {
+		if t.start < next {
+			continue
+		}
+		start := max(next, t.start-size/4)
+		end := min(len(runes), start+size)
+		res = append(res, wrap(runes, start, end, matched))
+		if len(res) == h.NumOfFragments {
+			break
+		}
+		next = end
+	}
+	return res
+}
+
+// wrap 返回 runes[start:end]，完整落在里面的命中的词加上标签
+func wrap(runes []rune, start, end int, matched []token) string {
+	var sb strings.Builder
+	cur := start
+	for _, t := range matched {
+		if t.start < start || t.end > end {
+			continue
+		}
+		sb.WriteString(string(runes[cur:t.start]))
+		sb.WriteString(preTag)
+		sb.WriteString(string(runes[t.start:t.end]))
+		sb.WriteString(postTag)
+		cur = t.end
+	}
+	sb.WriteString(string(runes[cur:end]))
+	return sb.String()
+}
@@ -0,0 +1,262 @@
+package embedded
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// positionGap 数组里面相邻两个值之间空出来的位置，避免短语跨值匹配，和 ES 的 position_increment_gap 一样
+const positionGap = 100
+
+// BM25 的参数，和 ES 的默认值一样
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+type document struct {
+	id     string
+	source map[string]any
+	// terms 字段 => 这个文档在字段上出现过的词，覆盖写的时候用来删掉旧的倒排
+	terms map[string][]string
+	// lengths 字段 => 词的数量
+	lengths map[string]int
+}
+
+type fieldStats struct {
+	// docs 有这个字段的文档数量
+	docs int
+	// tokens 所有文档在这个字段上的词的总数
+	tokens int
+}
+
+// index 一个索引。所有的字符串字段都会分词建倒排，数字和 keyword 的匹配直接看原始值
+type index struct {
+	// docs 下标就是文档的编号，覆盖写的时候编号不变
+	docs []*document
+	ids  map[string]int
+	// postings 字段 => 词 => 文档编号 => 出现的位置
+	postings map[string]map[string]map[int][]int
+	stats    map[string]*fieldStats
+}
+
+func newIndex() *index {
+	return &index{
+		ids:      make(map[string]int),
+		postings: make(map[string]map[string]map[int][]int),
+		stats:    make(map[string]*fieldStats),
+	}
+}
+
+// get 索引还不存在（idx 是 nil）的时候也可以调用
+func (idx *index) get(id string) (*document, bool) {
+	if idx == nil {
+		return nil, false
+	}
+	num, ok := idx.ids[id]
+	if !ok {
+		return nil, false
+	}
+	return idx.docs[num], true
+}
+
+func (idx *index) count() int {
+	return len(idx.ids)
+}
+
+func (idx *index) put(id string, source map[string]any) {
+	num, ok := idx.ids[id]
+	if ok {
+		idx.unindex(num)
+	} else {
+		num = len(idx.docs)
+		idx.docs = append(idx.docs, nil)
+		idx.ids[id] = num
+	}
+	doc := &document{
+		id:      id,
+		source:  source,
+		terms:   make(map[string][]string),
+		lengths: make(map[string]int),
+	}
+	for field, val := range source {
+		texts := stringValues(val)
+		if len(texts) == 0 {
+			continue
+		}
+		fieldPostings, ok := idx.postings[field]
+		if !ok {
+			fieldPostings = make(map[string]map[int][]int)
+			idx.postings[field] = fieldPostings
+		}
+		pos, length := 0, 0
+		for _, text := range texts {
+			for _, t := range analyze([]rune(text)) {
+				docs, ok := fieldPostings[t.term]
+				if !ok {
+					docs = make(map[int][]int)
+					fieldPostings[t.term] = docs
+				}
+				if len(docs[num]) == 0 {
+					doc.terms[field] = append(doc.terms[field], t.term)
+				}
+				docs[num] = append(docs[num], pos)
+				pos++
+				length++
+			}
+			pos += positionGap
+		}
+		doc.lengths[field] = length
+		st, ok := idx.stats[field]
+		if !ok {
+			st = &fieldStats{}
+			idx.stats[field] = st
+		}
+		st.docs++
+		st.tokens += length
+	}
+	idx.docs[num] = doc
+}
+
+// unindex 删掉文档的倒排，文档本身留给调用者覆盖
+func (idx *index) unindex(num int) {
+	doc := idx.docs[num]
+	for field, ts := range doc.terms {
+		for _, t := range ts {
+			docs := idx.postings[field][t]
+			delete(docs, num)
+			if len(docs) == 0 {
+				delete(idx.postings[field], t)
+			}
+		}
+	}
+	for field, length := range doc.lengths {
+		st := idx.stats[field]
+		st.docs--
+		st.tokens -= length
+	}
+}
+
+// bm25 term 在文档 num 的 field 上出现了 tf 次的得分
+func (idx *index) bm25(field, term string, num int, tf int) float64 {
+	st := idx.stats[field]
+	if st == nil || st.docs == 0 {
+		return 0
+	}
+	n := float64(st.docs)
+	df := float64(len(idx.postings[field][term]))
+	idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+	avg := float64(st.tokens) / n
+	dl := float64(idx.docs[num].lengths[field])
+	f := float64(tf)
+	return idf * f / (f + bm25K1*(1-bm25B+bm25B*dl/avg))
+}
+
+// decode 把文档统一转成 map，数字用 json.Number 保存，不然 long 会丢精度
+func decode(doc any) (map[string]any, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return decodeBytes(data)
+}
+
+func decodeBytes(data []byte) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var res map[string]any
+	err := dec.Decode(&res)
+	return res, err
+}
+
+// values 字段的所有值，数组会展开
+func values(val any) []any {
+	switch v := val.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	default:
+		return []any{v}
+	}
+}
+
+func stringValues(val any) []string {
+	var res []string
+	for _, v := range values(val) {
+		if s, ok := v.(string); ok {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func toInt(val any) (int64, bool) {
+	switch v := val.(type) {
+	case json.Number:
+		res, err := v.Int64()
+		return res, err == nil
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case float64:
+		return int64(v), v == math.Trunc(v) && math.Abs(v) < 1<<53
+	}
+	return 0, false
+}
+
+func toFloat(val any) (float64, bool) {
+	switch v := val.(type) {
+	case json.Number:
+		res, err := v.Float64()
+		return res, err == nil
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case string:
+		// 和 ES 一样，数字字段上可以用字符串查询
+		res, err := strconv.ParseFloat(v, 64)
+		return res, err == nil
+	}
+	return 0, false
+}
+
+// compare 比较两个值，nil 最小，数字按照大小比较，其余的按照字符串比较
+func compare(a, b any) int {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+	sa, aok := a.(string)
+	sb, bok := b.(string)
+	if aok && bok {
+		return cmp.Compare(sa, sb)
+	}
+	if ia, ok := toInt(a); ok {
+		if ib, ok := toInt(b); ok {
+			return cmp.Compare(ia, ib)
+		}
+	}
+	fa, _ := toFloat(a)
+	fb, _ := toFloat(b)
+	return cmp.Compare(fa, fb)
+}
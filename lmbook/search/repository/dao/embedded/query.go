@@ -0,0 +1,272 @@
+package embedded
+
+import (
+	"basic-go/lmbook/search/repository/dao"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// scores 命中的文档编号 => 得分
+type scores map[int]float64
+
+// eval 执行查询，算分的规则尽量和 ES 保持一致：
+// match 和 phrase 用 BM25，terms、range、prefix 是常量分，bool 把 Must 和 Should 的得分加起来
+func (idx *index) eval(c dao.Clause) (scores, error) {
+	switch q := c.(type) {
+	case dao.BoolClause:
+		return idx.evalBool(q)
+	case dao.MatchClause:
+		return idx.evalMatch(q), nil
+	case dao.PhraseClause:
+		res := make(scores)
+		for _, field := range q.Fields {
+			// 和 multi_match 的 best_fields 一样，取得分最高的字段
+			for num, score := range idx.evalPhrase(field, terms(q.Text)) {
+				if score > res[num] {
+					res[num] = score
+				}
+			}
+		}
+		return res, nil
+	case dao.TermsClause:
+		boost := q.Boost
+		if boost == 0 {
+			boost = 1
+		}
+		return idx.filter(q.Field, func(val any) bool {
+			for _, v := range q.Values {
+				if equal(val, v) {
+					return true
+				}
+			}
+			return false
+		}, boost), nil
+	case dao.RangeClause:
+		return idx.filter(q.Field, func(val any) bool {
+			if _, ok := toFloat(val); !ok {
+				return false
+			}
+			if q.Gte != nil && compare(val, q.Gte) < 0 {
+				return false
+			}
+			if q.Lt != nil && compare(val, q.Lt) >= 0 {
+				return false
+			}
+			return true
+		}, 1), nil
+	case dao.PrefixClause:
+		return idx.filter(q.Field, func(val any) bool {
+			s, ok := val.(string)
+			return ok && strings.HasPrefix(s, q.Prefix)
+		}, 1), nil
+	default:
+		return nil, fmt.Errorf("不支持的查询条件 %T", c)
+	}
+}
+
+func (idx *index) evalBool(q dao.BoolClause) (scores, error) {
+	var res scores
+	// and 和 res 取交集，withScore 为 false 的是 filter，不算分
+	and := func(cs []dao.Clause, withScore bool) error {
+		for _, c := range cs {
+			sub, err := idx.eval(c)
+			if err != nil {
+				return err
+			}
+			if res == nil {
+				res = make(scores, len(sub))
+				for num, score := range sub {
+					if !withScore {
+						score = 0
+					}
+					res[num] = score
+				}
+				continue
+			}
+			for num := range res {
+				score, ok := sub[num]
+				if !ok {
+					delete(res, num)
+					continue
+				}
+				if withScore {
+					res[num] += score
+				}
+			}
+		}
+		return nil
+	}
+	if err := and(q.Must, true); err != nil {
+		return nil, err
+	}
+	if err := and(q.Filter, false); err != nil {
+		return nil, err
+	}
+	msm := q.MinimumShouldMatch
+	if res == nil {
+		if msm == 0 && len(q.Should) > 0 {
+			msm = 1
+		}
+		// 只有 Should 或者 MustNot 的时候，从所有的文档里面挑
+		res = make(scores, idx.count())
+		for num, doc := range idx.docs {
+			if doc != nil {
+				res[num] = 0
+			}
+		}
+	}
+	if len(q.Should) > 0 {
+		shoulds := make([]scores, 0, len(q.Should))
+		for _, c := range q.Should {
+			sub, err := idx.eval(c)
+			if err != nil {
+				return nil, err
+			}
+			shoulds = append(shoulds, sub)
+		}
+		for num := range res {
+			cnt := 0
+			for _, sub := range shoulds {
+				if score, ok := sub[num]; ok {
+					cnt++
+					res[num] += score
+				}
+			}
+			if cnt < msm {
+				delete(res, num)
+			}
+		}
+	}
+	for _, c := range q.MustNot {
+		sub, err := idx.eval(c)
+		if err != nil {
+			return nil, err
+		}
+		for num := range sub {
+			delete(res, num)
+		}
+	}
+	return res, nil
+}
+
+// evalMatch 命中任意一个词就可以，得分是每个词的得分加起来
+func (idx *index) evalMatch(q dao.MatchClause) scores {
+	res := make(scores)
+	seen := make(map[string]struct{})
+	for _, t := range terms(q.Text) {
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		for num, positions := range idx.postings[q.Field][t] {
+			res[num] += idx.bm25(q.Field, t, num, len(positions))
+		}
+	}
+	return res
+}
+
+// evalPhrase 所有的词按照顺序连续出现
+func (idx *index) evalPhrase(field string, ts []string) scores {
+	res := make(scores)
+	if len(ts) == 0 {
+		return res
+	}
+	postings := make([]map[int][]int, 0, len(ts))
+	for _, t := range ts {
+		docs, ok := idx.postings[field][t]
+		if !ok {
+			return res
+		}
+		postings = append(postings, docs)
+	}
+	for num, firsts := range postings[0] {
+		freq := 0
+		for _, pos := range firsts {
+			if idx.phraseAt(postings, num, pos) {
+				freq++
+			}
+		}
+		if freq == 0 {
+			continue
+		}
+		for _, t := range ts {
+			res[num] += idx.bm25(field, t, num, freq)
+		}
+	}
+	return res
+}
+
+func (idx *index) phraseAt(postings []map[int][]int, num int, pos int) bool {
+	for i := 1; i < len(postings); i++ {
+		positions := postings[i][num]
+		want := pos + i
+		j := sort.SearchInts(positions, want)
+		if j == len(positions) || positions[j] != want {
+			return false
+		}
+	}
+	return true
+}
+
+// filter field 上任意一个值满足 fn 的文档，得分都是 score
+func (idx *index) filter(field string, fn func(val any) bool, score float64) scores {
+	res := make(scores)
+	for num, doc := range idx.docs {
+		if doc == nil {
+			continue
+		}
+		for _, val := range values(doc.source[field]) {
+			if fn(val) {
+				res[num] = score
+				break
+			}
+		}
+	}
+	return res
+}
+
+// equal 字符串和字符串比较，数字和数字比较。
+// 和 ES 一样，数字字段上可以用字符串形式的数字来查
+func equal(a, b any) bool {
+	sa, aok := a.(string)
+	sb, bok := b.(string)
+	if aok && bok {
+		return sa == sb
+	}
+	if _, ok := toFloat(a); !ok {
+		return false
+	}
+	if _, ok := toFloat(b); !ok {
+		return false
+	}
+	return compare(a, b) == 0
+}
+
+// highlightTerms 查询在每个字段上用来匹配的词，高亮用。MustNot 里面的不算
+func highlightTerms(c dao.Clause, res map[string]map[string]struct{}) {
+	add := func(field string, ts []string) {
+		set, ok := res[field]
+		if !ok {
+			set = make(map[string]struct{})
+			res[field] = set
+		}
+		for _, t := range ts {
+			set[t] = struct{}{}
+		}
+	}
+	switch q := c.(type) {
+	case dao.BoolClause:
+		for _, cs := range [][]dao.Clause{q.Must, q.Should, q.Filter} {
+			for _, sub := range cs {
+				highlightTerms(sub, res)
+			}
+		}
+	case dao.MatchClause:
+		add(q.Field, terms(q.Text))
+	case dao.PhraseClause:
+		for _, field := range q.Fields {
+			add(field, terms(q.Text))
+		}
+	}
+}
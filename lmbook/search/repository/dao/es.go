@@ -0,0 +1,186 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/olivere/elastic/v7"
+)
+
+// ESBackend 用 ES 实现的 Backend，写入的时候通过 IndexManager 处理重建索引期间的双写
+type ESBackend struct {
+	client  *elastic.Client
+	indices *IndexManager
+}
+
+func NewESBackend(client *elastic.Client) *ESBackend {
+	return &ESBackend{
+		client:  client,
+		indices: NewIndexManager(client),
+	}
+}
+
+// Indices 重建索引要用
+func (b *ESBackend) Indices() *IndexManager {
+	return b.indices
+}
+
+func (b *ESBackend) Put(ctx context.Context, index, id string, doc any) error {
+	return b.indices.Write(ctx, index, func(idx string) error {
+		_, err := b.client.Index().
+			Index(idx).Id(id).BodyJson(doc).Do(ctx)
+		return err
+	})
+}
+
+func (b *ESBackend) Update(ctx context.Context, index, id string, doc any, upsert bool) error {
+	return b.indices.Write(ctx, index, func(idx string) error {
+		_, err := b.client.Update().
+			Index(idx).Id(id).
+			Doc(doc).DocAsUpsert(upsert).Do(ctx)
+		if !upsert && elastic.IsNotFound(err) {
+			return nil
+		}
+		return err
+	})
+}
+
+func (b *ESBackend) Search(ctx context.Context, index string, req SearchRequest) (SearchResult, error) {
+	q, err := toESQuery(req.Query)
+	if err != nil {
+		return SearchResult{}, err
+	}
+	svc := b.client.Search(index).Query(q)
+	for _, f := range req.Sort {
+		if f == ScoreField {
+			svc = svc.SortBy(elastic.NewScoreSort())
+			continue
+		}
+		svc = svc.SortBy(elastic.NewFieldSort(f).Desc().Missing("_last"))
+	}
+	if req.Limit > 0 {
+		svc = svc.Size(req.Limit)
+	}
+	if len(req.SearchAfter) > 0 {
+		svc = svc.SearchAfter(req.SearchAfter...)
+	} else if req.Offset > 0 {
+		svc = svc.From(req.Offset)
+	}
+	if len(req.Includes) > 0 {
+		svc = svc.FetchSourceContext(elastic.NewFetchSourceContext(true).Include(req.Includes...))
+	}
+	if len(req.Highlights) > 0 {
+		fields := make([]*elastic.HighlighterField, 0, len(req.Highlights))
+		for _, h := range req.Highlights {
+			f := elastic.NewHighlighterField(h.Field).NumOfFragments(h.NumOfFragments)
+			if h.FragmentSize > 0 {
+				f = f.FragmentSize(h.FragmentSize)
+			}
+			fields = append(fields, f)
+		}
+		svc = svc.Highlight(elastic.NewHighlight().
+			PreTags("<em>").PostTags("</em>").Fields(fields...))
+	}
+	resp, err := svc.Do(ctx)
+	if err != nil {
+		return SearchResult{}, err
+	}
+	res := SearchResult{
+		Hits:  make([]Hit, 0, len(resp.Hits.Hits)),
+		Total: resp.TotalHits(),
+	}
+	for _, hit := range resp.Hits.Hits {
+		res.Hits = append(res.Hits, Hit{
+			Id:        hit.Id,
+			Source:    hit.Source,
+			Highlight: hit.Highlight,
+			Sort:      hit.Sort,
+		})
+	}
+	return res, nil
+}
+
+func (b *ESBackend) Suggest(ctx context.Context, index string, req SuggestRequest) ([]json.RawMessage, error) {
+	const name = "suggest"
+	suggester := elastic.NewCompletionSuggester(name).
+		Field(req.Field).Prefix(req.Prefix).
+		SkipDuplicates(true).Size(req.Size)
+	if len(req.Contexts) > 0 {
+		queries := make([]elastic.SuggesterContextQuery, 0, len(req.Contexts))
+		for name, values := range req.Contexts {
+			queries = append(queries, elastic.NewSuggesterCategoryQuery(name, values...))
+		}
+		suggester = suggester.ContextQueries(queries...)
+	}
+	svc := b.client.Search(index).Suggester(suggester)
+	if len(req.Includes) > 0 {
+		svc = svc.FetchSourceContext(elastic.NewFetchSourceContext(true).Include(req.Includes...))
+	}
+	resp, err := svc.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var res []json.RawMessage
+	for _, sug := range resp.Suggest[name] {
+		for _, opt := range sug.Options {
+			res = append(res, opt.Source)
+		}
+	}
+	return res, nil
+}
+
+// toESQuery 把 Clause 翻译成 ES 的查询
+func toESQuery(c Clause) (elastic.Query, error) {
+	switch q := c.(type) {
+	case BoolClause:
+		bq := elastic.NewBoolQuery()
+		var err error
+		add := func(fn func(...elastic.Query) *elastic.BoolQuery, cs []Clause) {
+			for _, sub := range cs {
+				if err != nil {
+					return
+				}
+				var esq elastic.Query
+				esq, err = toESQuery(sub)
+				if err == nil {
+					fn(esq)
+				}
+			}
+		}
+		add(bq.Must, q.Must)
+		add(bq.Should, q.Should)
+		add(bq.Filter, q.Filter)
+		add(bq.MustNot, q.MustNot)
+		if q.MinimumShouldMatch > 0 {
+			bq = bq.MinimumNumberShouldMatch(q.MinimumShouldMatch)
+		}
+		return bq, err
+	case MatchClause:
+		return elastic.NewMatchQuery(q.Field, q.Text), nil
+	case PhraseClause:
+		if len(q.Fields) == 1 {
+			return elastic.NewMatchPhraseQuery(q.Fields[0], q.Text), nil
+		}
+		return elastic.NewMultiMatchQuery(q.Text, q.Fields...).Type("phrase"), nil
+	case TermsClause:
+		tq := elastic.NewTermsQuery(q.Field, q.Values...)
+		if q.Boost > 0 {
+			tq = tq.Boost(q.Boost)
+		}
+		return tq, nil
+	case RangeClause:
+		rq := elastic.NewRangeQuery(q.Field)
+		if q.Gte != nil {
+			rq = rq.Gte(q.Gte)
+		}
+		if q.Lt != nil {
+			rq = rq.Lt(q.Lt)
+		}
+		return rq, nil
+	case PrefixClause:
+		return elastic.NewPrefixQuery(q.Field, q.Prefix), nil
+	default:
+		return nil, fmt.Errorf("不支持的查询条件 %T", c)
+	}
+}
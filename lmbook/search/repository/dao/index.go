@@ -0,0 +1,374 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/olivere/elastic/v7"
+)
+
+// 索引都是带版本的，比如说 article_index_v1，业务代码里面读写的 article_index 是别名。
+// 修改 mapping 的时候，重建一个新的版本，数据导完校验通过之后原子地切换别名，
+// 老的版本留着，出了问题可以切回去。
+//
+// 重建的过程中，新版本上面会挂一个 article_index_rebuild 的别名，
+// 所有实例写数据的时候都会额外写一份到这个别名指向的索引上，也就是双写。
+// 用 ES 的别名来记录而不是放在内存里，是因为同步数据的消费者分散在多个实例上。
+
+const (
+	rebuildSuffix = "_rebuild"
+	// legacySuffix 老的部署直接用别名的名字建的索引，切换的时候克隆成这个名字
+	legacySuffix = "_legacy"
+)
+
+var ErrUnknownIndex = errors.New("未知的索引")
+
+// Doc 重建索引的时候，直接写入 ES 的文档
+type Doc struct {
+	Id   string
+	Body any
+}
+
+type IndexManager struct {
+	client *elastic.Client
+	// 正在重建的索引，缓存一下，不然每次写数据都要多查一次 ES
+	ttl   time.Duration
+	lock  sync.RWMutex
+	cache map[string]rebuildTargets
+}
+
+type rebuildTargets struct {
+	indices  []string
+	expireAt time.Time
+}
+
+func NewIndexManager(client *elastic.Client) *IndexManager {
+	return &IndexManager{
+		client: client,
+		ttl:    time.Second * 5,
+		cache:  make(map[string]rebuildTargets),
+	}
+}
+
+// RebuildPropagation 开始重建之后，要等这么久所有实例才都会开始双写
+func (m *IndexManager) RebuildPropagation() time.Duration {
+	return m.ttl * 2
+}
+
+// Write 写别名，如果正在重建，也写一份到新的版本上
+func (m *IndexManager) Write(ctx context.Context, alias string, fn func(index string) error) error {
+	indices, err := m.rebuildTargets(ctx, alias)
+	if err != nil {
+		return err
+	}
+	// 写别名失败了也要接着写新版本：切换老部署的索引之前要克隆它，
+	// 克隆的时候它是禁止写入的，这段时间的数据只有新版本里面有
+	err = fn(alias)
+	for _, idx := range indices {
+		er := fn(idx)
+		if er != nil {
+			err = errors.Join(err, fmt.Errorf("双写重建中的索引 %s 失败 %w", idx, er))
+		}
+	}
+	return err
+}
+
+func (m *IndexManager) rebuildTargets(ctx context.Context, alias string) ([]string, error) {
+	now := time.Now()
+	m.lock.RLock()
+	t, ok := m.cache[alias]
+	m.lock.RUnlock()
+	if ok && now.Before(t.expireAt) {
+		return t.indices, nil
+	}
+	indices, err := m.indicesByAlias(ctx, alias+rebuildSuffix)
+	if err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	m.cache[alias] = rebuildTargets{indices: indices, expireAt: now.Add(m.ttl)}
+	m.lock.Unlock()
+	return indices, nil
+}
+
+// indicesByAlias 别名指向的索引，别名不存在返回空
+func (m *IndexManager) indicesByAlias(ctx context.Context, alias string) ([]string, error) {
+	res, err := m.client.Aliases().Alias(alias).Do(ctx)
+	if elastic.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res.IndicesByAlias(alias), nil
+}
+
+// Current 别名当前指向的索引。
+// 老的部署里面索引是直接用别名的名字创建的，这种情况下返回它自己
+func (m *IndexManager) Current(ctx context.Context, alias string) ([]string, error) {
+	indices, err := m.indicesByAlias(ctx, alias)
+	if err != nil || len(indices) > 0 {
+		return indices, err
+	}
+	ok, err := m.client.IndexExists(alias).Do(ctx)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return []string{alias}, nil
+}
+
+// NextVersion 下一个版本的索引名字，article_index_v1、article_index_v2 这样递增
+func (m *IndexManager) NextVersion(alias string) (string, error) {
+	versions, err := m.Versions(alias)
+	if err != nil {
+		return "", err
+	}
+	next := 1
+	if len(versions) > 0 {
+		next = version(alias, versions[len(versions)-1]) + 1
+	}
+	return fmt.Sprintf("%s_v%d", alias, next), nil
+}
+
+// Create 按照 alias 的 mapping 创建一个新版本，aliases 是同时挂上去的别名
+func (m *IndexManager) Create(ctx context.Context, alias, index string, aliases ...string) error {
+	cfg, ok := indexConfigs[alias]
+	if !ok {
+		return fmt.Errorf("%w %s", ErrUnknownIndex, alias)
+	}
+	var body map[string]any
+	err := json.Unmarshal([]byte(cfg), &body)
+	if err != nil {
+		return err
+	}
+	if len(aliases) > 0 {
+		as := make(map[string]any, len(aliases))
+		for _, a := range aliases {
+			as[a] = map[string]any{}
+		}
+		body["aliases"] = as
+	}
+	_, err = m.client.CreateIndex(index).BodyJson(body).Do(ctx)
+	return err
+}
+
+// StartRebuild 新版本开始双写
+func (m *IndexManager) StartRebuild(ctx context.Context, alias, index string) error {
+	_, err := m.client.Alias().
+		Action(elastic.NewAliasAddAction(alias + rebuildSuffix).Index(index)).Do(ctx)
+	return err
+}
+
+// AbortRebuild 放弃重建，停止双写并且删除新版本
+func (m *IndexManager) AbortRebuild(ctx context.Context, alias, index string) error {
+	_, err := m.client.DeleteIndex(index).Do(ctx)
+	if elastic.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// Count 文档数量，先刷新一下，保证刚写进去的也能数到
+func (m *IndexManager) Count(ctx context.Context, index string) (int64, error) {
+	_, err := m.client.Refresh(index).Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return m.client.Count(index).Do(ctx)
+}
+
+// Swap 原子地把别名切换到 index 上，同时停止双写，返回切换之前的索引。
+// 如果之前是直接用别名的名字建的索引，要在同一个请求里面把它删掉，不然别名没办法创建，
+// 所以删之前先把它克隆成 article_index_legacy 这样的索引保留下来，需要的话可以切回去
+func (m *IndexManager) Swap(ctx context.Context, alias, index string) ([]string, error) {
+	olds, err := m.Current(ctx, alias)
+	if err != nil {
+		return nil, err
+	}
+	actions := []elastic.AliasAction{
+		elastic.NewAliasRemoveAction(alias + rebuildSuffix).Index(index),
+		elastic.NewAliasAddAction(alias).Index(index),
+	}
+	var legacy string
+	for i, old := range olds {
+		if old == alias {
+			legacy = alias + legacySuffix
+			err = m.cloneLegacy(ctx, alias, legacy)
+			if err != nil {
+				return nil, err
+			}
+			olds[i] = legacy
+			actions = append(actions, elastic.NewAliasRemoveIndexAction(old))
+			continue
+		}
+		actions = append(actions, elastic.NewAliasRemoveAction(alias).Index(old))
+	}
+	_, err = m.client.Alias().Action(actions...).Do(ctx)
+	if err != nil && legacy != "" {
+		// 没有切换过去，老的索引继续用，克隆出来的删掉，不然下一次重建没办法再克隆
+		er := m.setWriteBlock(ctx, alias, false)
+		if er == nil {
+			_, er = m.client.DeleteIndex(legacy).Do(ctx)
+		}
+		if er != nil {
+			return nil, errors.Join(err, er)
+		}
+	}
+	return olds, err
+}
+
+// cloneLegacy 克隆老的部署直接用别名的名字建的索引，mapping 和数据都是一样的。
+// 克隆要求原来的索引禁止写入，这段时间里面写别名会失败，
+// 但是双写的新版本还能写进去，切换之后用的就是新版本，所以不会丢数据
+func (m *IndexManager) cloneLegacy(ctx context.Context, from, to string) error {
+	err := m.setWriteBlock(ctx, from, true)
+	if err != nil {
+		return err
+	}
+	_, err = m.client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodPost,
+		Path:   fmt.Sprintf("/%s/_clone/%s", from, to),
+	})
+	if err == nil {
+		// 克隆出来的索引连禁止写入的设置也一起继承了，切回去之后要能写
+		err = m.setWriteBlock(ctx, to, false)
+	}
+	if err != nil {
+		if er := m.setWriteBlock(ctx, from, false); er != nil {
+			return errors.Join(err, er)
+		}
+	}
+	return err
+}
+
+func (m *IndexManager) setWriteBlock(ctx context.Context, index string, block bool) error {
+	_, err := m.client.IndexPutSettings(index).
+		BodyJson(map[string]any{"index.blocks.write": block}).Do(ctx)
+	return err
+}
+
+// BulkCreate 批量写入新版本，已经存在的文档直接跳过。
+// 双写过来的数据一定比全量导入的新，所以不能覆盖
+func (m *IndexManager) BulkCreate(ctx context.Context, index string, docs []Doc) error {
+	if len(docs) == 0 {
+		return nil
+	}
+	bulk := m.client.Bulk().Index(index)
+	for _, doc := range docs {
+		bulk.Add(elastic.NewBulkCreateRequest().Id(doc.Id).Doc(doc.Body))
+	}
+	resp, err := bulk.Do(ctx)
+	if err != nil {
+		return err
+	}
+	for _, item := range resp.Failed() {
+		if item.Status == http.StatusConflict {
+			continue
+		}
+		return fmt.Errorf("写入文档 %s 失败 %s", item.Id, item.Error.Reason)
+	}
+	return nil
+}
+
+// Scan 分批读出 index 里面所有的文档
+func (m *IndexManager) Scan(ctx context.Context, index string, batchSize int,
+	fn func(docs []Doc) error) error {
+	scroll := m.client.Scroll(index).Size(batchSize)
+	defer scroll.Clear(context.Background())
+	for {
+		resp, err := scroll.Do(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		docs := make([]Doc, 0, len(resp.Hits.Hits))
+		for _, hit := range resp.Hits.Hits {
+			docs = append(docs, Doc{Id: hit.Id, Body: hit.Source})
+		}
+		err = fn(docs)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// CopyFields 把 from 里面的 fields 拷贝到 to 里面已经存在的文档上。
+// 用在热度这种不是从数据源来的字段上，不然重建之后就丢了
+func (m *IndexManager) CopyFields(ctx context.Context, from, to string, batchSize int,
+	fields ...string) error {
+	query := elastic.NewBoolQuery()
+	for _, f := range fields {
+		query.Should(elastic.NewExistsQuery(f))
+	}
+	scroll := m.client.Scroll(from).Size(batchSize).
+		Query(query.MinimumNumberShouldMatch(1)).
+		FetchSourceContext(elastic.NewFetchSourceContext(true).Include(fields...))
+	defer scroll.Clear(context.Background())
+	for {
+		resp, err := scroll.Do(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(resp.Hits.Hits) == 0 {
+			continue
+		}
+		bulk := m.client.Bulk().Index(to)
+		for _, hit := range resp.Hits.Hits {
+			bulk.Add(elastic.NewBulkUpdateRequest().Id(hit.Id).Doc(hit.Source))
+		}
+		res, err := bulk.Do(ctx)
+		if err != nil {
+			return err
+		}
+		for _, item := range res.Failed() {
+			// 新版本里面没有，说明数据源里面已经没有了
+			if item.Status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("拷贝文档 %s 失败 %s", item.Id, item.Error.Reason)
+		}
+	}
+}
+
+// Versions 所有的版本，按照版本号从小到大排序
+func (m *IndexManager) Versions(alias string) ([]string, error) {
+	names, err := m.client.IndexNames()
+	if err != nil {
+		return nil, err
+	}
+	res := slice.FilterDelete(names, func(idx int, src string) bool {
+		return version(alias, src) <= 0
+	})
+	sort.Slice(res, func(i, j int) bool {
+		return version(alias, res[i]) < version(alias, res[j])
+	})
+	return res, nil
+}
+
+// version 从 article_index_v2 里面解析出 2，不是 alias 的版本返回 0
+func version(alias, index string) int {
+	v, ok := strings.CutPrefix(index, alias+"_v")
+	if !ok {
+		return 0
+	}
+	res, err := strconv.Atoi(v)
+	if err != nil {
+		return 0
+	}
+	return res
+}
@@ -0,0 +1,133 @@
+package dao
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersion(t *testing.T) {
+	testCases := []struct {
+		name  string
+		index string
+		want  int
+	}{
+		{
+			name:  "正常版本",
+			index: "article_index_v12",
+			want:  12,
+		},
+		{
+			name:  "老的部署直接用了别名",
+			index: "article_index",
+		},
+		{
+			name:  "别的索引",
+			index: "user_index_v1",
+		},
+		{
+			name:  "前缀相同的别的索引",
+			index: "article_index_v1_backup",
+		},
+		{
+			name:  "重建的别名",
+			index: "article_index_rebuild",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, version(ArticleIndexName, tc.index))
+		})
+	}
+}
+
+func TestIndexManager_Swap(t *testing.T) {
+	const (
+		getAlias  = "GET /_alias/article_index"
+		exists    = "HEAD /article_index"
+		block     = `PUT /article_index/_settings {"index.blocks.write":true}`
+		unblock   = `PUT /article_index/_settings {"index.blocks.write":false}`
+		clone     = "POST /article_index/_clone/article_index_legacy"
+		unblockTo = `PUT /article_index_legacy/_settings {"index.blocks.write":false}`
+		swap      = `POST /_aliases {"actions":[{"remove":{"alias":"article_index_rebuild","index":"article_index_v2"}},` +
+			`{"add":{"alias":"article_index","index":"article_index_v2"}},{"remove_index":{"index":"article_index"}}]}`
+		swapV1 = `POST /_aliases {"actions":[{"remove":{"alias":"article_index_rebuild","index":"article_index_v2"}},` +
+			`{"add":{"alias":"article_index","index":"article_index_v2"}},{"remove":{"alias":"article_index","index":"article_index_v1"}}]}`
+		deleteClone = "DELETE /article_index_legacy"
+	)
+	testCases := []struct {
+		name string
+		// 没有列出来的请求都返回 {}
+		resps map[string]int
+
+		wantOlds  []string
+		wantCalls []string
+		wantErr   bool
+	}{
+		{
+			name:      "已经是别名了，直接切换",
+			resps:     map[string]int{},
+			wantOlds:  []string{"article_index_v1"},
+			wantCalls: []string{getAlias, swapV1},
+		},
+		{
+			name:      "老的部署，克隆一份再切换",
+			resps:     map[string]int{getAlias: http.StatusNotFound},
+			wantOlds:  []string{"article_index_legacy"},
+			wantCalls: []string{getAlias, exists, block, clone, unblockTo, swap},
+		},
+		{
+			name:      "克隆失败，老的索引恢复写入",
+			resps:     map[string]int{getAlias: http.StatusNotFound, clone: http.StatusBadRequest},
+			wantCalls: []string{getAlias, exists, block, clone, unblock},
+			wantErr:   true,
+		},
+		{
+			name:      "切换失败，老的索引恢复写入，删掉克隆出来的",
+			resps:     map[string]int{getAlias: http.StatusNotFound, swap: http.StatusBadRequest},
+			wantCalls: []string{getAlias, exists, block, clone, unblockTo, swap, unblock, deleteClone},
+			wantErr:   true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				call := r.Method + " " + r.URL.Path
+				if len(body) > 0 {
+					call += " " + string(body)
+				}
+				calls = append(calls, call)
+				w.Header().Set("Content-Type", "application/json")
+				if status, ok := tc.resps[call]; ok {
+					w.WriteHeader(status)
+					_, _ = w.Write([]byte(`{"error":{"type":"mock_error"}}`))
+					return
+				}
+				if call == getAlias {
+					_, _ = w.Write([]byte(`{"article_index_v1":{"aliases":{"article_index":{}}}}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer srv.Close()
+			client, err := elastic.NewClient(elastic.SetURL(srv.URL),
+				elastic.SetSniff(false), elastic.SetHealthcheck(false))
+			require.NoError(t, err)
+			olds, err := NewIndexManager(client).Swap(context.Background(), ArticleIndexName, "article_index_v2")
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.wantCalls, calls)
+			if err == nil {
+				assert.Equal(t, tc.wantOlds, olds)
+			}
+		})
+	}
+}
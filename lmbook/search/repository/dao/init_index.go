@@ -17,37 +17,37 @@ var (
 	tagIndex string
 )
 
+// indexConfigs 别名对应的 mapping，修改了 mapping 之后要用重建索引的接口切换到新版本
+var indexConfigs = map[string]string{
+	UserIndexName:    userIndex,
+	ArticleIndexName: articleIndex,
+	TagIndexName:     tagIndex,
+}
+
 // InitES 创建索引
 func InitES(client *elastic.Client) error {
 	const timeout = time.Second * 10
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
+	m := NewIndexManager(client)
 	var eg errgroup.Group
-	eg.Go(func() error {
-		return tryCreateIndex(ctx, client, UserIndexName, userIndex)
-	})
-	eg.Go(func() error {
-		return tryCreateIndex(ctx, client, ArticleIndexName, articleIndex)
-	})
-	eg.Go(func() error {
-		return tryCreateIndex(ctx, client, TagIndexName, articleIndex)
-	})
-
+	for alias := range indexConfigs {
+		alias := alias
+		eg.Go(func() error {
+			return tryCreateIndex(ctx, m, alias)
+		})
+	}
 	return eg.Wait()
 }
 
-func tryCreateIndex(ctx context.Context,
-	client *elastic.Client,
-	idxName, idxCfg string,
-) error {
-	// 索引可能已经建好了
-	ok, err := client.IndexExists(idxName).Do(ctx)
+func tryCreateIndex(ctx context.Context, m *IndexManager, alias string) error {
+	// 索引可能已经建好了，也可能是老的部署直接用别名的名字建的索引
+	cur, err := m.Current(ctx, alias)
 	if err != nil {
 		return err
 	}
-	if ok {
+	if len(cur) > 0 {
 		return nil
 	}
-	_, err = client.CreateIndex(idxName).Body(idxCfg).Do(ctx)
-	return err
+	return m.Create(ctx, alias, alias+"_v1", alias)
 }
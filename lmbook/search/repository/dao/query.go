@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"strings"
+
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// Query 全文匹配的部分，几个索引都是一样的
+type Query struct {
+	Keywords []string
+	// 要求完整出现的短语
+	Phrases  []string
+	Excludes []string
+}
+
+func (q Query) HasText() bool {
+	return len(q.Keywords) > 0 || len(q.Phrases) > 0
+}
+
+// build 把短语和排除条件加到 bq 上，在 fields 上匹配
+func (q Query) build(bq *BoolClause, fields ...string) *BoolClause {
+	for _, p := range q.Phrases {
+		bq.Must = append(bq.Must, PhraseClause{Fields: fields, Text: p})
+	}
+	for _, e := range q.Excludes {
+		// 用短语匹配，不然 -"Go 并发" 会把只有 Go 的也排除掉
+		bq.MustNot = append(bq.MustNot, PhraseClause{Fields: fields, Text: e})
+	}
+	return bq
+}
+
+// keywords 普通关键字还是合并起来用 match，交给分词器处理
+func (q Query) keywords() string {
+	return strings.Join(q.Keywords, " ")
+}
+
+// ArticleQuery 文章搜索条件
+type ArticleQuery struct {
+	Query
+	// 用户自己给文章打的标签命中了关键字，给予更高权重
+	TagArtIds []int64
+	// 下面是过滤条件，零值代表不过滤
+	AuthorIds []int64
+	// tag: 命中文章本身的标签，或者是用户自己打的标签（TaggedIds）都可以
+	Tags      []string
+	TaggedIds []int64
+	// 更新时间，毫秒，左闭右开
+	After  int64
+	Before int64
+}
+
+func (q ArticleQuery) Build() Clause {
+	bq := &BoolClause{Filter: []Clause{TermsClause{Field: "status", Values: []any{2}}}}
+	if len(q.Keywords) > 0 {
+		queryString := q.keywords()
+		bq.Must = append(bq.Must, BoolClause{Should: []Clause{
+			// 给予更高权重
+			TermsClause{Field: "id", Values: toAny(q.TagArtIds), Boost: 2},
+			MatchClause{Field: "title", Text: queryString},
+			MatchClause{Field: "content", Text: queryString},
+		}})
+	}
+	bq = q.Query.build(bq, "title", "content")
+	if len(q.AuthorIds) > 0 {
+		bq.Filter = append(bq.Filter, TermsClause{Field: "author_id", Values: toAny(q.AuthorIds)})
+	}
+	if len(q.Tags) > 0 {
+		bq.Filter = append(bq.Filter, BoolClause{
+			Should: []Clause{
+				TermsClause{Field: "tags", Values: slice.Map(q.Tags, func(idx int, src string) any {
+					return src
+				})},
+				TermsClause{Field: "id", Values: toAny(q.TaggedIds)},
+			},
+			MinimumShouldMatch: 1,
+		})
+	}
+	if q.After > 0 || q.Before > 0 {
+		rq := RangeClause{Field: "utime"}
+		if q.After > 0 {
+			rq.Gte = q.After
+		}
+		if q.Before > 0 {
+			rq.Lt = q.Before
+		}
+		bq.Filter = append(bq.Filter, rq)
+	}
+	return *bq
+}
+
+// UserQuery 用户只支持在昵称上匹配
+type UserQuery struct {
+	Query
+}
+
+func (q UserQuery) Build() Clause {
+	bq := &BoolClause{}
+	if len(q.Keywords) > 0 {
+		bq.Must = append(bq.Must, MatchClause{Field: "nickname", Text: q.keywords()})
+	}
+	return *q.Query.build(bq, "nickname")
+}
+
+func toAny(ids []int64) []any {
+	return slice.Map(ids, func(idx int, src int64) any {
+		return src
+	})
+}
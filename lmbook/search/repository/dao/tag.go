@@ -0,0 +1,96 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/ecodeclub/ekit/slice"
+)
+
+type TagSearchDAO struct {
+	backend Backend
+}
+
+func NewTagDAO(backend Backend) TagDAO {
+	return &TagSearchDAO{backend: backend}
+}
+
+func (t *TagSearchDAO) Search(ctx context.Context, uid int64, biz string, keywords []string) ([]int64, error) {
+	query := BoolClause{Must: []Clause{
+		// 必须是我打的标签
+		TermsClause{Field: "uid", Values: []any{uid}},
+		TermsClause{Field: "biz", Values: []any{biz}},
+		TermsClause{Field: "tags", Values: slice.Map(keywords, func(idx int, src string) any {
+			return src
+		})},
+	}}
+	resp, err := t.backend.Search(ctx, TagIndexName, SearchRequest{Query: query})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]int64, 0, len(resp.Hits))
+	for _, hit := range resp.Hits {
+		var bt BizTags
+		err = json.Unmarshal(hit.Source, &bt)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, bt.BizId)
+	}
+	return res, nil
+}
+
+func (t *TagSearchDAO) Suggest(ctx context.Context, uid int64, biz string, prefix string, limit int) ([]string, error) {
+	// 标签是私有的，不能用补全字段（会把别人的标签也提示出来），
+	// 所以用 keyword 上的前缀查询，再按照出现的次数排序
+	const maxDocs = 200
+	query := BoolClause{Must: []Clause{
+		TermsClause{Field: "uid", Values: []any{uid}},
+		TermsClause{Field: "biz", Values: []any{biz}},
+		PrefixClause{Field: "tags", Prefix: prefix},
+	}}
+	resp, err := t.backend.Search(ctx, TagIndexName, SearchRequest{
+		Query:    query,
+		Limit:    maxDocs,
+		Includes: []string{"tags"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	cnts := make(map[string]int)
+	for _, hit := range resp.Hits {
+		var bt BizTags
+		err = json.Unmarshal(hit.Source, &bt)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range bt.Tags {
+			if strings.HasPrefix(tag, prefix) {
+				cnts[tag]++
+			}
+		}
+	}
+	res := make([]string, 0, len(cnts))
+	for tag := range cnts {
+		res = append(res, tag)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if cnts[res[i]] != cnts[res[j]] {
+			return cnts[res[i]] > cnts[res[j]]
+		}
+		return res[i] < res[j]
+	})
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+type BizTags struct {
+	Uid   int64    `json:"uid"`
+	Biz   string   `json:"biz"`
+	BizId int64    `json:"biz_id"`
+	Tags  []string `json:"tags"`
+}
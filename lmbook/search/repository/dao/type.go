@@ -6,19 +6,76 @@ import (
 
 type UserDAO interface {
 	InputUser(ctx context.Context, user User) error
-	Search(ctx context.Context, keywords []string) ([]User, error)
+	Search(ctx context.Context, q UserQuery, opts SearchOptions) (Hits[User], error)
+	// FindIdsByNickname 昵称完整匹配的用户，author: 过滤的时候用
+	FindIdsByNickname(ctx context.Context, nicknames []string, limit int) ([]int64, error)
+	Suggest(ctx context.Context, prefix string, limit int) ([]User, error)
 }
 
 type ArticleDAO interface {
 	InputArticle(ctx context.Context, article Article) error
-	// Search artIds 命中了索引的 article id
-	Search(ctx context.Context, artIds []int64, keywords []string) ([]Article, error)
+	Search(ctx context.Context, q ArticleQuery, opts SearchOptions) (Hits[Article], error)
+	// Suggest 已发表的文章的标题前缀匹配，按照热度排序
+	Suggest(ctx context.Context, prefix string, limit int) ([]Article, error)
+	// UpdateHotScore 文章还没有同步过来的话，直接忽略
+	UpdateHotScore(ctx context.Context, id int64, score float64) error
 }
 
 type TagDAO interface {
 	Search(ctx context.Context, uid int64, biz string, keywords []string) ([]int64, error)
+	// Suggest uid 自己打过的、以 prefix 开头的标签，用得越多越靠前
+	Suggest(ctx context.Context, uid int64, biz string, prefix string, limit int) ([]string, error)
 }
 
 type AnyDAO interface {
 	Input(ctx context.Context, index, docID, data string) error
 }
+
+type SortBy uint8
+
+const (
+	SortByRelevance SortBy = iota
+	SortByRecency
+	SortByHotness
+)
+
+// SearchOptions 分页和排序
+type SearchOptions struct {
+	Offset int
+	Limit  int
+	Sort   SortBy
+	// 上一页最后一条的排序值，不为空的时候忽略 Offset
+	SearchAfter []any
+}
+
+// Hits 一页搜索结果
+type Hits[T any] struct {
+	Items []T
+	// 命中的总数
+	Total int64
+	// 最后一条的排序值，下一页的 search_after 就用它
+	LastSort []any
+}
+
+// sortFields 排序规则。最后都用 id 兜底，保证 search_after 翻页的时候顺序是稳定的
+func sortFields(sort SortBy) []string {
+	switch sort {
+	case SortByRecency:
+		return []string{"utime", "id"}
+	case SortByHotness:
+		return []string{"hot_score", ScoreField, "id"}
+	default:
+		return []string{ScoreField, "id"}
+	}
+}
+
+// searchRequest 分页和排序
+func searchRequest(q Clause, opts SearchOptions) SearchRequest {
+	return SearchRequest{
+		Query:       q,
+		Sort:        sortFields(opts.Sort),
+		Offset:      opts.Offset,
+		Limit:       opts.Limit,
+		SearchAfter: opts.SearchAfter,
+	}
+}
@@ -1,57 +0,0 @@
-package dao
-
-import (
-	"context"
-	"encoding/json"
-	"strconv"
-	"strings"
-
-	"github.com/olivere/elastic/v7"
-)
-
-const UserIndexName = "user_index"
-
-type User struct {
-	Id       int64  `json:"id"`
-	Email    string `json:"email"`
-	Nickname string `json:"nickname"`
-	Phone    string `json:"phone"`
-}
-
-type UserElasticDAO struct {
-	client *elastic.Client
-}
-
-func (h *UserElasticDAO) Search(ctx context.Context, keywords []string) ([]User, error) {
-	// 假定上面传入的 keywords 是经过了处理的
-	queryString := strings.Join(keywords, " ")
-	query := elastic.NewBoolQuery().Must(elastic.NewMatchQuery("nickname", queryString))
-	resp, err := h.client.Search(UserIndexName).Query(query).Do(ctx)
-	if err != nil {
-		return nil, err
-	}
-	res := make([]User, 0, len(resp.Hits.Hits))
-	for _, hit := range resp.Hits.Hits {
-		var ele User
-		err = json.Unmarshal(hit.Source, &ele)
-		if err != nil {
-			return nil, err
-		}
-		res = append(res, ele)
-	}
-	return res, nil
-}
-
-func (h *UserElasticDAO) InputUser(ctx context.Context, user User) error {
-	_, err := h.client.Index().
-		Index(UserIndexName).
-		Id(strconv.FormatInt(user.Id, 10)).
-		BodyJson(user).Do(ctx)
-	return err
-}
-
-func NewUserElasticDAO(client *elastic.Client) UserDAO {
-	return &UserElasticDAO{
-		client: client,
-	}
-}
@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+const UserIndexName = "user_index"
+
+type User struct {
+	Id       int64  `json:"id"`
+	Email    string `json:"email"`
+	Nickname string `json:"nickname"`
+	Phone    string `json:"phone"`
+}
+
+type UserSearchDAO struct {
+	backend Backend
+}
+
+func (h *UserSearchDAO) Search(ctx context.Context, q UserQuery, opts SearchOptions) (Hits[User], error) {
+	// 用户只按照相关度排序
+	opts.Sort = SortByRelevance
+	resp, err := h.backend.Search(ctx, UserIndexName, searchRequest(q.Build(), opts))
+	if err != nil {
+		return Hits[User]{}, err
+	}
+	res := Hits[User]{
+		Items: make([]User, 0, len(resp.Hits)),
+		Total: resp.Total,
+	}
+	for _, hit := range resp.Hits {
+		var ele User
+		err = json.Unmarshal(hit.Source, &ele)
+		if err != nil {
+			return Hits[User]{}, err
+		}
+		res.Items = append(res.Items, ele)
+		res.LastSort = hit.Sort
+	}
+	return res, nil
+}
+
+func (h *UserSearchDAO) FindIdsByNickname(ctx context.Context, nicknames []string, limit int) ([]int64, error) {
+	bq := BoolClause{}
+	for _, n := range nicknames {
+		bq.Should = append(bq.Should, PhraseClause{Fields: []string{"nickname"}, Text: n})
+	}
+	resp, err := h.backend.Search(ctx, UserIndexName, SearchRequest{
+		Query:    bq,
+		Limit:    limit,
+		Includes: []string{"id"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]int64, 0, len(resp.Hits))
+	for _, hit := range resp.Hits {
+		var ele User
+		err = json.Unmarshal(hit.Source, &ele)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, ele.Id)
+	}
+	return res, nil
+}
+
+func (h *UserSearchDAO) Suggest(ctx context.Context, prefix string, limit int) ([]User, error) {
+	sources, err := h.backend.Suggest(ctx, UserIndexName, SuggestRequest{
+		Field:    "nickname.suggest",
+		Prefix:   prefix,
+		Size:     limit,
+		Includes: []string{"id", "nickname"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]User, 0, len(sources))
+	for _, src := range sources {
+		var ele User
+		err = json.Unmarshal(src, &ele)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, ele)
+	}
+	return res, nil
+}
+
+func (h *UserSearchDAO) InputUser(ctx context.Context, user User) error {
+	return h.backend.Put(ctx, UserIndexName, strconv.FormatInt(user.Id, 10), user)
+}
+
+func NewUserDAO(backend Backend) UserDAO {
+	return &UserSearchDAO{
+		backend: backend,
+	}
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"basic-go/lmbook/search/domain"
+	"basic-go/lmbook/search/repository/dao"
+)
+
+// toDAOQuery 全文匹配的部分，过滤条件各个业务自己处理
+func toDAOQuery(q domain.Query) dao.Query {
+	return dao.Query{
+		Keywords: q.Keywords,
+		Phrases:  q.Phrases,
+		Excludes: q.Excludes,
+	}
+}
@@ -7,10 +7,16 @@ import (
 
 type UserRepository interface {
 	InputUser(ctx context.Context, msg domain.User) error
-	SearchUser(ctx context.Context, keywords []string) ([]domain.User, error)
+	SearchUser(ctx context.Context, q domain.Query, opt domain.PageOption) ([]domain.User, domain.Page, error)
+	SuggestUser(ctx context.Context, prefix string, limit int) ([]domain.User, error)
 }
 
 type ArticleRepository interface {
 	InputArticle(ctx context.Context, msg domain.Article) error
-	SearchArticle(ctx context.Context, uid int64, keywords []string) ([]domain.Article, error)
+	SearchArticle(ctx context.Context, uid int64, q domain.Query, opt domain.PageOption) ([]domain.Article, domain.Page, error)
+	// SuggestArticle 已发表的文章，按照热度排序
+	SuggestArticle(ctx context.Context, prefix string, limit int) ([]domain.Article, error)
+	// SuggestTag uid 自己给文章打过的标签
+	SuggestTag(ctx context.Context, uid int64, prefix string, limit int) ([]string, error)
+	InputPopularity(ctx context.Context, p domain.Popularity) error
 }
@@ -11,18 +11,39 @@ type userRepository struct {
 	dao dao.UserDAO
 }
 
-func (u *userRepository) SearchUser(ctx context.Context, keywords []string) ([]domain.User, error) {
-	users, err := u.dao.Search(ctx, keywords)
+func (u *userRepository) SearchUser(ctx context.Context, q domain.Query, opt domain.PageOption) ([]domain.User, domain.Page, error) {
+	opts, err := toSearchOptions(opt)
 	if err != nil {
-		return nil, err
+		return nil, domain.Page{}, err
 	}
-	return slice.Map(users, func(idx int, src dao.User) domain.User {
+	if !q.HasText() {
+		// 只有过滤条件，这些条件都是针对文章的
+		return []domain.User{}, domain.Page{}, nil
+	}
+	hits, err := u.dao.Search(ctx, dao.UserQuery{Query: toDAOQuery(q)}, opts)
+	if err != nil {
+		return nil, domain.Page{}, err
+	}
+	return slice.Map(hits.Items, func(idx int, src dao.User) domain.User {
 		return domain.User{
 			Id:       src.Id,
 			Email:    src.Email,
 			Nickname: src.Nickname,
 			Phone:    src.Phone,
 		}
+	}), toPage(hits, opt.Limit), nil
+}
+
+func (u *userRepository) SuggestUser(ctx context.Context, prefix string, limit int) ([]domain.User, error) {
+	users, err := u.dao.Suggest(ctx, prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(users, func(idx int, src dao.User) domain.User {
+		return domain.User{
+			Id:       src.Id,
+			Nickname: src.Nickname,
+		}
 	}), nil
 }
 
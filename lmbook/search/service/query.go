@@ -0,0 +1,171 @@
+package service
+
+import (
+	"basic-go/lmbook/search/domain"
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var ErrQuerySyntax = errors.New("搜索表达式语法错误")
+
+// dateLayout before: 和 after: 的日期格式
+const dateLayout = "2006-01-02"
+
+// ParseQuery 解析搜索表达式，支持的语法：
+//
+//	Go 并发         普通关键字，空格分割
+//	"Go 并发"       短语，要求完整出现
+//	-广告 -"标题党"  排除
+//	author:123     作者，可以是 id 也可以是昵称，昵称有空格的话用引号括起来
+//	tag:Go         标签
+//	after:2024-01-01 before:2024-02-01  按照更新时间过滤，after 包含当天，before 不包含
+//
+// 不认识的 xxx: 会被当成普通关键字，比如说搜索一个 URL
+func ParseQuery(expression string) (domain.Query, error) {
+	var q domain.Query
+	p := queryParser{src: []rune(expression)}
+	for {
+		p.skipSpace()
+		if p.eof() {
+			return q, nil
+		}
+		exclude := false
+		if p.peek() == '-' {
+			exclude = true
+			p.pos++
+		}
+		field, val, quoted, err := p.term()
+		if err != nil {
+			return domain.Query{}, err
+		}
+		if exclude {
+			// -author: 这种暂时不支持，直接整个排除掉
+			if field != "" {
+				val = field + ":" + val
+			}
+			if val != "" {
+				q.Excludes = append(q.Excludes, val)
+			}
+			continue
+		}
+		switch field {
+		case "":
+			if val == "" {
+				continue
+			}
+			if quoted {
+				q.Phrases = append(q.Phrases, val)
+			} else {
+				q.Keywords = append(q.Keywords, val)
+			}
+		case "author":
+			q.Authors = append(q.Authors, val)
+		case "tag":
+			q.Tags = append(q.Tags, val)
+		case "before":
+			q.Before, err = time.ParseInLocation(dateLayout, val, time.Local)
+		case "after":
+			q.After, err = time.ParseInLocation(dateLayout, val, time.Local)
+		}
+		if err != nil {
+			return domain.Query{}, errors.Join(ErrQuerySyntax, err)
+		}
+	}
+}
+
+// parseQueryOrPlain 解析不了就退化成普通的关键字匹配，
+// 毕竟绝大多数用户根本不知道有这些语法，没必要报错
+func parseQueryOrPlain(expression string) domain.Query {
+	q, err := ParseQuery(expression)
+	if err == nil {
+		return q
+	}
+	return domain.Query{Keywords: strings.Fields(expression)}
+}
+
+type queryParser struct {
+	src []rune
+	pos int
+}
+
+func (p *queryParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *queryParser) peek() rune {
+	return p.src[p.pos]
+}
+
+func (p *queryParser) skipSpace() {
+	for !p.eof() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+// term 读取一个词，可能是 field:value 的形式
+func (p *queryParser) term() (field string, val string, quoted bool, err error) {
+	if !p.eof() && p.peek() == '"' {
+		val, err = p.quoted()
+		return "", val, true, err
+	}
+	start := p.pos
+	for !p.eof() && !unicode.IsSpace(p.peek()) {
+		if p.peek() == ':' && isField(string(p.src[start:p.pos])) {
+			field = string(p.src[start:p.pos])
+			p.pos++
+			val, quoted, err = p.value()
+			if err == nil && val == "" {
+				err = ErrQuerySyntax
+			}
+			return field, val, quoted, err
+		}
+		if p.peek() == '"' {
+			// 词的中间出现引号，比如说 abc"def
+			return "", "", false, ErrQuerySyntax
+		}
+		p.pos++
+	}
+	return "", string(p.src[start:p.pos]), false, nil
+}
+
+func (p *queryParser) value() (string, bool, error) {
+	if !p.eof() && p.peek() == '"' {
+		val, err := p.quoted()
+		return val, true, err
+	}
+	start := p.pos
+	for !p.eof() && !unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+	return string(p.src[start:p.pos]), false, nil
+}
+
+// quoted 读取引号括起来的部分，不支持转义
+func (p *queryParser) quoted() (string, error) {
+	// 跳过左引号
+	p.pos++
+	start := p.pos
+	for !p.eof() && p.peek() != '"' {
+		p.pos++
+	}
+	if p.eof() {
+		return "", ErrQuerySyntax
+	}
+	val := strings.TrimSpace(string(p.src[start:p.pos]))
+	// 跳过右引号
+	p.pos++
+	if !p.eof() && !unicode.IsSpace(p.peek()) {
+		return "", ErrQuerySyntax
+	}
+	return val, nil
+}
+
+func isField(name string) bool {
+	switch name {
+	case "author", "tag", "before", "after":
+		return true
+	}
+	return false
+}
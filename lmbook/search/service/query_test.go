@@ -0,0 +1,75 @@
+package service
+
+import (
+	"basic-go/lmbook/search/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		wantQuery  domain.Query
+		wantErr    error
+	}{
+		{
+			name:       "普通关键字",
+			expression: "Go  并发 ",
+			wantQuery:  domain.Query{Keywords: []string{"Go", "并发"}},
+		},
+		{
+			name:       "短语和排除",
+			expression: `"Go 并发" -广告 -"标题 党"`,
+			wantQuery: domain.Query{
+				Phrases:  []string{"Go 并发"},
+				Excludes: []string{"广告", "标题 党"},
+			},
+		},
+		{
+			name:       "字段过滤",
+			expression: `Go author:123 author:"Tom White" tag:后端 after:2024-01-01 before:2024-02-01`,
+			wantQuery: domain.Query{
+				Keywords: []string{"Go"},
+				Authors:  []string{"123", "Tom White"},
+				Tags:     []string{"后端"},
+				After:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local),
+				Before:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local),
+			},
+		},
+		{
+			name:       "不认识的字段当成关键字",
+			expression: "http://example.com",
+			wantQuery:  domain.Query{Keywords: []string{"http://example.com"}},
+		},
+		{
+			name:       "引号没有闭合",
+			expression: `"Go 并发`,
+			wantErr:    ErrQuerySyntax,
+		},
+		{
+			name:       "字段没有值",
+			expression: "tag: Go",
+			wantErr:    ErrQuerySyntax,
+		},
+		{
+			name:       "日期格式不对",
+			expression: "before:昨天",
+			wantErr:    ErrQuerySyntax,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			q, err := ParseQuery(tc.expression)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantQuery, q)
+		})
+	}
+}
+
+func TestParseQueryOrPlain(t *testing.T) {
+	q := parseQueryOrPlain(`"Go 并发 tag:`)
+	assert.Equal(t, domain.Query{Keywords: []string{`"Go`, "并发", "tag:"}}, q)
+}
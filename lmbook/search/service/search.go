@@ -4,13 +4,24 @@ import (
 	"basic-go/lmbook/search/domain"
 	"basic-go/lmbook/search/repository"
 	"context"
-	"strings"
+	"errors"
 
 	"golang.org/x/sync/errgroup"
 )
 
+var ErrDeepPaging = errors.New("翻页太深，请使用游标翻页")
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	// ES 默认的 max_result_window，再往后只能用 search_after
+	maxResultWindow = 10000
+)
+
 type SearchService interface {
-	Search(ctx context.Context, uid int64, expression string) (domain.SearchResult, error)
+	Search(ctx context.Context, uid int64, expression string, opt domain.SearchOption) (domain.SearchResult, error)
+	// Suggest 输入提示，limit 是每一种提示的数量
+	Suggest(ctx context.Context, uid int64, prefix string, limit int) (domain.Suggestions, error)
 }
 
 type searchService struct {
@@ -22,24 +33,43 @@ func NewSearchService(userRepo repository.UserRepository, articleRepo repository
 	return &searchService{userRepo: userRepo, articleRepo: articleRepo}
 }
 
-func (s *searchService) Search(ctx context.Context, uid int64, expression string) (domain.SearchResult, error) {
-	// 这边一般要对 expression 进行一些预处理
-	// 正常大家都是使用的空格符来分割的，但是有些时候可能会手抖，输错
-	keywords := strings.Split(expression, " ")
+func (s *searchService) Search(ctx context.Context, uid int64, expression string, opt domain.SearchOption) (domain.SearchResult, error) {
+	opt, err := s.normalize(opt)
+	if err != nil {
+		return domain.SearchResult{}, err
+	}
+	// 解析不了的就当成普通关键字，用户手抖输错了也能搜出东西
+	q := parseQueryOrPlain(expression)
 	// 注意这里我们没有使用 multi query 或者 multi match 之类的写法
 	// 是因为正常来说，不同的业务放过来的数据，什么支持搜索，什么不支持搜索，
 	// 以及究竟怎么用于搜索，都是有区别的。所以这里我们利用两个 repo 来组合结果
 	var eg errgroup.Group
 	var res domain.SearchResult
 	eg.Go(func() error {
-		users, err := s.userRepo.SearchUser(ctx, keywords)
-		res.Users = users
-		return err
+		var er error
+		res.Users, res.UserPage, er = s.userRepo.SearchUser(ctx, q, opt.UserPage())
+		return er
 	})
 	eg.Go(func() error {
-		arts, err := s.articleRepo.SearchArticle(ctx, uid, keywords)
-		res.Articles = arts
-		return err
+		var er error
+		res.Articles, res.ArticlePage, er = s.articleRepo.SearchArticle(ctx, uid, q, opt.ArticlePage())
+		return er
 	})
 	return res, eg.Wait()
 }
+
+func (s *searchService) normalize(opt domain.SearchOption) (domain.SearchOption, error) {
+	if opt.Limit <= 0 {
+		opt.Limit = defaultPageSize
+	}
+	if opt.Limit > maxPageSize {
+		opt.Limit = maxPageSize
+	}
+	if opt.Offset < 0 {
+		opt.Offset = 0
+	}
+	if opt.Offset+opt.Limit > maxResultWindow {
+		return opt, ErrDeepPaging
+	}
+	return opt, nil
+}
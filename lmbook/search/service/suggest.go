@@ -0,0 +1,48 @@
+package service
+
+import (
+	"basic-go/lmbook/search/domain"
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultSuggestSize = 5
+	maxSuggestSize     = 10
+	// 太长的前缀基本不可能命中，也没必要提示了
+	maxPrefixLen = 50
+)
+
+func (s *searchService) Suggest(ctx context.Context, uid int64, prefix string, limit int) (domain.Suggestions, error) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" || utf8.RuneCountInString(prefix) > maxPrefixLen {
+		return domain.Suggestions{}, nil
+	}
+	if limit <= 0 {
+		limit = defaultSuggestSize
+	}
+	if limit > maxSuggestSize {
+		limit = maxSuggestSize
+	}
+	var eg errgroup.Group
+	var res domain.Suggestions
+	eg.Go(func() error {
+		var er error
+		res.Articles, er = s.articleRepo.SuggestArticle(ctx, prefix, limit)
+		return er
+	})
+	eg.Go(func() error {
+		var er error
+		res.Users, er = s.userRepo.SuggestUser(ctx, prefix, limit)
+		return er
+	})
+	eg.Go(func() error {
+		var er error
+		res.Tags, er = s.articleRepo.SuggestTag(ctx, uid, prefix, limit)
+		return er
+	})
+	return res, eg.Wait()
+}
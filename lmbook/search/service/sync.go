@@ -4,12 +4,16 @@ import (
 	"basic-go/lmbook/search/domain"
 	"basic-go/lmbook/search/repository"
 	"context"
+	"errors"
 )
 
+var ErrUnsupportedBiz = errors.New("不支持的业务")
+
 type SyncService interface {
 	InputArticle(ctx context.Context, article domain.Article) error
 	InputUser(ctx context.Context, user domain.User) error
 	InputAny(ctx context.Context, idxName, docID, data string) error
+	InputPopularity(ctx context.Context, p domain.Popularity) error
 }
 
 type syncService struct {
@@ -26,6 +30,14 @@ func (s *syncService) InputArticle(ctx context.Context, article domain.Article)
 	return s.articleRepo.InputArticle(ctx, article)
 }
 
+func (s *syncService) InputPopularity(ctx context.Context, p domain.Popularity) error {
+	// 目前只有文章用得上热度
+	if p.Biz != "article" {
+		return ErrUnsupportedBiz
+	}
+	return s.articleRepo.InputPopularity(ctx, p)
+}
+
 func (s *syncService) InputUser(ctx context.Context, user domain.User) error {
 	return s.userRepo.InputUser(ctx, user)
 }
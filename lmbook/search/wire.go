@@ -13,10 +13,10 @@ import (
 )
 
 var serviceProviderSet = wire.NewSet(
-	dao.NewUserElasticDAO,
-	dao.NewArticleElasticDAO,
-	dao.NewAnyESDAO,
-	dao.NewTagESDAO,
+	dao.NewUserDAO,
+	dao.NewArticleDAO,
+	dao.NewAnyDAO,
+	dao.NewTagDAO,
 	repository.NewUserRepository,
 	repository.NewArticleRepository,
 	repository.NewAnyRepository,
@@ -25,10 +25,11 @@ var serviceProviderSet = wire.NewSet(
 )
 
 var thirdProvider = wire.NewSet(
-	ioc.InitESClient,
+	ioc.InitBackend,
 	ioc.InitEtcdClient,
 	ioc.InitLogger,
-	ioc.InitKafka)
+	ioc.InitKafka,
+	ioc.InitArticleClient)
 
 func Init() *App {
 	wire.Build(
@@ -38,7 +39,9 @@ func Init() *App {
 		grpc.NewSearchService,
 		events.NewUserConsumer,
 		events.NewArticleConsumer,
+		events.NewPopularityConsumer,
 		ioc.InitGRPCxServer,
+		ioc.InitAdminServer,
 		ioc.NewConsumers,
 		wire.Struct(new(App), "*"),
 	)
@@ -19,14 +19,14 @@ import (
 // Injectors from wire.go:
 
 func Init() *App {
-	client := ioc.InitESClient()
-	anyDAO := dao.NewAnyESDAO(client)
+	backend := ioc.InitBackend()
+	anyDAO := dao.NewAnyDAO(backend)
 	anyRepository := repository.NewAnyRepository(anyDAO)
-	userDAO := dao.NewUserElasticDAO(client)
+	userDAO := dao.NewUserDAO(backend)
 	userRepository := repository.NewUserRepository(userDAO)
-	articleDAO := dao.NewArticleElasticDAO(client)
-	tagDAO := dao.NewTagESDAO(client)
-	articleRepository := repository.NewArticleRepository(articleDAO, tagDAO)
+	articleDAO := dao.NewArticleDAO(backend)
+	tagDAO := dao.NewTagDAO(backend)
+	articleRepository := repository.NewArticleRepository(articleDAO, tagDAO, userDAO)
 	syncService := service.NewSyncService(anyRepository, userRepository, articleRepository)
 	syncServiceServer := grpc.NewSyncServiceServer(syncService)
 	searchService := service.NewSearchService(userRepository, articleRepository)
@@ -37,16 +37,20 @@ func Init() *App {
 	saramaClient := ioc.InitKafka()
 	articleConsumer := events.NewArticleConsumer(saramaClient, loggerV1, syncService)
 	userConsumer := events.NewUserConsumer(saramaClient, loggerV1, syncService)
-	v := ioc.NewConsumers(articleConsumer, userConsumer)
+	popularityConsumer := events.NewPopularityConsumer(saramaClient, loggerV1, syncService)
+	v := ioc.NewConsumers(articleConsumer, userConsumer, popularityConsumer)
+	articleServiceClient := ioc.InitArticleClient(clientv3Client)
+	ginxServer := ioc.InitAdminServer(loggerV1, backend, saramaClient, articleServiceClient)
 	app := &App{
-		server:    server,
-		consumers: v,
+		server:      server,
+		consumers:   v,
+		adminServer: ginxServer,
 	}
 	return app
 }
 
 // wire.go:
 
-var serviceProviderSet = wire.NewSet(dao.NewUserElasticDAO, dao.NewArticleElasticDAO, dao.NewAnyESDAO, dao.NewTagESDAO, repository.NewUserRepository, repository.NewArticleRepository, repository.NewAnyRepository, service.NewSyncService, service.NewSearchService)
+var serviceProviderSet = wire.NewSet(dao.NewUserDAO, dao.NewArticleDAO, dao.NewAnyDAO, dao.NewTagDAO, repository.NewUserRepository, repository.NewArticleRepository, repository.NewAnyRepository, service.NewSyncService, service.NewSearchService)
 
-var thirdProvider = wire.NewSet(ioc.InitESClient, ioc.InitEtcdClient, ioc.InitLogger, ioc.InitKafka)
+var thirdProvider = wire.NewSet(ioc.InitBackend, ioc.InitEtcdClient, ioc.InitLogger, ioc.InitKafka, ioc.InitArticleClient)
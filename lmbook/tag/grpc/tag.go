@@ -60,6 +60,26 @@ func (t *TagServiceServer) GetBizTags(ctx context.Context, req *tagv1.GetBizTags
 	}, nil
 }
 
+func (t *TagServiceServer) BatchGetBizTags(ctx context.Context, req *tagv1.BatchGetBizTagsRequest) (*tagv1.BatchGetBizTagsResponse, error) {
+	owners := make(map[int64]int64, len(req.GetTargets()))
+	for _, target := range req.GetTargets() {
+		owners[target.GetBizId()] = target.GetUid()
+	}
+	res, err := t.service.GetBizTagsByIds(ctx, req.GetBiz(), owners)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[int64]*tagv1.BizTags, len(res))
+	for bizId, ts := range res {
+		tags[bizId] = &tagv1.BizTags{
+			Tags: slice.Map(ts, func(idx int, src domain.Tag) *tagv1.Tag {
+				return t.toDTO(src)
+			}),
+		}
+	}
+	return &tagv1.BatchGetBizTagsResponse{Tags: tags}, nil
+}
+
 func (t *TagServiceServer) toDTO(tag domain.Tag) *tagv1.Tag {
 	return &tagv1.Tag{
 		Id:   tag.Id,
@@ -33,6 +33,8 @@ type TagDAO interface {
 	CreateTagBiz(ctx context.Context, tagBiz []TagBiz) error
 	GetTagsByUid(ctx context.Context, uid int64) ([]Tag, error)
 	GetTagsByBiz(ctx context.Context, uid int64, biz string, bizId int64) ([]Tag, error)
+	// GetTagsByBizIds owners 是 bizId => uid，只返回对应的用户打的标签
+	GetTagsByBizIds(ctx context.Context, biz string, owners map[int64]int64) (map[int64][]Tag, error)
 	GetTags(ctx context.Context, offset, limit int) ([]Tag, error)
 	GetTagsById(ctx context.Context, ids []int64) ([]Tag, error)
 }
@@ -94,6 +96,32 @@ func (dao *GORMTagDAO) GetTagsByBiz(ctx context.Context, uid int64, biz string,
 	}), err
 }
 
+func (dao *GORMTagDAO) GetTagsByBizIds(ctx context.Context, biz string, owners map[int64]int64) (map[int64][]Tag, error) {
+	if len(owners) == 0 {
+		return map[int64][]Tag{}, nil
+	}
+	bizIds := make([]int64, 0, len(owners))
+	for bizId := range owners {
+		bizIds = append(bizIds, bizId)
+	}
+	var res []TagBiz
+	err := dao.db.WithContext(ctx).Model(&TagBiz{}).
+		InnerJoins("Tag", dao.db.Model(&Tag{})).
+		Where("biz = ? AND biz_id IN ?", biz, bizIds).Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	// 每个资源的用户都不一样，没办法写在 WHERE 里面，查出来再过滤
+	tags := make(map[int64][]Tag, len(owners))
+	for _, tb := range res {
+		if tb.Tag.Uid != owners[tb.BizId] {
+			continue
+		}
+		tags[tb.BizId] = append(tags[tb.BizId], *tb.Tag)
+	}
+	return tags, nil
+}
+
 func (dao *GORMTagDAO) GetTags(ctx context.Context, offset, limit int) ([]Tag, error) {
 	var res []Tag
 	err := dao.db.WithContext(ctx).Offset(offset).Limit(limit).Find(&res).Error
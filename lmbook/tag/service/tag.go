@@ -15,6 +15,8 @@ type TagService interface {
 	AttachTags(ctx context.Context, uid int64, biz string, bizId int64, tags []int64) error
 	GetTags(ctx context.Context, uid int64) ([]domain.Tag, error)
 	GetBizTags(ctx context.Context, uid int64, biz string, bizId int64) ([]domain.Tag, error)
+	// GetBizTagsByIds owners 是 bizId => uid，每个资源只返回对应的用户打的标签
+	GetBizTagsByIds(ctx context.Context, biz string, owners map[int64]int64) (map[int64][]domain.Tag, error)
 }
 
 type tagService struct {
@@ -59,6 +61,10 @@ func (svc *tagService) GetBizTags(ctx context.Context, uid int64, biz string, bi
 	return svc.repo.GetBizTags(ctx, uid, biz, bizId)
 }
 
+func (svc *tagService) GetBizTagsByIds(ctx context.Context, biz string, owners map[int64]int64) (map[int64][]domain.Tag, error) {
+	return svc.repo.GetBizTagsByIds(ctx, biz, owners)
+}
+
 func (svc *tagService) CreateTag(ctx context.Context, uid int64, name string) (int64, error) {
 	return svc.repo.CreateTag(ctx, domain.Tag{
 		Uid:  uid,